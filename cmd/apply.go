@@ -4,7 +4,6 @@ import (
 	"os"
 
 	"github.com/sirupsen/logrus"
-	"github.com/updatecli/updatecli/pkg/core/engine/manifest"
 
 	"github.com/spf13/cobra"
 )
@@ -27,22 +26,19 @@ var (
 		Short: "apply checks if an update is needed then apply the changes",
 		Run: func(cmd *cobra.Command, args []string) {
 			policyReferences = args
-			err := getPolicyFilesFromRegistry()
+			manifests, err := getPolicyManifests()
 			if err != nil {
 				logrus.Errorf("command failed: %s", err)
 				os.Exit(1)
 			}
 
-			e.Options.Manifests = append(e.Options.Manifests, manifest.Manifest{
-				Manifests: manifestFiles,
-				Values:    valuesFiles,
-				Secrets:   secretsFiles,
-			})
+			e.Options.Manifests = append(e.Options.Manifests, manifests...)
 
 			e.Options.Pipeline.Target.Commit = applyCommit
 			e.Options.Pipeline.Target.Push = applyPush
 			e.Options.Pipeline.Target.Clean = applyClean
 			e.Options.Pipeline.Target.DryRun = false
+			e.Options.Parallelism = parallelism
 
 			err = run("apply")
 			if err != nil {
@@ -62,5 +58,7 @@ func init() {
 	applyCmd.Flags().BoolVarP(&applyCommit, "commit", "", true, "Record changes to the repository, '--commit=false'")
 	applyCmd.Flags().BoolVarP(&applyPush, "push", "", true, "Update remote refs '--push=false'")
 	applyCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	applyCmd.Flags().StringArrayVar(&policyVerifyKeys, "verify-key", []string{}, "Sets public key used to verify policy signatures like '--verify-key=cosign.pub', policies without a valid signature are refused")
+	applyCmd.Flags().IntVar(&parallelism, "parallelism", 1, "Sets the maximum number of pipelines executed concurrently like '--parallelism=4'")
 	applyCmd.Flags().BoolVar(&applyClean, "clean", false, "Remove updatecli working directory like '--clean=true'")
 }
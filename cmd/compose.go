@@ -12,6 +12,8 @@ var (
 	composeCmdDisablePrepare bool
 	// composeCmdDisableTemplating represents the compose disable templating flag to enable or disable the templating stage
 	composeCmdDisableTemplating bool
+	// composeCmdFrozen represents the compose frozen flag to require every policy to be locked
+	composeCmdFrozen bool
 	// composeCmdFile represents the compose filename
 	composeCmdFile string
 	// composeDefaultCmdFile represents the default compose filename
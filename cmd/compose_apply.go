@@ -24,7 +24,7 @@ var (
 				os.Exit(1)
 			}
 
-			policies, err := c.GetPolicies(disableTLS)
+			policies, err := c.GetPolicies(disableTLS, policyVerifyKeys, composeCmdFrozen)
 			if err != nil {
 				logrus.Errorf("command failed: %s", err)
 				os.Exit(1)
@@ -36,6 +36,7 @@ var (
 			e.Options.Pipeline.Target.Push = composeApplyPush
 			e.Options.Pipeline.Target.Clean = composeApplyClean
 			e.Options.Pipeline.Target.DryRun = false
+			e.Options.Parallelism = parallelism
 
 			err = run("compose/apply")
 			if err != nil {
@@ -52,6 +53,9 @@ func init() {
 	composeApplyCmd.Flags().BoolVarP(&composeApplyCommit, "commit", "", true, "Record changes to the repository, '--commit=false'")
 	composeApplyCmd.Flags().BoolVarP(&composeApplyPush, "push", "", true, "Update remote refs '--push=false'")
 	composeApplyCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	composeApplyCmd.Flags().BoolVar(&composeCmdFrozen, "frozen", false, "Require every policy to be pinned by the compose lock file like '--frozen=true'")
+	composeApplyCmd.Flags().StringArrayVar(&policyVerifyKeys, "verify-key", []string{}, "Sets public key used to verify policy signatures like '--verify-key=cosign.pub', policies without a valid signature are refused")
+	composeApplyCmd.Flags().IntVar(&parallelism, "parallelism", 1, "Sets the maximum number of pipelines executed concurrently like '--parallelism=4'")
 	composeApplyCmd.Flags().BoolVar(&composeApplyClean, "clean", false, "Remove updatecli working directory like '--clean=true'")
 
 	composeCmd.AddCommand(composeApplyCmd)
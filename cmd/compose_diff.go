@@ -20,7 +20,7 @@ var (
 				os.Exit(1)
 			}
 
-			policies, err := c.GetPolicies(disableTLS)
+			policies, err := c.GetPolicies(disableTLS, policyVerifyKeys, composeCmdFrozen)
 			if err != nil {
 				logrus.Errorf("command failed: %s", err)
 				os.Exit(1)
@@ -32,6 +32,7 @@ var (
 			e.Options.Pipeline.Target.Push = false
 			e.Options.Pipeline.Target.Clean = composeCmdClean
 			e.Options.Pipeline.Target.DryRun = true
+			e.Options.Parallelism = parallelism
 
 			err = run("compose/diff")
 			if err != nil {
@@ -46,6 +47,9 @@ func init() {
 	composeDiffCmd.Flags().StringVarP(&composeCmdFile, "file", "f", composeDefaultCmdFile, "Define the Updatecli compose file name")
 	composeDiffCmd.Flags().BoolVar(&composeCmdClean, "clean", false, "Remove updatecli working directory like '--clean=true'")
 	composeDiffCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	composeDiffCmd.Flags().BoolVar(&composeCmdFrozen, "frozen", false, "Require every policy to be pinned by the compose lock file like '--frozen=true'")
+	composeDiffCmd.Flags().StringArrayVar(&policyVerifyKeys, "verify-key", []string{}, "Sets public key used to verify policy signatures like '--verify-key=cosign.pub', policies without a valid signature are refused")
+	composeDiffCmd.Flags().IntVar(&parallelism, "parallelism", 1, "Sets the maximum number of pipelines executed concurrently like '--parallelism=4'")
 
 	composeCmd.AddCommand(composeDiffCmd)
 }
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/updatecli/updatecli/pkg/core/compose"
+)
+
+var (
+	composeLockCmd = &cobra.Command{
+		Use:   "lock",
+		Short: "lock resolves policies defined by the compose file to digests and writes them to the compose lock file",
+		Run: func(cmd *cobra.Command, args []string) {
+
+			c, err := compose.New(composeCmdFile)
+			if err != nil {
+				logrus.Errorf("command failed: %s", err)
+				os.Exit(1)
+			}
+
+			err = c.Lock(disableTLS)
+			if err != nil {
+				logrus.Errorf("command failed: %s", err)
+				os.Exit(1)
+			}
+		},
+	}
+)
+
+func init() {
+	composeLockCmd.Flags().StringVarP(&composeCmdFile, "file", "f", composeDefaultCmdFile, "Define the Updatecli compose file name")
+	composeLockCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+
+	composeCmd.AddCommand(composeLockCmd)
+}
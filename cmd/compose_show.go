@@ -21,7 +21,7 @@ var (
 				os.Exit(1)
 			}
 
-			policies, err := c.GetPolicies(disableTLS)
+			policies, err := c.GetPolicies(disableTLS, policyVerifyKeys, composeCmdFrozen)
 			if err != nil {
 				logrus.Errorf("command failed: %s", err)
 				os.Exit(1)
@@ -50,6 +50,8 @@ func init() {
 	composeShowCmd.Flags().BoolVar(&composeCmdDisablePrepare, "disable-prepare", false, "--disable-prepare skip the Updatecli 'prepare' stage")
 	composeShowCmd.Flags().BoolVar(&composeCmdDisableTemplating, "disable-templating", false, "Disable manifest templating")
 	composeShowCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	composeShowCmd.Flags().BoolVar(&composeCmdFrozen, "frozen", false, "Require every policy to be pinned by the compose lock file like '--frozen=true'")
+	composeShowCmd.Flags().StringArrayVar(&policyVerifyKeys, "verify-key", []string{}, "Sets public key used to verify policy signatures like '--verify-key=cosign.pub', policies without a valid signature are refused")
 
 	composeCmd.AddCommand(composeShowCmd)
 }
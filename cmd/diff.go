@@ -4,7 +4,6 @@ import (
 	"os"
 
 	"github.com/sirupsen/logrus"
-	"github.com/updatecli/updatecli/pkg/core/engine/manifest"
 
 	"github.com/spf13/cobra"
 )
@@ -18,22 +17,19 @@ var (
 		Short: "diff shows changes",
 		Run: func(cmd *cobra.Command, args []string) {
 			policyReferences = args
-			err := getPolicyFilesFromRegistry()
+			manifests, err := getPolicyManifests()
 			if err != nil {
 				logrus.Errorf("command failed: %s", err)
 				os.Exit(1)
 			}
 
-			e.Options.Manifests = append(e.Options.Manifests, manifest.Manifest{
-				Manifests: manifestFiles,
-				Values:    valuesFiles,
-				Secrets:   secretsFiles,
-			})
+			e.Options.Manifests = append(e.Options.Manifests, manifests...)
 
 			e.Options.Pipeline.Target.Commit = false
 			e.Options.Pipeline.Target.Push = false
 			e.Options.Pipeline.Target.Clean = diffClean
 			e.Options.Pipeline.Target.DryRun = true
+			e.Options.Parallelism = parallelism
 
 			err = run("diff")
 			if err != nil {
@@ -51,4 +47,6 @@ func init() {
 	diffCmd.Flags().StringArrayVar(&secretsFiles, "secrets", []string{}, "Sets Sops secrets file uses for templating")
 	diffCmd.Flags().BoolVar(&diffClean, "clean", false, "Remove updatecli working directory like '--clean=true'")
 	diffCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	diffCmd.Flags().StringArrayVar(&policyVerifyKeys, "verify-key", []string{}, "Sets public key used to verify policy signatures like '--verify-key=cosign.pub', policies without a valid signature are refused")
+	diffCmd.Flags().IntVar(&parallelism, "parallelism", 1, "Sets the maximum number of pipelines executed concurrently like '--parallelism=4'")
 }
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	// manifestExportPolicyReferences is the list of OCI registry references to export
+	manifestExportPolicyReferences []string
+	// manifestExportOutput is the path to the OCI layout tarball to write
+	manifestExportOutput string
+
+	// manifestExportCmd is the Cobra command to export policies into an OCI layout tarball
+	manifestExportCmd = &cobra.Command{
+		Args:  cobra.MatchAll(cobra.MinimumNArgs(1)),
+		Use:   "export NAME[:TAG|@DIGEST]...",
+		Short: "export policies from OCI registries into an OCI layout tarball",
+		Run: func(cmd *cobra.Command, args []string) {
+			manifestExportPolicyReferences = args
+
+			err := run("manifest/export")
+			if err != nil {
+				logrus.Errorf("command failed: %s", err)
+				os.Exit(1)
+			}
+		},
+	}
+)
+
+func init() {
+	manifestExportCmd.Flags().StringVarP(&manifestExportOutput, "output", "o", "updatecli-policies.tar", "Sets the OCI layout tarball to write like '--output=policies.tar'")
+	manifestExportCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+
+	manifestCmd.AddCommand(manifestExportCmd)
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	// manifestImportBundle is the path to the OCI layout tarball to import
+	manifestImportBundle string
+	// manifestImportPrune removes previously imported policies
+	manifestImportPrune bool
+
+	// manifestImportCmd is the Cobra command to import policies from an OCI layout tarball
+	manifestImportCmd = &cobra.Command{
+		Args:  cobra.MatchAll(cobra.MaximumNArgs(1)),
+		Use:   "import [BUNDLE]",
+		Short: "import policies from an OCI layout tarball, imported policies are used when OCI registries can't be reached",
+		Long: `import policies from an OCI layout tarball.
+
+Imported policies are stored in the "updatecli/bundle" directory of the user cache directory.
+They are only used when the OCI registry of a policy can't be reached, including to resolve
+the latest version of a policy, until they are removed using "--prune".`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 && !manifestImportPrune {
+				logrus.Errorf("command failed: a bundle is required unless --prune is set")
+				os.Exit(1)
+			}
+
+			manifestImportBundle = ""
+			if len(args) > 0 {
+				manifestImportBundle = args[0]
+			}
+
+			err := run("manifest/import")
+			if err != nil {
+				logrus.Errorf("command failed: %s", err)
+				os.Exit(1)
+			}
+		},
+	}
+)
+
+func init() {
+	manifestImportCmd.Flags().BoolVar(&manifestImportPrune, "prune", false, "Remove previously imported policies before importing the bundle, if any, like '--prune=true'")
+
+	manifestCmd.AddCommand(manifestImportCmd)
+}
@@ -30,5 +30,6 @@ var (
 
 func init() {
 	manifestPullCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	manifestPullCmd.Flags().StringArrayVar(&policyVerifyKeys, "verify-key", []string{}, "Sets public key used to verify policy signatures like '--verify-key=cosign.pub', policies without a valid signature are refused")
 	manifestCmd.AddCommand(manifestPullCmd)
 }
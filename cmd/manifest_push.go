@@ -17,6 +17,8 @@ var (
 	manifestPushPolicyFile string
 	// manifestPushOverwrite is a boolean to overwrite existing manifest(s) in the registry
 	manifestPushOverwrite bool
+	// manifestPushSignKey is the path to the private key used to sign the pushed manifest(s)
+	manifestPushSignKey string
 
 	// manifestPushCmd is the Cobra command to push OCI registry manifest(s)
 	manifestPushCmd = &cobra.Command{
@@ -59,6 +61,7 @@ func init() {
 	manifestPushCmd.Flags().StringArrayVarP(&manifestPushPolicyReference, "tag", "t", []string{}, `Name and optionally a tag (format: "name:tag")`)
 	manifestPushCmd.Flags().StringArrayVar(&secretsFiles, "secrets", []string{}, "Sets secrets file uses for templating")
 	manifestPushCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	manifestPushCmd.Flags().StringVar(&manifestPushSignKey, "sign-key", "", "Sets the private key used to sign the pushed policy like '--sign-key=cosign.key', encrypted cosign keys are decrypted using the COSIGN_PASSWORD environment variable")
 	manifestPushCmd.Flags().BoolVar(&manifestPushOverwrite, "overwrite", false, "Overwrite existing manifest(s) in the registry like '--overwrite=true'")
 
 	manifestCmd.AddCommand(manifestPushCmd)
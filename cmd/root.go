@@ -1,12 +1,15 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/slices"
 
 	"github.com/updatecli/updatecli/pkg/core/cmdoptions"
+	"github.com/updatecli/updatecli/pkg/core/engine/manifest"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
 	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/registry"
 	"github.com/updatecli/updatecli/pkg/core/udash"
@@ -26,6 +29,9 @@ var (
 	verbose          bool
 	experimental     bool
 	disableTLS       bool
+	policyVerifyKeys []string
+	httpRetryJitter  bool
+	parallelism      int
 
 	rootCmd = &cobra.Command{
 		Use:   "updatecli",
@@ -55,7 +61,15 @@ func Execute() {
 func init() {
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "debug", "", false, "Debug Output")
 	rootCmd.PersistentFlags().BoolVarP(&experimental, "experimental", "", false, "Enable Experimental mode")
-	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+	rootCmd.PersistentFlags().StringVar(&httpclient.CacheDirectory, "http-cache-dir", "", "Sets the directory used to cache http responses like '--http-cache-dir=.cache/updatecli', caching is disabled by default")
+	rootCmd.PersistentFlags().DurationVar(&httpclient.CacheTTL, "http-cache-ttl", 0, "Sets how long cached http responses without caching headers are considered fresh like '--http-cache-ttl=1h'")
+	rootCmd.PersistentFlags().IntVar(&httpclient.DefaultRetryPolicy.MaxAttempts, "http-retry-max-attempts", 0, "Sets the maximum number of attempts for a failed http request like '--http-retry-max-attempts=5', default to 4")
+	rootCmd.PersistentFlags().StringVar(&httpclient.DefaultRetryPolicy.BaseDelay, "http-retry-base-delay", "", "Sets the delay before the first http retry, doubled after each attempt, like '--http-retry-base-delay=2s', default to 1s")
+	rootCmd.PersistentFlags().StringVar(&httpclient.DefaultRetryPolicy.MaxDelay, "http-retry-max-delay", "", "Sets the maximum delay between two http attempts like '--http-retry-max-delay=1m', default to 30s")
+	rootCmd.PersistentFlags().BoolVar(&httpRetryJitter, "http-retry-jitter", false, "Randomizes the delay between two http attempts")
+	rootCmd.PersistentFlags().IntSliceVar(&httpclient.DefaultRetryPolicy.StatusCodes, "http-retry-status-codes", nil, "Sets the http status codes triggering a retry like '--http-retry-status-codes=429,502,503,504'")
+	rootCmd.PersistentFlags().StringVar(&httpclient.DefaultRetryPolicy.Deadline, "http-retry-deadline", "", "Sets the maximum time spent on an http request, retries included, like '--http-retry-deadline=2m'")
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
 		if verbose {
 			logrus.SetLevel(logrus.DebugLevel)
 		}
@@ -63,6 +77,14 @@ func init() {
 			cmdoptions.Experimental = true
 			logrus.Infof("Experimental Mode Enabled")
 		}
+		// Only set jitter when requested so resources can still enable it
+		if cmd.Flags().Changed("http-retry-jitter") {
+			httpclient.DefaultRetryPolicy.Jitter = &httpRetryJitter
+		}
+		if err := httpclient.DefaultRetryPolicy.Validate(); err != nil {
+			return fmt.Errorf("http retry policy: %w", err)
+		}
+		return nil
 	}
 	rootCmd.AddCommand(
 		applyCmd,
@@ -154,7 +176,21 @@ func run(command string) error {
 		}
 
 	case "manifest/pull":
-		err := e.PullFromRegistry(manifestPullPolicyReference, disableTLS)
+		err := e.PullFromRegistry(manifestPullPolicyReference, disableTLS, policyVerifyKeys)
+		if err != nil {
+			logrus.Errorf("%s %s", result.FAILURE, err)
+			return err
+		}
+
+	case "manifest/export":
+		err := e.ExportToBundle(manifestExportPolicyReferences, manifestExportOutput, disableTLS)
+		if err != nil {
+			logrus.Errorf("%s %s", result.FAILURE, err)
+			return err
+		}
+
+	case "manifest/import":
+		err := e.ImportFromBundle(manifestImportBundle, manifestImportPrune)
 		if err != nil {
 			logrus.Errorf("%s %s", result.FAILURE, err)
 			return err
@@ -169,7 +205,8 @@ func run(command string) error {
 			disableTLS,
 			manifestPushPolicyFile,
 			manifestPushFileStore,
-			manifestPushOverwrite)
+			manifestPushOverwrite,
+			manifestPushSignKey)
 
 		if err != nil {
 			logrus.Errorf("%s %s", result.FAILURE, err)
@@ -235,22 +272,54 @@ func run(command string) error {
 	return nil
 }
 
-func getPolicyFilesFromRegistry() error {
+// getPolicyManifests returns the manifests to execute, including the policies pulled from a registry.
+// Policies without values schema are merged with the files from the command line into a single manifest,
+// so their values and secrets are shared as they always were.
+// If any policy provides a values schema, each policy is a manifest on its own so its values are validated
+// against its own values schema. In that case, values and secrets files from the command line are provided
+// to every policy while manifests from the command line are executed along the first policy.
+func getPolicyManifests() ([]manifest.Manifest, error) {
+
+	merged := manifest.Manifest{
+		Manifests: manifestFiles,
+		Values:    valuesFiles,
+		Secrets:   secretsFiles,
+	}
 
 	if slices.Equal(policyReferences, []string{""}) || slices.Equal(policyReferences, []string{}) {
-		return nil
+		return []manifest.Manifest{merged}, nil
 	}
 
+	hasValuesSchema := false
+	manifests := []manifest.Manifest{}
 	for _, policy := range policyReferences {
-		policyManifest, policyValues, policySecrets, err := registry.Pull(policy, disableTLS)
+		policyManifest, policyValues, policySecrets, policyValuesSchema, err := registry.Pull(policy, disableTLS, policyVerifyKeys)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		manifestFiles = append(policyManifest, manifestFiles...)
-		valuesFiles = append(policyValues, valuesFiles...)
-		secretsFiles = append(policySecrets, secretsFiles...)
+		if policyValuesSchema != "" {
+			hasValuesSchema = true
+		}
+
+		// Copy the policy files so the merged manifest doesn't share them with the policy manifest
+		merged.Manifests = append(append([]string{}, policyManifest...), merged.Manifests...)
+		merged.Values = append(append([]string{}, policyValues...), merged.Values...)
+		merged.Secrets = append(append([]string{}, policySecrets...), merged.Secrets...)
+
+		manifests = append(manifests, manifest.Manifest{
+			Manifests:    policyManifest,
+			Values:       append(policyValues, valuesFiles...),
+			Secrets:      append(policySecrets, secretsFiles...),
+			ValuesSchema: policyValuesSchema,
+		})
 	}
 
-	return nil
+	if !hasValuesSchema {
+		return []manifest.Manifest{merged}, nil
+	}
+
+	manifests[0].Manifests = append(manifests[0].Manifests, manifestFiles...)
+
+	return manifests, nil
 }
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/updatecli/updatecli/pkg/core/registry"
+)
+
+// pushTestPolicy pushes a policy to the registry host, with a values schema if schema isn't empty
+func pushTestPolicy(t *testing.T, host, policy, schema string) string {
+	dir := t.TempDir()
+
+	policyMetadata := "version: 0.1.0\n"
+	if schema != "" {
+		policyMetadata += "valuesschema: values.schema.json\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "values.schema.json"), []byte(schema), 0600))
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Policy.yaml"), []byte(policyMetadata), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "updatecli.yaml"), []byte("name: "+policy+"\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "values.yaml"), []byte(policy+": true\n"), 0600))
+
+	reference := fmt.Sprintf("%s/%s:0.1.0", host, policy)
+	require.NoError(t, registry.Push(
+		filepath.Join(dir, "Policy.yaml"),
+		[]string{"updatecli.yaml"},
+		[]string{"values.yaml"},
+		nil,
+		[]string{reference},
+		true,
+		dir,
+		false,
+		""))
+
+	return reference
+}
+
+func TestGetPolicyManifests(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	server := httptest.NewServer(ggcrregistry.New(ggcrregistry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	defer func(references, manifests, values []string, tls bool) {
+		policyReferences, manifestFiles, valuesFiles, disableTLS = references, manifests, values, tls
+	}(policyReferences, manifestFiles, valuesFiles, disableTLS)
+
+	manifestFiles = []string{"local.yaml"}
+	valuesFiles = []string{"local-values.yaml"}
+	disableTLS = true
+
+	t.Run("Policies with values schema", func(t *testing.T) {
+		// Each policy defines its own values schema
+		schemas := map[string]string{
+			"golang": `{"required": ["golang"]}`,
+			"npm":    `{"required": ["npm"]}`,
+		}
+
+		policyReferences = []string{
+			pushTestPolicy(t, u.Host, "golang", schemas["golang"]),
+			pushTestPolicy(t, u.Host, "npm", schemas["npm"]),
+		}
+
+		gotManifests, err := getPolicyManifests()
+		require.NoError(t, err)
+		require.Len(t, gotManifests, 2)
+
+		for i, policy := range []string{"golang", "npm"} {
+			got := gotManifests[i]
+
+			require.NotEmpty(t, got.ValuesSchema)
+			gotSchema, err := os.ReadFile(got.ValuesSchema)
+			require.NoError(t, err)
+			assert.Equal(t, schemas[policy], string(gotSchema))
+
+			require.Len(t, got.Values, 2)
+			gotValues, err := os.ReadFile(got.Values[0])
+			require.NoError(t, err)
+			assert.Equal(t, policy+": true\n", string(gotValues))
+			assert.Equal(t, "local-values.yaml", got.Values[1])
+		}
+
+		// Manifests from the command line are only executed once
+		assert.Contains(t, gotManifests[0].Manifests, "local.yaml")
+		assert.NotContains(t, gotManifests[1].Manifests, "local.yaml")
+	})
+
+	t.Run("Policies without values schema", func(t *testing.T) {
+		policyReferences = []string{
+			pushTestPolicy(t, u.Host, "helm", ""),
+			pushTestPolicy(t, u.Host, "cargo", ""),
+		}
+
+		gotManifests, err := getPolicyManifests()
+		require.NoError(t, err)
+
+		// Policies and command line files are merged into a single manifest
+		require.Len(t, gotManifests, 1)
+		got := gotManifests[0]
+
+		assert.Empty(t, got.ValuesSchema)
+		require.Len(t, got.Manifests, 3)
+		assert.Equal(t, "local.yaml", got.Manifests[2])
+
+		require.Len(t, got.Values, 3)
+		for i, policy := range []string{"cargo", "helm"} {
+			gotValues, err := os.ReadFile(got.Values[i])
+			require.NoError(t, err)
+			assert.Equal(t, policy+": true\n", string(gotValues))
+		}
+		assert.Equal(t, "local-values.yaml", got.Values[2])
+	})
+}
@@ -0,0 +1,104 @@
+package compose
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// lockFileExtension is the extension of the Updatecli compose lock file
+	lockFileExtension = ".lock"
+	// lockFileHeader is written at the top of every Updatecli compose lock file
+	lockFileHeader = "# This file is generated by \"updatecli compose lock\", do not edit it manually.\n"
+)
+
+// Lock contains the resolved digest of every policy defined in an Updatecli compose file
+type Lock struct {
+	// Policies contains the list of locked policies
+	Policies []LockedPolicy
+}
+
+// LockedPolicy contains a policy OCI reference resolved to a specific digest
+type LockedPolicy struct {
+	// Policy contains the policy OCI reference as defined in the compose file
+	Policy string
+	// Reference contains the resolved policy OCI reference, without digest
+	Reference string
+	// Digest contains the resolved policy manifest digest
+	Digest string
+}
+
+// GetLockFilename returns the lock file name associated to an Updatecli compose file
+// such as "updatecli-compose.lock" for "updatecli-compose.yaml"
+func GetLockFilename(filename string) string {
+	ext := filepath.Ext(filename)
+	if ext == ".yaml" || ext == ".yml" {
+		return strings.TrimSuffix(filename, ext) + lockFileExtension
+	}
+	return filename + lockFileExtension
+}
+
+// LoadLockFile loads an Updatecli compose lock file
+func LoadLockFile(filename string) (*Lock, error) {
+	var lock Lock
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading Updatecli compose lock file %q: %w", filename, err)
+	}
+
+	err = yaml.Unmarshal(data, &lock)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Updatecli compose lock file %q: %w", filename, err)
+	}
+
+	return &lock, nil
+}
+
+// Save writes the lock file
+func (l Lock) Save(filename string) error {
+	data := bytes.NewBufferString(lockFileHeader)
+
+	encoder := yaml.NewEncoder(data)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(l); err != nil {
+		return fmt.Errorf("marshaling Updatecli compose lock file %q: %w", filename, err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("marshaling Updatecli compose lock file %q: %w", filename, err)
+	}
+
+	err := os.WriteFile(filename, data.Bytes(), 0600)
+	if err != nil {
+		return fmt.Errorf("writing Updatecli compose lock file %q: %w", filename, err)
+	}
+
+	return nil
+}
+
+// Get returns the locked policy for a policy OCI reference
+func (l *Lock) Get(policy string) (LockedPolicy, bool) {
+	if l == nil {
+		return LockedPolicy{}, false
+	}
+
+	for i := range l.Policies {
+		if l.Policies[i].Policy == policy {
+			return l.Policies[i], true
+		}
+	}
+
+	return LockedPolicy{}, false
+}
+
+// PinnedReference returns the OCI reference pinned to the locked digest
+func (p LockedPolicy) PinnedReference() string {
+	return p.Reference + "@" + p.Digest
+}
@@ -0,0 +1,152 @@
+package compose
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/registry"
+)
+
+func TestGetLockFilename(t *testing.T) {
+	testdata := []struct {
+		name     string
+		file     string
+		expected string
+	}{
+		{
+			name:     "Default compose file",
+			file:     "updatecli-compose.yaml",
+			expected: "updatecli-compose.lock",
+		},
+		{
+			name:     "Compose file with yml extension",
+			file:     filepath.Join("ci", "compose.yml"),
+			expected: filepath.Join("ci", "compose.lock"),
+		},
+		{
+			name:     "Compose file without extension",
+			file:     "compose",
+			expected: "compose.lock",
+		},
+	}
+
+	for _, data := range testdata {
+		t.Run(data.name, func(t *testing.T) {
+			assert.Equal(t, data.expected, GetLockFilename(data.file))
+		})
+	}
+}
+
+// pushTestPolicy pushes a policy with a specific version to an OCI registry
+func pushTestPolicy(t *testing.T, reference, version string) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Policy.yaml"), []byte("version: "+version+"\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "updatecli.yaml"), []byte("name: "+version+"\n"), 0600))
+
+	err := registry.Push(
+		filepath.Join(dir, "Policy.yaml"),
+		[]string{"updatecli.yaml"},
+		nil,
+		nil,
+		[]string{reference},
+		true,
+		dir,
+		false,
+		"")
+	require.NoError(t, err)
+}
+
+func TestLock(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	server := httptest.NewServer(ggcrregistry.New(ggcrregistry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	policy := fmt.Sprintf("%s/policy", u.Host)
+	unlockedPolicy := fmt.Sprintf("%s/unlocked", u.Host)
+
+	pushTestPolicy(t, policy, "0.1.0")
+	pushTestPolicy(t, unlockedPolicy, "0.1.0")
+
+	dir := t.TempDir()
+	composeFile := filepath.Join(dir, "updatecli-compose.yaml")
+	lockFile := filepath.Join(dir, "updatecli-compose.lock")
+
+	require.NoError(t, os.WriteFile(composeFile, []byte("policies:\n  - policy: "+policy+"\n"), 0600))
+
+	c, err := New(composeFile)
+	require.NoError(t, err)
+
+	// Frozen mode requires every policy to be locked
+	_, err = c.GetPolicies(true, nil, true)
+	require.ErrorContains(t, err, "not locked")
+
+	require.NoError(t, c.Lock(true))
+
+	lock, err := LoadLockFile(lockFile)
+	require.NoError(t, err)
+	require.Len(t, lock.Policies, 1)
+	assert.Equal(t, policy, lock.Policies[0].Policy)
+	assert.Equal(t, policy+":0.1.0", lock.Policies[0].Reference)
+	assert.True(t, strings.HasPrefix(lock.Policies[0].Digest, "sha256:"))
+
+	// A newer policy version is ignored as long as the lock file is not refreshed
+	pushTestPolicy(t, policy, "0.2.0")
+
+	c, err = New(composeFile)
+	require.NoError(t, err)
+
+	gotManifests, err := c.GetPolicies(true, nil, true)
+	require.NoError(t, err)
+	require.Len(t, gotManifests, 1)
+	require.Len(t, gotManifests[0].Manifests, 1)
+
+	lockedDir := strings.TrimPrefix(lock.Policies[0].Digest, "sha256:")
+	assert.Equal(t, lockedDir, filepath.Base(filepath.Dir(gotManifests[0].Manifests[0])))
+
+	// Policies added to the compose file are drifting from the lock file
+	require.NoError(t, os.WriteFile(composeFile, []byte("policies:\n  - policy: "+policy+"\n  - policy: "+unlockedPolicy+"\n"), 0600))
+
+	c, err = New(composeFile)
+	require.NoError(t, err)
+
+	_, err = c.GetPolicies(true, nil, true)
+	require.ErrorContains(t, err, fmt.Sprintf("policy %q not locked", unlockedPolicy))
+
+	_, err = c.GetPolicies(true, nil, false)
+	require.NoError(t, err)
+
+	// Refreshing the lock file picks the newest policy version
+	require.NoError(t, c.Lock(true))
+
+	lock, err = LoadLockFile(lockFile)
+	require.NoError(t, err)
+	require.Len(t, lock.Policies, 2)
+	assert.Equal(t, policy+":0.2.0", lock.Policies[0].Reference)
+	assert.Equal(t, unlockedPolicy+":0.1.0", lock.Policies[1].Reference)
+
+	// Policies removed from the compose file are drifting from the lock file
+	require.NoError(t, os.WriteFile(composeFile, []byte("policies:\n  - policy: "+policy+"\n"), 0600))
+
+	c, err = New(composeFile)
+	require.NoError(t, err)
+
+	_, err = c.GetPolicies(true, nil, true)
+	require.ErrorContains(t, err, fmt.Sprintf("locked policy %q not defined", unlockedPolicy))
+}
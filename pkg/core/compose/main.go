@@ -1,7 +1,9 @@
 package compose
 
 import (
+	"errors"
 	"fmt"
+	"os"
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/engine/manifest"
@@ -12,6 +14,10 @@ import (
 type Compose struct {
 	// spec contains the compose spec
 	spec Spec
+	// lockFilename contains the compose lock file name
+	lockFilename string
+	// lock contains the compose lock, if any
+	lock *Lock
 }
 
 // New creates a new Compose object
@@ -38,12 +44,77 @@ func New(filename string) (Compose, error) {
 	}
 
 	c.spec = *spec
+	c.lockFilename = GetLockFilename(filename)
+
+	lock, err := LoadLockFile(c.lockFilename)
+	switch {
+	case err == nil:
+		logrus.Infof("Loading Updatecli compose lock file: %q", c.lockFilename)
+		c.lock = lock
+	case errors.Is(err, os.ErrNotExist):
+		logrus.Debugf("No Updatecli compose lock file %q detected", c.lockFilename)
+	default:
+		return c, err
+	}
 
 	return c, nil
 }
 
+// Lock resolves every policy OCI reference defined in the compose file to a digest
+// then writes the result to the compose lock file
+func (c *Compose) Lock(disableTLS bool) error {
+	var lock Lock
+	var errs []error
+
+	for i := range c.spec.Policies {
+		policy := c.spec.Policies[i].Policy
+		if policy == "" {
+			continue
+		}
+
+		if _, found := lock.Get(policy); found {
+			continue
+		}
+
+		reference, digest, err := registry.ResolveReference(policy, disableTLS)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("resolving policy %q: %s", policy, err))
+			continue
+		}
+
+		if locked, found := c.lock.Get(policy); found && locked.Digest != digest {
+			logrus.Infof("\t* %s: %s@%s => %s@%s", policy, locked.Reference, locked.Digest, reference, digest)
+		} else {
+			logrus.Infof("\t* %s: %s@%s", policy, reference, digest)
+		}
+
+		lock.Policies = append(lock.Policies, LockedPolicy{
+			Policy:    policy,
+			Reference: reference,
+			Digest:    digest,
+		})
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("policies errors: %s", errs)
+	}
+
+	if err := lock.Save(c.lockFilename); err != nil {
+		return err
+	}
+
+	c.lock = &lock
+
+	logrus.Infof("Updatecli compose lock file %q updated", c.lockFilename)
+
+	return nil
+}
+
 // GetPolicies returns a list of policies defined in the compose file
-func (c *Compose) GetPolicies(disableTLS bool) ([]manifest.Manifest, error) {
+// If publicKeys is not empty, policies pulled from an OCI registry must be signed by one of the public keys.
+// Policies locked in the compose lock file are pulled using their locked digest,
+// if frozen is true, every policy must be locked.
+func (c *Compose) GetPolicies(disableTLS bool, publicKeys []string, frozen bool) ([]manifest.Manifest, error) {
 	var manifests []manifest.Manifest
 	var errs []error
 
@@ -55,6 +126,22 @@ func (c *Compose) GetPolicies(disableTLS bool) ([]manifest.Manifest, error) {
 		errs = append(errs, err)
 	}
 
+	// In frozen mode, the lock file must exactly match the policies defined in the compose file
+	if frozen && c.lock != nil {
+		for _, locked := range c.lock.Policies {
+			isDefined := false
+			for i := range c.spec.Policies {
+				if c.spec.Policies[i].Policy == locked.Policy {
+					isDefined = true
+					break
+				}
+			}
+			if !isDefined {
+				errs = append(errs, fmt.Errorf("locked policy %q not defined in the compose file, run \"updatecli compose lock\"", locked.Policy))
+			}
+		}
+	}
+
 	for i := range c.spec.Policies {
 		if c.spec.Policies[i].IsZero() {
 			continue
@@ -63,10 +150,25 @@ func (c *Compose) GetPolicies(disableTLS bool) ([]manifest.Manifest, error) {
 		logrus.Infof("\nInitializing policy: %q\n", c.spec.Policies[i].Name)
 
 		var policyManifest, policyValues, policySecrets []string
+		var policyValuesSchema string
 		var err error
 
 		if c.spec.Policies[i].Policy != "" {
-			policyManifest, policyValues, policySecrets, err = registry.Pull(c.spec.Policies[i].Policy, disableTLS)
+			policyReference := c.spec.Policies[i].Policy
+
+			locked, found := c.lock.Get(policyReference)
+			switch {
+			case found:
+				logrus.Debugf("Policy %q locked to %q", policyReference, locked.PinnedReference())
+				policyReference = locked.PinnedReference()
+			case frozen:
+				errs = append(errs, fmt.Errorf("policy %q not locked in %q, run \"updatecli compose lock\"", c.spec.Policies[i].Policy, c.lockFilename))
+				continue
+			case c.lock != nil:
+				logrus.Warningf("Policy %q not locked in %q", policyReference, c.lockFilename)
+			}
+
+			policyManifest, policyValues, policySecrets, policyValuesSchema, err = registry.Pull(policyReference, disableTLS, publicKeys)
 			if err != nil {
 				errs = append(errs, fmt.Errorf("pulling policy %q: %s", c.spec.Policies[i].Policy, err))
 				continue
@@ -96,9 +198,10 @@ func (c *Compose) GetPolicies(disableTLS bool) ([]manifest.Manifest, error) {
 		showDetectedFiles(policySecrets, "secret")
 
 		manifests = append(manifests, manifest.Manifest{
-			Manifests: policyManifest,
-			Values:    policyValues,
-			Secrets:   policySecrets,
+			Manifests:    policyManifest,
+			Values:       policyValues,
+			Secrets:      policySecrets,
+			ValuesSchema: policyValuesSchema,
 		})
 	}
 
@@ -13,6 +13,10 @@ type Policy struct {
 	// Name contains the policy name
 	Name string `yaml:",omitempty"`
 	// Policy contains the policy OCI name
+	//
+	// The tag can also be a semver constraint, such as "ghcr.io/org/policies/go:~1.4", "ghcr.io/org/policies/go:^2"
+	// or "ghcr.io/org/policies/go:1.x", resolved to the newest matching policy version.
+	// A partial version such as "ghcr.io/org/policies/go:2" is pulled as a literal tag.
 	Policy string `yaml:",omitempty"`
 	// Config contains a list of Updatecli config file path
 	Config []string `yaml:",omitempty"`
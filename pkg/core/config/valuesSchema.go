@@ -0,0 +1,110 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	// jsonSchemaRootField is the field name used by gojsonschema for the document root
+	jsonSchemaRootField = "(root)"
+	// undefinedValuesFile is reported when a schema violation can't be attributed to a specific file
+	undefinedValuesFile = "merged values"
+)
+
+// ValidateValues validates values and secrets files against a JSON schema.
+// Files are merged the same way than for templating so the validated values are the ones used to render manifests.
+// Every schema violation is reported with the file, and the key path, responsible for it.
+func ValidateValues(schemaFile string, valuesFiles, secretsFiles []string) error {
+
+	schemaContent, err := os.ReadFile(schemaFile)
+	if err != nil {
+		return fmt.Errorf("reading values schema %q: %w", schemaFile, err)
+	}
+
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schemaContent))
+	if err != nil {
+		return fmt.Errorf("parsing values schema %q: %w", schemaFile, err)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	// origins contains the file defining each top level key.
+	// Deepmerge is not supported so the last file defining a key wins.
+	origins := make(map[string]string)
+
+	readFiles := func(files []string, encrypted bool) (map[string]interface{}, error) {
+		results := make(map[string]interface{})
+
+		for _, file := range files {
+			t := Template{fs: os.DirFS(cwd)}
+
+			var v map[string]interface{}
+			if err := t.readFile(file, &v, encrypted); err != nil {
+				return nil, fmt.Errorf("reading %q: %w", file, err)
+			}
+
+			for key := range v {
+				origins[key] = file
+			}
+
+			results = mergeValueFile(results, v)
+		}
+
+		return results, nil
+	}
+
+	values, err := readFiles(valuesFiles, false)
+	if err != nil {
+		return err
+	}
+
+	secrets, err := readFiles(secretsFiles, true)
+	if err != nil {
+		return err
+	}
+
+	result, err := schema.Validate(gojsonschema.NewGoLoader(mergeValueFile(values, secrets)))
+	if err != nil {
+		return fmt.Errorf("validating values against schema %q: %w", schemaFile, err)
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	violations := []string{}
+	for _, resultErr := range result.Errors() {
+		keyPath := resultErr.Field()
+
+		// Report the missing, or unexpected, key instead of its parent
+		switch resultErr.Type() {
+		case "required", "additional_property_not_allowed":
+			if property, ok := resultErr.Details()["property"].(string); ok {
+				if keyPath == jsonSchemaRootField {
+					keyPath = property
+				} else {
+					keyPath = keyPath + "." + property
+				}
+			}
+		}
+
+		valuesFile := undefinedValuesFile
+		if origin, ok := origins[strings.SplitN(keyPath, ".", 2)[0]]; ok {
+			valuesFile = fmt.Sprintf("%q", origin)
+		}
+
+		violations = append(violations, fmt.Sprintf("%s: %s: %s", valuesFile, keyPath, resultErr.Description()))
+	}
+
+	sort.Strings(violations)
+
+	return fmt.Errorf("values not matching schema %q:\n\t\t* %s", schemaFile, strings.Join(violations, "\n\t\t* "))
+}
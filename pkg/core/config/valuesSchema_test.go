@@ -0,0 +1,130 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testValuesSchema = `{
+  "type": "object",
+  "additionalProperties": false,
+  "required": ["scm"],
+  "properties": {
+    "scm": {
+      "type": "object",
+      "required": ["owner"],
+      "properties": {
+        "owner": {"type": "string"},
+        "branch": {"type": "string"}
+      }
+    },
+    "labels": {
+      "type": "array",
+      "items": {"type": "string"}
+    }
+  }
+}`
+
+func TestValidateValues(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile := func(name, content string) string {
+		filename := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(filename, []byte(content), 0600))
+		return filename
+	}
+
+	schema := writeFile("values.schema.json", testValuesSchema)
+	validValues := writeFile("valid.yaml", "scm:\n  owner: updatecli\n  branch: main\nlabels:\n  - dependencies\n")
+	wrongTypeValues := writeFile("wrongtype.yaml", "scm:\n  owner: updatecli\n  branch: 1\nlabels:\n  - true\n")
+	typoValues := writeFile("typo.yaml", "scm:\n  owner: updatecli\nlable:\n  - dependencies\n")
+	missingOwnerValues := writeFile("missingowner.yaml", "scm:\n  branch: main\n")
+	jsonValues := writeFile("values.json", `{"scm": {"owner": "updatecli"}}`)
+	invalidSchema := writeFile("invalid.schema.json", `{"type": "unknown"}`)
+
+	testdata := []struct {
+		name          string
+		schema        string
+		values        []string
+		expectedError []string
+	}{
+		{
+			name:   "Valid values",
+			schema: schema,
+			values: []string{validValues},
+		},
+		{
+			name:   "Valid json values",
+			schema: schema,
+			values: []string{jsonValues},
+		},
+		{
+			name:   "Later values file overriding an invalid key",
+			schema: schema,
+			values: []string{missingOwnerValues, validValues},
+		},
+		{
+			name:   "Every violation is reported with its file and key path",
+			schema: schema,
+			values: []string{wrongTypeValues},
+			expectedError: []string{
+				`"` + wrongTypeValues + `": scm.branch: Invalid type. Expected: string, given: integer`,
+				`"` + wrongTypeValues + `": labels.0: Invalid type. Expected: string, given: boolean`,
+			},
+		},
+		{
+			name:   "Typo in a values key",
+			schema: schema,
+			values: []string{typoValues},
+			expectedError: []string{
+				`"` + typoValues + `": lable: Additional property lable is not allowed`,
+			},
+		},
+		{
+			name:   "Missing required key",
+			schema: schema,
+			values: []string{validValues, missingOwnerValues},
+			expectedError: []string{
+				`"` + missingOwnerValues + `": scm.owner: owner is required`,
+			},
+		},
+		{
+			name:   "No values file",
+			schema: schema,
+			expectedError: []string{
+				"merged values: scm: scm is required",
+			},
+		},
+		{
+			name:          "Invalid schema",
+			schema:        invalidSchema,
+			values:        []string{validValues},
+			expectedError: []string{"parsing values schema"},
+		},
+		{
+			name:          "Missing schema",
+			schema:        filepath.Join(dir, "missing.json"),
+			values:        []string{validValues},
+			expectedError: []string{"reading values schema"},
+		},
+	}
+
+	for _, data := range testdata {
+		t.Run(data.name, func(t *testing.T) {
+			err := ValidateValues(data.schema, data.values, nil)
+			if len(data.expectedError) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, expectedError := range data.expectedError {
+				assert.Contains(t, err.Error(), expectedError)
+			}
+		})
+	}
+}
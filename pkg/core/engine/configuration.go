@@ -50,6 +50,24 @@ func (e *Engine) LoadConfigurations() error {
 			}
 		}
 
+		// Values are validated before templating, so errors are reported with the file and key responsible for them
+		if e.Options.Manifests[i].ValuesSchema != "" && !e.Options.Config.DisableTemplating {
+			err := config.ValidateValues(
+				e.Options.Manifests[i].ValuesSchema,
+				e.Options.Manifests[i].Values,
+				e.Options.Manifests[i].Secrets)
+			if err != nil {
+				errs = append(errs, err)
+				e.Reports = append(e.Reports,
+					reports.Report{
+						Result: result.FAILURE,
+						Err:    err.Error(),
+					},
+				)
+				continue
+			}
+		}
+
 		for _, manifestFile := range sanitizeUpdatecliManifestFilePath(e.Options.Manifests[i].Manifests) {
 
 			loadedConfigurations, err := config.New(
@@ -69,6 +69,38 @@ func TestLoadConfigurations(t *testing.T) {
 			wantErr:           true,
 			expectedError:     `"updatecli.d/failure.yaml" - scm ID "updatecli" from source ID "adopters" doesn't exist`,
 		},
+		{
+			name: "Success - Values matching the values schema",
+			wd:   "testdata/valuesSchema",
+			engine: Engine{
+				Options: Options{
+					Manifests: []manifest.Manifest{
+						{
+							Values:       []string{"values.yaml"},
+							ValuesSchema: "values.schema.json",
+						},
+					},
+				},
+			},
+			expectedPipelines: 1,
+		},
+		{
+			name: "Failure - Values not matching the values schema",
+			wd:   "testdata/valuesSchema",
+			engine: Engine{
+				Options: Options{
+					Manifests: []manifest.Manifest{
+						{
+							Values:       []string{"invalid.yaml"},
+							ValuesSchema: "values.schema.json",
+						},
+					},
+				},
+			},
+			expectedReports: 1,
+			wantErr:         true,
+			expectedError:   `"invalid.yaml": file: Invalid type. Expected: string, given: array`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -7,6 +7,8 @@ type Manifest struct {
 	Values []string
 	// Secrets is a list of Updatecli secret file
 	Secrets []string
+	// ValuesSchema is a JSON schema file used to validate values and secrets before templating
+	ValuesSchema string
 }
 
 func (m Manifest) IsZero() bool {
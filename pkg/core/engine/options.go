@@ -11,4 +11,7 @@ type Options struct {
 	Config    config.Option
 	Pipeline  pipeline.Options
 	Manifests []manifest.Manifest
+	// Parallelism defines the maximum number of pipelines executed concurrently.
+	// A value lower or equal to 1 runs pipelines one after the other.
+	Parallelism int
 }
@@ -8,12 +8,12 @@ import (
 )
 
 // PullFromRegistry retrieves an Updatecli policy from an OCI registry.
-func (e *Engine) PullFromRegistry(policyReference string, disableTLS bool) (err error) {
+func (e *Engine) PullFromRegistry(policyReference string, disableTLS bool, publicKeys []string) (err error) {
 
 	PrintTitle("Registry")
 
 	//nolint:dogsled
-	_, _, _, err = registry.Pull(policyReference, disableTLS)
+	_, _, _, _, err = registry.Pull(policyReference, disableTLS, publicKeys)
 	if err != nil {
 		return err
 	}
@@ -22,7 +22,7 @@ func (e *Engine) PullFromRegistry(policyReference string, disableTLS bool) (err
 }
 
 // PushToRegistry pushes an Updatecli policy to an OCI registry.
-func (e *Engine) PushToRegistry(manifests, valuesFiles, secretsFiles, policyReference []string, disableTLS bool, policyMetadataFile, fileStore string, overwrite bool) error {
+func (e *Engine) PushToRegistry(manifests, valuesFiles, secretsFiles, policyReference []string, disableTLS bool, policyMetadataFile, fileStore string, overwrite bool, signKey string) error {
 
 	PrintTitle("Registry")
 
@@ -58,10 +58,37 @@ func (e *Engine) PushToRegistry(manifests, valuesFiles, secretsFiles, policyRefe
 
 	relativeFromFileStore(manifests)
 
-	err := registry.Push(policyMetadataFile, manifests, valuesFiles, secretsFiles, policyReference, disableTLS, fileStore, overwrite)
+	err := registry.Push(policyMetadataFile, manifests, valuesFiles, secretsFiles, policyReference, disableTLS, fileStore, overwrite, signKey)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// ExportToBundle writes Updatecli policies from OCI registries into an OCI layout tarball.
+func (e *Engine) ExportToBundle(policyReferences []string, bundle string, disableTLS bool) error {
+
+	PrintTitle("Registry")
+
+	return registry.Export(policyReferences, bundle, disableTLS)
+}
+
+// ImportFromBundle loads Updatecli policies from an OCI layout tarball into the local policy store.
+// If prune is set, previously imported policies are removed first. An empty bundle only prunes the local policy store.
+func (e *Engine) ImportFromBundle(bundle string, prune bool) error {
+
+	PrintTitle("Registry")
+
+	if prune {
+		if err := registry.Prune(); err != nil {
+			return err
+		}
+	}
+
+	if bundle == "" {
+		return nil
+	}
+
+	return registry.Import(bundle)
+}
@@ -1,7 +1,11 @@
 package engine
 
 import (
+	"sync"
+
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline"
+	"github.com/updatecli/updatecli/pkg/core/reports"
 )
 
 // Run runs the full process
@@ -9,19 +13,54 @@ func (e *Engine) Run() (err error) {
 
 	PrintTitle("Pipeline")
 
-	for _, pipeline := range e.Pipelines {
+	pipelineReports := make([]reports.Report, len(e.Pipelines))
+
+	runPipeline := func(i int) {
+		pipeline := e.Pipelines[i]
 
 		err := pipeline.Run()
 
-		e.Reports = append(e.Reports, pipeline.Report)
+		pipelineReports[i] = pipeline.Report
 
 		if err != nil {
 			logrus.Printf("Pipeline %q failed\n", pipeline.Name)
 			logrus.Printf("Skipping due to:\n\t%s\n", err)
-			continue
 		}
 	}
 
+	switch e.Options.Parallelism > 1 {
+	case true:
+		logrus.Debugf("running pipelines with a parallelism of %d", e.Options.Parallelism)
+
+		wg := sync.WaitGroup{}
+		workers := make(chan struct{}, e.Options.Parallelism)
+
+		for _, group := range groupPipelines(e.Pipelines) {
+			wg.Add(1)
+			workers <- struct{}{}
+			go func(group []int) {
+				defer wg.Done()
+				defer func() { <-workers }()
+
+				// Pipelines from the same group share resources
+				// so they must run one after the other.
+				for _, i := range group {
+					runPipeline(i)
+				}
+			}(group)
+		}
+
+		wg.Wait()
+
+	case false:
+		for i := range e.Pipelines {
+			runPipeline(i)
+		}
+	}
+
+	// Reports are appended in the pipelines order, regardless of their completion order
+	e.Reports = append(e.Reports, pipelineReports...)
+
 	if err = e.runActions(); err != nil {
 		logrus.Errorf("running actions:\n%s", err)
 	}
@@ -36,3 +75,84 @@ func (e *Engine) Run() (err error) {
 
 	return nil
 }
+
+// groupPipelines returns pipelines indexes grouped by shared resources.
+// Two pipelines end up in the same group if they share a pipelineid,
+// an scm working directory, or if they both have targets updating the local working directory.
+// Groups, and indexes within a group, are sorted by pipeline order.
+func groupPipelines(pipelines []pipeline.Pipeline) [][]int {
+	parents := make([]int, len(pipelines))
+	for i := range parents {
+		parents[i] = i
+	}
+
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+		return parents[i]
+	}
+
+	union := func(i, j int) {
+		rootI, rootJ := find(i), find(j)
+		switch {
+		case rootI < rootJ:
+			parents[rootJ] = rootI
+		case rootJ < rootI:
+			parents[rootI] = rootJ
+		}
+	}
+
+	owners := make(map[string]int)
+	for i := range pipelines {
+		for _, key := range pipelineSharedKeys(&pipelines[i]) {
+			if owner, ok := owners[key]; ok {
+				union(owner, i)
+				continue
+			}
+			owners[key] = i
+		}
+	}
+
+	groups := [][]int{}
+	groupIndexes := make(map[int]int)
+	for i := range pipelines {
+		root := find(i)
+		index, ok := groupIndexes[root]
+		if !ok {
+			index = len(groups)
+			groupIndexes[root] = index
+			groups = append(groups, []int{})
+		}
+		groups[index] = append(groups[index], i)
+	}
+
+	return groups
+}
+
+// pipelineSharedKeys returns the list of resources identifiers that a pipeline
+// may modify and which therefore can't be used concurrently by another pipeline.
+func pipelineSharedKeys(p *pipeline.Pipeline) []string {
+	keys := []string{}
+
+	if p.ID != "" {
+		keys = append(keys, "pipelineid:"+p.ID)
+	}
+
+	for _, s := range p.SCMs {
+		if s.Handler == nil {
+			continue
+		}
+		keys = append(keys, "scm:"+s.Handler.GetDirectory())
+	}
+
+	for _, t := range p.Targets {
+		if t.Scm == nil {
+			keys = append(keys, "scm:local")
+			break
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/updatecli/updatecli/pkg/core/pipeline"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/target"
+)
+
+func TestGroupPipelines(t *testing.T) {
+	withSCM := func(id, dir string) pipeline.Pipeline {
+		return pipeline.Pipeline{
+			ID: id,
+			SCMs: map[string]scm.Scm{
+				"default": {Handler: &scm.MockScm{WorkingDir: dir}},
+			},
+		}
+	}
+
+	localTarget := pipeline.Pipeline{
+		Targets: map[string]target.Target{
+			"default": {},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		pipelines []pipeline.Pipeline
+		expected  [][]int
+	}{
+		{
+			name: "Independent pipelines",
+			pipelines: []pipeline.Pipeline{
+				withSCM("a", "/tmp/a"),
+				withSCM("b", "/tmp/b"),
+				{},
+			},
+			expected: [][]int{{0}, {1}, {2}},
+		},
+		{
+			name: "Shared scm directory",
+			pipelines: []pipeline.Pipeline{
+				withSCM("a", "/tmp/a"),
+				withSCM("b", "/tmp/b"),
+				withSCM("c", "/tmp/a"),
+			},
+			expected: [][]int{{0, 2}, {1}},
+		},
+		{
+			name: "Shared pipelineid",
+			pipelines: []pipeline.Pipeline{
+				withSCM("a", "/tmp/a"),
+				withSCM("b", "/tmp/b"),
+				withSCM("b", "/tmp/c"),
+			},
+			expected: [][]int{{0}, {1, 2}},
+		},
+		{
+			name: "Transitively shared resources",
+			pipelines: []pipeline.Pipeline{
+				withSCM("a", "/tmp/a"),
+				withSCM("b", "/tmp/b"),
+				withSCM("b", "/tmp/c"),
+				withSCM("d", "/tmp/a"),
+				withSCM("e", "/tmp/c"),
+			},
+			expected: [][]int{{0, 3}, {1, 2, 4}},
+		},
+		{
+			name: "Targets updating the local working directory",
+			pipelines: []pipeline.Pipeline{
+				localTarget,
+				withSCM("a", "/tmp/a"),
+				localTarget,
+			},
+			expected: [][]int{{0, 2}, {1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, groupPipelines(tt.pipelines))
+		})
+	}
+}
@@ -0,0 +1,330 @@
+package httpclient
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// cacheDateHeader stores the time a cached response was retrieved or revalidated
+	cacheDateHeader = "X-Updatecli-Cache-Date"
+	// CacheStatusHeader is set on responses served by the cache, with either "HIT" or "REVALIDATED"
+	CacheStatusHeader = "X-Updatecli-Cache"
+)
+
+var (
+	// cacheKeyHeaders are the request headers which are part of the cache key
+	cacheKeyHeaders = []string{"Accept", "Authorization", "Private-Token"}
+
+	// CacheDirectory defines the directory used to store http responses.
+	// The http cache is disabled when empty.
+	CacheDirectory string
+	// CacheTTL defines how long a cached response is considered fresh
+	// when the server doesn't provide any freshness information.
+	// Such responses are always revalidated when CacheTTL is zero.
+	CacheTTL time.Duration
+)
+
+// cacheTransport is an http.RoundTripper storing GET responses on disk.
+// It honors the Cache-Control and Expires headers and revalidates
+// stale responses using the ETag and Last-Modified headers.
+type cacheTransport struct {
+	directory string
+	ttl       time.Duration
+	transport http.RoundTripper
+	now       func() time.Time
+}
+
+// NewCachedTransport wraps transport with the on-disk http cache
+// if a cache directory is configured, otherwise it returns transport unchanged.
+func NewCachedTransport(transport http.RoundTripper) http.RoundTripper {
+	if CacheDirectory == "" {
+		return transport
+	}
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &cacheTransport{
+		directory: CacheDirectory,
+		ttl:       CacheTTL,
+		transport: transport,
+		now:       time.Now,
+	}
+}
+
+// NewClient returns an http client using the on-disk http cache if configured.
+func NewClient() HTTPClient {
+	return &http.Client{
+		Transport: NewCachedTransport(http.DefaultTransport),
+	}
+}
+
+func (c *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isCacheableRequest(req) {
+		return c.transport.RoundTrip(req)
+	}
+
+	key := c.cacheKey(req)
+
+	cached, err := c.load(key, req)
+	if err != nil {
+		logrus.Debugf("ignoring http cache entry for %q: %s", req.URL, err)
+		cached = nil
+	}
+
+	if cached != nil && !isVaryCovered(cached) {
+		logrus.Debugf("ignoring http cache entry for %q varying on %q", req.URL, cached.Header.Values("Vary"))
+		closeBody(cached)
+		cached = nil
+	}
+
+	if cached != nil && c.isFresh(cached) {
+		logrus.Debugf("http cache hit for %q", req.URL)
+		cached.Header.Set(CacheStatusHeader, "HIT")
+		return cached, nil
+	}
+
+	outReq := req
+	if cached != nil {
+		etag := cached.Header.Get("ETag")
+		lastModified := cached.Header.Get("Last-Modified")
+
+		if etag != "" || lastModified != "" {
+			outReq = req.Clone(req.Context())
+			if etag != "" {
+				outReq.Header.Set("If-None-Match", etag)
+			}
+			if lastModified != "" {
+				outReq.Header.Set("If-Modified-Since", lastModified)
+			}
+		}
+	}
+
+	resp, err := c.transport.RoundTrip(outReq)
+	if err != nil {
+		closeBody(cached)
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		logrus.Debugf("http cache entry revalidated for %q", req.URL)
+		closeBody(resp)
+
+		// Update the cached headers with the ones provided by the revalidation
+		for name, values := range resp.Header {
+			cached.Header[name] = values
+		}
+
+		if !isVaryCovered(cached) {
+			if err := os.Remove(c.filename(key)); err != nil && !os.IsNotExist(err) {
+				logrus.Debugf("removing http cache entry for %q: %s", req.URL, err)
+			}
+		} else if err := c.store(key, cached); err != nil {
+			logrus.Debugf("updating http cache entry for %q: %s", req.URL, err)
+		}
+
+		cached.Header.Set(CacheStatusHeader, "REVALIDATED")
+		return cached, nil
+	}
+
+	closeBody(cached)
+
+	if !isCacheableResponse(resp) {
+		return resp, nil
+	}
+
+	if err := c.store(key, resp); err != nil {
+		logrus.Debugf("storing http cache entry for %q: %s", req.URL, err)
+	}
+
+	return resp, nil
+}
+
+// cacheKey identifies a response based on the request url and the headers
+// which may change its content, so private responses are never shared between credentials.
+func (c *cacheTransport) cacheKey(req *http.Request) string {
+	h := sha256.New()
+	for _, value := range []string{req.Method, req.URL.String()} {
+		h.Write([]byte(value))
+		h.Write([]byte{0})
+	}
+	for _, header := range cacheKeyHeaders {
+		h.Write([]byte(req.Header.Get(header)))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *cacheTransport) filename(key string) string {
+	return filepath.Join(c.directory, key[:2], key)
+}
+
+// load returns the cached response for key, or nil if none is found
+func (c *cacheTransport) load(key string, req *http.Request) (*http.Response, error) {
+	data, err := os.ReadFile(c.filename(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+}
+
+// store saves resp on disk while keeping its body readable by the caller
+func (c *cacheTransport) store(key string, resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	resp.Header.Set(cacheDateHeader, c.now().UTC().Format(http.TimeFormat))
+	resp.Header.Del(CacheStatusHeader)
+
+	data, err := httputil.DumpResponse(resp, true)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	filename := c.filename(key)
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+
+	// Write then rename so concurrent pipelines never read a partial entry
+	tmp, err := os.CreateTemp(filepath.Dir(filename), key+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filename)
+}
+
+// isFresh returns true if the cached response can be used without contacting the server
+func (c *cacheTransport) isFresh(resp *http.Response) bool {
+	date, err := http.ParseTime(resp.Header.Get(cacheDateHeader))
+	if err != nil {
+		return false
+	}
+	age := c.now().Sub(date)
+
+	directives := parseCacheControl(resp.Header.Get("Cache-Control"))
+
+	if _, ok := directives["no-cache"]; ok {
+		return false
+	}
+
+	if maxAge, ok := directives["max-age"]; ok {
+		seconds, err := strconv.Atoi(maxAge)
+		if err != nil {
+			return false
+		}
+		return age < time.Duration(seconds)*time.Second
+	}
+
+	if expires := resp.Header.Get("Expires"); expires != "" {
+		expiresAt, err := http.ParseTime(expires)
+		if err != nil {
+			return false
+		}
+		return c.now().Before(expiresAt)
+	}
+
+	return age < c.ttl
+}
+
+// isCacheableRequest returns true if the response to req may be read from or written to the cache
+func isCacheableRequest(req *http.Request) bool {
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return false
+	}
+
+	_, noStore := parseCacheControl(req.Header.Get("Cache-Control"))["no-store"]
+	return !noStore
+}
+
+// isCacheableResponse returns true if resp may be written to the cache
+func isCacheableResponse(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+
+	directives := parseCacheControl(resp.Header.Get("Cache-Control"))
+	if _, ok := directives["no-store"]; ok {
+		return false
+	}
+
+	return isVaryCovered(resp)
+}
+
+// isVaryCovered returns true if every request header listed by the Vary header of resp is part of the cache key.
+// Otherwise the cached response could be served to a request expecting a different content.
+func isVaryCovered(resp *http.Response) bool {
+	for _, value := range resp.Header.Values("Vary") {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+
+			// "*" isn't a valid header name so it's never covered
+			if !slices.Contains(cacheKeyHeaders, http.CanonicalHeaderKey(name)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// parseCacheControl returns the Cache-Control directives with their optional value
+func parseCacheControl(header string) map[string]string {
+	directives := make(map[string]string)
+
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, value, _ := strings.Cut(part, "=")
+		directives[strings.ToLower(strings.TrimSpace(name))] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+
+	return directives
+}
+
+func closeBody(resp *http.Response) {
+	if resp != nil && resp.Body != nil {
+		resp.Body.Close()
+	}
+}
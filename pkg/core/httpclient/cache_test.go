@@ -0,0 +1,205 @@
+package httpclient
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheTransport(t *testing.T) {
+	tests := []struct {
+		name string
+		// handler headers returned by the test server
+		headers map[string]string
+		// requestHeaders are set on every client request
+		requestHeaders map[string]string
+		ttl            time.Duration
+		// elapsed is the time between the first and the second request
+		elapsed             time.Duration
+		expectedHits        int32
+		expectedCacheStatus string
+	}{
+		{
+			name:                "Fresh response based on max-age",
+			headers:             map[string]string{"Cache-Control": "public, max-age=60"},
+			elapsed:             30 * time.Second,
+			expectedHits:        1,
+			expectedCacheStatus: "HIT",
+		},
+		{
+			name:         "Stale response based on max-age without validator",
+			headers:      map[string]string{"Cache-Control": "max-age=60"},
+			elapsed:      90 * time.Second,
+			expectedHits: 2,
+		},
+		{
+			name:                "Stale response revalidated with ETag",
+			headers:             map[string]string{"Cache-Control": "max-age=60", "ETag": `"v1"`},
+			elapsed:             90 * time.Second,
+			expectedHits:        2,
+			expectedCacheStatus: "REVALIDATED",
+		},
+		{
+			name:                "Response revalidated with Last-Modified",
+			headers:             map[string]string{"Last-Modified": "Mon, 14 Oct 2024 10:00:00 GMT"},
+			expectedHits:        2,
+			expectedCacheStatus: "REVALIDATED",
+		},
+		{
+			name:                "Fresh response based on Expires",
+			headers:             map[string]string{"Expires": "Mon, 01 Jan 2024 01:00:00 GMT"},
+			elapsed:             30 * time.Minute,
+			expectedHits:        1,
+			expectedCacheStatus: "HIT",
+		},
+		{
+			name:                "Fresh response based on the default TTL",
+			ttl:                 time.Hour,
+			elapsed:             30 * time.Minute,
+			expectedHits:        1,
+			expectedCacheStatus: "HIT",
+		},
+		{
+			name:         "Stale response based on the default TTL",
+			ttl:          time.Hour,
+			elapsed:      2 * time.Hour,
+			expectedHits: 2,
+		},
+		{
+			name:         "Response with no-store",
+			headers:      map[string]string{"Cache-Control": "no-store", "ETag": `"v1"`},
+			ttl:          time.Hour,
+			expectedHits: 2,
+		},
+		{
+			name:                "Response with no-cache is always revalidated",
+			headers:             map[string]string{"Cache-Control": "no-cache", "ETag": `"v1"`},
+			ttl:                 time.Hour,
+			expectedHits:        2,
+			expectedCacheStatus: "REVALIDATED",
+		},
+		{
+			name:           "Request with no-store",
+			requestHeaders: map[string]string{"Cache-Control": "no-store"},
+			ttl:            time.Hour,
+			expectedHits:   2,
+		},
+		{
+			name:                "Response varying on a header part of the cache key",
+			headers:             map[string]string{"Cache-Control": "max-age=60", "Vary": "accept, Authorization"},
+			elapsed:             30 * time.Second,
+			expectedHits:        1,
+			expectedCacheStatus: "HIT",
+		},
+		{
+			name:         "Response varying on a header not part of the cache key",
+			headers:      map[string]string{"Cache-Control": "max-age=60", "Vary": "Accept, Accept-Language"},
+			elapsed:      30 * time.Second,
+			expectedHits: 2,
+		},
+		{
+			name:         "Response varying on any header",
+			headers:      map[string]string{"Cache-Control": "max-age=60", "Vary": "*"},
+			elapsed:      30 * time.Second,
+			expectedHits: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&hits, 1)
+
+				for name, value := range tt.headers {
+					w.Header().Set(name, value)
+				}
+
+				if etag := tt.headers["ETag"]; etag != "" && r.Header.Get("If-None-Match") == etag {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				if lastModified := tt.headers["Last-Modified"]; lastModified != "" && r.Header.Get("If-Modified-Since") == lastModified {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+
+				_, _ = w.Write([]byte("updatecli"))
+			}))
+			defer server.Close()
+
+			now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+			transport := &cacheTransport{
+				directory: t.TempDir(),
+				ttl:       tt.ttl,
+				transport: http.DefaultTransport,
+				now:       func() time.Time { return now },
+			}
+			client := &http.Client{Transport: transport}
+
+			get := func() *http.Response {
+				req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+				require.NoError(t, err)
+				for name, value := range tt.requestHeaders {
+					req.Header.Set(name, value)
+				}
+
+				resp, err := client.Do(req)
+				require.NoError(t, err)
+
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				resp.Body.Close()
+
+				assert.Equal(t, http.StatusOK, resp.StatusCode)
+				assert.Equal(t, "updatecli", string(body))
+
+				return resp
+			}
+
+			first := get()
+			assert.Empty(t, first.Header.Get(CacheStatusHeader))
+
+			now = now.Add(tt.elapsed)
+
+			second := get()
+			assert.Equal(t, tt.expectedCacheStatus, second.Header.Get(CacheStatusHeader))
+			assert.Equal(t, tt.expectedHits, atomic.LoadInt32(&hits))
+		})
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	transport := &cacheTransport{}
+
+	newRequest := func(authorization string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, "https://registry.npmjs.org/updatecli", nil)
+		require.NoError(t, err)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		return req
+	}
+
+	assert.Equal(t, transport.cacheKey(newRequest("")), transport.cacheKey(newRequest("")))
+	assert.NotEqual(t, transport.cacheKey(newRequest("")), transport.cacheKey(newRequest("Bearer token")))
+
+	// GitLab authenticates using the Private-Token header
+	privateTokenRequest := newRequest("")
+	privateTokenRequest.Header.Set("Private-Token", "token")
+	assert.NotEqual(t, transport.cacheKey(newRequest("")), transport.cacheKey(privateTokenRequest))
+}
+
+func TestNewCachedTransportDisabled(t *testing.T) {
+	CacheDirectory = ""
+
+	assert.Equal(t, http.DefaultTransport, NewCachedTransport(http.DefaultTransport))
+}
@@ -2,35 +2,279 @@ package httpclient
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"io"
-	"math"
+	"math/rand"
 	"net/http"
+	"slices"
+	"strconv"
 	"time"
+
+	"github.com/sirupsen/logrus"
 )
 
+// RetryCount is the default number of retries after a failed request
 const RetryCount = 3
 
+const (
+	defaultRetryBaseDelay = time.Second
+	defaultRetryMaxDelay  = 30 * time.Second
+)
+
+var (
+	// defaultRetryStatusCodes lists the http status codes retried by default
+	defaultRetryStatusCodes = []int{
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+
+	// DefaultRetryPolicy is the retry policy used by every http client
+	// unless a resource defines its own one. Unset fields use the built-in defaults.
+	DefaultRetryPolicy RetryPolicy
+)
+
+// RetryPolicy defines how failed http requests are retried.
+// Unset fields are taken from the global retry policy, defined by the "--http-retry-*" flags.
+type RetryPolicy struct {
+	/*
+		maxattempts defines the maximum number of attempts for a request, including the first one.
+
+		default:
+			4
+	*/
+	MaxAttempts int `yaml:",omitempty"`
+	/*
+		basedelay defines the delay before the first retry, doubled after each attempt.
+		It accepts a duration like "500ms" or "2s".
+
+		default:
+			1s
+	*/
+	BaseDelay string `yaml:",omitempty"`
+	/*
+		maxdelay defines the maximum delay between two attempts.
+		Retries stop when the server asks to wait longer using
+		the "Retry-After" or "X-RateLimit-Reset" response headers.
+
+		default:
+			30s
+	*/
+	MaxDelay string `yaml:",omitempty"`
+	/*
+		jitter randomizes each delay between zero and the computed backoff
+		so concurrent pipelines don't retry at the same time.
+		Setting it to false disables a jitter enabled globally.
+
+		default:
+			false
+	*/
+	Jitter *bool `yaml:",omitempty"`
+	/*
+		statuscodes defines the http response status codes which trigger a retry.
+		Network errors are always retried.
+
+		default:
+			[429, 502, 503, 504]
+	*/
+	StatusCodes []int `yaml:",omitempty"`
+	/*
+		deadline defines the maximum time spent on a request, retries included.
+		It accepts a duration like "1m".
+
+		default:
+			none
+	*/
+	Deadline string `yaml:",omitempty"`
+}
+
+// retryConfig is the parsed version of a RetryPolicy
+type retryConfig struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	jitter      bool
+	statusCodes []int
+	deadline    time.Duration
+}
+
+// IsZero returns true if no retry setting is defined
+func (p RetryPolicy) IsZero() bool {
+	return p.MaxAttempts == 0 &&
+		p.BaseDelay == "" &&
+		p.MaxDelay == "" &&
+		p.Jitter == nil &&
+		len(p.StatusCodes) == 0 &&
+		p.Deadline == ""
+}
+
+// Merge returns a copy of the policy where unset fields are taken from defaults
+func (p RetryPolicy) Merge(defaults RetryPolicy) RetryPolicy {
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = defaults.MaxAttempts
+	}
+	if p.BaseDelay == "" {
+		p.BaseDelay = defaults.BaseDelay
+	}
+	if p.MaxDelay == "" {
+		p.MaxDelay = defaults.MaxDelay
+	}
+	if p.Jitter == nil {
+		p.Jitter = defaults.Jitter
+	}
+	if len(p.StatusCodes) == 0 {
+		p.StatusCodes = defaults.StatusCodes
+	}
+	if p.Deadline == "" {
+		p.Deadline = defaults.Deadline
+	}
+
+	return p
+}
+
+// Validate checks that the retry policy is valid
+func (p RetryPolicy) Validate() error {
+	_, err := p.config()
+	return err
+}
+
+// config parses the retry policy and applies the built-in defaults
+func (p RetryPolicy) config() (retryConfig, error) {
+	c := retryConfig{
+		maxAttempts: RetryCount + 1,
+		baseDelay:   defaultRetryBaseDelay,
+		maxDelay:    defaultRetryMaxDelay,
+		jitter:      p.Jitter != nil && *p.Jitter,
+		statusCodes: defaultRetryStatusCodes,
+	}
+
+	switch {
+	case p.MaxAttempts < 0:
+		return retryConfig{}, fmt.Errorf("retry maxattempts must be greater than zero, got %d", p.MaxAttempts)
+	case p.MaxAttempts > 0:
+		c.maxAttempts = p.MaxAttempts
+	}
+
+	durations := []struct {
+		name  string
+		value string
+		dest  *time.Duration
+	}{
+		{name: "basedelay", value: p.BaseDelay, dest: &c.baseDelay},
+		{name: "maxdelay", value: p.MaxDelay, dest: &c.maxDelay},
+		{name: "deadline", value: p.Deadline, dest: &c.deadline},
+	}
+
+	for _, d := range durations {
+		if d.value == "" {
+			continue
+		}
+
+		value, err := time.ParseDuration(d.value)
+		if err != nil {
+			return retryConfig{}, fmt.Errorf("retry %s: %w", d.name, err)
+		}
+		if value < 0 {
+			return retryConfig{}, fmt.Errorf("retry %s must not be negative, got %q", d.name, d.value)
+		}
+		*d.dest = value
+	}
+
+	if c.baseDelay > c.maxDelay {
+		return retryConfig{}, fmt.Errorf("retry basedelay %q must not be greater than maxdelay %q", c.baseDelay, c.maxDelay)
+	}
+
+	for _, code := range p.StatusCodes {
+		if code < 100 || code > 599 {
+			return retryConfig{}, fmt.Errorf("retry statuscodes: invalid http status code %d", code)
+		}
+	}
+	if len(p.StatusCodes) > 0 {
+		c.statusCodes = p.StatusCodes
+	}
+
+	return c, nil
+}
+
 type retryTransport struct {
 	transport http.RoundTripper
+	config    retryConfig
+	now       func() time.Time
+	sleep     func(ctx context.Context, d time.Duration) error
 }
 
-func backoff(retries int) time.Duration {
-	return time.Duration(math.Pow(2, float64(retries))) * time.Second
+// newRetryTransport returns a transport retrying failed requests according to policy
+func newRetryTransport(transport http.RoundTripper, policy RetryPolicy) (*retryTransport, error) {
+	config, err := policy.config()
+	if err != nil {
+		return nil, err
+	}
+
+	return &retryTransport{
+		transport: transport,
+		config:    config,
+		now:       time.Now,
+		sleep:     sleepContext,
+	}, nil
 }
 
-func shouldRetry(err error, resp *http.Response) bool {
+// backoff returns the exponential delay before the given retry, starting at 1
+func (t *retryTransport) backoff(retry int) time.Duration {
+	delay := t.config.baseDelay
+	for i := 1; i < retry && delay < t.config.maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, t.config.maxDelay)
+
+	if t.config.jitter && delay > 0 {
+		//nolint:gosec // jitter doesn't need a cryptographic random number
+		delay = time.Duration(rand.Int63n(int64(delay) + 1))
+	}
+
+	return delay
+}
+
+func (t *retryTransport) shouldRetry(err error, resp *http.Response) bool {
 	if err != nil {
 		return true
 	}
 
-	if resp.StatusCode == http.StatusBadGateway ||
-		resp.StatusCode == http.StatusServiceUnavailable ||
-		resp.StatusCode == http.StatusGatewayTimeout ||
-		resp.StatusCode == http.StatusTooManyRequests {
-		return true
+	return slices.Contains(t.config.statusCodes, resp.StatusCode)
+}
+
+// serverDelay returns the delay requested by the server
+// using either the Retry-After or the X-RateLimit-Reset response header
+func (t *retryTransport) serverDelay(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
 	}
 
-	return false
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		// Retry-After is either a number of seconds or an http date
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return max(time.Duration(seconds)*time.Second, 0), true
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return max(date.Sub(t.now()), 0), true
+		}
+	}
+
+	if reset := resp.Header.Get("X-RateLimit-Reset"); reset != "" {
+		value, err := strconv.ParseInt(reset, 10, 64)
+		if err != nil {
+			return 0, false
+		}
+
+		// Most apis, like GitHub, return a unix timestamp while others return a number of seconds
+		if value > 1_000_000_000 {
+			return max(time.Unix(value, 0).Sub(t.now()), 0), true
+		}
+		return max(time.Duration(value)*time.Second, 0), true
+	}
+
+	return 0, false
 }
 
 func drainBody(resp *http.Response) error {
@@ -45,6 +289,18 @@ func drainBody(resp *http.Response) error {
 	return nil
 }
 
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	// Clone the request body
 	var bodyBytes []byte
@@ -53,20 +309,42 @@ func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		req.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
 	}
 
+	start := t.now()
+
 	// Send the request
 	resp, err := t.transport.RoundTrip(req)
 
 	// Retry logic
-	retries := 0
-	for shouldRetry(err, resp) && retries < RetryCount {
-		// Wait for the specified backoff period
-		time.Sleep(backoff(retries))
+	for attempt := 1; attempt < t.config.maxAttempts && t.shouldRetry(err, resp); attempt++ {
+		delay, requested := t.serverDelay(resp)
+		switch {
+		case requested && delay > t.config.maxDelay:
+			logrus.Debugf("not retrying %s %q, server requested to wait %s which exceeds the maximum delay of %s",
+				req.Method, req.URL.Redacted(), delay, t.config.maxDelay)
+			return resp, err
+		case !requested:
+			delay = t.backoff(attempt)
+		}
+
+		if t.config.deadline > 0 && t.now().Add(delay).Sub(start) > t.config.deadline {
+			logrus.Debugf("not retrying %s %q, retry deadline of %s reached",
+				req.Method, req.URL.Redacted(), t.config.deadline)
+			return resp, err
+		}
 
 		// We're going to retry, consume any response to reuse the connection.
 		if err = drainBody(resp); err != nil {
 			return resp, err
 		}
 
+		logrus.Debugf("retrying %s %q in %s (attempt %d/%d)",
+			req.Method, req.URL.Redacted(), delay, attempt+1, t.config.maxAttempts)
+
+		// Wait for the specified backoff period
+		if err = t.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+
 		// Clone the request body again
 		if req.Body != nil {
 			req.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
@@ -74,20 +352,50 @@ func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 		// Retry the request
 		resp, err = t.transport.RoundTrip(req)
-
-		retries++
 	}
 
 	// Return the response
 	return resp, err
 }
 
+// NewRetryClient returns an http client retrying failed requests
+// according to the DefaultRetryPolicy.
 func NewRetryClient() HTTPClient {
-	transport := &retryTransport{
-		transport: &http.Transport{},
+	client, err := NewRetryClientWithPolicy(RetryPolicy{})
+	if err != nil {
+		// DefaultRetryPolicy is validated when parsing the command line flags
+		logrus.Errorf("invalid default http retry policy, using built-in defaults: %s", err)
+		transport, _ := newRetryTransport(&http.Transport{}, RetryPolicy{})
+		return &http.Client{Transport: NewCachedTransport(transport)}
 	}
 
-	client := http.DefaultClient
-	client.Transport = transport
 	return client
 }
+
+// NewRetryClientWithPolicy returns an http client retrying failed requests
+// according to policy, where unset fields are taken from the DefaultRetryPolicy.
+func NewRetryClientWithPolicy(policy RetryPolicy) (HTTPClient, error) {
+	transport, err := NewRetryTransport(policy, &http.Transport{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{
+		Transport: NewCachedTransport(transport),
+	}, nil
+}
+
+// NewRetryTransport wraps transport to retry failed requests
+// according to policy, where unset fields are taken from the DefaultRetryPolicy.
+func NewRetryTransport(policy RetryPolicy, transport http.RoundTripper) (http.RoundTripper, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	retry, err := newRetryTransport(transport, policy.Merge(DefaultRetryPolicy))
+	if err != nil {
+		return nil, err
+	}
+
+	return retry, nil
+}
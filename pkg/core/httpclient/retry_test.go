@@ -0,0 +1,267 @@
+package httpclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTransport(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		// failures is the number of failed responses returned before a successful one
+		failures int32
+		// status is the status code of the failed responses
+		status  int
+		headers map[string]string
+		// expectedStatus is the status code returned to the client
+		expectedStatus int
+		expectedHits   int32
+		expectedDelays []time.Duration
+	}{
+		{
+			name:           "Default policy with exponential backoff",
+			failures:       3,
+			status:         http.StatusServiceUnavailable,
+			expectedStatus: http.StatusOK,
+			expectedHits:   4,
+			expectedDelays: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		{
+			name:           "Default policy gives up after four attempts",
+			failures:       10,
+			status:         http.StatusBadGateway,
+			expectedStatus: http.StatusBadGateway,
+			expectedHits:   4,
+			expectedDelays: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		{
+			name:           "Status code not retried by default",
+			failures:       1,
+			status:         http.StatusInternalServerError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedHits:   1,
+		},
+		{
+			name: "Custom status codes, attempts and delays",
+			policy: RetryPolicy{
+				MaxAttempts: 6,
+				BaseDelay:   "100ms",
+				MaxDelay:    "300ms",
+				StatusCodes: []int{http.StatusInternalServerError},
+			},
+			failures:       10,
+			status:         http.StatusInternalServerError,
+			expectedStatus: http.StatusInternalServerError,
+			expectedHits:   6,
+			expectedDelays: []time.Duration{
+				100 * time.Millisecond,
+				200 * time.Millisecond,
+				300 * time.Millisecond,
+				300 * time.Millisecond,
+				300 * time.Millisecond,
+			},
+		},
+		{
+			name:           "Retry-After in seconds",
+			failures:       1,
+			status:         http.StatusTooManyRequests,
+			headers:        map[string]string{"Retry-After": "10"},
+			expectedStatus: http.StatusOK,
+			expectedHits:   2,
+			expectedDelays: []time.Duration{10 * time.Second},
+		},
+		{
+			name:           "Retry-After as http date",
+			failures:       1,
+			status:         http.StatusServiceUnavailable,
+			headers:        map[string]string{"Retry-After": now.Add(5 * time.Second).Format(http.TimeFormat)},
+			expectedStatus: http.StatusOK,
+			expectedHits:   2,
+			expectedDelays: []time.Duration{5 * time.Second},
+		},
+		{
+			name:           "X-RateLimit-Reset as unix timestamp",
+			failures:       1,
+			status:         http.StatusTooManyRequests,
+			headers:        map[string]string{"X-RateLimit-Reset": "1704067220"},
+			expectedStatus: http.StatusOK,
+			expectedHits:   2,
+			expectedDelays: []time.Duration{20 * time.Second},
+		},
+		{
+			name:           "Server delay exceeding the maximum delay",
+			failures:       1,
+			status:         http.StatusTooManyRequests,
+			headers:        map[string]string{"Retry-After": "3600"},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedHits:   1,
+		},
+		{
+			name: "Deadline reached",
+			policy: RetryPolicy{
+				Deadline: "5s",
+			},
+			failures:       10,
+			status:         http.StatusServiceUnavailable,
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedHits:   3,
+			expectedDelays: []time.Duration{time.Second, 2 * time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, "updatecli", string(body))
+
+				if atomic.AddInt32(&hits, 1) <= tt.failures {
+					for name, value := range tt.headers {
+						w.Header().Set(name, value)
+					}
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			current := now
+			delays := []time.Duration{}
+
+			transport, err := newRetryTransport(http.DefaultTransport, tt.policy)
+			require.NoError(t, err)
+			transport.now = func() time.Time { return current }
+			transport.sleep = func(ctx context.Context, d time.Duration) error {
+				delays = append(delays, d)
+				current = current.Add(d)
+				return nil
+			}
+
+			req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("updatecli"))
+			require.NoError(t, err)
+
+			resp, err := (&http.Client{Transport: transport}).Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tt.expectedHits, atomic.LoadInt32(&hits))
+			if len(tt.expectedDelays) == 0 {
+				assert.Empty(t, delays)
+			} else {
+				assert.Equal(t, tt.expectedDelays, delays)
+			}
+		})
+	}
+}
+
+func TestRetryTransportJitter(t *testing.T) {
+	jitter := true
+	transport, err := newRetryTransport(http.DefaultTransport, RetryPolicy{Jitter: &jitter, BaseDelay: "1s"})
+	require.NoError(t, err)
+
+	for retry := 1; retry <= 5; retry++ {
+		delay := transport.backoff(retry)
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.LessOrEqual(t, delay, time.Duration(1<<(retry-1))*time.Second)
+	}
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  RetryPolicy
+		wantErr bool
+	}{
+		{
+			name: "Empty policy",
+		},
+		{
+			name: "Valid policy",
+			policy: RetryPolicy{
+				MaxAttempts: 2,
+				BaseDelay:   "500ms",
+				MaxDelay:    "1m",
+				StatusCodes: []int{http.StatusTooManyRequests},
+				Deadline:    "5m",
+			},
+		},
+		{
+			name:    "Negative max attempts",
+			policy:  RetryPolicy{MaxAttempts: -1},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid duration",
+			policy:  RetryPolicy{Deadline: "forever"},
+			wantErr: true,
+		},
+		{
+			name:    "Base delay greater than max delay",
+			policy:  RetryPolicy{BaseDelay: "1m", MaxDelay: "10s"},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid status code",
+			policy:  RetryPolicy{StatusCodes: []int{42}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRetryPolicyMerge(t *testing.T) {
+	enabled, disabled := true, false
+
+	defaults := RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   "2s",
+		Jitter:      &enabled,
+		StatusCodes: []int{http.StatusServiceUnavailable},
+	}
+
+	got := RetryPolicy{MaxAttempts: 2, Deadline: "1m"}.Merge(defaults)
+
+	assert.Equal(t, RetryPolicy{
+		MaxAttempts: 2,
+		BaseDelay:   "2s",
+		Jitter:      &enabled,
+		StatusCodes: []int{http.StatusServiceUnavailable},
+		Deadline:    "1m",
+	}, got)
+
+	// A resource can disable a jitter enabled globally
+	got = RetryPolicy{Jitter: &disabled}.Merge(defaults)
+	require.NotNil(t, got.Jitter)
+	assert.False(t, *got.Jitter)
+
+	config, err := got.config()
+	require.NoError(t, err)
+	assert.False(t, config.jitter)
+}
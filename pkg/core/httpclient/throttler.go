@@ -20,15 +20,18 @@ func (c *ThrottledTransport) RoundTrip(req *http.Request) (*http.Response, error
 	return c.roundTripperWrapper.RoundTrip(req)
 }
 
+// NewThrottledTransport returns a rate limited transport.
+// Responses served by the http cache, if enabled, are not rate limited.
 func NewThrottledTransport(limitPeriod time.Duration, requestCount int, transportWrap http.RoundTripper) http.RoundTripper {
-	return &ThrottledTransport{
+	return NewCachedTransport(&ThrottledTransport{
 		roundTripperWrapper: transportWrap,
 		rateLimiter:         rate.NewLimiter(rate.Every(limitPeriod), requestCount),
-	}
+	})
 }
 
+// NewThrottledClient returns a new http client using a rate limited transport.
 func NewThrottledClient(limitPeriod time.Duration, requestCount int, transportWrap http.RoundTripper) HTTPClient {
-	client := http.DefaultClient
-	client.Transport = NewThrottledTransport(limitPeriod, requestCount, transportWrap)
-	return client
+	return &http.Client{
+		Transport: NewThrottledTransport(limitPeriod, requestCount, transportWrap),
+	}
 }
@@ -0,0 +1,20 @@
+package httpclient
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewThrottledClient(t *testing.T) {
+	defaultTransport := http.DefaultClient.Transport
+
+	client := NewThrottledClient(time.Second, 1, http.DefaultTransport)
+
+	// The default http client used by other packages must be left untouched
+	assert.NotSame(t, http.DefaultClient, client)
+	assert.Equal(t, defaultTransport, http.DefaultClient.Transport)
+	assert.IsType(t, &ThrottledTransport{}, client.(*http.Client).Transport)
+}
@@ -0,0 +1,26 @@
+package log
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// loggerKey is the context key used to carry a scoped logger
+type loggerKey struct{}
+
+// WithLogger returns a copy of ctx carrying logger.
+// Resources retrieve it using FromContext so their output is recorded with the resource which emitted it.
+func WithLogger(ctx context.Context, logger *logrus.Entry) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger carried by ctx.
+// It falls back to the standard logger when ctx doesn't carry any logger.
+func FromContext(ctx context.Context) *logrus.Entry {
+	if logger, ok := ctx.Value(loggerKey{}).(*logrus.Entry); ok && logger != nil {
+		return logger
+	}
+
+	return logrus.NewEntry(logrus.StandardLogger())
+}
@@ -0,0 +1,24 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromContext(t *testing.T) {
+	var buffer bytes.Buffer
+
+	logger := logrus.New()
+	logger.SetOutput(&buffer)
+	logger.SetFormatter(NewTextFormat())
+
+	ctx := WithLogger(context.Background(), logrus.NewEntry(logger))
+	FromContext(ctx).Infof("from scoped logger")
+
+	assert.Equal(t, "from scoped logger\n", buffer.String())
+	assert.Equal(t, logrus.StandardLogger(), FromContext(context.Background()).Logger)
+}
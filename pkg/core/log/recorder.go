@@ -0,0 +1,63 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Recorder records the console output of a single resource execution.
+//
+// It provides a scoped logger writing both to stderr and to the recorder,
+// so every line logged with it is recorded whatever the goroutine it's used from.
+// Resources retrieve that logger from the context they receive, see FromContext.
+type Recorder struct {
+	mu     sync.Mutex
+	buffer bytes.Buffer
+	logger *logrus.Entry
+}
+
+// NewRecorder returns a recorder with a logger configured like the standard logger.
+// fields are attached to every entry emitted by the scoped logger.
+func NewRecorder(fields logrus.Fields) *Recorder {
+	r := Recorder{}
+
+	std := logrus.StandardLogger()
+
+	logger := &logrus.Logger{
+		Out:          io.MultiWriter(os.Stderr, &r),
+		Formatter:    std.Formatter,
+		Hooks:        std.Hooks,
+		Level:        std.GetLevel(),
+		ExitFunc:     std.ExitFunc,
+		ReportCaller: std.ReportCaller,
+	}
+
+	r.logger = logger.WithFields(fields)
+
+	return &r
+}
+
+// Logger returns the logger scoped to the recorded resource.
+func (r *Recorder) Logger() *logrus.Entry {
+	return r.logger
+}
+
+// Write implements the io.Writer interface.
+func (r *Recorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.buffer.Write(p)
+}
+
+// String returns the recorded console output.
+func (r *Recorder) String() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.buffer.String()
+}
@@ -0,0 +1,57 @@
+package log
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	logrus.SetFormatter(NewTextFormat())
+
+	recorder := NewRecorder(logrus.Fields{"stage": "target"})
+
+	logrus.Infof("from standard logger")
+	recorder.Logger().Infof("from scoped logger")
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		recorder.Logger().Infof("from scoped logger in background goroutine")
+	}()
+	wg.Wait()
+
+	assert.Equal(t,
+		"from scoped logger\nfrom scoped logger in background goroutine\n",
+		recorder.String())
+}
+
+func TestRecorderConcurrent(t *testing.T) {
+	logrus.SetFormatter(NewTextFormat())
+
+	recorders := make([]*Recorder, 5)
+	for i := range recorders {
+		recorders[i] = NewRecorder(logrus.Fields{})
+	}
+
+	wg := sync.WaitGroup{}
+	for i, recorder := range recorders {
+		wg.Add(1)
+		go func(i int, recorder *Recorder) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				recorder.Logger().Infof("recorder %d", i)
+			}
+		}(i, recorder)
+	}
+	wg.Wait()
+
+	for i, recorder := range recorders {
+		assert.Equal(t, strings.Repeat(fmt.Sprintf("recorder %d\n", i), 10), recorder.String())
+	}
+}
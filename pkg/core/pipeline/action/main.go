@@ -11,9 +11,13 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/jsonschema"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/reports"
+	azuredevops "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/pullrequest"
+	bitbucket "github.com/updatecli/updatecli/pkg/plugins/resources/bitbucket/pullrequest"
 	gitea "github.com/updatecli/updatecli/pkg/plugins/resources/gitea/pullrequest"
 	gitlab "github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/mergerequest"
 	stash "github.com/updatecli/updatecli/pkg/plugins/resources/stash/pullrequest"
+	azuredevopsscm "github.com/updatecli/updatecli/pkg/plugins/scms/azuredevops"
+	bitbucketscm "github.com/updatecli/updatecli/pkg/plugins/scms/bitbucket"
 	giteascm "github.com/updatecli/updatecli/pkg/plugins/scms/gitea"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/github"
 	gitlabscm "github.com/updatecli/updatecli/pkg/plugins/scms/gitlab"
@@ -21,10 +25,12 @@ import (
 )
 
 const (
-	gitlabIdentifier = "gitlab"
-	githubIdentifier = "github"
-	giteaIdentifier  = "gitea"
-	stashIdentifier  = "stash"
+	gitlabIdentifier      = "gitlab"
+	githubIdentifier      = "github"
+	giteaIdentifier       = "gitea"
+	stashIdentifier       = "stash"
+	bitbucketIdentifier   = "bitbucket"
+	azuredevopsIdentifier = "azuredevops"
 )
 
 var (
@@ -260,6 +266,62 @@ func (a *Action) generateActionHandler() error {
 
 		a.Handler = &g
 
+	case "bitbucket/pullrequest":
+		actionSpec := bitbucket.Spec{}
+
+		if a.Scm.Config.Kind != bitbucketIdentifier {
+			return fmt.Errorf("scm of kind %q is not compatible with action of kind %q",
+				a.Scm.Config.Kind,
+				a.Config.Kind)
+		}
+
+		err := mapstructure.Decode(a.Config.Spec, &actionSpec)
+		if err != nil {
+			return err
+		}
+
+		be, ok := a.Scm.Handler.(*bitbucketscm.Bitbucket)
+
+		if !ok {
+			return fmt.Errorf("scm is not of kind 'bitbucket'")
+		}
+
+		g, err := bitbucket.New(actionSpec, be)
+
+		if err != nil {
+			return err
+		}
+
+		a.Handler = &g
+
+	case "azuredevops/pullrequest":
+		actionSpec := azuredevops.Spec{}
+
+		if a.Scm.Config.Kind != azuredevopsIdentifier {
+			return fmt.Errorf("scm of kind %q is not compatible with action of kind %q",
+				a.Scm.Config.Kind,
+				a.Config.Kind)
+		}
+
+		err := mapstructure.Decode(a.Config.Spec, &actionSpec)
+		if err != nil {
+			return err
+		}
+
+		ae, ok := a.Scm.Handler.(*azuredevopsscm.AzureDevOps)
+
+		if !ok {
+			return fmt.Errorf("scm is not of kind 'azuredevops'")
+		}
+
+		g, err := azuredevops.New(actionSpec, ae)
+
+		if err != nil {
+			return err
+		}
+
+		a.Handler = &g
+
 	default:
 		logrus.Errorf("action of kind %q is not supported", a.Config.Kind)
 	}
@@ -273,10 +335,12 @@ func (Config) JSONSchema() *jschema.Schema {
 	type configAlias Config
 
 	anyOfSpec := map[string]interface{}{
-		"github/pullrequest":  &github.ActionSpec{},
-		"gitea/pullrequest":   &gitea.Spec{},
-		"stash/pullrequest":   &stash.Spec{},
-		"gitlab/mergerequest": &gitlab.Spec{},
+		"azuredevops/pullrequest": &azuredevops.Spec{},
+		"bitbucket/pullrequest":   &bitbucket.Spec{},
+		"github/pullrequest":      &github.ActionSpec{},
+		"gitea/pullrequest":       &gitea.Spec{},
+		"stash/pullrequest":       &stash.Spec{},
+		"gitlab/mergerequest":     &gitlab.Spec{},
 	}
 
 	return jsonschema.AppendOneOfToJsonSchema(configAlias{}, anyOfSpec)
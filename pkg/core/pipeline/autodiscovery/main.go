@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/argocd"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/bundler"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/cargo"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/composer"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/terraform"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/terragrunt"
 
@@ -16,13 +18,20 @@ import (
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/dockerfile"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/fleet"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/flux"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/githubaction"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/gitlabci"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/golang"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/gradle"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/helm"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/helmfile"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/ko"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/kubernetes"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/kustomize"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/maven"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/npm"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/nuget"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/pip"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/precommit"
 )
 
 var (
@@ -30,17 +39,26 @@ var (
 	DefaultCrawlerSpecs = Config{
 		Crawlers: CrawlersConfig{
 			"argocd":        argocd.Spec{},
+			"bundler":       bundler.Spec{},
 			"cargo":         cargo.Spec{},
+			"composer":      composer.Spec{},
 			"dockercompose": dockercompose.Spec{},
 			"dockerfile":    dockerfile.Spec{},
 			"flux":          flux.Spec{},
+			"githubaction":  githubaction.Spec{},
+			"gitlabci":      gitlabci.Spec{},
 			"golang/gomod":  golang.Spec{},
+			"gradle":        gradle.Spec{},
 			"helm":          helm.Spec{},
 			"helmfile":      helmfile.Spec{},
 			"ko":            ko.Spec{},
 			"kubernetes":    kubernetes.Spec{},
+			"kustomize":     kustomize.Spec{},
 			"maven":         maven.Spec{},
 			"npm":           npm.Spec{},
+			"nuget":         nuget.Spec{},
+			"pip":           pip.Spec{},
+			"precommit":     precommit.Spec{},
 			"prow":          kubernetes.Spec{},
 			"rancher/fleet": fleet.Spec{},
 			"terraform":     &terraform.Spec{},
@@ -51,17 +69,26 @@ var (
 	// AutodiscoverySpecs is a map of all Autodiscovery specification
 	AutodiscoverySpecsMapping = map[string]interface{}{
 		"argocd":        argocd.Spec{},
+		"bundler":       &bundler.Spec{},
 		"cargo":         &cargo.Spec{},
+		"composer":      &composer.Spec{},
 		"dockercompose": &dockercompose.Spec{},
 		"dockerfile":    &dockerfile.Spec{},
 		"flux":          &flux.Spec{},
+		"githubaction":  &githubaction.Spec{},
+		"gitlabci":      &gitlabci.Spec{},
 		"golang/gomod":  &golang.Spec{},
+		"gradle":        &gradle.Spec{},
 		"helm":          &helm.Spec{},
 		"helmfile":      &helmfile.Spec{},
 		"ko":            &ko.Spec{},
 		"kubernetes":    &kubernetes.Spec{},
+		"kustomize":     &kustomize.Spec{},
 		"maven":         &maven.Spec{},
 		"npm":           &npm.Spec{},
+		"nuget":         &nuget.Spec{},
+		"pip":           &pip.Spec{},
+		"precommit":     &precommit.Spec{},
 		"prow":          &kubernetes.Spec{},
 		"rancher/fleet": &fleet.Spec{},
 		"terraform":     &terraform.Spec{},
@@ -112,6 +139,17 @@ func New(spec Config, workDir string) (*AutoDiscovery, error) {
 			}
 
 			g.crawlers = append(g.crawlers, argocdCrawler)
+		case "bundler":
+			crawler, err := bundler.New(
+				g.spec.Crawlers[kind],
+				workDir,
+				g.spec.ScmId)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s - %s", kind, err))
+				continue
+			}
+
+			g.crawlers = append(g.crawlers, crawler)
 		case "cargo":
 			cargoCrawler, err := cargo.New(
 				g.spec.Crawlers[kind],
@@ -124,6 +162,18 @@ func New(spec Config, workDir string) (*AutoDiscovery, error) {
 
 			g.crawlers = append(g.crawlers, cargoCrawler)
 
+		case "composer":
+			crawler, err := composer.New(
+				g.spec.Crawlers[kind],
+				workDir,
+				g.spec.ScmId)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s - %s", kind, err))
+				continue
+			}
+
+			g.crawlers = append(g.crawlers, crawler)
+
 		case "dockercompose":
 			crawler, err := dockercompose.New(
 				g.spec.Crawlers[kind],
@@ -160,6 +210,30 @@ func New(spec Config, workDir string) (*AutoDiscovery, error) {
 
 			g.crawlers = append(g.crawlers, crawler)
 
+		case "githubaction":
+			crawler, err := githubaction.New(
+				g.spec.Crawlers[kind],
+				workDir,
+				g.spec.ScmId)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s - %s", kind, err))
+				continue
+			}
+
+			g.crawlers = append(g.crawlers, crawler)
+
+		case "gitlabci":
+			crawler, err := gitlabci.New(
+				g.spec.Crawlers[kind],
+				workDir,
+				g.spec.ScmId)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s - %s", kind, err))
+				continue
+			}
+
+			g.crawlers = append(g.crawlers, crawler)
+
 		case "golang/gomod":
 			crawler, err := golang.New(
 				g.spec.Crawlers[kind],
@@ -172,6 +246,18 @@ func New(spec Config, workDir string) (*AutoDiscovery, error) {
 
 			g.crawlers = append(g.crawlers, crawler)
 
+		case "gradle":
+			crawler, err := gradle.New(
+				g.spec.Crawlers[kind],
+				workDir,
+				g.spec.ScmId)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s - %s", kind, err))
+				continue
+			}
+
+			g.crawlers = append(g.crawlers, crawler)
+
 		case "helm":
 			crawler, err := helm.New(
 				g.spec.Crawlers[kind],
@@ -221,6 +307,18 @@ func New(spec Config, workDir string) (*AutoDiscovery, error) {
 
 			g.crawlers = append(g.crawlers, crawler)
 
+		case "kustomize":
+			crawler, err := kustomize.New(
+				g.spec.Crawlers[kind],
+				workDir,
+				g.spec.ScmId)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s - %s", kind, err))
+				continue
+			}
+
+			g.crawlers = append(g.crawlers, crawler)
+
 		case "terraform":
 			crawler, err := terraform.New(
 				g.spec.Crawlers[kind],
@@ -268,6 +366,43 @@ func New(spec Config, workDir string) (*AutoDiscovery, error) {
 			}
 
 			g.crawlers = append(g.crawlers, crawler)
+
+		case "nuget":
+			crawler, err := nuget.New(
+				g.spec.Crawlers[kind],
+				workDir,
+				g.spec.ScmId)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s - %s", kind, err))
+				continue
+			}
+
+			g.crawlers = append(g.crawlers, crawler)
+
+		case "pip":
+			crawler, err := pip.New(
+				g.spec.Crawlers[kind],
+				workDir,
+				g.spec.ScmId)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s - %s", kind, err))
+				continue
+			}
+
+			g.crawlers = append(g.crawlers, crawler)
+
+		case "precommit":
+			crawler, err := precommit.New(
+				g.spec.Crawlers[kind],
+				workDir,
+				g.spec.ScmId)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s - %s", kind, err))
+				continue
+			}
+
+			g.crawlers = append(g.crawlers, crawler)
+
 		case "prow":
 			crawler, err := kubernetes.New(
 				g.spec.Crawlers[kind],
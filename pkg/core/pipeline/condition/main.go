@@ -1,15 +1,14 @@
 package condition
 
 import (
-	"bytes"
+	"context"
 	"errors"
-	"io"
-	"os"
 	"strings"
 
 	jschema "github.com/invopop/jsonschema"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/jsonschema"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
@@ -47,21 +46,20 @@ type Config struct {
 // Run tests if a specific condition is true
 func (c *Condition) Run(source string) (err error) {
 
-	var consoleOutput bytes.Buffer
-	// By default logrus logs to stderr, so I guess we want to keep this behavior...
-	logrus.SetOutput(io.MultiWriter(os.Stderr, &consoleOutput))
-	/*
-		The last defer will be executed first,
-		so in this case we want to first save the console output
-		before setting back the logrus output to stdout.
-	*/
-	// By default logrus logs to stderr, so I guess we want to keep this behavior...
-	defer logrus.SetOutput(os.Stderr)
-	defer c.Result.SetConsoleOutput(&consoleOutput)
+	// Record the console output of this condition only, using a logger passed to the resource through ctx,
+	// so concurrent pipelines don't write into each other's console output.
+	recorder := log.NewRecorder(logrus.Fields{
+		"stage": "condition",
+		"kind":  c.Config.ResourceConfig.Kind,
+		"name":  c.Config.ResourceConfig.Name,
+	})
+	logger := recorder.Logger()
+	ctx := log.WithLogger(context.Background(), logger)
+	defer func() { c.Result.ConsoleOutput = recorder.String() }()
 
 	c.Result.Result = result.FAILURE
 
-	condition, err := resource.New(c.Config.ResourceConfig)
+	condition, err := resource.New(ctx, c.Config.ResourceConfig)
 	if err != nil {
 		return err
 	}
@@ -90,7 +88,7 @@ func (c *Condition) Run(source string) (err error) {
 		}
 	}
 
-	ok, message, err := condition.Condition(source, s)
+	ok, message, err := condition.Condition(ctx, source, s)
 	if ok {
 		c.Result.Result = result.SUCCESS
 		c.Result.Pass = true
@@ -106,7 +104,7 @@ func (c *Condition) Run(source string) (err error) {
 	// FailWhen is used to reverse the expected condition value
 	// If failwhen is set to true, then we expected a condition returning "true" would be considered as a failure
 	if c.Config.FailWhen {
-		logrus.Debugf("Expected successful condition result to be %v", !c.Config.FailWhen)
+		logger.Debugf("Expected successful condition result to be %v", !c.Config.FailWhen)
 		if c.Result.Pass {
 			c.Result.Result = result.FAILURE
 			c.Result.Pass = false
@@ -116,7 +114,7 @@ func (c *Condition) Run(source string) (err error) {
 		}
 	}
 
-	logrus.Infof("%s %s", c.Result.Result, c.Result.Description)
+	logger.Infof("%s %s", c.Result.Result, c.Result.Description)
 
 	return nil
 }
@@ -94,3 +94,23 @@ func TestRun(t *testing.T) {
 		})
 	}
 }
+
+func TestRunConsoleOutput(t *testing.T) {
+	c := Condition{
+		Config: Config{
+			ResourceConfig: resource.ResourceConfig{
+				Kind: "file",
+				Spec: file.Spec{
+					File:         "main.go",
+					MatchPattern: "Run",
+				},
+			},
+			DisableSourceInput: true,
+		},
+	}
+
+	require.NoError(t, c.Run(""))
+
+	// Lines logged by the resource are recorded with the condition result
+	assert.Contains(t, c.Result.ConsoleOutput, "matched the pattern")
+}
@@ -1,6 +1,7 @@
 package resource
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -8,7 +9,12 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/transformer"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/awsami"
+	azuredevopsBranch "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/branch"
+	azuredevopsTag "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/tag"
+	bitbucketBranch "github.com/updatecli/updatecli/pkg/plugins/resources/bitbucket/branch"
+	bitbucketTag "github.com/updatecli/updatecli/pkg/plugins/resources/bitbucket/tag"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/cargopackage"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/composer"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/csv"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerdigest"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerfile"
@@ -32,6 +38,9 @@ import (
 	"github.com/updatecli/updatecli/pkg/plugins/resources/json"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/maven"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/npm"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/nuget"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/pypi"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/rubygems"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/shell"
 	stashBranch "github.com/updatecli/updatecli/pkg/plugins/resources/stash/branch"
 	stashTag "github.com/updatecli/updatecli/pkg/plugins/resources/stash/tag"
@@ -66,7 +75,7 @@ type ResourceConfig struct {
 }
 
 // New returns a newly initialized Resource or an error
-func New(rs ResourceConfig) (resource Resource, err error) {
+func New(ctx context.Context, rs ResourceConfig) (resource Resource, err error) {
 	kind := strings.ToLower(rs.Kind)
 
 	if _, ok := GetResourceMapping()[kind]; !ok {
@@ -82,6 +91,10 @@ func New(rs ResourceConfig) (resource Resource, err error) {
 
 		return cargopackage.New(rs.Spec, rs.SCMID != "")
 
+	case "composer":
+
+		return composer.New(rs.Spec)
+
 	case "csv":
 
 		return csv.New(rs.Spec)
@@ -178,10 +191,38 @@ func New(rs ResourceConfig) (resource Resource, err error) {
 
 		return npm.New(rs.Spec)
 
+	case "nuget":
+
+		return nuget.New(rs.Spec)
+
+	case "pypi":
+
+		return pypi.New(rs.Spec)
+
+	case "rubygems":
+
+		return rubygems.New(rs.Spec)
+
 	case "shell":
 
 		return shell.New(rs.Spec)
 
+	case "azuredevops/branch":
+
+		return azuredevopsBranch.New(rs.Spec)
+
+	case "azuredevops/tag":
+
+		return azuredevopsTag.New(rs.Spec)
+
+	case "bitbucket/branch":
+
+		return bitbucketBranch.New(rs.Spec)
+
+	case "bitbucket/tag":
+
+		return bitbucketTag.New(rs.Spec)
+
 	case "stash/branch":
 
 		return stashBranch.New(rs.Spec)
@@ -220,11 +261,11 @@ func New(rs ResourceConfig) (resource Resource, err error) {
 
 	case "xml":
 
-		return xml.New(rs.Spec)
+		return xml.New(ctx, rs.Spec)
 
 	case "yaml":
 
-		return yaml.New(rs.Spec)
+		return yaml.New(ctx, rs.Spec)
 
 	default:
 
@@ -232,11 +273,12 @@ func New(rs ResourceConfig) (resource Resource, err error) {
 	}
 }
 
-// Resource allow to manipulate a resource that can be a source, a condition or a target
+// Resource allow to manipulate a resource that can be a source, a condition or a target.
+// ctx carries the logger scoped to the resource execution, retrieved using log.FromContext.
 type Resource interface {
-	Source(workingDir string, sourceResult *result.Source) error
-	Condition(version string, scm scm.ScmHandler) (pass bool, message string, err error)
-	Target(source string, scm scm.ScmHandler, dryRun bool, targetResult *result.Target) (err error)
+	Source(ctx context.Context, workingDir string, sourceResult *result.Source) error
+	Condition(ctx context.Context, version string, scm scm.ScmHandler) (pass bool, message string, err error)
+	Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, targetResult *result.Target) (err error)
 	Changelog() string
 }
 
@@ -244,7 +286,12 @@ type Resource interface {
 func GetResourceMapping() map[string]interface{} {
 	return map[string]interface{}{
 		"aws/ami":            &awsami.Spec{},
+		"azuredevops/branch": &azuredevopsBranch.Spec{},
+		"azuredevops/tag":    &azuredevopsTag.Spec{},
+		"bitbucket/branch":   &bitbucketBranch.Spec{},
+		"bitbucket/tag":      &bitbucketTag.Spec{},
 		"cargopackage":       &cargopackage.Spec{},
+		"composer":           &composer.Spec{},
 		"csv":                &csv.Spec{},
 		"dockerdigest":       &dockerdigest.Spec{},
 		"dockerfile":         &dockerfile.Spec{},
@@ -269,6 +316,9 @@ func GetResourceMapping() map[string]interface{} {
 		"json":               &json.Spec{},
 		"maven":              &maven.Spec{},
 		"npm":                &npm.Spec{},
+		"nuget":              &nuget.Spec{},
+		"pypi":               &pypi.Spec{},
+		"rubygems":           &rubygems.Spec{},
 		"shell":              &shell.Spec{},
 		"stash/branch":       &stashBranch.Spec{},
 		"stash/tag":          &stashTag.Spec{},
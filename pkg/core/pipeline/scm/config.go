@@ -9,6 +9,8 @@ import (
 	jschema "github.com/invopop/jsonschema"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/jsonschema"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/azuredevops"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/bitbucket"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/git"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/gitea"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/github"
@@ -153,11 +155,13 @@ func (Config) JSONSchema() *jschema.Schema {
 	type configAlias Config
 
 	anyOfSpec := map[string]interface{}{
-		"git":    &git.Spec{},
-		"gitea":  &gitea.Spec{},
-		"github": &github.Spec{},
-		"gitlab": &gitlab.Spec{},
-		"stash":  &stash.Spec{},
+		"azuredevops": &azuredevops.Spec{},
+		"bitbucket":   &bitbucket.Spec{},
+		"git":         &git.Spec{},
+		"gitea":       &gitea.Spec{},
+		"github":      &github.Spec{},
+		"gitlab":      &gitlab.Spec{},
+		"stash":       &stash.Spec{},
 	}
 
 	return jsonschema.AppendOneOfToJsonSchema(configAlias{}, anyOfSpec)
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/mitchellh/mapstructure"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/azuredevops"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/bitbucket"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/git"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/gitea"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/github"
@@ -63,6 +65,24 @@ func (s *Scm) GenerateSCM() error {
 	}
 
 	switch s.Config.Kind {
+	case "azuredevops":
+		g, err := azuredevops.New(s.Config.Spec, s.PipelineID)
+
+		if err != nil {
+			return err
+		}
+
+		s.Handler = g
+
+	case "bitbucket":
+		g, err := bitbucket.New(s.Config.Spec, s.PipelineID)
+
+		if err != nil {
+			return err
+		}
+
+		s.Handler = g
+
 	case "stash":
 		g, err := stash.New(s.Config.Spec, s.PipelineID)
 
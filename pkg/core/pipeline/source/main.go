@@ -1,9 +1,8 @@
 package source
 
 import (
-	"bytes"
+	"context"
 	"errors"
-	"io"
 	"os"
 	"strings"
 
@@ -11,6 +10,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/updatecli/updatecli/pkg/core/jsonschema"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
@@ -43,19 +43,18 @@ var (
 // Run execute actions defined by the source configuration
 func (s *Source) Run() (err error) {
 
-	var consoleOutput bytes.Buffer
-	// By default logrus logs to stderr, so I guess we want to keep this behavior...
-	logrus.SetOutput(io.MultiWriter(os.Stderr, &consoleOutput))
-	/*
-		The last defer will be executed first,
-		so in this case we want to first save the console output
-		before setting back the logrus output to stdout.
-	*/
-	// By default logrus logs to stderr, so I guess we want to keep this behavior...
-	defer logrus.SetOutput(os.Stderr)
-	defer s.Result.SetConsoleOutput(&consoleOutput)
-
-	source, err := resource.New(s.Config.ResourceConfig)
+	// Record the console output of this source only, using a logger passed to the resource through ctx,
+	// so concurrent pipelines don't write into each other's console output.
+	recorder := log.NewRecorder(logrus.Fields{
+		"stage": "source",
+		"kind":  s.Config.ResourceConfig.Kind,
+		"name":  s.Config.ResourceConfig.Name,
+	})
+	logger := recorder.Logger()
+	ctx := log.WithLogger(context.Background(), logger)
+	defer func() { s.Result.ConsoleOutput = recorder.String() }()
+
+	source, err := resource.New(ctx, s.Config.ResourceConfig)
 	if err != nil {
 		s.Result.Result = result.FAILURE
 		return err
@@ -87,37 +86,37 @@ func (s *Source) Run() (err error) {
 		workingDir = SCM.GetDirectory()
 	}
 
-	err = source.Source(workingDir, &s.Result)
+	err = source.Source(ctx, workingDir, &s.Result)
 
 	s.Output = s.Result.Information
 
 	if err != nil {
 		s.Result.Result = result.FAILURE
-		logrus.Errorf("%s %s", s.Result.Result, err)
+		logger.Errorf("%s %s", s.Result.Result, err)
 		return err
 	}
 
-	logrus.Infof("%s %s", s.Result.Result, s.Result.Description)
+	logger.Infof("%s %s", s.Result.Result, s.Result.Description)
 
 	// Once the source is executed, then it can retrieve its changelog
 	// Any error means an empty changelog
 	s.Changelog = source.Changelog()
 	if s.Changelog == "" {
-		logrus.Debugln("empty changelog found for the source")
+		logger.Debugln("empty changelog found for the source")
 	}
 	s.Result.Changelog = s.Changelog
 
 	if len(s.Config.ResourceConfig.Transformers) > 0 {
 		s.Output, err = s.Config.ResourceConfig.Transformers.Apply(s.Output)
 		if err != nil {
-			logrus.Errorf("%s %s", s.Result.Result, err)
+			logger.Errorf("%s %s", s.Result.Result, err)
 			s.Result.Result = result.FAILURE
 			return err
 		}
 	}
 
 	if len(s.Output) == 0 && s.Result.Result == result.SUCCESS {
-		logrus.Debugln("empty source detected")
+		logger.Debugln("empty source detected")
 	}
 
 	return err
@@ -1,16 +1,15 @@
 package target
 
 import (
-	"bytes"
+	"context"
 	"errors"
 	"fmt"
-	"io"
-	"os"
 	"strings"
 
 	jschema "github.com/invopop/jsonschema"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/jsonschema"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
@@ -94,17 +93,16 @@ func (t *Target) Check() (bool, error) {
 
 // Run applies a specific target configuration
 func (t *Target) Run(source string, o *Options) (err error) {
-	var consoleOutput bytes.Buffer
-	// By default logrus logs to stderr, so I guess we want to keep this behavior...
-	logrus.SetOutput(io.MultiWriter(os.Stderr, &consoleOutput))
-	/*
-		The last defer will be executed first,
-		so in this case we want to first save the console output
-		before setting back the logrus output to stdout.
-	*/
-	// By default logrus logs to stderr, so I guess we want to keep this behavior...
-	defer logrus.SetOutput(os.Stderr)
-	defer t.Result.SetConsoleOutput(&consoleOutput)
+	// Record the console output of this target only, using a logger passed to the resource through ctx,
+	// so concurrent pipelines don't write into each other's console output.
+	recorder := log.NewRecorder(logrus.Fields{
+		"stage": "target",
+		"kind":  t.Config.ResourceConfig.Kind,
+		"name":  t.Config.ResourceConfig.Name,
+	})
+	logger := recorder.Logger()
+	ctx := log.WithLogger(context.Background(), logger)
+	defer func() { t.Result.ConsoleOutput = recorder.String() }()
 
 	failTargetRun := func() {
 		t.Result.Result = result.FAILURE
@@ -120,10 +118,10 @@ func (t *Target) Run(source string, o *Options) (err error) {
 	}
 
 	if o.DryRun {
-		logrus.Infof("\n**Dry Run enabled**\n\n")
+		logger.Infof("\n**Dry Run enabled**\n\n")
 	}
 
-	target, err := resource.New(t.Config.ResourceConfig)
+	target, err := resource.New(ctx, t.Config.ResourceConfig)
 	if err != nil {
 		failTargetRun()
 		return err
@@ -131,14 +129,14 @@ func (t *Target) Run(source string, o *Options) (err error) {
 
 	// If no scm configuration provided then stop early
 	if t.Scm == nil {
-		err = target.Target(source, nil, o.DryRun, &t.Result)
+		err = target.Target(ctx, source, nil, o.DryRun, &t.Result)
 		if err != nil {
 			failTargetRun()
 			return err
 		}
 
 		// Could be improve to show attention description in yellow, success in green, failure in red
-		logrus.Infof("%s - %s", t.Result.Result, t.Result.Description)
+		logger.Infof("%s - %s", t.Result.Result, t.Result.Description)
 
 		return nil
 	}
@@ -159,14 +157,14 @@ func (t *Target) Run(source string, o *Options) (err error) {
 		return err
 	}
 
-	err = target.Target(source, s, o.DryRun, &t.Result)
+	err = target.Target(ctx, source, s, o.DryRun, &t.Result)
 	if err != nil {
 		failTargetRun()
 		return err
 	}
 
 	// Could be improve to show attention description in yellow, success in green, failure in red
-	logrus.Infof("%s - %s", t.Result.Result, t.Result.Description)
+	logger.Infof("%s - %s", t.Result.Result, t.Result.Description)
 
 	isRemoteBranchUpToDate, err := s.IsRemoteBranchUpToDate()
 	if err != nil {
@@ -181,7 +179,7 @@ func (t *Target) Run(source string, o *Options) (err error) {
 			return nil
 		}
 
-		logrus.Infof("\n\u26A0 While nothing change in the current pipeline run, according to the git history, some commits must be pushed\n")
+		logger.Infof("\n\u26A0 While nothing change in the current pipeline run, according to the git history, some commits must be pushed\n")
 		t.Result.Description = fmt.Sprintf("%s\n\n%s", t.Result.Description, "While nothing change in the current pipeline run, according to the git history, some commits must pushed")
 
 		// Even though the target has no changes, it has something to commit.
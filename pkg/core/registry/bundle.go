@@ -0,0 +1,413 @@
+package registry
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sirupsen/logrus"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/oci"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+// Export writes policies, and their signatures, from OCI registries into a single OCI layout tarball.
+func Export(policyReferences []string, bundle string, disableTLS bool) error {
+
+	if len(policyReferences) == 0 {
+		return errors.New("no policy to export")
+	}
+
+	layoutDir, err := os.MkdirTemp("", "updatecli-bundle")
+	if err != nil {
+		return fmt.Errorf("create bundle directory: %w", err)
+	}
+	defer os.RemoveAll(layoutDir)
+
+	store, err := oci.New(layoutDir)
+	if err != nil {
+		return fmt.Errorf("create OCI layout: %w", err)
+	}
+
+	ctx := context.Background()
+
+	for _, policy := range policyReferences {
+		ref, err := parseReference(policy, disableTLS)
+		if err != nil {
+			return fmt.Errorf("policy %q: %w", policy, err)
+		}
+
+		repo, err := remote.NewRepository(ref.String())
+		if err != nil {
+			return fmt.Errorf("new repository: %w", err)
+		}
+
+		repoCtx := auth.AppendRepositoryScope(ctx, repo.Reference, auth.ActionPull)
+
+		if disableTLS {
+			logrus.Debugln("TLS connection is disabled")
+			repo.PlainHTTP = true
+		}
+
+		if err := getCredentialsFromDockerStore(repo); err != nil {
+			return fmt.Errorf("credstore from docker: %w", err)
+		}
+
+		// The policy is tagged with its full reference, so policies from different repositories can coexist
+		manifestDescriptor, err := oras.ExtendedCopy(repoCtx, repo, ref.Reference, store, ref.String(), oras.DefaultExtendedCopyOptions)
+		if err != nil {
+			return fmt.Errorf("export policy %q: %w", policy, err)
+		}
+
+		logPolicyMetadata(ctx, store, manifestDescriptor, "Exporting", ref.String())
+	}
+
+	if err := writeTarball(layoutDir, bundle); err != nil {
+		return fmt.Errorf("write bundle %q: %w", bundle, err)
+	}
+
+	logrus.Infof("Updatecli policy bundle written to %q", bundle)
+
+	return nil
+}
+
+// Import loads policies, and their signatures, from an OCI layout tarball into the local policy store.
+// Imported policies are then used when their OCI registry can't be reached, until they are removed using Prune.
+func Import(bundle string) error {
+
+	localStorePath, err := getLocalStorePath()
+	if err != nil {
+		return err
+	}
+
+	layoutDir, err := os.MkdirTemp("", "updatecli-bundle")
+	if err != nil {
+		return fmt.Errorf("create bundle directory: %w", err)
+	}
+	defer os.RemoveAll(layoutDir)
+
+	if err := extractTarball(bundle, layoutDir); err != nil {
+		return fmt.Errorf("extract bundle %q: %w", bundle, err)
+	}
+
+	src, err := oci.New(layoutDir)
+	if err != nil {
+		return fmt.Errorf("open bundle %q: %w", bundle, err)
+	}
+
+	dst, err := oci.New(localStorePath)
+	if err != nil {
+		return fmt.Errorf("open local policy store: %w", err)
+	}
+
+	ctx := context.Background()
+
+	tags, err := registry.Tags(ctx, src)
+	if err != nil {
+		return fmt.Errorf("list bundle policies: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return fmt.Errorf("no policy found in bundle %q", bundle)
+	}
+
+	for _, tag := range tags {
+		manifestDescriptor, err := oras.ExtendedCopy(ctx, src, tag, dst, tag, oras.DefaultExtendedCopyOptions)
+		if err != nil {
+			return fmt.Errorf("import policy %q: %w", tag, err)
+		}
+
+		logPolicyMetadata(ctx, dst, manifestDescriptor, "Importing", tag)
+	}
+
+	logrus.Infof("%d Updatecli policies imported in %q", len(tags), localStorePath)
+
+	return nil
+}
+
+// Prune removes every imported policy from the local policy store,
+// so policies are retrieved from OCI registries again.
+func Prune() error {
+
+	localStorePath, err := getLocalStorePath()
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(localStorePath); errors.Is(err, os.ErrNotExist) {
+		logrus.Infof("No imported Updatecli policies to remove from %q", localStorePath)
+		return nil
+	}
+
+	if err := os.RemoveAll(localStorePath); err != nil {
+		return fmt.Errorf("remove local policy store %q: %w", localStorePath, err)
+	}
+
+	logrus.Infof("Imported Updatecli policies removed from %q", localStorePath)
+
+	return nil
+}
+
+// getLocalStorePath returns the path to the OCI layout containing imported policies.
+// The local policy store is located in the user cache directory, so other users can't alter it,
+// and it's kept until it is pruned.
+func getLocalStorePath() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("get user cache directory: %w", err)
+	}
+
+	return filepath.Join(cacheDir, "updatecli", "bundle"), nil
+}
+
+// isRegistryUnreachable returns true if err is caused by a network failure while contacting an OCI registry.
+// Imported policies are only used in that case, so they never take precedence over a reachable registry.
+func isRegistryUnreachable(err error) bool {
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	var netErr net.Error
+
+	return errors.As(err, &opErr) ||
+		errors.As(err, &dnsErr) ||
+		(errors.As(err, &netErr) && netErr.Timeout())
+}
+
+// resolveImportedReference looks for a policy in the local policy store populated by Import.
+// The returned reference tag is resolved the same way than for an OCI registry.
+func resolveImportedReference(ctx context.Context, ociName string) (*oci.Store, registry.Reference, bool, error) {
+
+	localStorePath, err := getLocalStorePath()
+	if err != nil {
+		return nil, registry.Reference{}, false, err
+	}
+
+	if _, err := os.Stat(filepath.Join(localStorePath, v1.ImageIndexFile)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, registry.Reference{}, false, nil
+		}
+		return nil, registry.Reference{}, false, err
+	}
+
+	name, constraint := splitReferenceConstraint(ociName)
+
+	ref, err := registry.ParseReference(name)
+	if err != nil {
+		return nil, registry.Reference{}, false, fmt.Errorf("parse reference: %w", err)
+	}
+
+	store, err := oci.New(localStorePath)
+	if err != nil {
+		return nil, registry.Reference{}, false, fmt.Errorf("open local policy store: %w", err)
+	}
+
+	// A specific tag, or digest, is used as is
+	if constraint == "" && ref.Reference != "" && ref.Reference != ociLatestTag {
+		_, err := store.Resolve(ctx, localReference(ref))
+		switch {
+		case err == nil:
+			return store, ref, true, nil
+		case errors.Is(err, errdef.ErrNotFound):
+			return nil, registry.Reference{}, false, nil
+		default:
+			return nil, registry.Reference{}, false, err
+		}
+	}
+
+	tags, err := registry.Tags(ctx, store)
+	if err != nil {
+		return nil, registry.Reference{}, false, fmt.Errorf("list imported policies: %w", err)
+	}
+
+	repositoryPrefix := ref.Registry + "/" + ref.Repository + ":"
+
+	var repositoryTags []string
+	for _, tag := range tags {
+		if strings.HasPrefix(tag, repositoryPrefix) {
+			repositoryTags = append(repositoryTags, strings.TrimPrefix(tag, repositoryPrefix))
+		}
+	}
+
+	if len(repositoryTags) == 0 {
+		return nil, registry.Reference{}, false, nil
+	}
+
+	ref.Reference, err = getLatestSemverTag(repositoryTags, constraint)
+	if err != nil {
+		logrus.Debugf("no imported policy matching %q: %s", ociName, err)
+		return nil, registry.Reference{}, false, nil
+	}
+
+	return store, ref, true, nil
+}
+
+// localReference returns the reference used to resolve a policy in the local policy store,
+// policies are tagged with their full reference while digests are used as is.
+func localReference(ref registry.Reference) string {
+	if _, err := ref.Digest(); err == nil {
+		return ref.Reference
+	}
+	return ref.String()
+}
+
+// logPolicyMetadata shows the policy metadata, from the policy manifest annotations
+func logPolicyMetadata(ctx context.Context, storage content.Fetcher, manifestDescriptor v1.Descriptor, action, policy string) {
+
+	manifestData, err := content.FetchAll(ctx, storage, manifestDescriptor)
+	if err != nil {
+		logrus.Debugf("fetch policy manifest: %s", err)
+		return
+	}
+
+	manifest := v1.Manifest{}
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		logrus.Debugf("unmarshal policy manifest: %s", err)
+		return
+	}
+
+	logrus.Infof("%s Updatecli policy %q", action, policy)
+	logrus.Infof("\t* digest: %s", manifestDescriptor.Digest)
+
+	for _, annotation := range []string{
+		v1.AnnotationVersion,
+		v1.AnnotationDescription,
+		v1.AnnotationSource,
+	} {
+		if value := manifest.Annotations[annotation]; value != "" {
+			logrus.Infof("\t* %s: %s", strings.TrimPrefix(annotation, "org.opencontainers.image."), strings.TrimSpace(value))
+		}
+	}
+}
+
+// writeTarball writes every file from a directory into a tarball
+func writeTarball(dir, output string) error {
+
+	f, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	tw := tar.NewWriter(f)
+
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		if relativePath == "." {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relativePath)
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		data, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer data.Close()
+
+		_, err = io.Copy(tw, data)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// extractTarball extracts a tarball into a directory
+func extractTarball(input, dir string) error {
+
+	f, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	tr := tar.NewReader(f)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// Prevent path traversal from a malicious bundle
+		if !filepath.IsLocal(filepath.FromSlash(header.Name)) {
+			return fmt.Errorf("invalid file path %q", header.Name)
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(header.Name))
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+
+			out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+			if err != nil {
+				return err
+			}
+
+			//nolint:gosec // the bundle is not compressed
+			if _, err := io.Copy(out, tr); err != nil {
+				out.Close()
+				return err
+			}
+
+			if err := out.Close(); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported file type %q for %q", header.Typeflag, header.Name)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package registry
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/errdef"
+)
+
+func TestExportImport(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	server := httptest.NewServer(ggcrregistry.New(
+		ggcrregistry.Logger(log.New(io.Discard, "", 0)),
+		ggcrregistry.WithReferrersSupport(true),
+	))
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	policy := fmt.Sprintf("%s/policy", u.Host)
+	signKey, verifyKey := newTestKeyPair(t)
+
+	digests := map[string]string{}
+	push := func(version string) {
+		policyDir := newTestPolicy(t, version)
+
+		err := Push(
+			filepath.Join(policyDir, "Policy.yaml"),
+			[]string{"updatecli.yaml"},
+			nil,
+			nil,
+			[]string{policy},
+			true,
+			policyDir,
+			false,
+			signKey)
+		require.NoError(t, err)
+
+		_, digests[version], err = ResolveReference(policy+":"+version, true)
+		require.NoError(t, err)
+	}
+
+	for _, version := range []string{"1.0.0", "1.0.1", "1.1.0"} {
+		push(version)
+	}
+
+	bundle := filepath.Join(t.TempDir(), "policies.tar")
+
+	err = Export([]string{policy + ":~1.0", policy + ":1.1.0"}, bundle, true)
+	require.NoError(t, err)
+
+	require.NoError(t, Import(bundle))
+
+	// Imported policies don't take precedence over a reachable OCI registry
+	push("1.2.0")
+
+	_, gotDigest, err := ResolveReference(policy, true)
+	require.NoError(t, err)
+	assert.Equal(t, digests["1.2.0"], gotDigest)
+
+	gotManifests, _, _, _, err := Pull(policy, true, nil)
+	require.NoError(t, err)
+	require.Len(t, gotManifests, 1)
+	assert.Equal(t, digests["1.2.0"][len("sha256:"):], filepath.Base(filepath.Dir(gotManifests[0])))
+
+	// From now on, policies must be retrieved without network access
+	server.Close()
+
+	testdata := []struct {
+		name            string
+		reference       string
+		publicKeys      []string
+		expectedVersion string
+		expectedError   bool
+	}{
+		{
+			name:            "Imported tag",
+			reference:       policy + ":1.1.0",
+			expectedVersion: "1.1.0",
+		},
+		{
+			name:            "Latest imported version",
+			reference:       policy,
+			expectedVersion: "1.1.0",
+		},
+		{
+			name:            "Imported version matching a constraint",
+			reference:       policy + ":~1.0",
+			expectedVersion: "1.0.1",
+		},
+		{
+			name:            "Imported digest",
+			reference:       policy + "@" + digests["1.0.1"],
+			expectedVersion: "1.0.1",
+		},
+		{
+			name:            "Imported signature",
+			reference:       policy + ":1.1.0",
+			publicKeys:      []string{verifyKey},
+			expectedVersion: "1.1.0",
+		},
+		{
+			name:          "Version not exported",
+			reference:     policy + ":1.0.0",
+			expectedError: true,
+		},
+	}
+
+	for _, data := range testdata {
+		t.Run(data.name, func(t *testing.T) {
+			gotManifests, _, _, _, err := Pull(data.reference, true, data.publicKeys)
+			if data.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, gotManifests, 1)
+
+			expectedDir := digests[data.expectedVersion][len("sha256:"):]
+			assert.Equal(t, expectedDir, filepath.Base(filepath.Dir(gotManifests[0])))
+
+			_, gotDigest, err := ResolveReference(data.reference, true)
+			require.NoError(t, err)
+			assert.Equal(t, digests[data.expectedVersion], gotDigest)
+		})
+	}
+
+	// Once pruned, policies are retrieved from the OCI registry again
+	require.NoError(t, Prune())
+
+	localStorePath, err := getLocalStorePath()
+	require.NoError(t, err)
+	assert.NoDirExists(t, localStorePath)
+
+	_, _, _, _, err = Pull(policy+":1.1.0", true, nil)
+	require.Error(t, err)
+
+	// Pruning an empty local policy store is a no-op
+	require.NoError(t, Prune())
+}
+
+func TestExtractTarball(t *testing.T) {
+	testdata := []struct {
+		name          string
+		file          string
+		expectedError bool
+	}{
+		{
+			name: "Local file",
+			file: "blobs/sha256/abc",
+		},
+		{
+			name:          "Path traversal",
+			file:          "../../abc",
+			expectedError: true,
+		},
+		{
+			name:          "Absolute path",
+			file:          "/tmp/abc",
+			expectedError: true,
+		},
+	}
+
+	for _, data := range testdata {
+		t.Run(data.name, func(t *testing.T) {
+			bundle := filepath.Join(t.TempDir(), "bundle.tar")
+
+			f, err := os.Create(bundle)
+			require.NoError(t, err)
+
+			tw := tar.NewWriter(f)
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: data.file, Mode: 0600, Size: 4, Typeflag: tar.TypeReg}))
+			_, err = tw.Write([]byte("test"))
+			require.NoError(t, err)
+			require.NoError(t, tw.Close())
+			require.NoError(t, f.Close())
+
+			dir := t.TempDir()
+			err = extractTarball(bundle, dir)
+			if data.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			got, err := os.ReadFile(filepath.Join(dir, data.file))
+			require.NoError(t, err)
+			assert.Equal(t, "test", string(got))
+		})
+	}
+}
+
+func TestIsRegistryUnreachable(t *testing.T) {
+	testdata := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "Connection refused",
+			err:      fmt.Errorf("resolve: %w", &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}),
+			expected: true,
+		},
+		{
+			name:     "Unknown host",
+			err:      fmt.Errorf("resolve: %w", &net.DNSError{Err: "no such host", Name: "registry.example"}),
+			expected: true,
+		},
+		{
+			name:     "Policy not found",
+			err:      fmt.Errorf("resolve: %w", errdef.ErrNotFound),
+			expected: false,
+		},
+	}
+
+	for _, data := range testdata {
+		t.Run(data.name, func(t *testing.T) {
+			assert.Equal(t, data.expected, isRegistryUnreachable(data.err))
+		})
+	}
+}
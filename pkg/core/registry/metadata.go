@@ -28,6 +28,9 @@ type PolicySpec struct {
 	Version string `yaml:",omitempty"`
 	// URL is the URL of the policy source code
 	URL string `yaml:",omitempty"`
+	// ValuesSchema is the path, relative to the policy root directory, of a JSON schema
+	// used to validate the values provided to the policy
+	ValuesSchema string `yaml:",omitempty"`
 }
 
 // LoadPolicyFile loads an Updatecli compose file into a compose Spec
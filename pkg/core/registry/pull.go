@@ -2,6 +2,7 @@ package registry
 
 import (
 	"context"
+	"crypto"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,94 +16,88 @@ import (
 	oras "oras.land/oras-go/v2"
 	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/content/file"
-	"oras.land/oras-go/v2/registry"
-	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
 )
 
 // Pull pulls an OCI image from a registry.
-func Pull(ociName string, disableTLS bool) (manifests []string, values []string, secrets []string, err error) {
+// If publicKeys is not empty, the policy is only pulled if it has a valid signature for one of the public keys.
+// valuesSchema is empty if the policy doesn't provide a JSON schema for its values.
+func Pull(ociName string, disableTLS bool, publicKeys []string) (manifests []string, values []string, secrets []string, valuesSchema string, err error) {
 
-	ref, err := registry.ParseReference(ociName)
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("parse reference: %w", err)
-	}
-
-	if ref.Reference == ociLatestTag || ref.Reference == "" {
-		ref.Reference, err = getLatestTagSortedBySemver(ref.Registry+"/"+ref.Repository, disableTLS)
+	var verifyKeys []crypto.PublicKey
+	if len(publicKeys) > 0 {
+		verifyKeys, err = LoadPublicKeys(publicKeys)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("get latest tag sorted by semver: %w", err)
+			return nil, nil, nil, "", fmt.Errorf("load public keys: %w", err)
 		}
 	}
 
-	// 1. Connect to a remote repository
 	ctx := context.Background()
 
-	repo, err := remote.NewRepository(ociName)
+	// 1. Resolve the policy from its OCI registry, or from imported policies if the registry can't be reached
+	source, err := resolvePolicySource(ctx, ociName, disableTLS)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("new repository: %w", err)
+		return nil, nil, nil, "", err
 	}
 
-	ctx = auth.AppendRepositoryScope(ctx, repo.Reference, auth.ActionPull)
+	ctx = auth.AppendRepositoryScope(ctx, source.ref, auth.ActionPull)
 
-	if disableTLS {
-		logrus.Debugln("TLS connection is disabled")
-		repo.PlainHTTP = true
+	// 2. Get the policy manifest
+	remoteManifestSpec := source.manifest
+	remoteManifestData, err := content.FetchAll(ctx, source.target, remoteManifestSpec)
+	if err != nil {
+		return nil, nil, nil, "", fmt.Errorf("fetch remote content: %w", err)
 	}
 
-	// 2. Get credentials from the docker credential store
-	if err := getCredentialsFromDockerStore(repo); err != nil {
-		return nil, nil, nil, fmt.Errorf("credstore from docker: %w", err)
+	// 2.5 Verify the policy manifest signature
+	if len(verifyKeys) > 0 {
+		if err := verifyManifest(ctx, source.target, remoteManifestSpec, verifyKeys); err != nil {
+			return nil, nil, nil, "", fmt.Errorf("verify policy %q: %w", ociName, err)
+		}
 	}
 
-	// 2.5 Get remote manifest digest
-	remoteManifestSpec, remoteManifestReader, err := oras.Fetch(ctx, repo, ref.String(), oras.DefaultFetchOptions)
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("fetch: %w", err)
-	}
+	// Create the policy root directory
+	policyRootDir := filepath.Join(getReferencePath(remoteManifestSpec.Digest.String())...)
 
-	remoteManifestData, err := content.ReadAll(remoteManifestReader, remoteManifestSpec)
+	manifests, values, secrets, valuesSchema, err = getUpdatecliFilesFromManifestLayers(remoteManifestData, policyRootDir)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("fetch remote content: %w", err)
+		return nil, nil, nil, "", fmt.Errorf("get media types from layers: %w", err)
 	}
 
-	// Create the policy root directory
-	policyRootDir := filepath.Join(getReferencePath(remoteManifestSpec.Digest.String())...)
+	isPolicyAvailable := isPolicyFilesExistLocally(policyRootDir, remoteManifestData)
+	if !isPolicyAvailable {
+		// Remove any leftover, or tampered, content before copying the policy again
+		if err := os.RemoveAll(policyRootDir); err != nil {
+			return nil, nil, nil, "", fmt.Errorf("clean policy root dir: %w", err)
+		}
+	}
 
 	fs, err := file.New(policyRootDir)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("create file store: %w", err)
+		return nil, nil, nil, "", fmt.Errorf("create file store: %w", err)
 	}
 	defer fs.Close()
 
 	// 3. Copy from the remote repository to the file store
-
-	// Fetch the remote manifest
-
-	remoteManifests, remoteValues, remoteSecrets, err := getUpdatecliFilesFromManifestLayers(remoteManifestData, policyRootDir)
-
-	manifests = remoteManifests
-	values = remoteValues
-	secrets = remoteSecrets
-
-	if isPolicyFilesExistLocally(policyRootDir, remoteManifests, remoteValues, remoteSecrets) {
+	if isPolicyAvailable {
 		logrus.Debugf("Policy %q already available in:\n\t* %s\n", ociName, policyRootDir)
 	} else {
 		logrus.Infof("Pulling Updatecli policy %q\n", ociName)
 
-		manifestDescriptor, err := oras.Copy(ctx, repo, ref.Reference, fs, ref.Reference, oras.DefaultCopyOptions)
+		// Copy by digest, so the pulled content is the one which has been verified
+		manifestDescriptor, err := oras.Copy(ctx, source.target, remoteManifestSpec.Digest.String(), fs, source.ref.Reference, oras.DefaultCopyOptions)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("copy: %w", err)
+			return nil, nil, nil, "", fmt.Errorf("copy: %w", err)
 		}
 
 		manifestData, err := content.FetchAll(ctx, fs, manifestDescriptor)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fetch manifest: %w", err)
+			return nil, nil, nil, "", fmt.Errorf("fetch manifest: %w", err)
 		}
 
-		manifests, values, secrets, err = getUpdatecliFilesFromManifestLayers(manifestData, policyRootDir)
+		manifests, values, secrets, valuesSchema, err = getUpdatecliFilesFromManifestLayers(manifestData, policyRootDir)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("get media types from layers: %w", err)
+			return nil, nil, nil, "", fmt.Errorf("get media types from layers: %w", err)
 		}
 	}
 
@@ -125,9 +120,13 @@ func Pull(ociName string, disableTLS bool) (manifests []string, values []string,
 		}
 	}
 
+	if valuesSchema != "" {
+		logrus.Debugf("Values schema:\n\t*%q\n", valuesSchema)
+	}
+
 	logrus.Debugf("policy successfully pulled in %s", policyRootDir)
 
-	return manifests, values, secrets, nil
+	return manifests, values, secrets, valuesSchema, nil
 }
 
 // getReferencePath returns the path to the file store for a given reference.
@@ -142,14 +141,14 @@ func getReferencePath(ref string) []string {
 	return refPath
 }
 
-// getUpdatecliFilesFromManifestLayers returns the list of manifests, values and secrets from an OCI manifest
+// getUpdatecliFilesFromManifestLayers returns the list of manifests, values, secrets and the values schema from an OCI manifest
 func getUpdatecliFilesFromManifestLayers(manifestData []byte, policyRootDir string) (
-	manifests []string, values []string, secrets []string, err error) {
+	manifests []string, values []string, secrets []string, valuesSchema string, err error) {
 
 	spec := spec.Manifest{}
 	err = json.Unmarshal(manifestData, &spec)
 	if err != nil {
-		return []string{}, []string{}, []string{}, fmt.Errorf("unmarshal manifest: %w", err)
+		return []string{}, []string{}, []string{}, "", fmt.Errorf("unmarshal manifest: %w", err)
 	}
 
 	for _, layer := range spec.Layers {
@@ -169,51 +168,92 @@ func getUpdatecliFilesFromManifestLayers(manifestData []byte, policyRootDir stri
 				secrets = append(secrets, filepath.Join(policyRootDir, title))
 			}
 
+		case updatecliValuesSchemaMediaType:
+			if title, ok := layer.Annotations["org.opencontainers.image.title"]; ok && title != "" {
+				valuesSchema = filepath.Join(policyRootDir, title)
+			}
+
 		default:
 			logrus.Warningf("unknown media type: %q\n", layer.MediaType)
 		}
 	}
 
-	return manifests, values, secrets, nil
+	return manifests, values, secrets, valuesSchema, nil
 }
 
 /*
 isPolicyFilesExistLocally returns true if the policy files are already available locally.
-note it does not check if the files are up to date, only if they exist locally.
+Every file must match the digest of its layer in the manifest, so a cached policy
+is only reused if its content is the one referenced, and possibly verified, by the manifest.
 */
-func isPolicyFilesExistLocally(policyRootDir string, manifests, values, secrets []string) bool {
+func isPolicyFilesExistLocally(policyRootDir string, manifestData []byte) bool {
 
 	errs := []error{}
 
 	policyRootDirFileInfo, err := os.Stat(policyRootDir)
-	// If store path exist and is a directory then we do nothing
-	if err == nil {
-		if !policyRootDirFileInfo.IsDir() {
-			errs = append(errs, fmt.Errorf("policy root dir %s already exist and is not a directory", policyRootDir))
-		}
-	} else {
-		if !errors.Is(err, os.ErrNotExist) {
-			errs = append(errs, fmt.Errorf("getting information about %s: %w", policyRootDir, err))
-		}
+	switch {
+	case err == nil && !policyRootDirFileInfo.IsDir():
+		errs = append(errs, fmt.Errorf("policy root dir %s already exist and is not a directory", policyRootDir))
+	case err != nil && errors.Is(err, os.ErrNotExist):
+		errs = append(errs, fmt.Errorf("%s does not exist locally", policyRootDir))
+	case err != nil:
+		errs = append(errs, fmt.Errorf("getting information about %s: %w", policyRootDir, err))
 	}
 
-	isFileExist := func(files []string) {
-		for _, f := range files {
-			_, err := os.Stat(f)
-			if err == nil {
-				continue
-			}
+	manifest := spec.Manifest{}
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		errs = append(errs, fmt.Errorf("unmarshal manifest: %w", err))
+	}
+
+	isFileMatchingLayer := func(layer spec.Descriptor) error {
+		title := layer.Annotations["org.opencontainers.image.title"]
+		if title == "" {
+			return nil
+		}
+
+		f := filepath.Join(policyRootDir, title)
+
+		fileInfo, err := os.Lstat(f)
+		if err != nil {
 			if errors.Is(err, os.ErrNotExist) {
-				errs = append(errs, fmt.Errorf("%s does not exist locally", f))
-			} else {
-				errs = append(errs, fmt.Errorf("something went wrong while checking if %s exist: %w", f, err))
+				return fmt.Errorf("%s does not exist locally", f)
 			}
+			return fmt.Errorf("something went wrong while checking if %s exist: %w", f, err)
+		}
+
+		if !fileInfo.Mode().IsRegular() {
+			return fmt.Errorf("%s is not a regular file", f)
+		}
+
+		if err := layer.Digest.Validate(); err != nil {
+			return fmt.Errorf("layer digest for %s: %w", f, err)
 		}
+
+		fp, err := os.Open(f)
+		if err != nil {
+			return fmt.Errorf("opening %s: %w", f, err)
+		}
+		defer fp.Close()
+
+		got, err := layer.Digest.Algorithm().FromReader(fp)
+		if err != nil {
+			return fmt.Errorf("computing digest of %s: %w", f, err)
+		}
+
+		if got != layer.Digest {
+			return fmt.Errorf("%s digest %q doesn't match the expected digest %q", f, got, layer.Digest)
+		}
+
+		return nil
 	}
 
-	isFileExist(manifests)
-	isFileExist(values)
-	isFileExist(secrets)
+	if len(errs) == 0 {
+		for _, layer := range manifest.Layers {
+			if err := isFileMatchingLayer(layer); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
 
 	if len(errs) > 0 {
 		for i := range errs {
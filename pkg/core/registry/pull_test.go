@@ -0,0 +1,132 @@
+package registry
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullValuesSchema(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	host := newTestRegistry(t)
+
+	policyDir := newTestPolicy(t, "0.1.0")
+	policy := fmt.Sprintf("%s/policy:0.1.0", host)
+
+	policyWithSchemaDir := newTestPolicy(t, "0.2.0")
+	policyWithSchema := fmt.Sprintf("%s/policy:0.2.0", host)
+
+	require.NoError(t, os.WriteFile(filepath.Join(policyWithSchemaDir, "Policy.yaml"), []byte("version: 0.2.0\nvaluesschema: values.schema.json\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(policyWithSchemaDir, "values.schema.json"), []byte(`{"type": "object"}`), 0600))
+
+	for dir, reference := range map[string]string{policyDir: policy, policyWithSchemaDir: policyWithSchema} {
+		err := Push(
+			filepath.Join(dir, "Policy.yaml"),
+			[]string{"updatecli.yaml"},
+			nil,
+			nil,
+			[]string{reference},
+			true,
+			dir,
+			false,
+			"")
+		require.NoError(t, err)
+	}
+
+	_, _, _, gotValuesSchema, err := Pull(policy, true, nil)
+	require.NoError(t, err)
+	assert.Empty(t, gotValuesSchema)
+
+	// Pull twice to cover policies already available locally
+	for range 2 {
+		gotManifests, _, _, gotValuesSchema, err := Pull(policyWithSchema, true, nil)
+		require.NoError(t, err)
+		require.Len(t, gotManifests, 1)
+
+		assert.Equal(t, filepath.Join(filepath.Dir(gotManifests[0]), "values.schema.json"), gotValuesSchema)
+
+		got, err := os.ReadFile(gotValuesSchema)
+		require.NoError(t, err)
+		assert.Equal(t, `{"type": "object"}`, string(got))
+	}
+}
+
+func TestPushValuesSchemaRelativeToPolicyRoot(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	host := newTestRegistry(t)
+	policy := fmt.Sprintf("%s/policy:0.1.0", host)
+
+	// The policy is located in a sub directory of the file store
+	fileStore := t.TempDir()
+	policyDir := filepath.Join(fileStore, "policies", "golang")
+	require.NoError(t, os.MkdirAll(policyDir, 0700))
+
+	require.NoError(t, os.WriteFile(filepath.Join(policyDir, "Policy.yaml"), []byte("version: 0.1.0\nvaluesschema: values.schema.json\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(policyDir, "updatecli.yaml"), []byte("name: 0.1.0\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(policyDir, "values.schema.json"), []byte(`{"type": "object"}`), 0600))
+
+	err := Push(
+		filepath.Join(policyDir, "Policy.yaml"),
+		[]string{filepath.Join("policies", "golang", "updatecli.yaml")},
+		nil,
+		nil,
+		[]string{policy},
+		true,
+		fileStore,
+		false,
+		"")
+	require.NoError(t, err)
+
+	gotManifests, _, _, gotValuesSchema, err := Pull(policy, true, nil)
+	require.NoError(t, err)
+	require.Len(t, gotManifests, 1)
+
+	assert.Equal(t, filepath.Join(filepath.Dir(gotManifests[0]), "values.schema.json"), gotValuesSchema)
+
+	got, err := os.ReadFile(gotValuesSchema)
+	require.NoError(t, err)
+	assert.Equal(t, `{"type": "object"}`, string(got))
+}
+
+func TestPullTamperedPolicy(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	host := newTestRegistry(t)
+	policyDir := newTestPolicy(t, "0.1.0")
+	policy := fmt.Sprintf("%s/policy:0.1.0", host)
+
+	signKey, verifyKey := newTestKeyPair(t)
+
+	err := Push(
+		filepath.Join(policyDir, "Policy.yaml"),
+		[]string{"updatecli.yaml"},
+		nil,
+		nil,
+		[]string{policy},
+		true,
+		policyDir,
+		false,
+		signKey)
+	require.NoError(t, err)
+
+	gotManifests, _, _, _, err := Pull(policy, true, []string{verifyKey})
+	require.NoError(t, err)
+	require.Len(t, gotManifests, 1)
+
+	// Policy files already available locally are only reused if they match the verified manifest
+	require.NoError(t, os.WriteFile(gotManifests[0], []byte("name: tampered\n"), 0600))
+
+	gotManifests, _, _, _, err = Pull(policy, true, []string{verifyKey})
+	require.NoError(t, err)
+	require.Len(t, gotManifests, 1)
+
+	got, err := os.ReadFile(gotManifests[0])
+	require.NoError(t, err)
+	assert.Equal(t, "name: 0.1.0\n", string(got))
+}
@@ -111,7 +111,8 @@ func TestPushPullPolicy(t *testing.T) {
 				data.toPushPolicyName,
 				data.disableTLS,
 				data.toPushFileStore,
-				data.overwrite)
+				data.overwrite,
+				"")
 			require.NoError(t, err)
 
 			err = Push(
@@ -122,12 +123,14 @@ func TestPushPullPolicy(t *testing.T) {
 				data.toPushPolicyName,
 				data.disableTLS,
 				data.toPushFileStore,
-				data.overwrite)
+				data.overwrite,
+				"")
 			require.NoError(t, err)
 
-			gotManifests, gotValues, gotSecrets, err := Pull(
+			gotManifests, gotValues, gotSecrets, _, err := Pull(
 				data.toPushPolicyName[0],
 				data.disableTLS,
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -155,7 +158,7 @@ func sanitizeDirPath(policyRef string, disableTLS bool, manifestFiles, valuesFil
 	}
 
 	if ref.Reference == ociLatestTag || ref.Reference == "" {
-		ref.Reference, err = getLatestTagSortedBySemver(ref.Registry+"/"+ref.Repository, disableTLS)
+		ref.Reference, err = getLatestTagSortedBySemver(ref.Registry+"/"+ref.Repository, "", disableTLS)
 		if err != nil {
 			return nil, nil, nil, fmt.Errorf("get latest tag sorted by semver: %w", err)
 		}
@@ -2,9 +2,11 @@ package registry
 
 import (
 	"context"
+	"crypto"
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -20,7 +22,8 @@ import (
 )
 
 // Push pushes updatecli manifest(s) as an OCI image to an OCI registry.
-func Push(policyMetadataFile string, manifests []string, values []string, secrets []string, policyReferenceNames []string, disableTLS bool, fileStore string, overwrite bool) error {
+// If signKey is not empty, the pushed manifest digest is signed using the private key signKey.
+func Push(policyMetadataFile string, manifests []string, values []string, secrets []string, policyReferenceNames []string, disableTLS bool, fileStore string, overwrite bool, signKey string) error {
 	var err error
 
 	policySpec, err := LoadPolicyFile(policyMetadataFile)
@@ -28,6 +31,14 @@ func Push(policyMetadataFile string, manifests []string, values []string, secret
 		return fmt.Errorf("load policy file: %w", err)
 	}
 
+	var signer crypto.Signer
+	if signKey != "" {
+		signer, err = LoadPrivateKey(signKey)
+		if err != nil {
+			return fmt.Errorf("load signing key: %w", err)
+		}
+	}
+
 	logrus.Infof("Pushing Updatecli policy:\n\t=> %s\n\n", strings.Join(policyReferenceNames, "\n\t=> "))
 
 	if fileStore == "" {
@@ -73,6 +84,37 @@ func Push(policyMetadataFile string, manifests []string, values []string, secret
 		return fmt.Errorf("add secrets: %w", err)
 	}
 
+	if policySpec.ValuesSchema != "" {
+		// The values schema is relative to the policy root directory, where the policy metadata file is,
+		// while the file store names files relative to its own root directory
+		valuesSchema := policySpec.ValuesSchema
+		if !filepath.IsAbs(valuesSchema) {
+			valuesSchema = filepath.Join(filepath.Dir(policyMetadataFile), valuesSchema)
+		}
+
+		valuesSchema, err = filepath.Abs(valuesSchema)
+		if err != nil {
+			return fmt.Errorf("add values schema: %w", err)
+		}
+
+		absFileStore, err := filepath.Abs(fileStore)
+		if err != nil {
+			return fmt.Errorf("add values schema: %w", err)
+		}
+
+		valuesSchemaName, err := filepath.Rel(absFileStore, valuesSchema)
+		if err != nil {
+			return fmt.Errorf("add values schema: %w", err)
+		}
+
+		fileDescriptor, err := fs.Add(ctx, filepath.ToSlash(valuesSchemaName), updatecliValuesSchemaMediaType, valuesSchema)
+		if err != nil {
+			return fmt.Errorf("add values schema %s: %w", policySpec.ValuesSchema, err)
+		}
+
+		fileDescriptors = append(fileDescriptors, fileDescriptor)
+	}
+
 	// 2. Pack the files and tag the packed manifest
 	opts := oras.PackManifestOptions{
 		Layers: fileDescriptors,
@@ -145,10 +187,17 @@ func Push(policyMetadataFile string, manifests []string, values []string, secret
 		}
 
 		// 3. Copy from the file store to the remote repository
-		_, err = oras.Copy(ctx, fs, tag, repo, tag, oras.DefaultCopyOptions)
+		pushedDescriptor, err := oras.Copy(ctx, fs, tag, repo, tag, oras.DefaultCopyOptions)
 		if err != nil {
 			return fmt.Errorf("upload artifact to %s: %w", repo.Reference.Reference, err)
 		}
+
+		// 4. Sign the manifest digest
+		if signer != nil {
+			if err := signManifest(ctx, repo, pushedDescriptor, signer); err != nil {
+				return fmt.Errorf("sign artifact %s: %w", policyReferenceNames[i], err)
+			}
+		}
 	}
 
 	return nil
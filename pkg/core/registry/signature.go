@@ -0,0 +1,366 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote"
+)
+
+// simpleSigningPayload is the cosign "simple signing" payload signed for a policy manifest
+// https://github.com/containers/image/blob/main/docs/containers-signature.5.md
+type simpleSigningPayload struct {
+	Critical simpleSigningCritical  `json:"critical"`
+	Optional map[string]interface{} `json:"optional"`
+}
+
+type simpleSigningCritical struct {
+	Identity simpleSigningIdentity `json:"identity"`
+	Image    simpleSigningImage    `json:"image"`
+	Type     string                `json:"type"`
+}
+
+type simpleSigningIdentity struct {
+	DockerReference string `json:"docker-reference"`
+}
+
+type simpleSigningImage struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+// encryptedPrivateKey is the format used by cosign to store encrypted private keys
+type encryptedPrivateKey struct {
+	KDF struct {
+		Name   string `json:"name"`
+		Params struct {
+			N int `json:"N"`
+			R int `json:"r"`
+			P int `json:"p"`
+		} `json:"params"`
+		Salt []byte `json:"salt"`
+	} `json:"kdf"`
+	Cipher struct {
+		Name  string `json:"name"`
+		Nonce []byte `json:"nonce"`
+	} `json:"cipher"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// LoadPrivateKey loads a PEM encoded private key used to sign policies.
+// Encrypted cosign private keys are decrypted using the COSIGN_PASSWORD environment variable.
+func LoadPrivateKey(filename string) (crypto.Signer, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read private key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %q", filename)
+	}
+
+	var key interface{}
+
+	switch block.Type {
+	case "ENCRYPTED SIGSTORE PRIVATE KEY", "ENCRYPTED COSIGN PRIVATE KEY":
+		der, err := decryptPrivateKey(block.Bytes, []byte(os.Getenv(cosignPasswordEnv)))
+		if err != nil {
+			return nil, fmt.Errorf("decrypt private key %q: %w", filename, err)
+		}
+		key, err = x509.ParsePKCS8PrivateKey(der)
+		if err != nil {
+			return nil, fmt.Errorf("parse private key %q: %w", filename, err)
+		}
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse private key %q: %w", filename, err)
+		}
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse private key %q: %w", filename, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported PEM type %q in %q", block.Type, filename)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T in %q", key, filename)
+	}
+
+	return signer, nil
+}
+
+// decryptPrivateKey decrypts a cosign private key encrypted with scrypt and nacl/secretbox
+func decryptPrivateKey(data, password []byte) ([]byte, error) {
+	var encrypted encryptedPrivateKey
+	if err := json.Unmarshal(data, &encrypted); err != nil {
+		return nil, fmt.Errorf("unmarshal encrypted key: %w", err)
+	}
+
+	if encrypted.KDF.Name != "scrypt" {
+		return nil, fmt.Errorf("unsupported key derivation function %q", encrypted.KDF.Name)
+	}
+
+	if encrypted.Cipher.Name != "nacl/secretbox" {
+		return nil, fmt.Errorf("unsupported cipher %q", encrypted.Cipher.Name)
+	}
+
+	if len(encrypted.Cipher.Nonce) != 24 {
+		return nil, fmt.Errorf("wrong nonce size %d", len(encrypted.Cipher.Nonce))
+	}
+
+	derivedKey, err := scrypt.Key(
+		password,
+		encrypted.KDF.Salt,
+		encrypted.KDF.Params.N,
+		encrypted.KDF.Params.R,
+		encrypted.KDF.Params.P,
+		32)
+	if err != nil {
+		return nil, fmt.Errorf("derive key: %w", err)
+	}
+
+	var key [32]byte
+	var nonce [24]byte
+	copy(key[:], derivedKey)
+	copy(nonce[:], encrypted.Cipher.Nonce)
+
+	der, ok := secretbox.Open(nil, encrypted.Ciphertext, &nonce, &key)
+	if !ok {
+		return nil, errors.New("wrong password")
+	}
+
+	return der, nil
+}
+
+// LoadPublicKeys loads PEM encoded public keys used to verify policy signatures.
+func LoadPublicKeys(filenames []string) ([]crypto.PublicKey, error) {
+	publicKeys := make([]crypto.PublicKey, 0, len(filenames))
+
+	for _, filename := range filenames {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("read public key: %w", err)
+		}
+
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM data found in %q", filename)
+		}
+
+		if block.Type != "PUBLIC KEY" {
+			return nil, fmt.Errorf("unsupported PEM type %q in %q", block.Type, filename)
+		}
+
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse public key %q: %w", filename, err)
+		}
+
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return publicKeys, nil
+}
+
+// signPayload signs a payload using the same algorithms as cosign
+func signPayload(signer crypto.Signer, payload []byte) ([]byte, error) {
+	if _, ok := signer.(ed25519.PrivateKey); ok {
+		return signer.Sign(rand.Reader, payload, crypto.Hash(0))
+	}
+
+	digest := sha256.Sum256(payload)
+	return signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
+
+// verifyPayload verifies a payload signature using the same algorithms as cosign
+func verifyPayload(publicKey crypto.PublicKey, payload, signature []byte) error {
+	digest := sha256.Sum256(payload)
+
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return errors.New("invalid ECDSA signature")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return fmt.Errorf("invalid RSA signature: %w", err)
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, payload, signature) {
+			return errors.New("invalid ed25519 signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	return nil
+}
+
+// signManifest signs a policy manifest digest then stores the signature,
+// in the cosign format, as an OCI referrer of the policy manifest.
+func signManifest(ctx context.Context, repo *remote.Repository, manifestDescriptor v1.Descriptor, signer crypto.Signer) error {
+
+	payload, err := json.Marshal(simpleSigningPayload{
+		Critical: simpleSigningCritical{
+			Identity: simpleSigningIdentity{
+				DockerReference: repo.Reference.Registry + "/" + repo.Reference.Repository,
+			},
+			Image: simpleSigningImage{
+				DockerManifestDigest: manifestDescriptor.Digest.String(),
+			},
+			Type: cosignSignatureType,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	signature, err := signPayload(signer, payload)
+	if err != nil {
+		return fmt.Errorf("sign payload: %w", err)
+	}
+
+	payloadDescriptor := content.NewDescriptorFromBytes(cosignSimpleSigningMediaType, payload)
+	payloadDescriptor.Annotations = map[string]string{
+		cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+	}
+
+	if err := repo.Push(ctx, payloadDescriptor, bytes.NewReader(payload)); err != nil {
+		return fmt.Errorf("push signature payload: %w", err)
+	}
+
+	opts := oras.PackManifestOptions{
+		Subject: &manifestDescriptor,
+		Layers:  []v1.Descriptor{payloadDescriptor},
+	}
+
+	signatureDescriptor, err := oras.PackManifest(ctx, repo, oras.PackManifestVersion1_1, cosignSignatureArtifactType, opts)
+	if err != nil {
+		return fmt.Errorf("push signature manifest: %w", err)
+	}
+
+	logrus.Infof("policy %s signed with %s", manifestDescriptor.Digest, signatureDescriptor.Digest)
+
+	return nil
+}
+
+// verifyManifest ensures that a policy manifest has at least one signature,
+// stored as an OCI referrer, which is valid for one of the public keys.
+// The storage is either a remote OCI repository or the local policy store.
+func verifyManifest(ctx context.Context, storage content.ReadOnlyGraphStorage, manifestDescriptor v1.Descriptor, publicKeys []crypto.PublicKey) error {
+
+	// Referrers are not filtered by artifact type as some registries
+	// report the config media type instead of the manifest artifact type
+	referrerDescriptors, err := registry.Referrers(ctx, storage, manifestDescriptor, "")
+	if err != nil {
+		return fmt.Errorf("list signatures: %w", err)
+	}
+
+	var errs []error
+	signatureFound := false
+	for _, referrerDescriptor := range referrerDescriptors {
+		referrerData, err := content.FetchAll(ctx, storage, referrerDescriptor)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: fetch referrer manifest: %w", referrerDescriptor.Digest, err))
+			continue
+		}
+
+		referrerManifest := v1.Manifest{}
+		if err := json.Unmarshal(referrerData, &referrerManifest); err != nil {
+			errs = append(errs, fmt.Errorf("%s: unmarshal referrer manifest: %w", referrerDescriptor.Digest, err))
+			continue
+		}
+
+		if referrerManifest.ArtifactType != cosignSignatureArtifactType &&
+			referrerManifest.Config.MediaType != cosignSignatureArtifactType {
+			continue
+		}
+
+		signatureFound = true
+
+		err = verifySignatureManifest(ctx, storage, referrerManifest, manifestDescriptor, publicKeys)
+		if err == nil {
+			logrus.Infof("policy %s signature %s verified", manifestDescriptor.Digest, referrerDescriptor.Digest)
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", referrerDescriptor.Digest, err))
+	}
+
+	if !signatureFound {
+		return fmt.Errorf("no signature found for policy %s", manifestDescriptor.Digest)
+	}
+
+	return fmt.Errorf("no valid signature found for policy %s: %w", manifestDescriptor.Digest, errors.Join(errs...))
+}
+
+// verifySignatureManifest checks if a cosign signature manifest contains a valid signature of the policy manifest
+func verifySignatureManifest(ctx context.Context, storage content.ReadOnlyStorage, signatureManifest v1.Manifest, manifestDescriptor v1.Descriptor, publicKeys []crypto.PublicKey) error {
+
+	for _, layer := range signatureManifest.Layers {
+		if layer.MediaType != cosignSimpleSigningMediaType {
+			continue
+		}
+
+		encodedSignature, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+		if err != nil {
+			return fmt.Errorf("decode signature: %w", err)
+		}
+
+		payload, err := content.FetchAll(ctx, storage, layer)
+		if err != nil {
+			return fmt.Errorf("fetch signature payload: %w", err)
+		}
+
+		var p simpleSigningPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return fmt.Errorf("unmarshal signature payload: %w", err)
+		}
+
+		if p.Critical.Type != cosignSignatureType {
+			return fmt.Errorf("unsupported signature type %q", p.Critical.Type)
+		}
+
+		if p.Critical.Image.DockerManifestDigest != manifestDescriptor.Digest.String() {
+			return fmt.Errorf("signature is for digest %q", p.Critical.Image.DockerManifestDigest)
+		}
+
+		for _, publicKey := range publicKeys {
+			err := verifyPayload(publicKey, payload, signature)
+			if err == nil {
+				return nil
+			}
+			logrus.Debugln(err)
+		}
+	}
+
+	return errors.New("signature not matching any public key")
+}
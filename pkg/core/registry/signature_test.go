@@ -0,0 +1,253 @@
+package registry
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+// newTestRegistry starts an in-process OCI registry supporting the referrers API
+func newTestRegistry(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(ggcrregistry.New(
+		ggcrregistry.Logger(log.New(io.Discard, "", 0)),
+		ggcrregistry.WithReferrersSupport(true),
+	))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	return u.Host
+}
+
+// newTestPolicy writes a policy, with its manifest, in a temporary directory
+func newTestPolicy(t *testing.T, version string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Policy.yaml"), []byte("version: "+version+"\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "updatecli.yaml"), []byte("name: "+version+"\n"), 0600))
+
+	return dir
+}
+
+// newTestKeyPair writes a PEM encoded ECDSA key pair in a temporary directory
+func newTestKeyPair(t *testing.T) (privateKeyFile, publicKeyFile string) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	publicKey, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+
+	privateKeyFile = filepath.Join(dir, "cosign.key")
+	publicKeyFile = filepath.Join(dir, "cosign.pub")
+
+	require.NoError(t, os.WriteFile(privateKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey}), 0600))
+	require.NoError(t, os.WriteFile(publicKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}), 0600))
+
+	return privateKeyFile, publicKeyFile
+}
+
+func TestSignVerifyPolicy(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	host := newTestRegistry(t)
+	policyDir := newTestPolicy(t, "0.1.0")
+
+	signKey, verifyKey := newTestKeyPair(t)
+	_, otherVerifyKey := newTestKeyPair(t)
+
+	signedPolicy := fmt.Sprintf("%s/signed:0.1.0", host)
+	unsignedPolicy := fmt.Sprintf("%s/unsigned:0.1.0", host)
+
+	err := Push(
+		filepath.Join(policyDir, "Policy.yaml"),
+		[]string{"updatecli.yaml"},
+		nil,
+		nil,
+		[]string{signedPolicy},
+		true,
+		policyDir,
+		false,
+		signKey)
+	require.NoError(t, err)
+
+	err = Push(
+		filepath.Join(policyDir, "Policy.yaml"),
+		[]string{"updatecli.yaml"},
+		nil,
+		nil,
+		[]string{unsignedPolicy},
+		true,
+		policyDir,
+		false,
+		"")
+	require.NoError(t, err)
+
+	testData := []struct {
+		name          string
+		policy        string
+		publicKeys    []string
+		expectedError bool
+	}{
+		{
+			name:       "Signed policy verified with the matching public key",
+			policy:     signedPolicy,
+			publicKeys: []string{verifyKey},
+		},
+		{
+			name:       "Signed policy verified with one of the public keys",
+			policy:     signedPolicy,
+			publicKeys: []string{otherVerifyKey, verifyKey},
+		},
+		{
+			name:          "Signed policy refused with a mismatched public key",
+			policy:        signedPolicy,
+			publicKeys:    []string{otherVerifyKey},
+			expectedError: true,
+		},
+		{
+			name:          "Unsigned policy refused",
+			policy:        unsignedPolicy,
+			publicKeys:    []string{verifyKey},
+			expectedError: true,
+		},
+		{
+			name:   "Unsigned policy pulled without verification",
+			policy: unsignedPolicy,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			gotManifests, _, _, _, err := Pull(data.policy, true, data.publicKeys)
+			if data.expectedError {
+				require.Error(t, err)
+				assert.Nil(t, gotManifests)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, gotManifests, 1)
+			assert.Equal(t, "updatecli.yaml", filepath.Base(gotManifests[0]))
+		})
+	}
+}
+
+func TestLoadPrivateKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+
+	// Encrypt the private key the same way than "cosign generate-key-pair"
+	encryptedKey := encryptedPrivateKey{}
+	encryptedKey.KDF.Name = "scrypt"
+	encryptedKey.KDF.Params.N = 1024
+	encryptedKey.KDF.Params.R = 8
+	encryptedKey.KDF.Params.P = 1
+	encryptedKey.KDF.Salt = []byte("0123456789abcdef0123456789abcdef")
+	encryptedKey.Cipher.Name = "nacl/secretbox"
+	encryptedKey.Cipher.Nonce = []byte("0123456789abcdef01234567")
+
+	derivedKey, err := scrypt.Key([]byte("secret"), encryptedKey.KDF.Salt, 1024, 8, 1, 32)
+	require.NoError(t, err)
+
+	var secretKey [32]byte
+	var nonce [24]byte
+	copy(secretKey[:], derivedKey)
+	copy(nonce[:], encryptedKey.Cipher.Nonce)
+	encryptedKey.Ciphertext = secretbox.Seal(nil, der, &nonce, &secretKey)
+
+	encryptedData, err := json.Marshal(encryptedKey)
+	require.NoError(t, err)
+
+	encryptedKeyFile := filepath.Join(dir, "encrypted.key")
+	require.NoError(t, os.WriteFile(encryptedKeyFile, pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED SIGSTORE PRIVATE KEY", Bytes: encryptedData}), 0600))
+
+	plainKeyFile := filepath.Join(dir, "plain.key")
+	require.NoError(t, os.WriteFile(plainKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+
+	publicKeyFile := filepath.Join(dir, "public.pub")
+	require.NoError(t, os.WriteFile(publicKeyFile, []byte("not a key"), 0600))
+
+	testData := []struct {
+		name          string
+		file          string
+		password      string
+		expectedError bool
+	}{
+		{
+			name:     "Encrypted cosign private key",
+			file:     encryptedKeyFile,
+			password: "secret",
+		},
+		{
+			name:          "Encrypted cosign private key with a wrong password",
+			file:          encryptedKeyFile,
+			password:      "wrong",
+			expectedError: true,
+		},
+		{
+			name: "Unencrypted PKCS8 private key",
+			file: plainKeyFile,
+		},
+		{
+			name:          "Not a PEM file",
+			file:          publicKeyFile,
+			expectedError: true,
+		},
+	}
+
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			t.Setenv(cosignPasswordEnv, data.password)
+
+			signer, err := LoadPrivateKey(data.file)
+			if data.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			payload := []byte("payload")
+			signature, err := signPayload(signer, payload)
+			require.NoError(t, err)
+
+			assert.NoError(t, verifyPayload(crypto.PublicKey(&key.PublicKey), payload, signature))
+			assert.Error(t, verifyPayload(crypto.PublicKey(&key.PublicKey), []byte("tampered"), signature))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
@@ -19,7 +20,8 @@ import (
 )
 
 // getLatestTagSortedBySemver returns the latest tag sorted by semver
-func getLatestTagSortedBySemver(refName string, disableTLS bool) (string, error) {
+// If constraint is not empty, only tags matching the semver constraint are considered.
+func getLatestTagSortedBySemver(refName string, constraint string, disableTLS bool) (string, error) {
 
 	repo, err := remote.NewRepository(refName)
 	if err != nil {
@@ -43,6 +45,22 @@ func getLatestTagSortedBySemver(refName string, disableTLS bool) (string, error)
 		return "", fmt.Errorf("get tags: %w", err)
 	}
 
+	return getLatestSemverTag(tags, constraint)
+}
+
+// getLatestSemverTag returns the latest tag sorted by semver from a list of tags
+// If constraint is not empty, only tags matching the semver constraint are considered.
+func getLatestSemverTag(tags []string, constraint string) (string, error) {
+
+	var c *semver.Constraints
+	if constraint != "" {
+		var err error
+		c, err = semver.NewConstraint(constraint)
+		if err != nil {
+			return "", fmt.Errorf("parse version constraint %q: %w", constraint, err)
+		}
+	}
+
 	result := []*semver.Version{}
 	for i := range tags {
 		s, err := semver.NewVersion(tags[i])
@@ -51,10 +69,18 @@ func getLatestTagSortedBySemver(refName string, disableTLS bool) (string, error)
 			continue
 		}
 
+		if c != nil && !c.Check(s) {
+			logrus.Debugf("Ignoring tag %q not matching constraint %q", tags[i], constraint)
+			continue
+		}
+
 		result = append(result, s)
 	}
 
 	if len(result) == 0 {
+		if c != nil {
+			return "", fmt.Errorf("no valid semver tags found matching constraint %q", constraint)
+		}
 		return "", fmt.Errorf("no valid semver tags found")
 	}
 
@@ -90,22 +116,15 @@ func getCredentialsFromDockerStore(repo *remote.Repository) error {
 // FetchManifest fetches the OCI manifest from the remote repository
 func FetchManifest(ociName string, disableTLS bool) (v1.Descriptor, error) {
 
-	ref, err := registry.ParseReference(ociName)
+	ref, err := parseReference(ociName, disableTLS)
 	if err != nil {
-		return v1.Descriptor{}, fmt.Errorf("parse reference: %w", err)
-	}
-
-	if ref.Reference == ociLatestTag || ref.Reference == "" {
-		ref.Reference, err = getLatestTagSortedBySemver(ref.Registry+"/"+ref.Repository, disableTLS)
-		if err != nil {
-			return v1.Descriptor{}, fmt.Errorf("get latest tag sorted by semver: %w", err)
-		}
+		return v1.Descriptor{}, err
 	}
 
 	// 1. Connect to a remote repository
 	ctx := context.Background()
 
-	repo, err := remote.NewRepository(ociName)
+	repo, err := remote.NewRepository(ref.String())
 	if err != nil {
 		return v1.Descriptor{}, fmt.Errorf("new repository: %w", err)
 	}
@@ -142,3 +161,141 @@ func FetchManifest(ociName string, disableTLS bool) (v1.Descriptor, error) {
 
 	return manifestData, nil
 }
+
+// ResolveReference resolves an OCI reference to the policy manifest digest.
+// The returned reference contains the resolved tag, if any, but not the digest.
+func ResolveReference(ociName string, disableTLS bool) (reference string, digest string, err error) {
+
+	source, err := resolvePolicySource(context.Background(), ociName, disableTLS)
+	if err != nil {
+		return "", "", err
+	}
+
+	return getReferenceWithoutDigest(source.ref), source.manifest.Digest.String(), nil
+}
+
+// policySource describes where a policy is retrieved from.
+type policySource struct {
+	// target is either the OCI repository, or the local policy store populated by Import
+	target oras.ReadOnlyGraphTarget
+	// ref is the policy reference, with its tag resolved
+	ref registry.Reference
+	// manifest describes the policy manifest
+	manifest v1.Descriptor
+}
+
+// resolvePolicySource resolves a policy from its OCI registry.
+// Imported policies are only used when the OCI registry can't be reached,
+// so they never take precedence over the content of a reachable registry.
+func resolvePolicySource(ctx context.Context, ociName string, disableTLS bool) (policySource, error) {
+
+	source, err := resolveRemotePolicySource(ctx, ociName, disableTLS)
+	if err == nil || !isRegistryUnreachable(err) {
+		return source, err
+	}
+
+	localStore, ref, found, importErr := resolveImportedReference(ctx, ociName)
+	if importErr != nil {
+		return policySource{}, fmt.Errorf("resolve imported policy: %w", importErr)
+	}
+
+	if !found {
+		return policySource{}, err
+	}
+
+	logrus.Infof("OCI registry unreachable, using imported policy %q for %q, imported policies can be removed using \"updatecli manifest import --prune\"", ref.String(), ociName)
+
+	manifest, err := localStore.Resolve(ctx, localReference(ref))
+	if err != nil {
+		return policySource{}, fmt.Errorf("resolve %q: %w", ref.String(), err)
+	}
+
+	return policySource{target: localStore, ref: ref, manifest: manifest}, nil
+}
+
+// resolveRemotePolicySource resolves a policy from its OCI registry
+func resolveRemotePolicySource(ctx context.Context, ociName string, disableTLS bool) (policySource, error) {
+
+	ref, err := parseReference(ociName, disableTLS)
+	if err != nil {
+		return policySource{}, err
+	}
+
+	repo, err := remote.NewRepository(ref.String())
+	if err != nil {
+		return policySource{}, fmt.Errorf("new repository: %w", err)
+	}
+
+	ctx = auth.AppendRepositoryScope(ctx, repo.Reference, auth.ActionPull)
+
+	if disableTLS {
+		logrus.Debugln("TLS connection is disabled")
+		repo.PlainHTTP = true
+	}
+
+	if err := getCredentialsFromDockerStore(repo); err != nil {
+		return policySource{}, fmt.Errorf("credstore from docker: %w", err)
+	}
+
+	manifest, err := repo.Resolve(ctx, ref.Reference)
+	if err != nil {
+		return policySource{}, fmt.Errorf("resolve %q: %w", ref.String(), err)
+	}
+
+	return policySource{target: repo, ref: ref, manifest: manifest}, nil
+}
+
+// getReferenceWithoutDigest returns the reference name with its tag, if any, but without digest
+func getReferenceWithoutDigest(ref registry.Reference) string {
+	reference := ref.Registry + "/" + ref.Repository
+	if _, err := ref.Digest(); err != nil {
+		// The reference is a tag
+		reference = reference + ":" + ref.Reference
+	}
+	return reference
+}
+
+// parseReference parses an OCI reference where the tag can also be a semver constraint such as "~1.4" or "^2".
+// If the tag is empty, "latest", or a semver constraint, then it is resolved to the newest matching semver tag.
+func parseReference(ociName string, disableTLS bool) (registry.Reference, error) {
+
+	name, constraint := splitReferenceConstraint(ociName)
+
+	ref, err := registry.ParseReference(name)
+	if err != nil {
+		return registry.Reference{}, fmt.Errorf("parse reference: %w", err)
+	}
+
+	if constraint != "" || ref.Reference == ociLatestTag || ref.Reference == "" {
+		ref.Reference, err = getLatestTagSortedBySemver(ref.Registry+"/"+ref.Repository, constraint, disableTLS)
+		if err != nil {
+			return registry.Reference{}, fmt.Errorf("get latest tag sorted by semver: %w", err)
+		}
+	}
+
+	return ref, nil
+}
+
+// splitReferenceConstraint splits an OCI reference from its tag if the tag is a semver constraint,
+// such as "~1.4", "^2", ">=1.2, <2" or a wildcard like "1.x" and "1.4.*".
+// A partial version such as "2" or "1.4" is a valid OCI tag, so it is pulled as a literal tag.
+func splitReferenceConstraint(ociName string) (name string, constraint string) {
+
+	// A digest always takes precedence over the tag
+	if strings.Contains(ociName, "@") {
+		return ociName, ""
+	}
+
+	// The tag is located after the last "/" so a registry port is not considered as a tag
+	index := strings.LastIndex(ociName, ":")
+	if index < 0 || index < strings.LastIndex(ociName, "/") {
+		return ociName, ""
+	}
+
+	tag := ociName[index+1:]
+	if tagRegexp.MatchString(tag) && !wildcardConstraintRegexp.MatchString(tag) {
+		return ociName, ""
+	}
+
+	return ociName[:index], strings.TrimSpace(tag)
+}
@@ -0,0 +1,201 @@
+package registry
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitReferenceConstraint(t *testing.T) {
+	testdata := []struct {
+		name               string
+		reference          string
+		expectedName       string
+		expectedConstraint string
+	}{
+		{
+			name:         "Reference without tag",
+			reference:    "ghcr.io/updatecli/policies/golang",
+			expectedName: "ghcr.io/updatecli/policies/golang",
+		},
+		{
+			name:         "Reference with a tag",
+			reference:    "ghcr.io/updatecli/policies/golang:1.4.0",
+			expectedName: "ghcr.io/updatecli/policies/golang:1.4.0",
+		},
+		{
+			name:         "Reference with a registry port and without tag",
+			reference:    "localhost:5000/policies/golang",
+			expectedName: "localhost:5000/policies/golang",
+		},
+		{
+			name:               "Reference with a tilde constraint",
+			reference:          "ghcr.io/updatecli/policies/golang:~1.4",
+			expectedName:       "ghcr.io/updatecli/policies/golang",
+			expectedConstraint: "~1.4",
+		},
+		{
+			name:               "Reference with a registry port and a caret constraint",
+			reference:          "localhost:5000/policies/golang:^2",
+			expectedName:       "localhost:5000/policies/golang",
+			expectedConstraint: "^2",
+		},
+		{
+			name:               "Reference with a range constraint",
+			reference:          "ghcr.io/updatecli/policies/golang:>=1.2, <2",
+			expectedName:       "ghcr.io/updatecli/policies/golang",
+			expectedConstraint: ">=1.2, <2",
+		},
+		{
+			name:               "Reference with a major wildcard constraint",
+			reference:          "ghcr.io/updatecli/policies/golang:1.x",
+			expectedName:       "ghcr.io/updatecli/policies/golang",
+			expectedConstraint: "1.x",
+		},
+		{
+			name:               "Reference with a minor wildcard constraint",
+			reference:          "localhost:5000/policies/golang:1.4.X",
+			expectedName:       "localhost:5000/policies/golang",
+			expectedConstraint: "1.4.X",
+		},
+		{
+			name:               "Reference with a star wildcard constraint",
+			reference:          "ghcr.io/updatecli/policies/golang:1.4.*",
+			expectedName:       "ghcr.io/updatecli/policies/golang",
+			expectedConstraint: "1.4.*",
+		},
+		{
+			name:               "Reference with a star constraint",
+			reference:          "ghcr.io/updatecli/policies/golang:*",
+			expectedName:       "ghcr.io/updatecli/policies/golang",
+			expectedConstraint: "*",
+		},
+		{
+			name:         "Reference with a partial version tag",
+			reference:    "ghcr.io/updatecli/policies/golang:2",
+			expectedName: "ghcr.io/updatecli/policies/golang:2",
+		},
+		{
+			name:         "Reference with a tag containing a x",
+			reference:    "ghcr.io/updatecli/policies/golang:1.4.x-beta",
+			expectedName: "ghcr.io/updatecli/policies/golang:1.4.x-beta",
+		},
+		{
+			name:         "Reference with a digest",
+			reference:    "ghcr.io/updatecli/policies/golang:1.4.0@sha256:7aaff2727eef42f7d0add2d5ed3fd83f74a125420682bec7e4bc8835bb28e833",
+			expectedName: "ghcr.io/updatecli/policies/golang:1.4.0@sha256:7aaff2727eef42f7d0add2d5ed3fd83f74a125420682bec7e4bc8835bb28e833",
+		},
+	}
+
+	for _, data := range testdata {
+		t.Run(data.name, func(t *testing.T) {
+			gotName, gotConstraint := splitReferenceConstraint(data.reference)
+			assert.Equal(t, data.expectedName, gotName)
+			assert.Equal(t, data.expectedConstraint, gotConstraint)
+		})
+	}
+}
+
+func TestResolveReference(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+
+	host := newTestRegistry(t)
+	policy := fmt.Sprintf("%s/policy", host)
+
+	for _, version := range []string{"1.3.9", "1.4.0", "1.4.2", "1.5.0", "2.0.0", "2.1.0-rc.1"} {
+		policyDir := newTestPolicy(t, version)
+
+		err := Push(
+			filepath.Join(policyDir, "Policy.yaml"),
+			[]string{"updatecli.yaml"},
+			nil,
+			nil,
+			[]string{policy},
+			true,
+			policyDir,
+			false,
+			"")
+		require.NoError(t, err)
+	}
+
+	testdata := []struct {
+		name              string
+		reference         string
+		expectedReference string
+		expectedError     bool
+	}{
+		{
+			name:              "Latest version without tag",
+			reference:         policy,
+			expectedReference: policy + ":2.1.0-rc.1",
+		},
+		{
+			name:              "Latest version with the latest tag",
+			reference:         policy + ":latest",
+			expectedReference: policy + ":2.1.0-rc.1",
+		},
+		{
+			name:              "Exact tag",
+			reference:         policy + ":1.4.0",
+			expectedReference: policy + ":1.4.0",
+		},
+		{
+			name:              "Tilde constraint only takes patch releases",
+			reference:         policy + ":~1.4",
+			expectedReference: policy + ":1.4.2",
+		},
+		{
+			name:              "Caret constraint doesn't jump major versions",
+			reference:         policy + ":^1",
+			expectedReference: policy + ":1.5.0",
+		},
+		{
+			name:              "Caret constraint ignores pre-releases",
+			reference:         policy + ":^2",
+			expectedReference: policy + ":2.0.0",
+		},
+		{
+			name:              "Wildcard constraint",
+			reference:         policy + ":1.x",
+			expectedReference: policy + ":1.5.0",
+		},
+		{
+			name:              "Range constraint",
+			reference:         policy + ":>=1.0, <1.4",
+			expectedReference: policy + ":1.3.9",
+		},
+		{
+			name:          "Constraint without matching tag",
+			reference:     policy + ":^3",
+			expectedError: true,
+		},
+		{
+			name:          "Invalid constraint",
+			reference:     policy + ":~~1",
+			expectedError: true,
+		},
+	}
+
+	for _, data := range testdata {
+		t.Run(data.name, func(t *testing.T) {
+			gotReference, gotDigest, err := ResolveReference(data.reference, true)
+			if data.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, data.expectedReference, gotReference)
+			assert.Contains(t, gotDigest, "sha256:")
+
+			// Pulling the reference must retrieve the same policy
+			gotManifests, _, _, _, err := Pull(data.reference, true, nil)
+			require.NoError(t, err)
+			require.Len(t, gotManifests, 1)
+			assert.Equal(t, gotDigest[len("sha256:"):], filepath.Base(filepath.Dir(gotManifests[0])))
+		})
+	}
+}
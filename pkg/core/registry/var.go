@@ -1,5 +1,7 @@
 package registry
 
+import "regexp"
+
 var (
 	// updatecliManifestMediaType is the OCI media type for updatecli manifests.
 	updatecliManifestMediaType string = "application/io.updatecli.policy.manifest.alpha"
@@ -7,8 +9,25 @@ var (
 	updatecliValueMediaType string = "application/io.updatecli.policy.value.alpha"
 	// updatecliSecretMediaType is the OCI media type for updatecli secret file.
 	updatecliSecretMediaType string = "application/io.updatecli.policy.secret.alpha"
+	// updatecliValuesSchemaMediaType is the OCI media type for updatecli values JSON schema file.
+	updatecliValuesSchemaMediaType string = "application/io.updatecli.policy.values.schema.alpha"
 	// ociArtifactType is the media type for updatecli OCI artifacts.
 	ociArtifactType string = "application/io.updatecli.policy.alpha"
 	// ociLatestTag is the default tag for updatecli OCI images.
 	ociLatestTag string = "latest"
+	// tagRegexp matches a valid OCI tag as defined by the OCI distribution specification.
+	tagRegexp = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+	// wildcardConstraintRegexp matches a semver constraint using wildcards, such as "1.x" or "1.4.*",
+	// which would otherwise be a valid OCI tag.
+	wildcardConstraintRegexp = regexp.MustCompile(`^v?\d+(\.\d+)*(\.[xX*])+$`)
+	// cosignSignatureArtifactType is the artifact type used by cosign for signatures stored as OCI referrers.
+	cosignSignatureArtifactType string = "application/vnd.dev.cosign.artifact.sig.v1+json"
+	// cosignSimpleSigningMediaType is the media type of the cosign signed payload.
+	cosignSimpleSigningMediaType string = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// cosignSignatureAnnotation is the layer annotation holding the base64 encoded cosign signature.
+	cosignSignatureAnnotation string = "dev.cosignproject.cosign/signature"
+	// cosignSignatureType is the cosign signed payload type.
+	cosignSignatureType string = "cosign container image signature"
+	// cosignPasswordEnv is the environment variable holding the password used to decrypt cosign private keys.
+	cosignPasswordEnv string = "COSIGN_PASSWORD"
 )
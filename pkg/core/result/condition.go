@@ -1,7 +1,5 @@
 package result
 
-import "bytes"
-
 // Conditions holds condition execution result
 type Condition struct {
 	//Name holds the condition name
@@ -25,8 +23,3 @@ type Condition struct {
 	// ConsoleOutput stores the console output of the condition execution
 	ConsoleOutput string
 }
-
-// SetConsoleOutput sets the console output of the condition execution
-func (c *Condition) SetConsoleOutput(out *bytes.Buffer) {
-	c.ConsoleOutput = out.String()
-}
@@ -1,9 +1,5 @@
 package result
 
-import (
-	"bytes"
-)
-
 // Source holds source execution result
 type Source struct {
 	// Name holds the source name
@@ -29,8 +25,3 @@ type Source struct {
 	// ConsoleOutput stores the console output of the source execution
 	ConsoleOutput string
 }
-
-// SetConsoleOutput sets the console output of the source execution
-func (s *Source) SetConsoleOutput(out *bytes.Buffer) {
-	s.ConsoleOutput = out.String()
-}
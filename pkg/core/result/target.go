@@ -1,7 +1,6 @@
 package result
 
 import (
-	"bytes"
 	"fmt"
 )
 
@@ -42,8 +41,3 @@ func (t *Target) String() string {
 	str = str + fmt.Sprintf("\n%s - %s", t.Result, t.Description)
 	return str
 }
-
-// SetConsoleOutput sets the console output of the target execution
-func (t *Target) SetConsoleOutput(out *bytes.Buffer) {
-	t.ConsoleOutput = out.String()
-}
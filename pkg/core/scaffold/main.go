@@ -13,6 +13,8 @@ var (
 	defaultConfigDir = "updatecli.d"
 	// defaultValuesFile is the default values file name
 	defaultValuesFile = "values.yaml"
+	// defaultValuesSchemaFile is the default values JSON schema file name
+	defaultValuesSchemaFile = "values.schema.json"
 )
 
 // Scaffold is the main structure to scaffold a new Updatecli policy
@@ -21,6 +23,8 @@ type Scaffold struct {
 	PolicyFile string
 	// ValuesFile is the values directory name
 	ValuesFile string
+	// ValuesSchemaFile is the values JSON schema file name
+	ValuesSchemaFile string
 	// SecretsDir is the secrets directory name
 	SecretsDir string
 	// ConfigDir is the config directory name
@@ -40,6 +44,7 @@ func (s *Scaffold) Init() {
 	setDefaultValues(&s.PolicyFile, defaultPolicyFile)
 	setDefaultValues(&s.SecretsDir, defaultSecretsDir)
 	setDefaultValues(&s.ValuesFile, defaultValuesFile)
+	setDefaultValues(&s.ValuesSchemaFile, defaultValuesSchemaFile)
 }
 
 // Run scaffold a new Updatecli policy
@@ -47,7 +52,7 @@ func (s *Scaffold) Run(rootDir string) error {
 	s.Init()
 	logrus.Debugf("Initialize an Updatecli policy")
 
-	err := s.scaffoldPolicy(&PolicySpec{}, rootDir)
+	err := s.scaffoldPolicy(&PolicySpec{ValuesSchema: s.ValuesSchemaFile}, rootDir)
 	if err != nil {
 		return err
 	}
@@ -57,6 +62,11 @@ func (s *Scaffold) Run(rootDir string) error {
 		return err
 	}
 
+	err = s.scaffoldValuesSchema(rootDir)
+	if err != nil {
+		return err
+	}
+
 	err = s.scaffoldConfig(rootDir)
 	if err != nil {
 		return err
@@ -7,6 +7,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/config"
+	"github.com/updatecli/updatecli/pkg/core/registry"
 )
 
 func TestRun(t *testing.T) {
@@ -27,6 +29,18 @@ func TestRun(t *testing.T) {
 	assert.FileExists(t, filepath.Join(testRootDir, "README.md"))
 
 	assert.FileExists(t, filepath.Join(testRootDir, "values.yaml"))
+	assert.FileExists(t, filepath.Join(testRootDir, "values.schema.json"))
+
+	policySpec, err := registry.LoadPolicyFile(filepath.Join(testRootDir, "Policy.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "values.schema.json", policySpec.ValuesSchema)
+
+	// The scaffolded values must match the scaffolded schema
+	err = config.ValidateValues(
+		filepath.Join(testRootDir, "values.schema.json"),
+		[]string{filepath.Join(testRootDir, "values.yaml")},
+		nil)
+	assert.NoError(t, err)
 
 	assert.FileExists(t, filepath.Join(testRootDir, "CHANGELOG.md"))
 
@@ -41,6 +41,11 @@ licenses:
 # Description is the short policy description
 description: |
   {{ .Description }}
+{{- if .ValuesSchema }}
+
+# ValuesSchema is the JSON schema file used to validate the policy values
+valuesschema: {{ .ValuesSchema }}
+{{- end }}
 `
 	defaultAuthors       []string = []string{"Please insert an author for your policy"}
 	defaultDocumentation string   = "Please insert a documentation url for your policy"
@@ -70,6 +75,8 @@ type PolicySpec struct {
 	Vendor string
 	// URL is the policy url
 	URL string
+	// ValuesSchema is the values JSON schema file
+	ValuesSchema string
 }
 
 // sanitize set default values for the policy specification
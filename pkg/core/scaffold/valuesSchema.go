@@ -0,0 +1,88 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	valuesSchemaTemplate string = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "title": "Updatecli policy values",
+  "description": "JSON schema used to validate the values provided to the Updatecli policy",
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "scm": {
+      "description": "scm contains the settings used by the Updatecli manifest scm configurations",
+      "type": "object",
+      "properties": {
+        "default": {
+          "type": "object",
+          "additionalProperties": false,
+          "properties": {
+            "user": {
+              "description": "user is the git commit author name",
+              "type": "string"
+            },
+            "email": {
+              "description": "email is the git commit author email",
+              "type": "string"
+            },
+            "owner": {
+              "description": "owner is the git repository owner",
+              "type": "string"
+            },
+            "repository": {
+              "description": "repository is the git repository name",
+              "type": "string"
+            },
+            "username": {
+              "description": "username is the user used to authenticate with the scm",
+              "type": "string"
+            },
+            "branch": {
+              "description": "branch is the git branch to work from",
+              "type": "string"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`
+)
+
+func (s *Scaffold) scaffoldValuesSchema(dirname string) error {
+
+	if _, err := os.Stat(dirname); os.IsNotExist(err) {
+		err := os.MkdirAll(dirname, 0755)
+		if err != nil {
+			return err
+		}
+	}
+
+	valuesSchemaFilePath := filepath.Join(dirname, s.ValuesSchemaFile)
+
+	if _, err := os.Stat(valuesSchemaFilePath); err == nil {
+		logrus.Infof("Skipping, values schema already exist: %s", valuesSchemaFilePath)
+		return nil
+	}
+
+	f, err := os.Create(valuesSchemaFilePath)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	_, err = f.Write([]byte(valuesSchemaTemplate))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
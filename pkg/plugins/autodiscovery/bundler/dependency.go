@@ -0,0 +1,210 @@
+package bundler
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// rubygemsURL is the default gem server
+const rubygemsURL string = "https://rubygems.org"
+
+func (b Bundler) discoverGemfileManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	searchFromDir := b.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if b.spec.RootDir != "" && !path.IsAbs(b.spec.RootDir) {
+		searchFromDir = filepath.Join(b.rootDir, b.spec.RootDir)
+	}
+
+	foundFiles, err := searchGemfiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+
+		logrus.Debugf("parsing file %q", foundFile)
+
+		relativeFoundFile, err := filepath.Rel(b.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		lockFile := filepath.Join(filepath.Dir(foundFile), GemFileLock)
+		lockFileDetected := isLockFileDetected(lockFile)
+
+		// It doesn't make sense to update a Gemfile if Updatecli can't update its lock file
+		if lockFileDetected && !isBundleInstalled() {
+			logrus.Warningf("skipping, Gemfile.lock detected but Updatecli couldn't detect the bundle command to update it in case of a Gemfile update")
+			continue
+		}
+
+		gems, err := parseGemfile(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		lockedVersions := map[string]string{}
+		if lockFileDetected {
+			lockedVersions, err = parseGemfileLock(lockFile)
+			if err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+		}
+
+		sort.Slice(gems, func(i, j int) bool {
+			return gems[i].Name < gems[j].Name
+		})
+
+		for _, g := range gems {
+			g.LockedVersion = lockedVersions[g.Name]
+
+			pinnedVersion, isPinned := isExactRequirement(g.Requirements)
+
+			// A gem not pinned to a specific version can only be updated through the lock file
+			if !isPinned && !lockFileDetected {
+				logrus.Debugf("Ignoring gem %q from %q. Its version requirement %q is handled by Bundler", g.Name, relativeFoundFile, strings.Join(g.Requirements, ", "))
+				continue
+			}
+
+			currentVersion := g.LockedVersion
+			if currentVersion == "" {
+				currentVersion = pinnedVersion
+			}
+
+			if len(b.spec.Ignore) > 0 {
+				if b.spec.Ignore.isMatchingRules(b.rootDir, relativeFoundFile, g.Name, currentVersion) {
+					logrus.Debugf("Ignoring gem %q from %q, as matching ignore rule(s)\n", g.Name, relativeFoundFile)
+					continue
+				}
+			}
+
+			if len(b.spec.Only) > 0 {
+				if !b.spec.Only.isMatchingRules(b.rootDir, relativeFoundFile, g.Name, currentVersion) {
+					logrus.Debugf("Ignoring gem %q from %q, as not matching only rule(s)\n", g.Name, relativeFoundFile)
+					continue
+				}
+			}
+
+			sourceVersionFilterKind, sourceVersionFilterPattern := b.getVersionFilter(g, pinnedVersion, isPinned)
+
+			// The rubygems resource already defaults to rubygems.org
+			url := g.Source
+			if strings.TrimSuffix(url, "/") == rubygemsURL {
+				url = ""
+			}
+
+			params := struct {
+				ManifestName               string
+				SourceID                   string
+				SourceName                 string
+				GemName                    string
+				URL                        string
+				SourceVersionFilterKind    string
+				SourceVersionFilterPattern string
+				GemfileTarget              bool
+				TargetID                   string
+				TargetName                 string
+				File                       string
+				LockFileTarget             bool
+				LockFileTargetID           string
+				LockFileTargetName         string
+				TargetWorkdir              string
+				ScmID                      string
+			}{
+				ManifestName:               fmt.Sprintf("Bump %q gem version", g.Name),
+				SourceID:                   "rubygems",
+				SourceName:                 fmt.Sprintf("Get %q gem version", g.Name),
+				GemName:                    g.Name,
+				URL:                        url,
+				SourceVersionFilterKind:    sourceVersionFilterKind,
+				SourceVersionFilterPattern: sourceVersionFilterPattern,
+				GemfileTarget:              isPinned,
+				TargetID:                   "rubygems",
+				TargetName:                 fmt.Sprintf("Bump %q gem version to {{ source \"rubygems\" }}", g.Name),
+				File:                       relativeFoundFile,
+				LockFileTarget:             lockFileDetected,
+				LockFileTargetID:           "gemfile-lock",
+				LockFileTargetName:         fmt.Sprintf("Update %q gem in Gemfile.lock", g.Name),
+				TargetWorkdir:              filepath.Dir(relativeFoundFile),
+				ScmID:                      b.scmID,
+			}
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// getVersionFilter returns the version filter used to retrieve the newest gem version
+// while respecting the version requirements specified by the Gemfile
+func (b Bundler) getVersionFilter(g gem, pinnedVersion string, isPinned bool) (kind, pattern string) {
+	kind = version.SEMVERVERSIONKIND
+
+	switch {
+	case isPinned:
+		pattern = ">=" + pinnedVersion
+		if !b.spec.VersionFilter.IsZero() {
+			var err error
+			kind = b.versionFilter.Kind
+			pattern, err = b.versionFilter.GreaterThanPattern(pinnedVersion)
+			if err != nil {
+				logrus.Debugf("building version filter pattern: %s", err)
+				pattern = "*"
+			}
+			return kind, pattern
+		}
+
+	case len(g.Requirements) > 0:
+		var err error
+		pattern, err = toSemverConstraint(g.Requirements)
+		if err != nil {
+			logrus.Debugf("gem %q: %s", g.Name, err)
+			return version.LATESTVERSIONKIND, version.LATESTVERSIONKIND
+		}
+
+	case g.LockedVersion != "":
+		pattern = ">=" + g.LockedVersion
+
+	default:
+		return version.LATESTVERSIONKIND, version.LATESTVERSIONKIND
+	}
+
+	// Some gems don't follow semantic versioning, such as "1.2.3.4"
+	if _, err := semver.NewConstraint(pattern); err != nil {
+		logrus.Debugf("gem %q: version constraint %q not supported, fallback to latest version", g.Name, pattern)
+		return version.LATESTVERSIONKIND, version.LATESTVERSIONKIND
+	}
+
+	return kind, pattern
+}
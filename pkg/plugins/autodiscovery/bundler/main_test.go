@@ -0,0 +1,117 @@
+package bundler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	// The Gemfile located next to a Gemfile.lock is skipped if bundle isn't installed
+	if isBundleInstalled() {
+		t.Skip("bundle command detected, skipping")
+	}
+
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "Gemfile without lock file",
+			rootDir: "testdata",
+			expectedPipelines: []string{`name: 'Bump "internal" gem version'
+sources:
+  rubygems:
+    name: 'Get "internal" gem version'
+    kind: 'rubygems'
+    spec:
+      name: 'internal'
+      url: 'https://gems.example.com'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=2.0.0'
+targets:
+  rubygems:
+    name: 'Bump "internal" gem version to {{ source "rubygems" }}'
+    kind: 'rubygems'
+    spec:
+      name: 'internal'
+      file: 'lib/Gemfile'
+    sourceid: 'rubygems'
+`, `name: 'Bump "rake" gem version'
+sources:
+  rubygems:
+    name: 'Get "rake" gem version'
+    kind: 'rubygems'
+    spec:
+      name: 'rake'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=13.1.0'
+targets:
+  rubygems:
+    name: 'Bump "rake" gem version to {{ source "rubygems" }}'
+    kind: 'rubygems'
+    spec:
+      name: 'rake'
+      file: 'lib/Gemfile'
+    sourceid: 'rubygems'
+`},
+		},
+		{
+			name:    "Gemfile with only rule and version filter",
+			rootDir: "testdata",
+			spec: Spec{
+				Only: MatchingRules{
+					{
+						Gems: map[string]string{
+							"rake": ">=13",
+						},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump "rake" gem version'
+sources:
+  rubygems:
+    name: 'Get "rake" gem version'
+    kind: 'rubygems'
+    spec:
+      name: 'rake'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=13.1.0'
+targets:
+  rubygems:
+    name: 'Bump "rake" gem version to {{ source "rubygems" }}'
+    kind: 'rubygems'
+    spec:
+      name: 'rake'
+      file: 'lib/Gemfile'
+    sourceid: 'rubygems'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := New(tt.spec, tt.rootDir, "")
+			require.NoError(t, err)
+
+			var pipelines []string
+			rawPipelines, err := b.DiscoverManifests()
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tt.expectedPipelines), len(rawPipelines))
+
+			for i := range rawPipelines {
+				// We expect manifest generated by the autodiscovery to use the yaml syntax
+				pipelines = append(pipelines, string(rawPipelines[i]))
+				assert.Equal(t, tt.expectedPipelines[i], pipelines[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package bundler
+
+var (
+	// manifestTemplate is the Go template used to generate gem manifests
+	manifestTemplate string = `name: '{{ .ManifestName }}'
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'rubygems'
+    spec:
+      name: '{{ .GemName }}'
+{{- if .URL }}
+      url: '{{ .URL }}'
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+targets:
+{{- if .GemfileTarget }}
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+    kind: 'rubygems'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      name: '{{ .GemName }}'
+      file: '{{ .File }}'
+    sourceid: '{{ .SourceID }}'
+{{- end }}
+{{- if .LockFileTarget }}
+  {{ .LockFileTargetID }}:
+    name: '{{ .LockFileTargetName }}'
+{{- if .GemfileTarget }}
+    dependson:
+      - {{ .TargetID }}
+{{- end }}
+    disablesourceinput: true
+    kind: shell
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      command: 'bundle lock --update {{ .GemName }}'
+      environments:
+        - name: PATH
+        - name: HOME
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - 'Gemfile.lock'
+      workdir: '{{ .TargetWorkdir }}'
+{{- end }}
+`
+)
@@ -0,0 +1,101 @@
+package bundler
+
+import (
+	"path/filepath"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// MatchingRule allows to specifies rules to identify manifest
+type MatchingRule struct {
+	// Path specifies a Gemfile path pattern, the pattern requires to match all of name, not just a substring.
+	Path string
+	// Gems specifies the list of gems to check, the value accepts a semantic versioning constraint such as ">=1.0"
+	Gems map[string]string
+}
+
+type MatchingRules []MatchingRule
+
+// isMatchingRules checks if a specific file content matches the "only" rule
+func (m MatchingRules) isMatchingRules(rootDir, filePath, gemName, gemVersion string) bool {
+	var ruleResults []bool
+
+	if len(m) > 0 {
+		for _, rule := range m {
+			/*
+				Check if rule.Path is matching. Path accepts wildcard path
+			*/
+
+			if rule.Path != "" {
+				if filepath.IsAbs(rule.Path) {
+					filePath = filepath.Join(rootDir, filePath)
+				}
+
+				match, err := filepath.Match(rule.Path, filePath)
+				if err != nil {
+					logrus.Errorf("%s - %q", err, rule.Path)
+					continue
+				}
+				ruleResults = append(ruleResults, match)
+				if match {
+					logrus.Debugf("file path %q matching rule %q", filePath, rule.Path)
+				}
+			}
+
+			/*
+				Checks if policy is matching the policy constraint.
+			*/
+
+			if len(rule.Gems) > 0 {
+				match := false
+
+			outGem:
+				for ruleGemName, ruleGemVersion := range rule.Gems {
+
+					if gemName == ruleGemName {
+						if ruleGemVersion == "" {
+							match = true
+							break outGem
+						}
+
+						v, err := semver.NewVersion(gemVersion)
+						if err != nil {
+							match = gemVersion == ruleGemVersion
+							logrus.Debugf("%q - %s", gemVersion, err)
+							break outGem
+						}
+
+						c, err := semver.NewConstraint(ruleGemVersion)
+						if err != nil {
+							match = gemVersion == ruleGemVersion
+							logrus.Debugf("%q %s", err, ruleGemVersion)
+							break outGem
+						}
+
+						match = c.Check(v)
+						break outGem
+					}
+				}
+				ruleResults = append(ruleResults, match)
+			}
+
+			/*
+				If at least one rule is failing then we return false
+			*/
+			isAllMatching := true
+			for i := range ruleResults {
+				if !ruleResults[i] {
+					isAllMatching = false
+				}
+			}
+			if isAllMatching {
+				return true
+			}
+			ruleResults = []bool{}
+		}
+		return false
+	}
+
+	return false
+}
@@ -0,0 +1,108 @@
+package bundler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRule(t *testing.T) {
+
+	dataset := []struct {
+		name           string
+		rules          MatchingRules
+		filePath       string
+		gemName        string
+		gemVersion     string
+		expectedResult bool
+	}{
+		{
+			name: "Matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "testdata/app/Gemfile",
+				},
+			},
+			filePath:       "testdata/app/Gemfile",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "testdata/app/Gemfile",
+				},
+			},
+			filePath:       "testdata/lib/Gemfile",
+			expectedResult: false,
+		},
+		{
+			name: "Matching gem version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "testdata/*/Gemfile",
+					Gems: map[string]string{
+						"rails": ">=7, <8",
+					},
+				},
+			},
+			filePath:       "testdata/app/Gemfile",
+			gemName:        "rails",
+			gemVersion:     "7.1.3",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching gem version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Gems: map[string]string{
+						"rails": "<7",
+					},
+				},
+			},
+			filePath:       "testdata/app/Gemfile",
+			gemName:        "rails",
+			gemVersion:     "7.1.3",
+			expectedResult: false,
+		},
+		{
+			name: "Matching gem without version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Gems: map[string]string{
+						"pg": "",
+					},
+				},
+			},
+			filePath:       "testdata/app/Gemfile",
+			gemName:        "pg",
+			gemVersion:     "1.5.3",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching gem name",
+			rules: MatchingRules{
+				MatchingRule{
+					Gems: map[string]string{
+						"pg": "",
+					},
+				},
+			},
+			filePath:       "testdata/app/Gemfile",
+			gemName:        "puma",
+			gemVersion:     "6.4.2",
+			expectedResult: false,
+		},
+	}
+
+	for _, d := range dataset {
+		t.Run(d.name, func(t *testing.T) {
+			gotResult := d.rules.isMatchingRules(
+				"",
+				d.filePath,
+				d.gemName,
+				d.gemVersion)
+			assert.Equal(t, d.expectedResult, gotResult)
+		})
+	}
+}
@@ -0,0 +1,282 @@
+package bundler
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// GemFile is the file listing the gems required by a Ruby project
+	GemFile string = "Gemfile"
+	// GemFileLock is the file locking the gem versions resolved by Bundler
+	GemFileLock string = "Gemfile.lock"
+)
+
+var (
+	// gemRegex matches a Gemfile gem statement
+	gemRegex = regexp.MustCompile(`^\s*gem\s*\(?\s*["']([^"']+)["'](.*)$`)
+	// gemArgumentRegex matches a string argument following the gem name
+	gemArgumentRegex = regexp.MustCompile(`^\s*,\s*["']([^"']*)["']`)
+	// sourceRegex matches a Gemfile source statement
+	sourceRegex = regexp.MustCompile(`^\s*source\s*\(?\s*["']([^"']+)["']\s*\)?\s*(do\b.*)?$`)
+	// gemSourceOptionRegex matches the source option of a gem statement
+	gemSourceOptionRegex = regexp.MustCompile(`(?:\bsource:|:source\s*=>)\s*["']([^"']+)["']`)
+	// unversionedOptionRegex matches the options of gems not retrieved from a gem server
+	unversionedOptionRegex = regexp.MustCompile(`(?:\b(?:git|github|gist|bitbucket|path):|:(?:git|github|gist|bitbucket|path)\s*=>)`)
+	// blockStartRegex matches the statements opening a block closed by "end"
+	blockStartRegex = regexp.MustCompile(`(?:\bdo\s*(?:\|[^|]*\|)?\s*$|^\s*(?:if|unless|case|begin)\b)`)
+	// blockEndRegex matches the end of a block
+	blockEndRegex = regexp.MustCompile(`^\s*end\b`)
+	// lockSpecRegex matches a gem locked in the specs list of a Gemfile.lock
+	lockSpecRegex = regexp.MustCompile(`^    ([^\s(]+) \(([^)]+)\)$`)
+	// requirementRegex matches a gem version requirement
+	requirementRegex = regexp.MustCompile(`^(~>|>=|<=|!=|=|>|<)?\s*([0-9][0-9A-Za-z.]*)$`)
+)
+
+// gem describes a gem required by a Gemfile
+type gem struct {
+	Name string
+	// Requirements contains the version requirements such as "~> 7.1"
+	Requirements []string
+	// Source is the gem server hosting the gem
+	Source string
+	// LockedVersion is the version resolved in the Gemfile.lock
+	LockedVersion string
+}
+
+// searchGemfiles looks, recursively, for every files named Gemfile from a root directory.
+func searchGemfiles(rootDir string) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for Gemfile files in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		// Gems installed in the project directory shouldn't be updated
+		if d.IsDir() && (d.Name() == "vendor" || d.Name() == ".bundle" || d.Name() == "node_modules") {
+			return filepath.SkipDir
+		}
+
+		if !d.IsDir() && d.Name() == GemFile {
+			foundFiles = append(foundFiles, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return foundFiles, nil
+}
+
+// parseGemfile returns the gems, retrieved from a gem server, required by a Gemfile
+func parseGemfile(filename string) ([]gem, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var gems []gem
+
+	defaultSource := ""
+	// sources contains the source of every opened block, or an empty string if the block doesn't define one
+	sources := []string{}
+
+	currentSource := func() string {
+		for i := len(sources) - 1; i >= 0; i-- {
+			if sources[i] != "" {
+				return sources[i]
+			}
+		}
+		return defaultSource
+	}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 && !strings.ContainsAny(line[:i], `"'`) {
+			line = line[:i]
+		}
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if blockEndRegex.MatchString(line) {
+			if len(sources) > 0 {
+				sources = sources[:len(sources)-1]
+			}
+			continue
+		}
+
+		if m := sourceRegex.FindStringSubmatch(line); m != nil {
+			if m[2] == "" {
+				defaultSource = m[1]
+			} else {
+				sources = append(sources, m[1])
+			}
+			continue
+		}
+
+		if blockStartRegex.MatchString(line) {
+			sources = append(sources, "")
+			continue
+		}
+
+		m := gemRegex.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		if unversionedOptionRegex.MatchString(m[2]) {
+			logrus.Debugf("Ignoring gem %q. Updating git or path gems is not supported at this time", m[1])
+			continue
+		}
+
+		g := gem{
+			Name:   m[1],
+			Source: currentSource(),
+		}
+
+		if s := gemSourceOptionRegex.FindStringSubmatch(m[2]); s != nil {
+			g.Source = s[1]
+		}
+
+		arguments := m[2]
+		for {
+			a := gemArgumentRegex.FindStringSubmatchIndex(arguments)
+			if a == nil {
+				break
+			}
+			g.Requirements = append(g.Requirements, strings.TrimSpace(arguments[a[2]:a[3]]))
+			arguments = arguments[a[1]:]
+		}
+
+		gems = append(gems, g)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return gems, nil
+}
+
+// parseGemfileLock returns the gem versions resolved from gem servers by a Gemfile.lock
+func parseGemfileLock(filename string) (map[string]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	versions := map[string]string{}
+	section := ""
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line != "" && !strings.HasPrefix(line, " ") {
+			section = strings.TrimSpace(line)
+			continue
+		}
+
+		// Git and path gems are locked in the GIT and PATH sections
+		if section != "GEM" {
+			continue
+		}
+
+		if m := lockSpecRegex.FindStringSubmatch(line); m != nil {
+			// Platform specific gems are suffixed by their platform such as "1.15.4-x86_64-linux"
+			v, _, _ := strings.Cut(m[2], "-")
+			versions[m[1]] = v
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// toSemverConstraint converts gem version requirements into a semantic versioning constraint
+// https://guides.rubygems.org/patterns/#pessimistic-version-constraint
+func toSemverConstraint(requirements []string) (string, error) {
+	var constraints []string
+
+	for _, requirement := range requirements {
+		m := requirementRegex.FindStringSubmatch(requirement)
+		if m == nil {
+			return "", fmt.Errorf("version requirement %q not supported", requirement)
+		}
+
+		operator, version := m[1], m[2]
+		switch operator {
+		case "":
+			constraints = append(constraints, "="+version)
+		case "~>":
+			segments := strings.Split(version, ".")
+			upper := segments[:1]
+			if len(segments) > 1 {
+				upper = segments[:len(segments)-1]
+			}
+
+			var major int
+			if _, err := fmt.Sscanf(upper[len(upper)-1], "%d", &major); err != nil {
+				return "", fmt.Errorf("version requirement %q not supported", requirement)
+			}
+			upper = append(append([]string{}, upper[:len(upper)-1]...), fmt.Sprint(major+1))
+
+			constraints = append(constraints, ">="+version, "<"+strings.Join(upper, "."))
+		default:
+			constraints = append(constraints, operator+version)
+		}
+	}
+
+	return strings.Join(constraints, ", "), nil
+}
+
+// isExactRequirement returns true if the gem is pinned to a specific version,
+// and returns that version
+func isExactRequirement(requirements []string) (string, bool) {
+	if len(requirements) != 1 {
+		return "", false
+	}
+
+	m := requirementRegex.FindStringSubmatch(requirements[0])
+	if m == nil || (m[1] != "" && m[1] != "=") {
+		return "", false
+	}
+
+	return m[2], true
+}
+
+// isLockFileDetected returns true if the lock file exists
+func isLockFileDetected(lockfile string) bool {
+	_, err := os.Stat(lockfile)
+	return err == nil
+}
+
+// isBundleInstalled returns true if the bundle command is available
+func isBundleInstalled() bool {
+	_, err := exec.LookPath("bundle")
+	return err == nil
+}
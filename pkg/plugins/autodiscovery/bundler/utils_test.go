@@ -0,0 +1,130 @@
+package bundler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestParseGemfile(t *testing.T) {
+	gems, err := parseGemfile("testdata/app/Gemfile")
+	require.NoError(t, err)
+
+	expectedGems := []gem{
+		{Name: "rails", Requirements: []string{"~> 7.1.3"}, Source: "https://rubygems.org"},
+		{Name: "pg", Requirements: []string{"1.5.3"}, Source: "https://rubygems.org"},
+		{Name: "puma", Requirements: []string{">= 5.0", "< 7"}, Source: "https://rubygems.org"},
+		{Name: "bootsnap", Source: "https://rubygems.org"},
+		{Name: "sidekiq", Requirements: []string{"= 7.2.1"}, Source: "https://rubygems.org"},
+		{Name: "rspec-rails", Requirements: []string{"6.1.1"}, Source: "https://rubygems.org"},
+		{Name: "private_gem", Requirements: []string{"0.4.0"}, Source: "https://gems.example.com"},
+	}
+
+	assert.Equal(t, expectedGems, gems)
+}
+
+func TestParseGemfileLock(t *testing.T) {
+	versions, err := parseGemfileLock("testdata/app/Gemfile.lock")
+	require.NoError(t, err)
+
+	expectedVersions := map[string]string{
+		"bootsnap": "1.18.3",
+		"nokogiri": "1.16.2",
+		"pg":       "1.5.3",
+		"puma":     "6.4.2",
+		"rails":    "7.1.3.2",
+	}
+
+	assert.Equal(t, expectedVersions, versions)
+}
+
+func TestToSemverConstraint(t *testing.T) {
+	testdata := []struct {
+		requirements []string
+		expected     string
+		wantErr      bool
+	}{
+		{requirements: []string{"~> 7.1"}, expected: ">=7.1, <8"},
+		{requirements: []string{"~> 7.1.3"}, expected: ">=7.1.3, <7.2"},
+		{requirements: []string{"~> 2"}, expected: ">=2, <3"},
+		{requirements: []string{">= 5.0", "< 7"}, expected: ">=5.0, <7"},
+		{requirements: []string{"1.5.3"}, expected: "=1.5.3"},
+		{requirements: []string{"~> v1"}, wantErr: true},
+		{requirements: []string{">= 1.0 < 2"}, wantErr: true},
+	}
+
+	for _, tt := range testdata {
+		t.Run(strings.Join(tt.requirements, ", "), func(t *testing.T) {
+			got, err := toSemverConstraint(tt.requirements)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestGetVersionFilter(t *testing.T) {
+	testdata := []struct {
+		name            string
+		spec            Spec
+		gem             gem
+		expectedKind    string
+		expectedPattern string
+	}{
+		{
+			name:            "Pinned gem",
+			gem:             gem{Name: "pg", Requirements: []string{"1.5.3"}},
+			expectedKind:    "semver",
+			expectedPattern: ">=1.5.3",
+		},
+		{
+			name: "Pinned gem with version filter",
+			spec: Spec{
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+			},
+			gem:             gem{Name: "pg", Requirements: []string{"= 1.5.3"}},
+			expectedKind:    "semver",
+			expectedPattern: "1.x",
+		},
+		{
+			name:            "Pessimistic version requirement",
+			gem:             gem{Name: "rails", Requirements: []string{"~> 7.1.3"}, LockedVersion: "7.1.3"},
+			expectedKind:    "semver",
+			expectedPattern: ">=7.1.3, <7.2",
+		},
+		{
+			name:            "No version requirement",
+			gem:             gem{Name: "bootsnap", LockedVersion: "1.18.3"},
+			expectedKind:    "semver",
+			expectedPattern: ">=1.18.3",
+		},
+		{
+			name:            "Version not following semantic versioning",
+			gem:             gem{Name: "rails", LockedVersion: "7.1.3.2"},
+			expectedKind:    "latest",
+			expectedPattern: "latest",
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := New(tt.spec, "testdata", "")
+			require.NoError(t, err)
+
+			pinnedVersion, isPinned := isExactRequirement(tt.gem.Requirements)
+			kind, pattern := b.getVersionFilter(tt.gem, pinnedVersion, isPinned)
+
+			assert.Equal(t, tt.expectedKind, kind)
+			assert.Equal(t, tt.expectedPattern, pattern)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package composer
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func (c Composer) discoverComposerManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	searchFromDir := c.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if c.spec.RootDir != "" && !path.IsAbs(c.spec.RootDir) {
+		searchFromDir = filepath.Join(c.rootDir, c.spec.RootDir)
+	}
+
+	foundFiles, err := searchComposerJsonFiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+
+		logrus.Debugf("parsing file %q", foundFile)
+
+		relativeFoundFile, err := filepath.Rel(c.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next composer.json if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		// It doesn't make sense to update the composer.json if Updatecli do not have access to the composer command to update composer.lock
+		composerLockTargetEnabled := false
+		if isLockFileDetected(filepath.Join(filepath.Dir(foundFile), ComposerLockFile)) {
+			switch isComposerInstalled() {
+			case true:
+				composerLockTargetEnabled = true
+			case false:
+				logrus.Warning("skipping, composer lock file detected but Updatecli couldn't detect the composer command to update it in case of a composer.json update")
+				continue
+			}
+		}
+
+		data, err := loadComposerJsonData(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		getManifest := func(dependencies map[string]string, dependencyType string) {
+			if len(dependencies) == 0 {
+				logrus.Debugf("no composer %s found in %q\n", dependencyType, foundFile)
+				return
+			}
+
+			packageNames := make([]string, 0, len(dependencies))
+			for packageName := range dependencies {
+				packageNames = append(packageNames, packageName)
+			}
+			sort.Strings(packageNames)
+
+			for _, packageName := range packageNames {
+				constraint := dependencies[packageName]
+
+				if isPlatformPackage(packageName) {
+					continue
+				}
+
+				operator, packageVersion, ok := parseConstraint(constraint)
+				if !ok {
+					logrus.Debugf("Ignoring composer package %q. Its version constraint %q is handled by composer", packageName, constraint)
+					continue
+				}
+
+				if len(c.spec.Ignore) > 0 {
+					if c.spec.Ignore.isMatchingRules(c.rootDir, relativeFoundFile, packageName, packageVersion) {
+						logrus.Debugf("Ignoring composer package %q from %q, as matching ignore rule(s)\n", packageName, relativeFoundFile)
+						continue
+					}
+				}
+
+				if len(c.spec.Only) > 0 {
+					if !c.spec.Only.isMatchingRules(c.rootDir, relativeFoundFile, packageName, packageVersion) {
+						logrus.Debugf("Ignoring composer package %q from %q, as not matching only rule(s)\n", packageName, relativeFoundFile)
+						continue
+					}
+				}
+
+				sourceVersionFilterKind, sourceVersionFilterPattern, err := c.getVersionFilter(operator, packageVersion)
+				if err != nil {
+					logrus.Debugf("Ignoring composer package %q: %s", packageName, err)
+					continue
+				}
+
+				params := struct {
+					ManifestName               string
+					SourceID                   string
+					SourceName                 string
+					PackageName                string
+					SourceVersionFilterKind    string
+					SourceVersionFilterPattern string
+					TargetID                   string
+					TargetName                 string
+					TargetComposerLockEnabled  bool
+					TargetComposerLockName     string
+					TargetWorkdir              string
+					File                       string
+					ScmID                      string
+				}{
+					ManifestName:               fmt.Sprintf("Bump %q composer package version", packageName),
+					SourceID:                   "composer",
+					SourceName:                 fmt.Sprintf("Get %q composer package version", packageName),
+					PackageName:                packageName,
+					SourceVersionFilterKind:    sourceVersionFilterKind,
+					SourceVersionFilterPattern: sourceVersionFilterPattern,
+					TargetID:                   "composer",
+					TargetName:                 fmt.Sprintf("Bump %q composer package version to {{ source \"composer\" }}", packageName),
+					TargetComposerLockEnabled:  composerLockTargetEnabled,
+					TargetComposerLockName:     fmt.Sprintf("Update %q composer package in composer.lock", packageName),
+					TargetWorkdir:              filepath.Dir(relativeFoundFile),
+					File:                       relativeFoundFile,
+					ScmID:                      c.scmID,
+				}
+
+				manifest := bytes.Buffer{}
+				if err := tmpl.Execute(&manifest, params); err != nil {
+					logrus.Debugln(err)
+					continue
+				}
+
+				manifests = append(manifests, manifest.Bytes())
+			}
+		}
+
+		getManifest(data.Require, "require")
+		getManifest(data.RequireDev, "require-dev")
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// getVersionFilter returns the version filter used to retrieve the newest package version.
+// The version constraint defined from composer.json is reused, otherwise the pinned version
+// is converted to ">=x.y.z" or to the version filter defined in the manifest.
+func (c Composer) getVersionFilter(operator, packageVersion string) (kind, pattern string, err error) {
+	if operator != "" && operator != "=" {
+		pattern, err = toSemverConstraint(operator, packageVersion)
+		return version.SEMVERVERSIONKIND, pattern, err
+	}
+
+	kind = version.SEMVERVERSIONKIND
+	pattern = ">=" + packageVersion
+
+	if !c.spec.VersionFilter.IsZero() {
+		kind = c.versionFilter.Kind
+		pattern, err = c.versionFilter.GreaterThanPattern(packageVersion)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			pattern = "*"
+		}
+	}
+
+	return kind, pattern, nil
+}
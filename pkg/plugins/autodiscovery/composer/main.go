@@ -0,0 +1,111 @@
+package composer
+
+import (
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines the parameters which can be provided to the composer crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for composer.json
+	RootDir string `yaml:",omitempty"`
+	// Ignore specifies rule to ignore composer.json update.
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only specify required rule to restrict composer.json update.
+	Only MatchingRules `yaml:",omitempty"`
+	/*
+		`versionfilter` provides parameters to specify the version pattern to use when generating manifest.
+
+		kind - semver
+			versionfilter of kind `semver` uses semantic versioning as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a version constraint` such as `>= 1.0.0`
+
+		kind - regex
+			versionfilter of kind `regex` uses regular expression as version filtering
+			pattern accepts a valid regular expression
+
+		example:
+		```
+			versionfilter:
+				kind: semver
+				pattern: minor
+		```
+
+		remark:
+			The versionfilter only applies to packages pinned to a specific version such as `"monolog/monolog": "3.4.0"`,
+			other version constraints such as `^6.3` are reused as is.
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+// Composer holds all information needed to generate composer manifests.
+type Composer struct {
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for composer.json
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID string) (Composer, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Composer{}, err
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Composer{}, err
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		// By default, composer packages follow semantic versioning
+		newFilter.Kind = version.SEMVERVERSIONKIND
+		newFilter.Pattern = "*"
+	}
+
+	return Composer{
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+	}, nil
+}
+
+// DiscoverManifests returns the manifests updating composer.json and composer.lock
+func (c Composer) DiscoverManifests() ([][]byte, error) {
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle("Composer"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("Composer")+1))
+
+	manifests, err := c.discoverComposerManifests()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return manifests, nil
+}
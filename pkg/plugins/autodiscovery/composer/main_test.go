@@ -0,0 +1,139 @@
+package composer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	// The composer.json located next to a composer.lock is skipped if composer isn't installed
+	if isComposerInstalled() {
+		t.Skip("composer command detected, skipping")
+	}
+
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "Scenario 1",
+			rootDir: "testdata",
+			expectedPipelines: []string{`name: 'Bump "monolog/monolog" composer package version'
+sources:
+  composer:
+    name: 'Get "monolog/monolog" composer package version'
+    kind: 'composer'
+    spec:
+      name: 'monolog/monolog'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=3.4.0'
+targets:
+  composer:
+    name: 'Bump "monolog/monolog" composer package version to {{ source "composer" }}'
+    kind: 'composer'
+    spec:
+      name: 'monolog/monolog'
+      file: 'app/composer.json'
+    sourceid: 'composer'
+`, `name: 'Bump "symfony/console" composer package version'
+sources:
+  composer:
+    name: 'Get "symfony/console" composer package version'
+    kind: 'composer'
+    spec:
+      name: 'symfony/console'
+      versionfilter:
+        kind: 'semver'
+        pattern: '^6.3'
+targets:
+  composer:
+    name: 'Bump "symfony/console" composer package version to {{ source "composer" }}'
+    kind: 'composer'
+    spec:
+      name: 'symfony/console'
+      file: 'app/composer.json'
+    sourceid: 'composer'
+`, `name: 'Bump "phpunit/phpunit" composer package version'
+sources:
+  composer:
+    name: 'Get "phpunit/phpunit" composer package version'
+    kind: 'composer'
+    spec:
+      name: 'phpunit/phpunit'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=10.2, <11'
+targets:
+  composer:
+    name: 'Bump "phpunit/phpunit" composer package version to {{ source "composer" }}'
+    kind: 'composer'
+    spec:
+      name: 'phpunit/phpunit'
+      file: 'app/composer.json'
+    sourceid: 'composer'
+`},
+		},
+		{
+			name:    "Only rule with version filter",
+			rootDir: "testdata",
+			spec: Spec{
+				Only: MatchingRules{
+					{
+						Packages: map[string]string{
+							"monolog/monolog": ">=3",
+						},
+					},
+				},
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump "monolog/monolog" composer package version'
+sources:
+  composer:
+    name: 'Get "monolog/monolog" composer package version'
+    kind: 'composer'
+    spec:
+      name: 'monolog/monolog'
+      versionfilter:
+        kind: 'semver'
+        pattern: '3.x'
+targets:
+  composer:
+    name: 'Bump "monolog/monolog" composer package version to {{ source "composer" }}'
+    kind: 'composer'
+    spec:
+      name: 'monolog/monolog'
+      file: 'app/composer.json'
+    sourceid: 'composer'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.spec, tt.rootDir, "")
+			require.NoError(t, err)
+
+			var pipelines []string
+			rawPipelines, err := c.DiscoverManifests()
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tt.expectedPipelines), len(rawPipelines))
+
+			for i := range rawPipelines {
+				// We expect manifest generated by the autodiscovery to use the yaml syntax
+				pipelines = append(pipelines, string(rawPipelines[i]))
+				assert.Equal(t, tt.expectedPipelines[i], pipelines[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package composer
+
+var (
+	// manifestTemplate is the Go template used to generate composer manifests
+	manifestTemplate string = `name: '{{ .ManifestName }}'
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'composer'
+    spec:
+      name: '{{ .PackageName }}'
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+    kind: 'composer'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      name: '{{ .PackageName }}'
+      file: '{{ .File }}'
+    sourceid: '{{ .SourceID }}'
+{{- if .TargetComposerLockEnabled }}
+  composer.lock:
+    name: '{{ .TargetComposerLockName }}'
+    dependson:
+      - {{ .TargetID }}
+    disablesourceinput: true
+    kind: shell
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      command: 'composer update {{ .PackageName }} --no-install --no-scripts --no-plugins --no-interaction'
+      environments:
+        - name: PATH
+        - name: HOME
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - 'composer.lock'
+      workdir: '{{ .TargetWorkdir }}'
+{{- end }}
+`
+)
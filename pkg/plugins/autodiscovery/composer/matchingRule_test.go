@@ -0,0 +1,94 @@
+package composer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRule(t *testing.T) {
+
+	dataset := []struct {
+		name           string
+		rules          MatchingRules
+		filePath       string
+		packageName    string
+		packageVersion string
+		expectedResult bool
+	}{
+		{
+			name: "Matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "app/*.json",
+				},
+			},
+			filePath:       "app/composer.json",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "app/*.json",
+				},
+			},
+			filePath:       "library/composer.json",
+			expectedResult: false,
+		},
+		{
+			name: "Matching package name ignoring case",
+			rules: MatchingRules{
+				MatchingRule{
+					Packages: map[string]string{
+						"Monolog/Monolog": "",
+					},
+				},
+			},
+			filePath:       "app/composer.json",
+			packageName:    "monolog/monolog",
+			packageVersion: "3.4.0",
+			expectedResult: true,
+		},
+		{
+			name: "Matching package version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "app/*.json",
+					Packages: map[string]string{
+						"monolog/monolog": ">=3",
+					},
+				},
+			},
+			filePath:       "app/composer.json",
+			packageName:    "monolog/monolog",
+			packageVersion: "3.4.0",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching package version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Packages: map[string]string{
+						"monolog/monolog": "<3",
+					},
+				},
+			},
+			filePath:       "app/composer.json",
+			packageName:    "monolog/monolog",
+			packageVersion: "3.4.0",
+			expectedResult: false,
+		},
+	}
+
+	for _, d := range dataset {
+		t.Run(d.name, func(t *testing.T) {
+			gotResult := d.rules.isMatchingRules(
+				"",
+				d.filePath,
+				d.packageName,
+				d.packageVersion)
+			assert.Equal(t, d.expectedResult, gotResult)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package composer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// ComposerJsonFile is the file listing the packages required by a PHP project
+	ComposerJsonFile string = "composer.json"
+	// ComposerLockFile is the file locking the package versions resolved by composer
+	ComposerLockFile string = "composer.lock"
+)
+
+var (
+	// constraintRegex matches the version constraints which can be updated by the composer target
+	constraintRegex = regexp.MustCompile(`^(\^|~|>=|==?)?\s*v?([0-9]+(?:\.[0-9]+){0,3})$`)
+)
+
+// composerJsonData represent the struct content of composer.json
+type composerJsonData struct {
+	Name       string
+	Require    map[string]string `json:"require,omitempty"`
+	RequireDev map[string]string `json:"require-dev,omitempty"`
+}
+
+// searchComposerJsonFiles looks, recursively, for every files named composer.json from a root directory.
+func searchComposerJsonFiles(rootDir string) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for composer.json files in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		// Updatecli should ignore all composer.json from the directory named "vendor"
+		// as they are automatically installed by composer
+		if d.IsDir() && d.Name() == "vendor" {
+			return filepath.SkipDir
+		}
+
+		if !d.IsDir() && d.Name() == ComposerJsonFile {
+			foundFiles = append(foundFiles, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return foundFiles, nil
+}
+
+// loadComposerJsonData read a file an return its content
+func loadComposerJsonData(filename string) (*composerJsonData, error) {
+
+	rawFileContent, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var data composerJsonData
+
+	err = json.Unmarshal(rawFileContent, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// isPlatformPackage returns true if the package is provided by the platform such as "php" or "ext-json"
+// https://getcomposer.org/doc/01-basic-usage.md#platform-packages
+func isPlatformPackage(packageName string) bool {
+	return !strings.Contains(packageName, "/")
+}
+
+// parseConstraint returns the operator and the version of a version constraint
+// which can be updated by the composer target
+func parseConstraint(constraint string) (operator, version string, ok bool) {
+	m := constraintRegex.FindStringSubmatch(strings.TrimSpace(constraint))
+	if m == nil {
+		return "", "", false
+	}
+
+	operator = m[1]
+	if operator == "==" {
+		operator = "="
+	}
+
+	return operator, m[2], true
+}
+
+// toSemverConstraint converts a composer version constraint into a semantic versioning constraint
+// https://getcomposer.org/doc/articles/versions.md#next-significant-release-operators
+func toSemverConstraint(operator, version string) (string, error) {
+	switch operator {
+	case "^", ">=":
+		return operator + version, nil
+
+	case "~":
+		// "~1.2" allows ">=1.2 <2.0" while "~1.2.3" allows ">=1.2.3 <1.3.0"
+		segments := strings.Split(version, ".")
+		upper := segments[:1]
+		if len(segments) > 1 {
+			upper = segments[:len(segments)-1]
+		}
+
+		last, err := strconv.Atoi(upper[len(upper)-1])
+		if err != nil {
+			return "", fmt.Errorf("version constraint %q not supported", operator+version)
+		}
+		upper = append(append([]string{}, upper[:len(upper)-1]...), strconv.Itoa(last+1))
+
+		return fmt.Sprintf(">=%s, <%s", version, strings.Join(upper, ".")), nil
+	}
+
+	return "", fmt.Errorf("version constraint %q not supported", operator+version)
+}
+
+// isLockFileDetected returns true if the lock file exists
+func isLockFileDetected(lockfile string) bool {
+	_, err := os.Stat(lockfile)
+	return err == nil
+}
+
+// isComposerInstalled returns true if the composer command is available
+func isComposerInstalled() bool {
+	_, err := exec.LookPath("composer")
+	return err == nil
+}
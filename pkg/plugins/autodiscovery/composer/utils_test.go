@@ -0,0 +1,66 @@
+package composer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		constraint       string
+		expectedOperator string
+		expectedVersion  string
+		expectedOK       bool
+	}{
+		{constraint: "3.4.0", expectedVersion: "3.4.0", expectedOK: true},
+		{constraint: "v3.4.0", expectedVersion: "3.4.0", expectedOK: true},
+		{constraint: "==3.4.0", expectedOperator: "=", expectedVersion: "3.4.0", expectedOK: true},
+		{constraint: "^6.3", expectedOperator: "^", expectedVersion: "6.3", expectedOK: true},
+		{constraint: "~10.2.1", expectedOperator: "~", expectedVersion: "10.2.1", expectedOK: true},
+		{constraint: ">= 8.1", expectedOperator: ">=", expectedVersion: "8.1", expectedOK: true},
+		{constraint: "^7.0 || ^8.0"},
+		{constraint: "1.0 - 2.0"},
+		{constraint: "6.3.*"},
+		{constraint: "dev-main"},
+		{constraint: "3.4.0@beta"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			operator, version, ok := parseConstraint(tt.constraint)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedOperator, operator)
+			assert.Equal(t, tt.expectedVersion, version)
+		})
+	}
+}
+
+func TestToSemverConstraint(t *testing.T) {
+	tests := []struct {
+		operator string
+		version  string
+		expected string
+		wantErr  bool
+	}{
+		{operator: "^", version: "6.3", expected: "^6.3"},
+		{operator: ">=", version: "8.1", expected: ">=8.1"},
+		{operator: "~", version: "1.2", expected: ">=1.2, <2"},
+		{operator: "~", version: "1.2.3", expected: ">=1.2.3, <1.3"},
+		{operator: "~", version: "2", expected: ">=2, <3"},
+		{operator: "=", version: "1.0.0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.operator+tt.version, func(t *testing.T) {
+			got, err := toSemverConstraint(tt.operator, tt.version)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
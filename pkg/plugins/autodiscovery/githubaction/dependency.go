@@ -0,0 +1,233 @@
+package githubaction
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerimage"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// defaultGitUsername is the username used to clone a git repository when only a token is provided
+	defaultGitUsername string = "oauth2"
+)
+
+func (g GitHubAction) discoverWorkflowManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	searchFromDir := g.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if g.spec.RootDir != "" && !path.IsAbs(g.spec.RootDir) {
+		searchFromDir = filepath.Join(g.rootDir, g.spec.RootDir)
+	}
+
+	foundFiles, err := searchWorkflowFiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+
+		logrus.Debugf("parsing file %q", foundFile)
+
+		relativeFoundFile, err := filepath.Rel(g.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		actions, err := getActions(foundFile, g.defaultURL)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		if len(actions) == 0 {
+			logrus.Debugf("no action found in %q\n", foundFile)
+			continue
+		}
+
+		// Each match pattern updates every occurrence so we only need one manifest per pattern
+		knownPatterns := map[string]bool{}
+
+		for _, a := range actions {
+
+			if len(g.spec.Ignore) > 0 {
+				if g.spec.Ignore.isMatchingRules(g.rootDir, relativeFoundFile, a.name(), a.Version) {
+					logrus.Debugf("Ignoring action %q from %q, as matching ignore rule(s)\n", a.name(), relativeFoundFile)
+					continue
+				}
+			}
+
+			if len(g.spec.Only) > 0 {
+				if !g.spec.Only.isMatchingRules(g.rootDir, relativeFoundFile, a.name(), a.Version) {
+					logrus.Debugf("Ignoring action %q from %q, as not matching only rule(s)\n", a.name(), relativeFoundFile)
+					continue
+				}
+			}
+
+			params := struct {
+				ManifestName               string
+				ActionScmID                string
+				ActionScmURL               string
+				SourceID                   string
+				SourceName                 string
+				SourceKind                 string
+				SourceTagFilter            string
+				SourceVersionFilterKind    string
+				SourceVersionFilterPattern string
+				CommitSourceID             string
+				CommitSourceName           string
+				Commit                     string
+				Image                      string
+				URL                        string
+				Owner                      string
+				Repository                 string
+				Username                   string
+				Token                      string
+				TargetID                   string
+				TargetName                 string
+				TargetMatchPattern         string
+				TargetReplacePattern       string
+				File                       string
+				ScmID                      string
+			}{
+				ManifestName:     fmt.Sprintf("Bump %q action version", a.name()),
+				ActionScmID:      "action",
+				SourceID:         "release",
+				SourceName:       fmt.Sprintf("Get latest %q action version", a.name()),
+				CommitSourceID:   "commit",
+				CommitSourceName: fmt.Sprintf("Get %q action commit for version {{ source \"release\" }}", a.name()),
+				Commit:           a.Commit,
+				TargetID:         "action",
+				TargetName:       fmt.Sprintf("Bump %q action version to {{ source \"release\" }}", a.name()),
+				File:             relativeFoundFile,
+				ScmID:            g.scmID,
+			}
+
+			switch {
+			case a.Image != "":
+				sourceSpec := dockerimage.NewDockerImageSpecFromImage(a.Image, a.Version, nil)
+				if sourceSpec == nil {
+					logrus.Debugf("Ignoring action %q from %q, tag %q not supported", a.name(), relativeFoundFile, a.Version)
+					continue
+				}
+
+				params.SourceKind = "dockerimage"
+				params.Image = a.Image
+				params.SourceTagFilter = sourceSpec.TagFilter
+				params.SourceVersionFilterKind = sourceSpec.VersionFilter.Kind
+				params.SourceVersionFilterPattern = sourceSpec.VersionFilter.Pattern
+
+				// If a versionfilter is specified in the manifest then we want to be sure that it takes precedence
+				if !g.spec.VersionFilter.IsZero() {
+					params.SourceTagFilter = ""
+					params.SourceVersionFilterKind, params.SourceVersionFilterPattern = g.getVersionFilter(a.Version)
+				}
+
+				params.TargetMatchPattern = `(uses:\s*["']?` + regexp.QuoteMeta(dockerPrefix+a.Image+":") + `)[^\s"'#@]+`
+				params.TargetReplacePattern = `${1}{{ source "release" }}`
+
+			default:
+				params.SourceVersionFilterKind, params.SourceVersionFilterPattern = g.getVersionFilter(a.Version)
+				params.URL = a.URL.String()
+				params.Owner = a.Owner
+				params.Repository = a.Repository
+
+				credential, found := g.spec.Credentials[a.URL.Host]
+				if found {
+					params.Username = credential.Username
+					params.Token = credential.Token
+				}
+
+				switch {
+				// Releases are published for full versions, and don't provide the commit associated to a tag
+				case found && credential.Token != "" && a.Commit == "" && isFullVersion(a.Version):
+					params.SourceKind = "githubrelease"
+					if credential.Kind == giteaKind {
+						params.SourceKind = "gitea/release"
+					}
+				default:
+					params.SourceKind = "gittag"
+					params.ActionScmURL = fmt.Sprintf("%s/%s/%s.git", a.URL.String(), a.Owner, a.Repository)
+					if params.Token != "" && params.Username == "" {
+						params.Username = defaultGitUsername
+					}
+				}
+
+				usesPattern := `(uses:\s*["']?` + regexp.QuoteMeta(a.Reference+"@") + `)`
+				switch a.Commit {
+				case "":
+					params.TargetMatchPattern = usesPattern + `v?[0-9]+(?:\.[0-9]+)*(["']?(?:\s|$))`
+					params.TargetReplacePattern = `${1}{{ source "release" }}${2}`
+				default:
+					params.TargetMatchPattern = usesPattern + `[0-9a-f]{40}(["']?\s*#\s*(?:tag=)?)v?[0-9]+(?:\.[0-9]+)*`
+					params.TargetReplacePattern = `${1}{{ source "commit" }}${2}{{ source "release" }}`
+				}
+			}
+
+			if knownPatterns[params.TargetMatchPattern] {
+				continue
+			}
+			knownPatterns[params.TargetMatchPattern] = true
+
+			params.TargetMatchPattern = escapeSingleQuote(params.TargetMatchPattern)
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// getVersionFilter returns the version filter used to retrieve the newest action version.
+// Actions pinned to a major, or minor, version are updated to the latest tag using the same precision,
+// otherwise the current version is converted to ">=x.y.z" or to the version filter defined in the manifest.
+func (g GitHubAction) getVersionFilter(currentVersion string) (kind, pattern string) {
+	if !isFullVersion(currentVersion) && versionRegex.MatchString(currentVersion) {
+		return version.REGEXVERSIONKIND, getPrecisionPattern(currentVersion)
+	}
+
+	kind = version.SEMVERVERSIONKIND
+	pattern = ">=" + currentVersion
+
+	if !g.spec.VersionFilter.IsZero() {
+		var err error
+		kind = g.versionFilter.Kind
+		pattern, err = g.versionFilter.GreaterThanPattern(currentVersion)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			pattern = "*"
+		}
+	}
+
+	return kind, pattern
+}
+
+// escapeSingleQuote escapes single quotes from a value used in a yaml single-quoted string
+func escapeSingleQuote(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
@@ -0,0 +1,188 @@
+package githubaction
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// defaultURL is the git server used to resolve actions referenced without hostname
+	defaultURL string = "https://github.com"
+	// githubKind identifies a GitHub, or GitHub Enterprise, git server
+	githubKind string = "github"
+	// giteaKind identifies a Gitea git server
+	giteaKind string = "gitea"
+)
+
+// Spec defines the parameters which can be provided to the GitHub action crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for GitHub workflows and actions
+	RootDir string `yaml:",omitempty"`
+	// Ignore specifies rule to ignore action update.
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only specify required rule to restrict action update.
+	Only MatchingRules `yaml:",omitempty"`
+	/*
+		`versionfilter` provides parameters to specify the version pattern to use when generating manifest.
+
+		kind - semver
+			versionfilter of kind `semver` uses semantic versioning as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a version constraint` such as `>= 1.0.0`
+
+		kind - regex
+			versionfilter of kind `regex` uses regular expression as version filtering
+			pattern accepts a valid regular expression
+
+		example:
+		```
+			versionfilter:
+				kind: semver
+				pattern: minor
+		```
+
+		remark:
+			The versionfilter only applies to actions pinned to a full version such as `actions/checkout@v4.1.1`,
+			actions pinned to a major or minor version such as `actions/checkout@v4` are updated to the latest tag with the same precision.
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	/*
+		`url` defines the git server used to resolve actions referenced without hostname such as `actions/checkout@v4`
+
+		default:
+			https://github.com
+
+		remark:
+			Set it to your GitHub Enterprise url, or to the "DEFAULT_ACTIONS_URL" setting of your Gitea instance.
+	*/
+	URL string `yaml:",omitempty"`
+	/*
+		`credentials` defines the credentials used to retrieve action versions, where the key is the git server hostname such as `github.com`
+
+		example:
+		```
+			credentials:
+				github.com:
+					token: '{{ requiredEnv "GITHUB_TOKEN" }}'
+				gitea.example.com:
+					kind: gitea
+					token: '{{ requiredEnv "GITEA_TOKEN" }}'
+		```
+
+		remark:
+			Without credentials, action versions are retrieved from the action git repository tags.
+			With credentials, action versions are retrieved from the GitHub or Gitea releases.
+	*/
+	Credentials map[string]Credential `yaml:",omitempty"`
+}
+
+// Credential defines the credentials used to interact with a git server
+type Credential struct {
+	// Kind specifies the git server kind, accepted values are "github" and "gitea", default to "github"
+	Kind string `yaml:",omitempty"`
+	// Username specifies the username used to authenticate with the git server
+	Username string `yaml:",omitempty"`
+	// Token specifies the token used to authenticate with the git server
+	Token string `yaml:",omitempty"`
+}
+
+// GitHubAction holds all information needed to generate GitHub action manifests.
+type GitHubAction struct {
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for GitHub workflows and actions
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	// defaultURL holds the git server used to resolve actions referenced without hostname
+	defaultURL *url.URL
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID string) (GitHubAction, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return GitHubAction{}, err
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return GitHubAction{}, err
+	}
+
+	for host, credential := range s.Credentials {
+		switch credential.Kind {
+		case "":
+			credential.Kind = githubKind
+			s.Credentials[host] = credential
+		case githubKind, giteaKind:
+		default:
+			return GitHubAction{}, fmt.Errorf("credential kind %q for %q not supported, accepted values are %q and %q",
+				credential.Kind, host, githubKind, giteaKind)
+		}
+	}
+
+	rawURL := s.URL
+	if rawURL == "" {
+		rawURL = defaultURL
+	}
+	if !strings.HasPrefix(rawURL, "https://") && !strings.HasPrefix(rawURL, "http://") {
+		rawURL = "https://" + rawURL
+	}
+
+	u, err := url.Parse(strings.TrimSuffix(rawURL, "/"))
+	if err != nil {
+		return GitHubAction{}, fmt.Errorf("parsing url %q: %w", s.URL, err)
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		// By default, actions are versioned using semantic versioning
+		newFilter.Kind = version.SEMVERVERSIONKIND
+		newFilter.Pattern = "*"
+	}
+
+	return GitHubAction{
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+		defaultURL:    u,
+	}, nil
+}
+
+// DiscoverManifests returns the manifests updating actions used by GitHub and Gitea workflows
+func (g GitHubAction) DiscoverManifests() ([][]byte, error) {
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle("GitHub Action"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("GitHub Action")+1))
+
+	manifests, err := g.discoverWorkflowManifests()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return manifests, nil
+}
@@ -0,0 +1,189 @@
+package githubaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "Action pinned to a commit",
+			rootDir: "testdata/github",
+			spec: Spec{
+				Only: MatchingRules{
+					{
+						Actions: map[string]string{
+							"github/codeql-action/init": "",
+						},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump "github/codeql-action/init" action version'
+scms:
+  action:
+    kind: 'git'
+    spec:
+      url: 'https://github.com/github/codeql-action.git'
+sources:
+  release:
+    name: 'Get latest "github/codeql-action/init" action version'
+    kind: 'gittag'
+    scmid: 'action'
+    spec:
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=v2.21.9'
+  commit:
+    name: 'Get "github/codeql-action/init" action commit for version {{ source "release" }}'
+    kind: 'gittag'
+    scmid: 'action'
+    spec:
+      key: 'commit'
+      versionfilter:
+        kind: 'latest'
+        pattern: '{{ source "release" }}'
+    dependson:
+      - 'release'
+targets:
+  action:
+    name: 'Bump "github/codeql-action/init" action version to {{ source "release" }}'
+    kind: 'file'
+    spec:
+      file: '.github/workflows/ci.yaml'
+      matchpattern: '(uses:\s*["'']?github/codeql-action/init@)[0-9a-f]{40}(["'']?\s*#\s*(?:tag=)?)v?[0-9]+(?:\.[0-9]+)*'
+      replacepattern: '${1}{{ source "commit" }}${2}{{ source "release" }}'
+    sourceid: 'release'
+`},
+		},
+		{
+			name:    "Gitea workflow with credentials",
+			rootDir: "testdata/gitea",
+			spec: Spec{
+				Credentials: map[string]Credential{
+					"gitea.com": {
+						Kind:  "gitea",
+						Token: "secret",
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump "https://gitea.com/actions/checkout" action version'
+sources:
+  release:
+    name: 'Get latest "https://gitea.com/actions/checkout" action version'
+    kind: 'gitea/release'
+    spec:
+      url: 'https://gitea.com'
+      owner: 'actions'
+      repository: 'checkout'
+      token: 'secret'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=v3.6.0'
+targets:
+  action:
+    name: 'Bump "https://gitea.com/actions/checkout" action version to {{ source "release" }}'
+    kind: 'file'
+    spec:
+      file: '.gitea/workflows/build.yml'
+      matchpattern: '(uses:\s*["'']?https://gitea\.com/actions/checkout@)v?[0-9]+(?:\.[0-9]+)*(["'']?(?:\s|$))'
+      replacepattern: '${1}{{ source "release" }}${2}'
+    sourceid: 'release'
+`, `name: 'Bump "actions/setup-go" action version'
+scms:
+  action:
+    kind: 'git'
+    spec:
+      url: 'https://github.com/actions/setup-go.git'
+sources:
+  release:
+    name: 'Get latest "actions/setup-go" action version'
+    kind: 'gittag'
+    scmid: 'action'
+    spec:
+      versionfilter:
+        kind: 'regex'
+        pattern: '^v\d+$'
+targets:
+  action:
+    name: 'Bump "actions/setup-go" action version to {{ source "release" }}'
+    kind: 'file'
+    spec:
+      file: '.gitea/workflows/build.yml'
+      matchpattern: '(uses:\s*["'']?actions/setup-go@)v?[0-9]+(?:\.[0-9]+)*(["'']?(?:\s|$))'
+      replacepattern: '${1}{{ source "release" }}${2}'
+    sourceid: 'release'
+`},
+		},
+		{
+			name:    "GitHub Enterprise",
+			rootDir: "testdata/github",
+			spec: Spec{
+				URL: "ghe.example.com",
+				Credentials: map[string]Credential{
+					"ghe.example.com": {
+						Token: "secret",
+					},
+				},
+				Only: MatchingRules{
+					{
+						Path: ".github/workflows/*",
+						Actions: map[string]string{
+							"actions/setup-go": ">=4",
+						},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump "actions/setup-go" action version'
+sources:
+  release:
+    name: 'Get latest "actions/setup-go" action version'
+    kind: 'githubrelease'
+    spec:
+      url: 'https://ghe.example.com'
+      owner: 'actions'
+      repository: 'setup-go'
+      token: 'secret'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=v4.1.0'
+targets:
+  action:
+    name: 'Bump "actions/setup-go" action version to {{ source "release" }}'
+    kind: 'file'
+    spec:
+      file: '.github/workflows/ci.yaml'
+      matchpattern: '(uses:\s*["'']?actions/setup-go@)v?[0-9]+(?:\.[0-9]+)*(["'']?(?:\s|$))'
+      replacepattern: '${1}{{ source "release" }}${2}'
+    sourceid: 'release'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := New(tt.spec, tt.rootDir, "")
+			require.NoError(t, err)
+
+			var pipelines []string
+			rawPipelines, err := g.DiscoverManifests()
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tt.expectedPipelines), len(rawPipelines))
+
+			for i := range rawPipelines {
+				// We expect manifest generated by the autodiscovery to use the yaml syntax
+				pipelines = append(pipelines, string(rawPipelines[i]))
+				assert.Equal(t, tt.expectedPipelines[i], pipelines[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package githubaction
+
+var (
+	// manifestTemplate is the Go template used to generate GitHub action manifests
+	manifestTemplate string = `name: '{{ .ManifestName }}'
+{{- if .ActionScmURL }}
+scms:
+  {{ .ActionScmID }}:
+    kind: 'git'
+    spec:
+      url: '{{ .ActionScmURL }}'
+{{- if .Token }}
+      username: '{{ .Username }}'
+      password: '{{ .Token }}'
+{{- end }}
+{{- end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: '{{ .SourceKind }}'
+{{- if .ActionScmURL }}
+    scmid: '{{ .ActionScmID }}'
+{{- end }}
+    spec:
+{{- if eq .SourceKind "dockerimage" }}
+      image: '{{ .Image }}'
+      tagfilter: '{{ .SourceTagFilter }}'
+{{- else if ne .SourceKind "gittag" }}
+      url: '{{ .URL }}'
+      owner: '{{ .Owner }}'
+      repository: '{{ .Repository }}'
+{{- if .Username }}
+      username: '{{ .Username }}'
+{{- end }}
+      token: '{{ .Token }}'
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+{{- if .Commit }}
+  {{ .CommitSourceID }}:
+    name: '{{ .CommitSourceName }}'
+    kind: 'gittag'
+    scmid: '{{ .ActionScmID }}'
+    spec:
+      key: 'commit'
+      versionfilter:
+        kind: 'latest'
+        pattern: '{{ "{{" }} source "{{ .SourceID }}" {{ "}}" }}'
+    dependson:
+      - '{{ .SourceID }}'
+{{- end }}
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+    kind: 'file'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      file: '{{ .File }}'
+      matchpattern: '{{ .TargetMatchPattern }}'
+      replacepattern: '{{ .TargetReplacePattern }}'
+    sourceid: '{{ .SourceID }}'
+`
+)
@@ -0,0 +1,104 @@
+package githubaction
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// MatchingRule allows to specifies rules to identify manifest
+type MatchingRule struct {
+	// Path specifies a workflow, or action, file path pattern, the pattern requires to match all of name, not just a substring.
+	Path string `yaml:",omitempty"`
+	// Actions specifies the list of actions to check such as "actions/checkout" or "docker://alpine",
+	// the value accepts a semantic versioning constraint such as ">=4"
+	Actions map[string]string `yaml:",omitempty"`
+}
+
+type MatchingRules []MatchingRule
+
+// isMatchingRules checks if a specific file content matches the "only" rule
+func (m MatchingRules) isMatchingRules(rootDir, filePath, actionName, actionVersion string) bool {
+	var ruleResults []bool
+
+	if len(m) > 0 {
+		for _, rule := range m {
+			/*
+				Check if rule.Path is matching. Path accepts wildcard path
+			*/
+
+			if rule.Path != "" {
+				if filepath.IsAbs(rule.Path) {
+					filePath = filepath.Join(rootDir, filePath)
+				}
+
+				match, err := filepath.Match(rule.Path, filePath)
+				if err != nil {
+					logrus.Errorf("%s - %q", err, rule.Path)
+					continue
+				}
+				ruleResults = append(ruleResults, match)
+				if match {
+					logrus.Debugf("file path %q matching rule %q", filePath, rule.Path)
+				}
+			}
+
+			/*
+				Checks if action is matching the policy constraint.
+			*/
+
+			if len(rule.Actions) > 0 {
+				match := false
+
+			outAction:
+				for ruleActionName, ruleActionVersion := range rule.Actions {
+
+					// GitHub owner and repository names are case insensitive
+					if strings.EqualFold(actionName, ruleActionName) {
+						if ruleActionVersion == "" {
+							match = true
+							break outAction
+						}
+
+						v, err := semver.NewVersion(actionVersion)
+						if err != nil {
+							match = actionVersion == ruleActionVersion
+							logrus.Debugf("%q - %s", actionVersion, err)
+							break outAction
+						}
+
+						c, err := semver.NewConstraint(ruleActionVersion)
+						if err != nil {
+							match = actionVersion == ruleActionVersion
+							logrus.Debugf("%q %s", err, ruleActionVersion)
+							break outAction
+						}
+
+						match = c.Check(v)
+						break outAction
+					}
+				}
+				ruleResults = append(ruleResults, match)
+			}
+
+			/*
+				If at least one rule is failing then we return false
+			*/
+			isAllMatching := true
+			for i := range ruleResults {
+				if !ruleResults[i] {
+					isAllMatching = false
+				}
+			}
+			if isAllMatching {
+				return true
+			}
+			ruleResults = []bool{}
+		}
+		return false
+	}
+
+	return false
+}
@@ -0,0 +1,94 @@
+package githubaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRule(t *testing.T) {
+
+	dataset := []struct {
+		name           string
+		rules          MatchingRules
+		filePath       string
+		actionName     string
+		actionVersion  string
+		expectedResult bool
+	}{
+		{
+			name: "Matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: ".github/workflows/*",
+				},
+			},
+			filePath:       ".github/workflows/ci.yaml",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: ".github/workflows/*",
+				},
+			},
+			filePath:       ".github/actions/setup/action.yml",
+			expectedResult: false,
+		},
+		{
+			name: "Matching action name ignoring case",
+			rules: MatchingRules{
+				MatchingRule{
+					Actions: map[string]string{
+						"Actions/Checkout": "",
+					},
+				},
+			},
+			filePath:       ".github/workflows/ci.yaml",
+			actionName:     "actions/checkout",
+			actionVersion:  "v4.1.1",
+			expectedResult: true,
+		},
+		{
+			name: "Matching action version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: ".github/workflows/*",
+					Actions: map[string]string{
+						"actions/checkout": ">=4",
+					},
+				},
+			},
+			filePath:       ".github/workflows/ci.yaml",
+			actionName:     "actions/checkout",
+			actionVersion:  "v4.1.1",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching action version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Actions: map[string]string{
+						"actions/checkout": "<4",
+					},
+				},
+			},
+			filePath:       ".github/workflows/ci.yaml",
+			actionName:     "actions/checkout",
+			actionVersion:  "v4.1.1",
+			expectedResult: false,
+		},
+	}
+
+	for _, d := range dataset {
+		t.Run(d.name, func(t *testing.T) {
+			gotResult := d.rules.isMatchingRules(
+				"",
+				d.filePath,
+				d.actionName,
+				d.actionVersion)
+			assert.Equal(t, d.expectedResult, gotResult)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package githubaction
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// dockerPrefix is the prefix of actions running a docker image such as "docker://alpine:3.18"
+	dockerPrefix string = "docker://"
+)
+
+var (
+	// workflowDirs lists the directories containing workflow files
+	workflowDirs = []string{
+		filepath.Join(".github", "workflows"),
+		filepath.Join(".gitea", "workflows"),
+	}
+	// actionFiles lists the filenames of composite actions
+	actionFiles = []string{"action.yml", "action.yaml"}
+
+	// usesRegex matches a "uses" step, or job, parameter followed by an optional comment
+	usesRegex = regexp.MustCompile(`^\s*(?:-\s+)?uses:\s*["']?([^"'\s#]+)["']?\s*(?:#\s*(.*?))?\s*$`)
+	// commitRegex matches a full length git commit hash
+	commitRegex = regexp.MustCompile(`^[0-9a-f]{40}$`)
+	// versionRegex matches tags which can be updated such as "v4", "v4.1", or "v4.1.1"
+	versionRegex = regexp.MustCompile(`^v?[0-9]+(?:\.[0-9]+){0,2}$`)
+	// commentVersionRegex matches the version set in a comment next to a commit hash such as "# v4.1.1" or "# tag=v4.1.1"
+	commentVersionRegex = regexp.MustCompile(`^(?:tag=)?(v?[0-9]+(?:\.[0-9]+){0,2})\b`)
+)
+
+// action represents an action referenced from a workflow or a composite action
+type action struct {
+	// Reference holds the action reference without its version such as "actions/checkout"
+	Reference string
+	// URL holds the git server url such as "https://github.com"
+	URL *url.URL
+	// Owner holds the action repository owner
+	Owner string
+	// Repository holds the action repository name
+	Repository string
+	// Version holds the action version, retrieved from the comment for actions pinned to a commit
+	Version string
+	// Commit holds the commit hash used to pin the action
+	Commit string
+	// Image holds the docker image name, for actions running a docker image
+	Image string
+}
+
+// name returns the action name, used by matching rules, such as "actions/checkout" or "docker://alpine"
+func (a action) name() string {
+	if a.Image != "" {
+		return dockerPrefix + a.Image
+	}
+	return a.Reference
+}
+
+// searchWorkflowFiles looks, recursively, for GitHub or Gitea workflows and composite actions from a root directory.
+func searchWorkflowFiles(rootDir string) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for workflow files in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		if d.IsDir() && (d.Name() == "node_modules" || d.Name() == ".git") {
+			return filepath.SkipDir
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		for _, actionFile := range actionFiles {
+			if d.Name() == actionFile {
+				foundFiles = append(foundFiles, path)
+				return nil
+			}
+		}
+
+		ext := filepath.Ext(d.Name())
+		if ext != ".yml" && ext != ".yaml" {
+			return nil
+		}
+
+		for _, workflowDir := range workflowDirs {
+			if strings.HasSuffix(filepath.Dir(path), workflowDir) {
+				foundFiles = append(foundFiles, path)
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return foundFiles, nil
+}
+
+// getActions returns the actions referenced by a workflow or a composite action file
+func getActions(filename string, defaultURL *url.URL) ([]action, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var actions []action
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		m := usesRegex.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+
+		a, err := parseAction(m[1], m[2], defaultURL)
+		if err != nil {
+			logrus.Debugf("skipping action %q from %q: %s", m[1], filename, err)
+			continue
+		}
+
+		actions = append(actions, a)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+// parseAction parses a "uses" value, and its comment, such as "actions/checkout@v4",
+// "https://gitea.com/actions/checkout@v4", or "docker://alpine:3.18"
+func parseAction(uses, comment string, defaultURL *url.URL) (action, error) {
+
+	if strings.HasPrefix(uses, "./") || strings.HasPrefix(uses, "../") {
+		return action{}, fmt.Errorf("local action not supported")
+	}
+
+	if strings.HasPrefix(uses, dockerPrefix) {
+		image, tag, found := strings.Cut(strings.TrimPrefix(uses, dockerPrefix), ":")
+		if !found || strings.Contains(tag, "@") || strings.Contains(image, "@") {
+			return action{}, fmt.Errorf("docker image without tag, or with digest, not supported")
+		}
+		return action{Image: image, Version: tag}, nil
+	}
+
+	reference, ref, found := strings.Cut(uses, "@")
+	if !found || ref == "" {
+		return action{}, fmt.Errorf("no version specified")
+	}
+
+	a := action{
+		Reference: reference,
+		URL:       defaultURL,
+	}
+
+	actionPath := reference
+	if strings.HasPrefix(reference, "https://") || strings.HasPrefix(reference, "http://") {
+		u, err := url.Parse(reference)
+		if err != nil {
+			return action{}, err
+		}
+		a.URL = &url.URL{Scheme: u.Scheme, Host: u.Host}
+		actionPath = strings.TrimPrefix(u.Path, "/")
+	}
+
+	// The action may be located in a subdirectory of the repository such as "github/codeql-action/init"
+	segments := strings.Split(actionPath, "/")
+	if len(segments) < 2 || segments[0] == "" || segments[1] == "" {
+		return action{}, fmt.Errorf("wrong action reference %q", reference)
+	}
+	a.Owner = segments[0]
+	a.Repository = segments[1]
+
+	switch {
+	case commitRegex.MatchString(ref):
+		m := commentVersionRegex.FindStringSubmatch(comment)
+		if m == nil {
+			return action{}, fmt.Errorf("action pinned to a commit without version comment")
+		}
+		a.Commit = ref
+		a.Version = m[1]
+	case versionRegex.MatchString(ref):
+		a.Version = ref
+	default:
+		return action{}, fmt.Errorf("version %q not supported", ref)
+	}
+
+	return a, nil
+}
+
+// isFullVersion returns true if the version specifies the major, minor, and patch such as "v4.1.1"
+func isFullVersion(version string) bool {
+	return strings.Count(version, ".") == 2
+}
+
+// getPrecisionPattern returns the regular expression matching versions with the same precision,
+// and prefix, such as "^v\d+$" for "v4"
+func getPrecisionPattern(version string) string {
+	pattern := `^`
+	if strings.HasPrefix(version, "v") {
+		pattern += `v`
+	}
+	pattern += `\d+`
+	pattern += strings.Repeat(`\.\d+`, strings.Count(version, "."))
+	return pattern + `$`
+}
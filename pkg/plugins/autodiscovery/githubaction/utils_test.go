@@ -0,0 +1,120 @@
+package githubaction
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchWorkflowFiles(t *testing.T) {
+	got, err := searchWorkflowFiles("testdata")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"testdata/gitea/.gitea/workflows/build.yml",
+		"testdata/github/.github/actions/setup/action.yml",
+		"testdata/github/.github/workflows/ci.yaml",
+	}, got)
+}
+
+func TestParseAction(t *testing.T) {
+	defaultURL := &url.URL{Scheme: "https", Host: "github.com"}
+
+	tests := []struct {
+		uses     string
+		comment  string
+		expected action
+		wantErr  bool
+	}{
+		{
+			uses: "actions/checkout@v4",
+			expected: action{
+				Reference:  "actions/checkout",
+				URL:        defaultURL,
+				Owner:      "actions",
+				Repository: "checkout",
+				Version:    "v4",
+			},
+		},
+		{
+			uses:    "github/codeql-action/init@8aff97f12c99086bdb92ff62ae06dbbcdf07941b",
+			comment: "v2.21.9",
+			expected: action{
+				Reference:  "github/codeql-action/init",
+				URL:        defaultURL,
+				Owner:      "github",
+				Repository: "codeql-action",
+				Version:    "v2.21.9",
+				Commit:     "8aff97f12c99086bdb92ff62ae06dbbcdf07941b",
+			},
+		},
+		{
+			uses:    "actions/setup-go@93397bea11091df50f3d7e59dc26a7711a8bcfbe",
+			comment: "tag=v4.1.0",
+			expected: action{
+				Reference:  "actions/setup-go",
+				URL:        defaultURL,
+				Owner:      "actions",
+				Repository: "setup-go",
+				Version:    "v4.1.0",
+				Commit:     "93397bea11091df50f3d7e59dc26a7711a8bcfbe",
+			},
+		},
+		{
+			uses: "https://gitea.com/actions/checkout@v3.6.0",
+			expected: action{
+				Reference:  "https://gitea.com/actions/checkout",
+				URL:        &url.URL{Scheme: "https", Host: "gitea.com"},
+				Owner:      "actions",
+				Repository: "checkout",
+				Version:    "v3.6.0",
+			},
+		},
+		{
+			uses: "docker://alpine:3.18",
+			expected: action{
+				Image:   "alpine",
+				Version: "3.18",
+			},
+		},
+		{
+			uses:    "actions/setup-go@93397bea11091df50f3d7e59dc26a7711a8bcfbe",
+			wantErr: true,
+		},
+		{uses: "actions/cache@main", wantErr: true},
+		{uses: "./.github/actions/setup", wantErr: true},
+		{uses: "docker://alpine", wantErr: true},
+		{uses: "actions@v1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uses, func(t *testing.T) {
+			got, err := parseAction(tt.uses, tt.comment, defaultURL)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestGetPrecisionPattern(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{version: "v4", expected: `^v\d+$`},
+		{version: "v4.1", expected: `^v\d+\.\d+$`},
+		{version: "4.1.0", expected: `^\d+\.\d+\.\d+$`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getPrecisionPattern(tt.version))
+		})
+	}
+}
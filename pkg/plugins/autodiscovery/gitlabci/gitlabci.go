@@ -0,0 +1,270 @@
+package gitlabci
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerimage"
+)
+
+func (g GitLabCI) discoverGitLabCIManifests() ([][]byte, error) {
+	var manifests [][]byte
+
+	searchFromDir := g.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if g.spec.RootDir != "" && !path.IsAbs(g.spec.RootDir) {
+		searchFromDir = filepath.Join(g.rootDir, g.spec.RootDir)
+	}
+
+	foundFiles, err := searchGitLabCIFiles(searchFromDir, g.files)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+		logrus.Debugf("parsing file %q", foundFile)
+
+		relativeFoundFile, err := filepath.Rel(g.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		images, includes, err := getGitLabCIData(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		for _, image := range images {
+			manifest, err := g.generateImageManifest(image, relativeFoundFile)
+			if err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			if manifest == nil {
+				continue
+			}
+
+			manifests = append(manifests, manifest)
+		}
+
+		for _, i := range includes {
+			manifest, err := g.generateIncludeManifest(i, relativeFoundFile)
+			if err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			if manifest == nil {
+				continue
+			}
+
+			manifests = append(manifests, manifest)
+		}
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+func (g GitLabCI) generateImageManifest(image containerImage, relativeFoundFile string) ([]byte, error) {
+	var err error
+
+	// Images defined using CI/CD variables such as "$CI_REGISTRY_IMAGE:latest" can't be resolved
+	if strings.Contains(image.Image, "$") {
+		return nil, fmt.Errorf("ignoring image %q because it's using a variable", image.Image)
+	}
+
+	imageName, imageTag, imageDigest, err := dockerimage.ParseOCIReferenceInfo(image.Image)
+	if err != nil {
+		return nil, fmt.Errorf("parsing image %q: %s", image.Image, err)
+	}
+
+	if imageDigest != "" && imageTag == "" {
+		return nil, fmt.Errorf("ignoring image %q because it has a digest but we can't identify the tag", image.Image)
+	}
+
+	if len(g.spec.Ignore) > 0 {
+		if g.spec.Ignore.isMatchingRules(g.rootDir, relativeFoundFile, image.Job, imageName, "") {
+			logrus.Debugf("Ignoring container image %q from %q, as matching ignore rule(s)\n", imageName, relativeFoundFile)
+			return nil, nil
+		}
+	}
+
+	if len(g.spec.Only) > 0 {
+		if !g.spec.Only.isMatchingRules(g.rootDir, relativeFoundFile, image.Job, imageName, "") {
+			logrus.Debugf("Ignoring container image %q from %q, as not matching only rule(s)\n", imageName, relativeFoundFile)
+			return nil, nil
+		}
+	}
+
+	sourceSpec := dockerimage.NewDockerImageSpecFromImage(imageName, imageTag, g.spec.Auths)
+	if sourceSpec == nil && !g.digest {
+		logrus.Infoln("No source spec detected")
+		return nil, nil
+	}
+
+	versionFilterKind := g.versionFilter.Kind
+	versionFilterPattern := g.versionFilter.Pattern
+	tagFilter := "*"
+
+	if sourceSpec != nil {
+		versionFilterKind = sourceSpec.VersionFilter.Kind
+		versionFilterPattern = sourceSpec.VersionFilter.Pattern
+		tagFilter = sourceSpec.TagFilter
+	}
+
+	// If a versionfilter is specified in the manifest then we want to be sure that it takes precedence
+	if !g.spec.VersionFilter.IsZero() {
+		versionFilterKind = g.versionFilter.Kind
+		versionFilterPattern, err = g.versionFilter.GreaterThanPattern(imageTag)
+		if err != nil {
+			versionFilterPattern = "*"
+			logrus.Debugf("building version filter pattern: %s", err)
+		}
+	}
+
+	var tmpl *template.Template
+	switch {
+	case g.digest && sourceSpec != nil:
+		tmpl, err = template.New("manifest").Parse(manifestTemplateDigestAndLatest)
+	case g.digest && sourceSpec == nil:
+		tmpl, err = template.New("manifest").Parse(manifestTemplateDigest)
+	default:
+		tmpl, err = template.New("manifest").Parse(manifestTemplateLatest)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	manifestName := fmt.Sprintf("deps(gitlabci): bump container image %q", imageName)
+	if image.Job != "" {
+		manifestName = fmt.Sprintf("%s for job %q", manifestName, image.Job)
+	}
+
+	params := struct {
+		ManifestName         string
+		ImageName            string
+		ImageTag             string
+		SourceID             string
+		SourceTagFilter      string
+		VersionFilterKind    string
+		VersionFilterPattern string
+		TargetID             string
+		TargetKey            string
+		TargetPrefix         string
+		TargetFile           string
+		ScmID                string
+	}{
+		ManifestName:         escapeSingleQuote(manifestName),
+		ImageName:            imageName,
+		ImageTag:             imageTag,
+		SourceID:             "image",
+		SourceTagFilter:      tagFilter,
+		TargetID:             "image",
+		TargetPrefix:         imageName + ":",
+		TargetKey:            escapeSingleQuote(image.Key),
+		TargetFile:           relativeFoundFile,
+		VersionFilterKind:    versionFilterKind,
+		VersionFilterPattern: versionFilterPattern,
+		ScmID:                g.scmID,
+	}
+
+	manifest := bytes.Buffer{}
+	if err := tmpl.Execute(&manifest, params); err != nil {
+		return nil, err
+	}
+
+	return manifest.Bytes(), nil
+}
+
+func (g GitLabCI) generateIncludeManifest(i include, relativeFoundFile string) ([]byte, error) {
+
+	owner, repository, err := splitProject(i.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(g.spec.Ignore) > 0 {
+		if g.spec.Ignore.isMatchingRules(g.rootDir, relativeFoundFile, "", "", i.Project) {
+			logrus.Debugf("Ignoring include %q from %q, as matching ignore rule(s)\n", i.Project, relativeFoundFile)
+			return nil, nil
+		}
+	}
+
+	if len(g.spec.Only) > 0 {
+		if !g.spec.Only.isMatchingRules(g.rootDir, relativeFoundFile, "", "", i.Project) {
+			logrus.Debugf("Ignoring include %q from %q, as not matching only rule(s)\n", i.Project, relativeFoundFile)
+			return nil, nil
+		}
+	}
+
+	versionFilterKind := g.versionFilter.Kind
+	versionFilterPattern := ">=" + i.Ref
+
+	// If a versionfilter is specified in the manifest then we want to be sure that it takes precedence
+	if !g.spec.VersionFilter.IsZero() {
+		versionFilterPattern, err = g.versionFilter.GreaterThanPattern(i.Ref)
+		if err != nil {
+			versionFilterPattern = "*"
+			logrus.Debugf("building version filter pattern: %s", err)
+		}
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplateInclude)
+	if err != nil {
+		return nil, err
+	}
+
+	params := struct {
+		ManifestName         string
+		Project              string
+		URL                  string
+		Token                string
+		Owner                string
+		Repository           string
+		SourceID             string
+		VersionFilterKind    string
+		VersionFilterPattern string
+		TargetID             string
+		TargetKey            string
+		TargetFile           string
+		ScmID                string
+	}{
+		ManifestName:         fmt.Sprintf("deps(gitlabci): bump include %q ref", i.Project),
+		Project:              i.Project,
+		URL:                  g.spec.URL,
+		Token:                g.spec.Token,
+		Owner:                owner,
+		Repository:           repository,
+		SourceID:             "include",
+		VersionFilterKind:    versionFilterKind,
+		VersionFilterPattern: versionFilterPattern,
+		TargetID:             "include",
+		TargetKey:            i.Key,
+		TargetFile:           relativeFoundFile,
+		ScmID:                g.scmID,
+	}
+
+	manifest := bytes.Buffer{}
+	if err := tmpl.Execute(&manifest, params); err != nil {
+		return nil, err
+	}
+
+	return manifest.Bytes(), nil
+}
+
+// escapeSingleQuote escapes single quotes from a value used in a yaml single-quoted string
+func escapeSingleQuote(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
@@ -0,0 +1,157 @@
+package gitlabci
+
+import (
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/docker"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+var (
+	// DefaultGitLabCIFiles specifies accepted GitLab CI files
+	DefaultGitLabCIFiles []string = []string{".gitlab-ci.yml", ".gitlab-ci.yaml"}
+)
+
+// Spec defines the parameters which can be provided to the GitLab CI crawler.
+type Spec struct {
+	// Auths provides a map of registry credentials where the key is the registry URL without scheme
+	Auths map[string]docker.InlineKeyChain `yaml:",omitempty"`
+	/*
+		digest provides parameters to specify if the generated manifest should use a digest on top of the tag.
+	*/
+	Digest *bool `yaml:",omitempty"`
+	/*
+		files allows to specify a list of GitLab CI files pattern to analyze.
+
+		default:
+			* .gitlab-ci.yml
+			* .gitlab-ci.yaml
+
+		remark:
+			The pattern syntax is the one used by Go filepath.Match and is matched against the file name.
+	*/
+	Files []string `yaml:",omitempty"`
+	// RootDir defines the root directory used to recursively search for GitLab CI files
+	RootDir string `yaml:",omitempty"`
+	// Ignore allows to specify rule to ignore autodiscovery a specific GitLab CI image or include based on a rule
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only allows to specify rule to only autodiscover manifest for a specific GitLab CI image or include based on a rule
+	Only MatchingRules `yaml:",omitempty"`
+	/*
+		url defines the GitLab url used to retrieve tags of projects referenced by "include" keywords.
+
+		default:
+			"gitlab.com"
+	*/
+	URL string `yaml:",omitempty"`
+	/*
+		token defines the credential used to retrieve tags of private projects referenced by "include" keywords.
+
+		remark:
+			A token is a sensitive information, it's recommended to not set this value directly in the configuration file
+			but to use an environment variable or a SOPS file.
+	*/
+	Token string `yaml:",omitempty"`
+	/*
+		versionfilter provides parameters to specify the version pattern used when generating manifest.
+
+		kind - semver
+			versionfilter of kind `semver` uses semantic versioning as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a version constraint` such as `>= 1.0.0`
+
+		kind - regex
+			versionfilter of kind `regex` uses regular expression as version filtering
+			pattern accepts a valid regular expression
+
+		example:
+		```
+			versionfilter:
+				kind: semver
+				pattern: minor
+		```
+
+		and its type like regex, semver, or just latest.
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+// GitLabCI holds all information needed to generate GitLab CI manifests.
+type GitLabCI struct {
+	// digest holds the value of the digest parameter
+	digest bool
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// files holds the list of files to analyze
+	files []string
+	// rootDir defines the root directory from where looking for GitLab CI files
+	rootDir string
+	// scmID hold the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid GitLabCI object.
+func New(spec interface{}, rootDir, scmID string) (GitLabCI, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return GitLabCI{}, err
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	// If no RootDir have been provided via settings,
+	// then fallback to the current process path.
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return GitLabCI{}, err
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		newFilter.Kind = version.SEMVERVERSIONKIND
+		newFilter.Pattern = "*"
+	}
+
+	digest := true
+	if s.Digest != nil {
+		digest = *s.Digest
+	}
+
+	files := DefaultGitLabCIFiles
+	if len(s.Files) > 0 {
+		files = s.Files
+	}
+
+	return GitLabCI{
+		digest:        digest,
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		files:         files,
+		versionFilter: newFilter,
+	}, nil
+}
+
+func (g GitLabCI) DiscoverManifests() ([][]byte, error) {
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle("GitLab CI"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("GitLab CI")+1))
+
+	return g.discoverGitLabCIManifests()
+}
@@ -0,0 +1,177 @@
+package gitlabci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	disabled := false
+
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "Job image",
+			rootDir: "testdata/success",
+			spec: Spec{
+				Digest: &disabled,
+				Only: MatchingRules{
+					{
+						Path:   ".gitlab-ci.yml",
+						Images: []string{"golang"},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'deps(gitlabci): bump container image "golang" for job "build"'
+sources:
+  image:
+    name: 'get latest container image tag for "golang"'
+    kind: 'dockerimage'
+    spec:
+      image: 'golang'
+      tagfilter: '^\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=1.21.3'
+targets:
+  image:
+    name: 'deps(gitlabci): bump container image "golang" to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: '.gitlab-ci.yml'
+      key: '$.build.image.name'
+    sourceid: 'image'
+    transformers:
+      - addprefix: 'golang:'
+`},
+		},
+		{
+			name:    "Job service with digest",
+			rootDir: "testdata/success",
+			spec: Spec{
+				Only: MatchingRules{
+					{
+						Jobs: []string{"unit test"},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'deps(gitlabci): bump container image "postgres" for job "unit test"'
+sources:
+  image:
+    name: 'get latest container image tag for "postgres"'
+    kind: 'dockerimage'
+    spec:
+      image: 'postgres'
+      tagfilter: '^\d*(\.\d*){1}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=15.4'
+  image-digest:
+    name: 'get latest container image digest for "postgres:15.4"'
+    kind: 'dockerdigest'
+    spec:
+      image: 'postgres'
+      tag: '{{ source "image" }}'
+    dependson:
+      - 'image'
+targets:
+  image:
+    name: 'deps(gitlabci): bump container image digest for "postgres:15.4"'
+    kind: 'yaml'
+    spec:
+      file: '.gitlab-ci.yml'
+      key: '$.''unit test''.services[0].name'
+    sourceid: 'image-digest'
+    transformers:
+      - addprefix: 'postgres:'
+`},
+		},
+		{
+			name:    "Project includes",
+			rootDir: "testdata/success",
+			spec: Spec{
+				URL:   "gitlab.example.com",
+				Token: "secret",
+				Ignore: MatchingRules{
+					{
+						Images: []string{"node", "docker", "golang", "postgres", "python"},
+					},
+				},
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+			},
+			expectedPipelines: []string{`name: 'deps(gitlabci): bump include "my-group/ci-templates" ref'
+sources:
+  include:
+    name: 'get latest tag for GitLab project "my-group/ci-templates"'
+    kind: 'gitlab/tag'
+    spec:
+      url: 'gitlab.example.com'
+      token: 'secret'
+      owner: 'my-group'
+      repository: 'ci-templates'
+      versionfilter:
+        kind: 'semver'
+        pattern: '1.x'
+targets:
+  include:
+    name: 'deps(gitlabci): bump include "my-group/ci-templates" ref to {{ source "include" }}'
+    kind: 'yaml'
+    spec:
+      file: '.gitlab-ci.yml'
+      key: '$.include[1].ref'
+    sourceid: 'include'
+`, `name: 'deps(gitlabci): bump include "my-group/my-subgroup/security" ref'
+sources:
+  include:
+    name: 'get latest tag for GitLab project "my-group/my-subgroup/security"'
+    kind: 'gitlab/tag'
+    spec:
+      url: 'gitlab.example.com'
+      token: 'secret'
+      owner: 'my-group/my-subgroup'
+      repository: 'security'
+      versionfilter:
+        kind: 'semver'
+        pattern: '2.x'
+targets:
+  include:
+    name: 'deps(gitlabci): bump include "my-group/my-subgroup/security" ref to {{ source "include" }}'
+    kind: 'yaml'
+    spec:
+      file: 'templates/.gitlab-ci.yaml'
+      key: '$.include.ref'
+    sourceid: 'include'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := New(tt.spec, tt.rootDir, "")
+			require.NoError(t, err)
+
+			var pipelines []string
+			rawPipelines, err := g.DiscoverManifests()
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tt.expectedPipelines), len(rawPipelines))
+
+			for i := range rawPipelines {
+				// We expect manifest generated by the autodiscovery to use the yaml syntax
+				pipelines = append(pipelines, string(rawPipelines[i]))
+				assert.Equal(t, tt.expectedPipelines[i], pipelines[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package gitlabci
+
+const (
+	// manifestTemplateLatest is the Go template used to generate GitLab CI manifests updating a container image tag
+	manifestTemplateLatest string = `name: '{{ .ManifestName }}'
+sources:
+  {{ .SourceID }}:
+    name: 'get latest container image tag for "{{ .ImageName }}"'
+    kind: 'dockerimage'
+    spec:
+      image: '{{ .ImageName }}'
+      tagfilter: '{{ .SourceTagFilter }}'
+      versionfilter:
+        kind: '{{ .VersionFilterKind }}'
+        pattern: '{{ .VersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: 'deps(gitlabci): bump container image "{{ .ImageName }}" to {{ "{{" }} source "{{ .SourceID }}" {{ "}}" }}'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .TargetFile }}'
+      key: '{{ .TargetKey }}'
+    sourceid: '{{ .SourceID }}'
+    transformers:
+      - addprefix: '{{ .TargetPrefix }}'
+`
+	// manifestTemplateDigestAndLatest is the Go template used to generate GitLab CI manifests updating a container image tag and digest
+	manifestTemplateDigestAndLatest string = `name: '{{ .ManifestName }}'
+sources:
+  {{ .SourceID }}:
+    name: 'get latest container image tag for "{{ .ImageName }}"'
+    kind: 'dockerimage'
+    spec:
+      image: '{{ .ImageName }}'
+      tagfilter: '{{ .SourceTagFilter }}'
+      versionfilter:
+        kind: '{{ .VersionFilterKind }}'
+        pattern: '{{ .VersionFilterPattern }}'
+  {{ .SourceID }}-digest:
+    name: 'get latest container image digest for "{{ .ImageName }}:{{ .ImageTag }}"'
+    kind: 'dockerdigest'
+    spec:
+      image: '{{ .ImageName }}'
+      tag: '{{ "{{" }} source "{{ .SourceID }}" {{ "}}" }}'
+    dependson:
+      - '{{ .SourceID }}'
+targets:
+  {{ .TargetID }}:
+    name: 'deps(gitlabci): bump container image digest for "{{ .ImageName }}:{{ .ImageTag }}"'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .TargetFile }}'
+      key: '{{ .TargetKey }}'
+    sourceid: '{{ .SourceID }}-digest'
+    transformers:
+      - addprefix: '{{ .TargetPrefix }}'
+`
+	// manifestTemplateDigest is the Go template used to generate GitLab CI manifests pinning a container image digest
+	manifestTemplateDigest string = `name: '{{ .ManifestName }}'
+sources:
+  {{ .SourceID }}-digest:
+    name: 'get latest container image digest for "{{ .ImageName }}:{{ .ImageTag }}"'
+    kind: 'dockerdigest'
+    spec:
+      image: '{{ .ImageName }}'
+      tag: '{{ .ImageTag }}'
+targets:
+  {{ .TargetID }}:
+    name: 'deps(gitlabci): bump container image digest for "{{ .ImageName }}:{{ .ImageTag }}"'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .TargetFile }}'
+      key: '{{ .TargetKey }}'
+    sourceid: '{{ .SourceID }}-digest'
+    transformers:
+      - addprefix: '{{ .TargetPrefix }}'
+`
+	// manifestTemplateInclude is the Go template used to generate GitLab CI manifests updating an include ref
+	manifestTemplateInclude string = `name: '{{ .ManifestName }}'
+sources:
+  {{ .SourceID }}:
+    name: 'get latest tag for GitLab project "{{ .Project }}"'
+    kind: 'gitlab/tag'
+    spec:
+{{- if .URL }}
+      url: '{{ .URL }}'
+{{- end }}
+{{- if .Token }}
+      token: '{{ .Token }}'
+{{- end }}
+      owner: '{{ .Owner }}'
+      repository: '{{ .Repository }}'
+      versionfilter:
+        kind: '{{ .VersionFilterKind }}'
+        pattern: '{{ .VersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: 'deps(gitlabci): bump include "{{ .Project }}" ref to {{ "{{" }} source "{{ .SourceID }}" {{ "}}" }}'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .TargetFile }}'
+      key: '{{ .TargetKey }}'
+    sourceid: '{{ .SourceID }}'
+`
+)
@@ -0,0 +1,106 @@
+package gitlabci
+
+import (
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+)
+
+// MatchingRule allows to specifies rules to identify manifest
+type MatchingRule struct {
+	// Path specifies a GitLab CI file path pattern, the pattern requires to match all of name, not just a subpart of the path.
+	Path string
+	// Jobs specifies the list of GitLab CI jobs to check, "default" matches the default job settings
+	Jobs []string
+	// Images specifies the list of container images, without tag, to check
+	Images []string
+	// Projects specifies the list of included GitLab projects to check
+	Projects []string
+}
+
+type MatchingRules []MatchingRule
+
+// isMatchingRules checks if a specific GitLab CI image, or include, matches the rules
+func (m MatchingRules) isMatchingRules(rootDir, filePath, job, image, project string) bool {
+	var ruleResults []bool
+
+	if len(m) > 0 {
+		for _, rule := range m {
+			/*
+				Check if rule.Path is matching. Path accepts wildcard path
+			*/
+
+			if rule.Path != "" {
+				if filepath.IsAbs(rule.Path) {
+					filePath = filepath.Join(rootDir, filePath)
+				}
+
+				match, err := filepath.Match(rule.Path, filePath)
+				if err != nil {
+					logrus.Errorf("%s - %q", err, rule.Path)
+					continue
+				}
+				ruleResults = append(ruleResults, match)
+				if match {
+					logrus.Debugf("file path %q matching rule %q", filePath, rule.Path)
+				}
+			}
+
+			/*
+				Checks if the job is matching the policy constraint.
+			*/
+
+			if len(rule.Jobs) > 0 {
+				ruleResults = append(ruleResults, containsString(rule.Jobs, job))
+			}
+
+			/*
+				Checks if the container image is matching the policy constraint.
+			*/
+
+			if len(rule.Images) > 0 {
+				ruleResults = append(ruleResults, containsString(rule.Images, image))
+			}
+
+			/*
+				Checks if the included project is matching the policy constraint.
+			*/
+
+			if len(rule.Projects) > 0 {
+				ruleResults = append(ruleResults, containsString(rule.Projects, project))
+			}
+
+			/*
+				If at least one rule is failing then we return false
+			*/
+			isAllMatching := true
+			for i := range ruleResults {
+				if !ruleResults[i] {
+					isAllMatching = false
+				}
+			}
+			if isAllMatching {
+				return true
+			}
+			ruleResults = []bool{}
+		}
+		return false
+	}
+
+	return false
+}
+
+// containsString returns true if value is a non empty element of list
+func containsString(list []string, value string) bool {
+	if value == "" {
+		return false
+	}
+
+	for i := range list {
+		if list[i] == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+package gitlabci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRule(t *testing.T) {
+
+	dataset := []struct {
+		rules          MatchingRules
+		name           string
+		filePath       string
+		job            string
+		image          string
+		project        string
+		rootDir        string
+		expectedResult bool
+	}{
+		{
+			name: "Matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: ".gitlab-ci.yml",
+				},
+			},
+			filePath:       ".gitlab-ci.yml",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: ".gitlab-ci.yml",
+				},
+			},
+			filePath:       "templates/.gitlab-ci.yml",
+			expectedResult: false,
+		},
+		{
+			name: "Matching job and image",
+			rules: MatchingRules{
+				MatchingRule{
+					Jobs:   []string{"build"},
+					Images: []string{"golang"},
+				},
+			},
+			filePath:       ".gitlab-ci.yml",
+			job:            "build",
+			image:          "golang",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching job",
+			rules: MatchingRules{
+				MatchingRule{
+					Jobs:   []string{"test"},
+					Images: []string{"golang"},
+				},
+			},
+			filePath:       ".gitlab-ci.yml",
+			job:            "build",
+			image:          "golang",
+			expectedResult: false,
+		},
+		{
+			name: "Image rule not matching include",
+			rules: MatchingRules{
+				MatchingRule{
+					Images: []string{"golang"},
+				},
+			},
+			filePath:       ".gitlab-ci.yml",
+			project:        "my-group/ci-templates",
+			expectedResult: false,
+		},
+		{
+			name: "Matching project",
+			rules: MatchingRules{
+				MatchingRule{
+					Projects: []string{"my-group/ci-templates"},
+				},
+			},
+			filePath:       ".gitlab-ci.yml",
+			project:        "my-group/ci-templates",
+			expectedResult: true,
+		},
+	}
+
+	for _, d := range dataset {
+		t.Run(d.name, func(t *testing.T) {
+			gotResult := d.rules.isMatchingRules(
+				d.rootDir,
+				d.filePath,
+				d.job,
+				d.image,
+				d.project)
+
+			assert.Equal(t, d.expectedResult, gotResult)
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package gitlabci
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// reservedKeywords lists the GitLab CI global keywords which can't be used as job name
+	reservedKeywords = []string{
+		"after_script",
+		"before_script",
+		"cache",
+		"default",
+		"image",
+		"include",
+		"services",
+		"spec",
+		"stages",
+		"types",
+		"variables",
+		"workflow",
+	}
+
+	// plainKeyRegex matches keys which can be used in a yaml path without quotes
+	plainKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	// refRegex matches include refs which can be updated such as "v1.2.3" or "1.2.3"
+	refRegex = regexp.MustCompile(`^v?[0-9]+\.[0-9]+\.[0-9]+$`)
+)
+
+// containerImage represents a container image referenced by a GitLab CI file
+type containerImage struct {
+	// Job holds the name of the job using the image, it's empty for global images
+	Job string
+	// Image holds the full image reference such as "node:20"
+	Image string
+	// Key holds the yaml path of the image reference
+	Key string
+}
+
+// include represents a GitLab project file included from a GitLab CI file
+type include struct {
+	// Project holds the full path of the included project such as "my-group/my-project"
+	Project string
+	// Ref holds the included project ref
+	Ref string
+	// Key holds the yaml path of the ref
+	Key string
+}
+
+// searchGitLabCIFiles will look, recursively, for every files matching one of the GitLab CI file patterns from a root directory.
+func searchGitLabCIFiles(rootDir string, files []string) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for GitLab CI file(s) in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		if d.IsDir() && (d.Name() == ".git" || d.Name() == "node_modules") {
+			return filepath.SkipDir
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		for _, f := range files {
+			match, err := filepath.Match(f, d.Name())
+			if err != nil {
+				logrus.Debugf("%s - %q", err, f)
+				continue
+			}
+
+			if match {
+				foundFiles = append(foundFiles, path)
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debugf("%d GitLab CI file(s) found", len(foundFiles))
+	for _, foundFile := range foundFiles {
+		logrus.Debugf("    * %q", foundFile)
+	}
+
+	return foundFiles, nil
+}
+
+// getGitLabCIData reads a GitLab CI file for container images and includes that could be automatically updated.
+func getGitLabCIData(filename string) (images []containerImage, includes []include, err error) {
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, nil, err
+	}
+
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return nil, nil, nil
+	}
+
+	root := document.Content[0]
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		name := root.Content[i].Value
+		value := root.Content[i+1]
+
+		switch name {
+		case "image":
+			images = append(images, getImages("", value, "$.image")...)
+		case "services":
+			images = append(images, getServices("", value, "$.services")...)
+		case "include":
+			includes = append(includes, getIncludes(value)...)
+		case "default":
+			images = append(images, getJobImages(name, value, "$.default")...)
+		default:
+			if isReservedKeyword(name) || value.Kind != yaml.MappingNode {
+				continue
+			}
+
+			key, err := getYamlPathKey(name)
+			if err != nil {
+				logrus.Debugf("skipping job %q from %q: %s", name, filename, err)
+				continue
+			}
+
+			images = append(images, getJobImages(name, value, "$."+key)...)
+		}
+	}
+
+	return images, includes, nil
+}
+
+// getJobImages returns the container images defined by the "image" and "services" keywords of a job
+func getJobImages(job string, node *yaml.Node, key string) []containerImage {
+	var images []containerImage
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		switch node.Content[i].Value {
+		case "image":
+			images = append(images, getImages(job, node.Content[i+1], key+".image")...)
+		case "services":
+			images = append(images, getServices(job, node.Content[i+1], key+".services")...)
+		}
+	}
+
+	return images
+}
+
+// getImages returns the container image defined either as a string such as "node:20"
+// or as a mapping with a "name" key
+func getImages(job string, node *yaml.Node, key string) []containerImage {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Value == "" {
+			return nil
+		}
+		return []containerImage{{Job: job, Image: node.Value, Key: key}}
+
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == "name" && node.Content[i+1].Kind == yaml.ScalarNode && node.Content[i+1].Value != "" {
+				return []containerImage{{Job: job, Image: node.Content[i+1].Value, Key: key + ".name"}}
+			}
+		}
+	}
+
+	return nil
+}
+
+// getServices returns the container images defined by a list of services
+func getServices(job string, node *yaml.Node, key string) []containerImage {
+	var images []containerImage
+
+	if node.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	for i, service := range node.Content {
+		images = append(images, getImages(job, service, fmt.Sprintf("%s[%d]", key, i))...)
+	}
+
+	return images
+}
+
+// getIncludes returns the project includes pinned to a version.
+// The include keyword accepts either a single include or a list of includes
+func getIncludes(node *yaml.Node) []include {
+	var includes []include
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		if i, ok := getInclude(node, "$.include"); ok {
+			includes = append(includes, i)
+		}
+	case yaml.SequenceNode:
+		for index, item := range node.Content {
+			if i, ok := getInclude(item, fmt.Sprintf("$.include[%d]", index)); ok {
+				includes = append(includes, i)
+			}
+		}
+	}
+
+	return includes
+}
+
+// getInclude returns a project include if it's pinned to a version
+func getInclude(node *yaml.Node, key string) (include, bool) {
+	if node.Kind != yaml.MappingNode {
+		return include{}, false
+	}
+
+	i := include{
+		Key: key + ".ref",
+	}
+
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		if node.Content[index+1].Kind != yaml.ScalarNode {
+			continue
+		}
+
+		switch node.Content[index].Value {
+		case "project":
+			i.Project = node.Content[index+1].Value
+		case "ref":
+			i.Ref = node.Content[index+1].Value
+		}
+	}
+
+	if i.Project == "" || i.Ref == "" {
+		return include{}, false
+	}
+
+	if !refRegex.MatchString(i.Ref) {
+		logrus.Debugf("skipping include %q, ref %q is not a version", i.Project, i.Ref)
+		return include{}, false
+	}
+
+	return i, true
+}
+
+// isReservedKeyword returns true if name is a GitLab CI global keyword
+func isReservedKeyword(name string) bool {
+	for _, keyword := range reservedKeywords {
+		if name == keyword {
+			return true
+		}
+	}
+	return false
+}
+
+// getYamlPathKey returns the key, quoted if needed, so it can be used in a yaml path
+func getYamlPathKey(key string) (string, error) {
+	if plainKeyRegex.MatchString(key) {
+		return key, nil
+	}
+
+	if strings.Contains(key, "'") {
+		return "", fmt.Errorf("key containing a single quote not supported")
+	}
+
+	return "'" + key + "'", nil
+}
+
+// splitProject returns the owner, and repository, of a GitLab project path such as "my-group/my-subgroup/my-project"
+func splitProject(project string) (owner, repository string, err error) {
+	project = strings.Trim(project, "/")
+
+	index := strings.LastIndex(project, "/")
+	if index <= 0 {
+		return "", "", fmt.Errorf("wrong project path %q", project)
+	}
+
+	return project[:index], project[index+1:], nil
+}
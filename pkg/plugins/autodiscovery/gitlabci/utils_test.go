@@ -0,0 +1,90 @@
+package gitlabci
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchGitLabCIFiles(t *testing.T) {
+	foundFiles, err := searchGitLabCIFiles("testdata/success", DefaultGitLabCIFiles)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		filepath.Join("testdata", "success", ".gitlab-ci.yml"),
+		filepath.Join("testdata", "success", "templates", ".gitlab-ci.yaml"),
+	}, foundFiles)
+}
+
+func TestGetGitLabCIData(t *testing.T) {
+	images, includes, err := getGitLabCIData(filepath.Join("testdata", "success", ".gitlab-ci.yml"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []containerImage{
+		{Job: "", Image: "node:20.8.0", Key: "$.image"},
+		{Job: "default", Image: "docker:24.0.5-dind", Key: "$.default.services[0]"},
+		{Job: "build", Image: "golang:1.21.3", Key: "$.build.image.name"},
+		{Job: "unit test", Image: "$CI_REGISTRY_IMAGE:latest", Key: "$.'unit test'.image"},
+		{Job: "unit test", Image: "postgres:15.4", Key: "$.'unit test'.services[0].name"},
+	}, images)
+
+	assert.Equal(t, []include{
+		{Project: "my-group/ci-templates", Ref: "v1.2.0", Key: "$.include[1].ref"},
+	}, includes)
+}
+
+func TestGetYamlPathKey(t *testing.T) {
+	testdata := []struct {
+		key           string
+		expectedKey   string
+		expectedError bool
+	}{
+		{key: "build", expectedKey: "build"},
+		{key: "unit-test_1", expectedKey: "unit-test_1"},
+		{key: "unit test", expectedKey: "'unit test'"},
+		{key: ".template", expectedKey: "'.template'"},
+		{key: "deploy:prod", expectedKey: "'deploy:prod'"},
+		{key: "it's", expectedError: true},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.key, func(t *testing.T) {
+			got, err := getYamlPathKey(tt.key)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedKey, got)
+		})
+	}
+}
+
+func TestSplitProject(t *testing.T) {
+	testdata := []struct {
+		project            string
+		expectedOwner      string
+		expectedRepository string
+		expectedError      bool
+	}{
+		{project: "my-group/my-project", expectedOwner: "my-group", expectedRepository: "my-project"},
+		{project: "my-group/my-subgroup/my-project", expectedOwner: "my-group/my-subgroup", expectedRepository: "my-project"},
+		{project: "/my-group/my-project", expectedOwner: "my-group", expectedRepository: "my-project"},
+		{project: "my-project", expectedError: true},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.project, func(t *testing.T) {
+			owner, repository, err := splitProject(tt.project)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOwner, owner)
+			assert.Equal(t, tt.expectedRepository, repository)
+		})
+	}
+}
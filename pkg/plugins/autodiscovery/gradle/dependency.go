@@ -0,0 +1,188 @@
+package gradle
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+)
+
+func (g Gradle) discoverGradleManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	searchFromDir := g.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if g.spec.RootDir != "" && !path.IsAbs(g.spec.RootDir) {
+		searchFromDir = filepath.Join(g.rootDir, g.spec.RootDir)
+	}
+
+	foundCatalogs, foundBuildScripts, err := searchGradleFiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	getManifests := func(foundFile, projectDir, targetKind string, dependencies []dependency) {
+		relativeFoundFile, err := filepath.Rel(g.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next Gradle file if one fail
+			logrus.Debugln(err)
+			return
+		}
+
+		if len(dependencies) == 0 {
+			logrus.Debugf("no Gradle dependency found in %q\n", foundFile)
+			return
+		}
+
+		repositories := getRepositories(projectDir, searchFromDir)
+
+		for _, dep := range dependencies {
+
+			if len(g.spec.Ignore) > 0 {
+				if g.spec.Ignore.isMatchingRules(g.rootDir, relativeFoundFile, dep) {
+					logrus.Debugf("Ignoring Gradle dependency %q from %q, as matching ignore rule(s)\n", dep.name(), relativeFoundFile)
+					continue
+				}
+			}
+
+			if len(g.spec.Only) > 0 {
+				if !g.spec.Only.isMatchingRules(g.rootDir, relativeFoundFile, dep) {
+					logrus.Debugf("Ignoring Gradle dependency %q from %q, as not matching only rule(s)\n", dep.name(), relativeFoundFile)
+					continue
+				}
+			}
+
+			sourceRepositories := repositories
+			// Gradle plugins are published on the Gradle Plugin Portal
+			if dep.isPlugin() && !containsString(repositories, pluginPortalRepository) {
+				sourceRepositories = append([]string{pluginPortalRepository}, repositories...)
+			}
+
+			groupID, artifactID := dep.mavenCoordinates()
+
+			manifestName := fmt.Sprintf("Bump Gradle dependency %q", dep.name())
+			sourceName := fmt.Sprintf("Get latest Gradle dependency %q version", dep.name())
+			targetName := fmt.Sprintf("Bump Gradle dependency %q to {{ source \"maven\" }}", dep.name())
+			if dep.isPlugin() {
+				manifestName = fmt.Sprintf("Bump Gradle plugin %q", dep.name())
+				sourceName = fmt.Sprintf("Get latest Gradle plugin %q version", dep.name())
+				targetName = fmt.Sprintf("Bump Gradle plugin %q to {{ source \"maven\" }}", dep.name())
+			}
+			if dep.VersionRef != "" {
+				manifestName = fmt.Sprintf("Bump Gradle version catalog version %q", dep.VersionRef)
+				targetName = fmt.Sprintf("Bump Gradle version catalog version %q to {{ source \"maven\" }}", dep.VersionRef)
+			}
+
+			sourceVersionFilterKind, sourceVersionFilterPattern := g.getVersionFilter(dep.Version)
+
+			params := struct {
+				ManifestName               string
+				SourceID                   string
+				SourceName                 string
+				SourceGroupID              string
+				SourceArtifactID           string
+				SourceRepositories         []string
+				SourceVersionFilterKind    string
+				SourceVersionFilterPattern string
+				TargetID                   string
+				TargetName                 string
+				TargetKind                 string
+				TargetKey                  string
+				TargetValue                string
+				TargetMatchPattern         string
+				TargetReplacePattern       string
+				File                       string
+				ScmID                      string
+			}{
+				ManifestName:               manifestName,
+				SourceID:                   "maven",
+				SourceName:                 sourceName,
+				SourceGroupID:              groupID,
+				SourceArtifactID:           artifactID,
+				SourceRepositories:         sourceRepositories,
+				SourceVersionFilterKind:    sourceVersionFilterKind,
+				SourceVersionFilterPattern: sourceVersionFilterPattern,
+				TargetID:                   "gradle",
+				TargetName:                 targetName,
+				TargetKind:                 targetKind,
+				TargetKey:                  dep.TargetKey,
+				TargetValue:                dep.TargetValue,
+				TargetMatchPattern:         escapeSingleQuote(dep.TargetMatchPattern),
+				TargetReplacePattern:       escapeSingleQuote(dep.TargetReplacePattern),
+				File:                       relativeFoundFile,
+				ScmID:                      g.scmID,
+			}
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	for _, foundCatalog := range foundCatalogs {
+		logrus.Debugf("parsing file %q", foundCatalog)
+
+		dependencies, err := getVersionCatalogDependencies(foundCatalog)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		// Version catalogs are located in the "gradle" directory of the project
+		getManifests(foundCatalog, filepath.Dir(filepath.Dir(foundCatalog)), "toml", dependencies)
+	}
+
+	for _, foundBuildScript := range foundBuildScripts {
+		logrus.Debugf("parsing file %q", foundBuildScript)
+
+		dependencies, err := getBuildScriptDependencies(foundBuildScript)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		getManifests(foundBuildScript, filepath.Dir(foundBuildScript), "file", dependencies)
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// getVersionFilter returns the version filter used to retrieve the newest dependency version.
+// The current version is converted to ">=x.y.z" or to the version filter defined in the manifest.
+func (g Gradle) getVersionFilter(currentVersion string) (kind, pattern string) {
+	kind = g.versionFilter.Kind
+	pattern = ">=" + currentVersion
+
+	if !g.spec.VersionFilter.IsZero() {
+		var err error
+		pattern, err = g.versionFilter.GreaterThanPattern(currentVersion)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			pattern = "*"
+		}
+	}
+
+	return kind, pattern
+}
+
+// escapeSingleQuote escapes single quotes from a value used in a yaml single-quoted string
+func escapeSingleQuote(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
@@ -0,0 +1,120 @@
+package gradle
+
+import (
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines the parameters which can be provided to the Gradle crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for Gradle version catalogs and build scripts
+	RootDir string `yaml:",omitempty"`
+	// Ignore specifies rule to ignore Gradle dependency or plugin update.
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only specify required rule to restrict Gradle dependency or plugin update.
+	Only MatchingRules `yaml:",omitempty"`
+	/*
+		`versionfilter` provides parameters to specify the version pattern to use when generating manifest.
+
+		kind - maven
+			versionfilter of kind `maven` uses the maven version ordering as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a version range` such as `[1.0,2.0)` or `>=1.0,<2`
+
+		kind - semver
+			versionfilter of kind `semver` uses semantic versioning as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a version constraint` such as `>= 1.0.0`
+
+		kind - regex
+			versionfilter of kind `regex` uses regular expression as version filtering
+			pattern accepts a valid regular expression
+
+		example:
+		```
+			versionfilter:
+				kind: maven
+				pattern: minor
+		```
+
+		remark:
+			By default, Updatecli looks for any version greater than or equal to the one currently used,
+			according to the maven version ordering.
+			Pre-releases and snapshots are ignored unless the version currently used is one of them.
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+// Gradle holds all information needed to generate Gradle manifests.
+type Gradle struct {
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for Gradle files
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID string) (Gradle, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Gradle{}, err
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Gradle{}, err
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		// Gradle resolves versions using the same ordering than maven
+		newFilter.Kind = version.MAVENVERSIONKIND
+		newFilter.Pattern = "*"
+	}
+
+	return Gradle{
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+	}, nil
+}
+
+// DiscoverManifests returns the manifests updating Gradle version catalogs and build scripts
+func (g Gradle) DiscoverManifests() ([][]byte, error) {
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle("Gradle"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("Gradle")+1))
+
+	manifests, err := g.discoverGradleManifests()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return manifests, nil
+}
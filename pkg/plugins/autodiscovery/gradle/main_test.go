@@ -0,0 +1,191 @@
+package gradle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "Version catalog",
+			rootDir: "testdata",
+			spec: Spec{
+				Only: MatchingRules{
+					{
+						Path: "gradle/libs.versions.toml",
+						ArtifactIDs: map[string]string{
+							"commons-lang3": "",
+							"guava":         "",
+						},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump Gradle dependency "org.apache.commons:commons-lang3"'
+sources:
+  maven:
+    name: 'Get latest Gradle dependency "org.apache.commons:commons-lang3" version'
+    kind: 'maven'
+    spec:
+      groupid: 'org.apache.commons'
+      artifactid: 'commons-lang3'
+      repositories:
+        - 'https://jitpack.io'
+        - 'https://maven.google.com/'
+      versionfilter:
+        kind: 'maven'
+        pattern: '>=3.13.0'
+targets:
+  gradle:
+    name: 'Bump Gradle dependency "org.apache.commons:commons-lang3" to {{ source "maven" }}'
+    kind: 'toml'
+    spec:
+      file: 'gradle/libs.versions.toml'
+      key: 'libraries.commons-lang3.version'
+    sourceid: 'maven'
+`, `name: 'Bump Gradle dependency "com.google.guava:guava"'
+sources:
+  maven:
+    name: 'Get latest Gradle dependency "com.google.guava:guava" version'
+    kind: 'maven'
+    spec:
+      groupid: 'com.google.guava'
+      artifactid: 'guava'
+      repositories:
+        - 'https://jitpack.io'
+        - 'https://maven.google.com/'
+      versionfilter:
+        kind: 'maven'
+        pattern: '>=32.1.2-jre'
+targets:
+  gradle:
+    name: 'Bump Gradle dependency "com.google.guava:guava" to {{ source "maven" }}'
+    kind: 'toml'
+    spec:
+      file: 'gradle/libs.versions.toml'
+      key: 'libraries.guava'
+      value: 'com.google.guava:guava:{{ source "maven" }}'
+    sourceid: 'maven'
+`},
+		},
+		{
+			name:    "Kotlin plugin with version filter",
+			rootDir: "testdata",
+			spec: Spec{
+				Only: MatchingRules{
+					{
+						Plugins: map[string]string{
+							"org.jetbrains.kotlin.jvm": "",
+						},
+					},
+				},
+				VersionFilter: version.Filter{
+					Kind:    "maven",
+					Pattern: "minor",
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump Gradle plugin "org.jetbrains.kotlin.jvm"'
+sources:
+  maven:
+    name: 'Get latest Gradle plugin "org.jetbrains.kotlin.jvm" version'
+    kind: 'maven'
+    spec:
+      groupid: 'org.jetbrains.kotlin.jvm'
+      artifactid: 'org.jetbrains.kotlin.jvm.gradle.plugin'
+      repositories:
+        - 'https://plugins.gradle.org/m2/'
+        - 'https://jitpack.io'
+        - 'https://maven.google.com/'
+      versionfilter:
+        kind: 'maven'
+        pattern: '>=1.9.10,<2-alpha'
+targets:
+  gradle:
+    name: 'Bump Gradle plugin "org.jetbrains.kotlin.jvm" to {{ source "maven" }}'
+    kind: 'file'
+    spec:
+      file: 'app/build.gradle.kts'
+      matchpattern: '(\bkotlin\s*\(\s*["'']jvm["'']\s*\)\s*version\s*\(?\s*["''])[^"''$]+'
+      replacepattern: '${1}{{ source "maven" }}'
+    sourceid: 'maven'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := New(tt.spec, tt.rootDir, "")
+			require.NoError(t, err)
+
+			var pipelines []string
+			rawPipelines, err := g.DiscoverManifests()
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tt.expectedPipelines), len(rawPipelines))
+
+			for i := range rawPipelines {
+				// We expect manifest generated by the autodiscovery to use the yaml syntax
+				pipelines = append(pipelines, string(rawPipelines[i]))
+				assert.Equal(t, tt.expectedPipelines[i], pipelines[i])
+			}
+		})
+	}
+}
+
+func TestGetVersionFilterPicksStableVersion(t *testing.T) {
+	versions := []string{"1.9.0", "1.9.10", "1.9.20", "2.0.0-Beta1", "2.0.0-RC1", "2.0.0-SNAPSHOT"}
+
+	testdata := []struct {
+		name           string
+		spec           Spec
+		currentVersion string
+		expected       string
+	}{
+		{
+			name:           "Default version filter",
+			currentVersion: "1.9.10",
+			expected:       "1.9.20",
+		},
+		{
+			name: "Minor version filter",
+			spec: Spec{
+				VersionFilter: version.Filter{
+					Kind:    version.MAVENVERSIONKIND,
+					Pattern: "minor",
+				},
+			},
+			currentVersion: "1.9.10",
+			expected:       "1.9.20",
+		},
+		{
+			name:           "Pre-release currently used",
+			currentVersion: "2.0.0-Beta1",
+			expected:       "2.0.0-SNAPSHOT",
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := New(tt.spec, "testdata", "")
+			require.NoError(t, err)
+
+			kind, pattern := g.getVersionFilter(tt.currentVersion)
+
+			filter, err := version.Filter{Kind: kind, Pattern: pattern}.Init()
+			require.NoError(t, err)
+
+			got, err := filter.Search(versions)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got.GetVersion())
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package gradle
+
+var (
+	// manifestTemplate is the Go template used to generate Gradle manifests
+	manifestTemplate string = `name: '{{ .ManifestName }}'
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'maven'
+    spec:
+      groupid: '{{ .SourceGroupID }}'
+      artifactid: '{{ .SourceArtifactID }}'
+{{- if .SourceRepositories }}
+      repositories:
+{{- range $repo := .SourceRepositories }}
+        - '{{ $repo }}'
+{{- end }}
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+    kind: '{{ .TargetKind }}'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      file: '{{ .File }}'
+{{- if eq .TargetKind "toml" }}
+      key: '{{ .TargetKey }}'
+{{- if .TargetValue }}
+      value: '{{ .TargetValue }}'
+{{- end }}
+{{- else }}
+      matchpattern: '{{ .TargetMatchPattern }}'
+      replacepattern: '{{ .TargetReplacePattern }}'
+{{- end }}
+    sourceid: '{{ .SourceID }}'
+`
+)
@@ -0,0 +1,140 @@
+package gradle
+
+import (
+	"path/filepath"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// MatchingRule allows to specifies rules to identify manifest
+type MatchingRule struct {
+	// Path specifies a Gradle file path pattern, the pattern requires to match all of name, not just a substring.
+	Path string `yaml:",omitempty"`
+	// GroupIDs specifies the list of dependency group to check
+	GroupIDs []string `yaml:",omitempty"`
+	// ArtifactIDs specifies the list of dependency artifact to check, the value accepts a semantic versioning constraint such as ">=1.0"
+	ArtifactIDs map[string]string `yaml:",omitempty"`
+	// Plugins specifies the list of Gradle plugin id to check, the value accepts a semantic versioning constraint such as ">=1.0"
+	Plugins map[string]string `yaml:",omitempty"`
+}
+
+type MatchingRules []MatchingRule
+
+// isMatchingRules checks if a specific file content matches the "only" rule
+func (m MatchingRules) isMatchingRules(rootDir, filePath string, dep dependency) bool {
+	var ruleResults []bool
+
+	if len(m) > 0 {
+		for _, rule := range m {
+			/*
+				Check if rule.Path is matching. Path accepts wildcard path
+			*/
+
+			if rule.Path != "" {
+				if filepath.IsAbs(rule.Path) {
+					filePath = filepath.Join(rootDir, filePath)
+				}
+
+				match, err := filepath.Match(rule.Path, filePath)
+				if err != nil {
+					logrus.Errorf("%s - %q", err, rule.Path)
+					continue
+				}
+				ruleResults = append(ruleResults, match)
+				if match {
+					logrus.Debugf("file path %q matching rule %q", filePath, rule.Path)
+				}
+			}
+
+			/*
+				Checks if the dependency group is matching the policy constraint.
+			*/
+
+			if len(rule.GroupIDs) > 0 {
+				match := false
+
+				if !dep.isPlugin() {
+				outGroupID:
+					for i := range rule.GroupIDs {
+						if dep.GroupID == rule.GroupIDs[i] {
+							match = true
+							break outGroupID
+						}
+					}
+				}
+				ruleResults = append(ruleResults, match)
+			}
+
+			/*
+				Checks if the dependency artifact is matching the policy constraint.
+			*/
+
+			if len(rule.ArtifactIDs) > 0 {
+				match := false
+				if !dep.isPlugin() {
+					match = isMatchingVersions(rule.ArtifactIDs, dep.ArtifactID, dep.Version)
+				}
+				ruleResults = append(ruleResults, match)
+			}
+
+			/*
+				Checks if the plugin is matching the policy constraint.
+			*/
+
+			if len(rule.Plugins) > 0 {
+				match := false
+				if dep.isPlugin() {
+					match = isMatchingVersions(rule.Plugins, dep.PluginID, dep.Version)
+				}
+				ruleResults = append(ruleResults, match)
+			}
+
+			/*
+				If at least one rule is failing then we return false
+			*/
+			isAllMatching := true
+			for i := range ruleResults {
+				if !ruleResults[i] {
+					isAllMatching = false
+				}
+			}
+			if isAllMatching {
+				return true
+			}
+			ruleResults = []bool{}
+		}
+		return false
+	}
+
+	return false
+}
+
+// isMatchingVersions checks if a name is listed in rules and if its version matches the associated constraint
+func isMatchingVersions(rules map[string]string, name, currentVersion string) bool {
+	for ruleName, ruleVersion := range rules {
+		if name != ruleName {
+			continue
+		}
+
+		if ruleVersion == "" {
+			return true
+		}
+
+		v, err := semver.NewVersion(currentVersion)
+		if err != nil {
+			logrus.Debugf("%q - %s", currentVersion, err)
+			return currentVersion == ruleVersion
+		}
+
+		c, err := semver.NewConstraint(ruleVersion)
+		if err != nil {
+			logrus.Debugf("%q %s", err, ruleVersion)
+			return currentVersion == ruleVersion
+		}
+
+		return c.Check(v)
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+package gradle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRule(t *testing.T) {
+
+	dataset := []struct {
+		name           string
+		rules          MatchingRules
+		filePath       string
+		dep            dependency
+		expectedResult bool
+	}{
+		{
+			name: "Matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "gradle/*.toml",
+				},
+			},
+			filePath:       "gradle/libs.versions.toml",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "gradle/*.toml",
+				},
+			},
+			filePath:       "app/build.gradle.kts",
+			expectedResult: false,
+		},
+		{
+			name: "Matching group and artifact version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					GroupIDs: []string{"com.google.guava"},
+					ArtifactIDs: map[string]string{
+						"guava": ">=32",
+					},
+				},
+			},
+			filePath:       "app/build.gradle.kts",
+			dep:            dependency{GroupID: "com.google.guava", ArtifactID: "guava", Version: "32.1.2"},
+			expectedResult: true,
+		},
+		{
+			name: "Not matching artifact version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					ArtifactIDs: map[string]string{
+						"guava": ">=33",
+					},
+				},
+			},
+			filePath:       "app/build.gradle.kts",
+			dep:            dependency{GroupID: "com.google.guava", ArtifactID: "guava", Version: "32.1.2"},
+			expectedResult: false,
+		},
+		{
+			name: "Matching plugin",
+			rules: MatchingRules{
+				MatchingRule{
+					Plugins: map[string]string{
+						"org.springframework.boot": "",
+					},
+				},
+			},
+			filePath:       "app/build.gradle.kts",
+			dep:            dependency{PluginID: "org.springframework.boot", Version: "3.1.4"},
+			expectedResult: true,
+		},
+		{
+			name: "Artifact rule doesn't match plugin",
+			rules: MatchingRules{
+				MatchingRule{
+					ArtifactIDs: map[string]string{
+						"org.springframework.boot": "",
+					},
+				},
+			},
+			filePath:       "app/build.gradle.kts",
+			dep:            dependency{PluginID: "org.springframework.boot", Version: "3.1.4"},
+			expectedResult: false,
+		},
+	}
+
+	for _, d := range dataset {
+		t.Run(d.name, func(t *testing.T) {
+			gotResult := d.rules.isMatchingRules("", d.filePath, d.dep)
+			assert.Equal(t, d.expectedResult, gotResult)
+		})
+	}
+}
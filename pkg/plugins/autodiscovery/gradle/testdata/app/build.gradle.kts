@@ -0,0 +1,16 @@
+plugins {
+    kotlin("jvm") version "1.9.10"
+    id("org.springframework.boot") version "3.1.4"
+    alias(libs.plugins.spotless)
+}
+
+val junitVersion = "5.10.0"
+
+dependencies {
+    implementation(platform("org.springframework.boot:spring-boot-dependencies:3.1.4"))
+    implementation("com.fasterxml.jackson.core:jackson-databind:2.15.2")
+    implementation("io.netty:netty-all:4.1.+")
+    implementation(libs.commons.lang3)
+    testImplementation("org.junit.jupiter:junit-jupiter:$junitVersion")
+    testImplementation("com.fasterxml.jackson.core:jackson-databind:2.15.2")
+}
@@ -0,0 +1,11 @@
+rootProject.name = "demo"
+
+dependencyResolutionManagement {
+    repositories {
+        mavenCentral()
+        google()
+        maven("https://jitpack.io")
+    }
+}
+
+include("app", "legacy")
@@ -0,0 +1,457 @@
+package gradle
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// versionCatalogSuffix is the suffix of Gradle version catalog files such as gradle/libs.versions.toml
+	versionCatalogSuffix string = ".versions.toml"
+	// versionCatalogDir is the directory where Gradle looks for version catalogs
+	versionCatalogDir string = "gradle"
+	// pluginPortalRepository is the maven repository of the Gradle Plugin Portal
+	pluginPortalRepository string = "https://plugins.gradle.org/m2/"
+	// googleRepository is the maven repository configured by google()
+	googleRepository string = "https://maven.google.com/"
+	// pluginMarkerSuffix is the suffix of the marker artifact published for every Gradle plugin
+	// https://docs.gradle.org/current/userguide/plugins.html#sec:plugin_markers
+	pluginMarkerSuffix string = ".gradle.plugin"
+	// kotlinPluginPrefix is the plugin id prefix used by the kotlin("...") plugin notation
+	kotlinPluginPrefix string = "org.jetbrains.kotlin."
+	// sourceVersionPlaceholder is replaced at runtime by the version retrieved from the source
+	sourceVersionPlaceholder string = `{{ source "maven" }}`
+)
+
+var (
+	// buildScriptFiles lists the Gradle scripts which can declare dependencies or plugins
+	buildScriptFiles = []string{"build.gradle", "build.gradle.kts", "settings.gradle", "settings.gradle.kts"}
+	// settingsFiles lists the Gradle scripts which can declare project wide repositories
+	settingsFiles = []string{"settings.gradle", "settings.gradle.kts"}
+
+	// pinnedVersionRegex matches versions which can be updated, rejecting dynamic versions such as "1.+" or "latest.release"
+	// and versions relying on variables such as "$kotlinVersion"
+	pinnedVersionRegex = regexp.MustCompile(`^[0-9][\w.\-]*$`)
+
+	// stringNotationRegex matches dependencies declared using the string notation such as
+	// implementation("com.google.guava:guava:32.1.2-jre") or implementation 'com.google.guava:guava:32.1.2-jre'
+	stringNotationRegex = regexp.MustCompile(`(?m)^\s*[A-Za-z][\w]*\s*\(?\s*(?:(?:platform|enforcedPlatform)\s*\(\s*)?["']([\w.\-]+):([\w.\-]+):([^"'\s:@]+)(?:@\w+)?["']`)
+	// mapNotationRegex matches dependencies declared using the map notation such as
+	// implementation group: 'com.google.guava', name: 'guava', version: '32.1.2-jre'
+	mapNotationRegex = regexp.MustCompile(`\bgroup\s*[:=]\s*["']([\w.\-]+)["']\s*,\s*name\s*[:=]\s*["']([\w.\-]+)["']\s*,\s*version\s*[:=]\s*["']([^"']+)["']`)
+	// pluginRegex matches plugins declared such as id("org.springframework.boot") version "3.1.4"
+	pluginRegex = regexp.MustCompile(`\bid\s*\(?\s*["']([\w.\-]+)["']\s*\)?\s*version\s*\(?\s*["']([^"']+)["']`)
+	// kotlinPluginRegex matches Kotlin plugins declared such as kotlin("jvm") version "1.9.10"
+	kotlinPluginRegex = regexp.MustCompile(`\bkotlin\s*\(\s*["']([\w.\-]+)["']\s*\)\s*version\s*\(?\s*["']([^"']+)["']`)
+
+	// mavenRepositoryRegex matches repositories declared such as maven("https://jitpack.io") or maven { url 'https://jitpack.io' }
+	mavenRepositoryRegex = regexp.MustCompile(`\bmaven\s*(?:\(\s*(?:url\s*=\s*)?(?:uri\s*\(\s*)?["']([^"']+)["']|\{[^}]*?\burl\s*(?:=\s*)?\(?\s*(?:uri\s*\(\s*)?["']([^"']+)["'])`)
+	// googleRepositoryRegex matches the google() repository shortcut
+	googleRepositoryRegex = regexp.MustCompile(`\bgoogle\s*\(\s*\)`)
+)
+
+// dependency represents a Gradle dependency, or plugin, which can be updated
+type dependency struct {
+	// GroupID defines the dependency group
+	GroupID string
+	// ArtifactID defines the dependency artifact
+	ArtifactID string
+	// PluginID defines the Gradle plugin id, it is empty for library dependencies
+	PluginID string
+	// Version defines the version currently used
+	Version string
+	// VersionRef defines the version catalog entry, from the [versions] table, holding the version
+	VersionRef string
+	// TargetKey defines the toml key to update in a version catalog
+	TargetKey string
+	// TargetValue defines the toml value to set in a version catalog, the source output is used if empty
+	TargetValue string
+	// TargetMatchPattern defines the regular expression matching the version to update in a build script
+	TargetMatchPattern string
+	// TargetReplacePattern defines the replacement of TargetMatchPattern in a build script
+	TargetReplacePattern string
+}
+
+// isPlugin returns true if the dependency is a Gradle plugin
+func (d dependency) isPlugin() bool {
+	return d.PluginID != ""
+}
+
+// name returns the dependency name such as "com.google.guava:guava" or the plugin id
+func (d dependency) name() string {
+	if d.isPlugin() {
+		return d.PluginID
+	}
+	return d.GroupID + ":" + d.ArtifactID
+}
+
+// mavenCoordinates returns the maven groupid and artifactid used to retrieve the dependency versions.
+// Gradle plugins are resolved through their plugin marker artifact.
+func (d dependency) mavenCoordinates() (groupID, artifactID string) {
+	if d.isPlugin() {
+		return d.PluginID, d.PluginID + pluginMarkerSuffix
+	}
+	return d.GroupID, d.ArtifactID
+}
+
+// versionCatalog represents the content of a Gradle version catalog
+// https://docs.gradle.org/current/userguide/platforms.html#sub:conventional-dependencies-toml
+type versionCatalog struct {
+	Versions  map[string]interface{} `toml:"versions"`
+	Libraries map[string]interface{} `toml:"libraries"`
+	Plugins   map[string]interface{} `toml:"plugins"`
+}
+
+// searchGradleFiles looks, recursively, for Gradle version catalogs and build scripts from a root directory.
+func searchGradleFiles(rootDir string) (catalogs []string, buildScripts []string, err error) {
+
+	logrus.Debugf("Looking for Gradle files in %q", rootDir)
+
+	err = filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		// Updatecli should ignore Gradle output and cache directories
+		if d.IsDir() && (d.Name() == "build" || d.Name() == ".gradle" || d.Name() == "node_modules") {
+			return filepath.SkipDir
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		switch {
+		case strings.HasSuffix(d.Name(), versionCatalogSuffix) && filepath.Base(filepath.Dir(path)) == versionCatalogDir:
+			catalogs = append(catalogs, path)
+		case isBuildScript(d.Name()):
+			buildScripts = append(buildScripts, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return catalogs, buildScripts, nil
+}
+
+// isBuildScript returns true if the filename is a Gradle build or settings script
+func isBuildScript(filename string) bool {
+	for _, f := range buildScriptFiles {
+		if filename == f {
+			return true
+		}
+	}
+	return false
+}
+
+// isPinnedVersion returns true if the version is a fixed version which can be updated
+func isPinnedVersion(version string) bool {
+	return pinnedVersionRegex.MatchString(version)
+}
+
+// getVersionCatalogDependencies returns the dependencies, and plugins, defined in a Gradle version catalog
+func getVersionCatalogDependencies(filename string) ([]dependency, error) {
+	var catalog versionCatalog
+
+	if _, err := toml.DecodeFile(filename, &catalog); err != nil {
+		return nil, err
+	}
+
+	var dependencies []dependency
+
+	// versionRefs associates a [versions] entry to the first library, or plugin, referencing it
+	versionRefs := map[string]dependency{}
+
+	addDependency := func(dep dependency, alias, table, notation string, rawVersion interface{}) {
+		switch v := rawVersion.(type) {
+		case string:
+			if !isPinnedVersion(v) {
+				logrus.Debugf("skipping %q from %q, version %q is not a fixed version", dep.name(), filename, v)
+				return
+			}
+			dep.Version = v
+			if notation != "" {
+				// The version is part of the string notation such as "com.google.guava:guava:32.1.2-jre"
+				dep.TargetKey = table + "." + alias
+				dep.TargetValue = notation + ":" + sourceVersionPlaceholder
+			} else {
+				dep.TargetKey = table + "." + alias + ".version"
+			}
+			dependencies = append(dependencies, dep)
+
+		case map[string]interface{}:
+			ref, ok := v["ref"].(string)
+			if !ok {
+				logrus.Debugf("skipping %q from %q, rich versions are not supported", dep.name(), filename)
+				return
+			}
+			if _, found := versionRefs[ref]; !found {
+				versionRefs[ref] = dep
+			}
+
+		case nil:
+			logrus.Debugf("skipping %q from %q, no version defined", dep.name(), filename)
+		}
+	}
+
+	for _, alias := range sortedKeys(catalog.Libraries) {
+		// Aliases containing a dot can't be used as a toml key
+		if strings.Contains(alias, ".") {
+			logrus.Debugf("skipping library %q from %q, alias not supported", alias, filename)
+			continue
+		}
+
+		switch library := catalog.Libraries[alias].(type) {
+		case string:
+			// "group:artifact:version"
+			parts := strings.Split(library, ":")
+			if len(parts) != 3 {
+				logrus.Debugf("skipping library %q from %q, no version defined", alias, filename)
+				continue
+			}
+			addDependency(
+				dependency{GroupID: parts[0], ArtifactID: parts[1]},
+				alias, "libraries", parts[0]+":"+parts[1], parts[2])
+
+		case map[string]interface{}:
+			dep := dependency{}
+			if module, ok := library["module"].(string); ok {
+				group, artifact, found := strings.Cut(module, ":")
+				if !found {
+					logrus.Debugf("skipping library %q from %q, wrong module %q", alias, filename, module)
+					continue
+				}
+				dep.GroupID, dep.ArtifactID = group, artifact
+			} else {
+				dep.GroupID, _ = library["group"].(string)
+				dep.ArtifactID, _ = library["name"].(string)
+			}
+
+			if dep.GroupID == "" || dep.ArtifactID == "" {
+				logrus.Debugf("skipping library %q from %q, no module defined", alias, filename)
+				continue
+			}
+
+			addDependency(dep, alias, "libraries", "", library["version"])
+		}
+	}
+
+	for _, alias := range sortedKeys(catalog.Plugins) {
+		if strings.Contains(alias, ".") {
+			logrus.Debugf("skipping plugin %q from %q, alias not supported", alias, filename)
+			continue
+		}
+
+		switch plugin := catalog.Plugins[alias].(type) {
+		case string:
+			// "id:version"
+			id, pluginVersion, found := strings.Cut(plugin, ":")
+			if !found {
+				logrus.Debugf("skipping plugin %q from %q, no version defined", alias, filename)
+				continue
+			}
+			addDependency(dependency{PluginID: id}, alias, "plugins", id, pluginVersion)
+
+		case map[string]interface{}:
+			id, _ := plugin["id"].(string)
+			if id == "" {
+				logrus.Debugf("skipping plugin %q from %q, no id defined", alias, filename)
+				continue
+			}
+			addDependency(dependency{PluginID: id}, alias, "plugins", "", plugin["version"])
+		}
+	}
+
+	var versionDependencies []dependency
+	for _, name := range sortedKeys(catalog.Versions) {
+		dep, found := versionRefs[name]
+		if !found {
+			logrus.Debugf("skipping version %q from %q, not referenced by any library or plugin", name, filename)
+			continue
+		}
+
+		v, ok := catalog.Versions[name].(string)
+		if !ok || !isPinnedVersion(v) {
+			logrus.Debugf("skipping version %q from %q, not a fixed version", name, filename)
+			continue
+		}
+
+		if strings.Contains(name, ".") {
+			logrus.Debugf("skipping version %q from %q, name not supported", name, filename)
+			continue
+		}
+
+		dep.Version = v
+		dep.VersionRef = name
+		dep.TargetKey = "versions." + name
+		versionDependencies = append(versionDependencies, dep)
+	}
+
+	return append(versionDependencies, dependencies...), nil
+}
+
+// getBuildScriptDependencies returns the dependencies, and plugins, declared in a Groovy or Kotlin Gradle script
+func getBuildScriptDependencies(filename string) ([]dependency, error) {
+	rawContent, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	content := string(rawContent)
+
+	var dependencies []dependency
+	// Each match pattern updates every occurrence so we only need one manifest per pattern
+	knownPatterns := map[string]bool{}
+
+	addDependency := func(dep dependency) {
+		if !isPinnedVersion(dep.Version) {
+			logrus.Debugf("skipping %q from %q, version %q is not a fixed version", dep.name(), filename, dep.Version)
+			return
+		}
+		if knownPatterns[dep.TargetMatchPattern] {
+			return
+		}
+		knownPatterns[dep.TargetMatchPattern] = true
+		dependencies = append(dependencies, dep)
+	}
+
+	for _, m := range stringNotationRegex.FindAllStringSubmatch(content, -1) {
+		addDependency(dependency{
+			GroupID:              m[1],
+			ArtifactID:           m[2],
+			Version:              m[3],
+			TargetMatchPattern:   `(["']` + regexp.QuoteMeta(m[1]+":"+m[2]+":") + `)[^"'\s:@$]+`,
+			TargetReplacePattern: "${1}" + sourceVersionPlaceholder,
+		})
+	}
+
+	for _, m := range mapNotationRegex.FindAllStringSubmatch(content, -1) {
+		addDependency(dependency{
+			GroupID:    m[1],
+			ArtifactID: m[2],
+			Version:    m[3],
+			TargetMatchPattern: `(\bgroup\s*[:=]\s*["']` + regexp.QuoteMeta(m[1]) +
+				`["']\s*,\s*name\s*[:=]\s*["']` + regexp.QuoteMeta(m[2]) +
+				`["']\s*,\s*version\s*[:=]\s*["'])[^"'$]+`,
+			TargetReplacePattern: "${1}" + sourceVersionPlaceholder,
+		})
+	}
+
+	for _, m := range pluginRegex.FindAllStringSubmatch(content, -1) {
+		addDependency(dependency{
+			PluginID:             m[1],
+			Version:              m[2],
+			TargetMatchPattern:   `(\bid\s*\(?\s*["']` + regexp.QuoteMeta(m[1]) + `["']\s*\)?\s*version\s*\(?\s*["'])[^"'$]+`,
+			TargetReplacePattern: "${1}" + sourceVersionPlaceholder,
+		})
+	}
+
+	for _, m := range kotlinPluginRegex.FindAllStringSubmatch(content, -1) {
+		addDependency(dependency{
+			PluginID:             kotlinPluginPrefix + m[1],
+			Version:              m[2],
+			TargetMatchPattern:   `(\bkotlin\s*\(\s*["']` + regexp.QuoteMeta(m[1]) + `["']\s*\)\s*version\s*\(?\s*["'])[^"'$]+`,
+			TargetReplacePattern: "${1}" + sourceVersionPlaceholder,
+		})
+	}
+
+	return dependencies, nil
+}
+
+// getRepositories returns the maven repositories declared by the Gradle scripts of a project directory,
+// followed by the ones declared in the settings scripts of its parent directories up to the root directory.
+func getRepositories(dir, rootDir string) []string {
+	files := []string{}
+	for _, f := range buildScriptFiles {
+		files = append(files, filepath.Join(dir, f))
+	}
+
+	for {
+		rel, err := filepath.Rel(rootDir, dir)
+		if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+			break
+		}
+		dir = filepath.Dir(dir)
+
+		for _, f := range settingsFiles {
+			files = append(files, filepath.Join(dir, f))
+		}
+	}
+
+	var repositories []string
+	for _, f := range files {
+		content, err := os.ReadFile(f)
+		if err != nil {
+			continue
+		}
+
+		for _, repository := range parseRepositories(string(content)) {
+			if !containsString(repositories, repository) {
+				repositories = append(repositories, repository)
+			}
+		}
+	}
+
+	return repositories
+}
+
+// parseRepositories returns the maven repository urls declared in a Gradle script
+func parseRepositories(content string) []string {
+	var repositories []string
+
+	for _, m := range mavenRepositoryRegex.FindAllStringSubmatch(content, -1) {
+		repository := m[1]
+		if repository == "" {
+			repository = m[2]
+		}
+
+		// Repositories relying on variables can't be resolved
+		if !strings.HasPrefix(repository, "https://") && !strings.HasPrefix(repository, "http://") {
+			continue
+		}
+		if strings.Contains(repository, "$") {
+			continue
+		}
+
+		repositories = append(repositories, repository)
+	}
+
+	if googleRepositoryRegex.MatchString(content) {
+		repositories = append(repositories, googleRepository)
+	}
+
+	return repositories
+}
+
+// containsString returns true if a string is part of a list
+func containsString(list []string, s string) bool {
+	for i := range list {
+		if list[i] == s {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the map keys sorted alphabetically
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
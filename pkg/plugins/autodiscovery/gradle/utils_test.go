@@ -0,0 +1,193 @@
+package gradle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchGradleFiles(t *testing.T) {
+	catalogs, buildScripts, err := searchGradleFiles("testdata")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"testdata/gradle/libs.versions.toml"}, catalogs)
+	assert.Equal(t, []string{
+		"testdata/app/build.gradle.kts",
+		"testdata/legacy/build.gradle",
+		"testdata/settings.gradle.kts",
+	}, buildScripts)
+}
+
+func TestGetVersionCatalogDependencies(t *testing.T) {
+	got, err := getVersionCatalogDependencies("testdata/gradle/libs.versions.toml")
+	require.NoError(t, err)
+
+	expected := []dependency{
+		{
+			GroupID:    "org.junit.jupiter",
+			ArtifactID: "junit-jupiter",
+			Version:    "5.10.0",
+			VersionRef: "junit",
+			TargetKey:  "versions.junit",
+		},
+		{
+			GroupID:    "org.jetbrains.kotlin",
+			ArtifactID: "kotlin-stdlib",
+			Version:    "1.9.10",
+			VersionRef: "kotlin",
+			TargetKey:  "versions.kotlin",
+		},
+		{
+			GroupID:    "org.apache.commons",
+			ArtifactID: "commons-lang3",
+			Version:    "3.13.0",
+			TargetKey:  "libraries.commons-lang3.version",
+		},
+		{
+			GroupID:     "com.google.guava",
+			ArtifactID:  "guava",
+			Version:     "32.1.2-jre",
+			TargetKey:   "libraries.guava",
+			TargetValue: `com.google.guava:guava:{{ source "maven" }}`,
+		},
+		{
+			PluginID:    "com.diffplug.spotless",
+			Version:     "6.22.0",
+			TargetKey:   "plugins.spotless",
+			TargetValue: `com.diffplug.spotless:{{ source "maven" }}`,
+		},
+		{
+			PluginID:  "com.github.ben-manes.versions",
+			Version:   "0.48.0",
+			TargetKey: "plugins.versions.version",
+		},
+	}
+
+	assert.Equal(t, expected, got)
+}
+
+func TestGetBuildScriptDependencies(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		expected []dependency
+	}{
+		{
+			name: "Kotlin DSL",
+			file: "testdata/app/build.gradle.kts",
+			expected: []dependency{
+				{
+					GroupID:              "org.springframework.boot",
+					ArtifactID:           "spring-boot-dependencies",
+					Version:              "3.1.4",
+					TargetMatchPattern:   `(["']org\.springframework\.boot:spring-boot-dependencies:)[^"'\s:@$]+`,
+					TargetReplacePattern: `${1}{{ source "maven" }}`,
+				},
+				{
+					GroupID:              "com.fasterxml.jackson.core",
+					ArtifactID:           "jackson-databind",
+					Version:              "2.15.2",
+					TargetMatchPattern:   `(["']com\.fasterxml\.jackson\.core:jackson-databind:)[^"'\s:@$]+`,
+					TargetReplacePattern: `${1}{{ source "maven" }}`,
+				},
+				{
+					PluginID:             "org.springframework.boot",
+					Version:              "3.1.4",
+					TargetMatchPattern:   `(\bid\s*\(?\s*["']org\.springframework\.boot["']\s*\)?\s*version\s*\(?\s*["'])[^"'$]+`,
+					TargetReplacePattern: `${1}{{ source "maven" }}`,
+				},
+				{
+					PluginID:             "org.jetbrains.kotlin.jvm",
+					Version:              "1.9.10",
+					TargetMatchPattern:   `(\bkotlin\s*\(\s*["']jvm["']\s*\)\s*version\s*\(?\s*["'])[^"'$]+`,
+					TargetReplacePattern: `${1}{{ source "maven" }}`,
+				},
+			},
+		},
+		{
+			name: "Groovy DSL",
+			file: "testdata/legacy/build.gradle",
+			expected: []dependency{
+				{
+					GroupID:              "org.slf4j",
+					ArtifactID:           "slf4j-api",
+					Version:              "2.0.9",
+					TargetMatchPattern:   `(["']org\.slf4j:slf4j-api:)[^"'\s:@$]+`,
+					TargetReplacePattern: `${1}{{ source "maven" }}`,
+				},
+				{
+					GroupID:              "org.projectlombok",
+					ArtifactID:           "lombok",
+					Version:              "1.18.30",
+					TargetMatchPattern:   `(\bgroup\s*[:=]\s*["']org\.projectlombok["']\s*,\s*name\s*[:=]\s*["']lombok["']\s*,\s*version\s*[:=]\s*["'])[^"'$]+`,
+					TargetReplacePattern: `${1}{{ source "maven" }}`,
+				},
+				{
+					PluginID:             "com.github.ben-manes.versions",
+					Version:              "0.48.0",
+					TargetMatchPattern:   `(\bid\s*\(?\s*["']com\.github\.ben-manes\.versions["']\s*\)?\s*version\s*\(?\s*["'])[^"'$]+`,
+					TargetReplacePattern: `${1}{{ source "maven" }}`,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getBuildScriptDependencies(tt.file)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestGetRepositories(t *testing.T) {
+	tests := []struct {
+		dir      string
+		expected []string
+	}{
+		{
+			dir: "testdata",
+			expected: []string{
+				"https://jitpack.io",
+				"https://maven.google.com/",
+			},
+		},
+		{
+			dir: "testdata/legacy",
+			expected: []string{
+				"https://repo.example.com/releases",
+				"https://jitpack.io",
+				"https://maven.google.com/",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getRepositories(tt.dir, "testdata"))
+		})
+	}
+}
+
+func TestIsPinnedVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected bool
+	}{
+		{version: "32.1.2-jre", expected: true},
+		{version: "2.0.0.RELEASE", expected: true},
+		{version: "4.1.+"},
+		{version: "latest.release"},
+		{version: "[1.0,2.0)"},
+		{version: "$junitVersion"},
+		{version: "${postgresVersion}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isPinnedVersion(tt.version))
+		})
+	}
+}
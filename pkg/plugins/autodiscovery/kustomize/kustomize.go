@@ -0,0 +1,332 @@
+package kustomize
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerimage"
+)
+
+func (k Kustomize) discoverKustomizeManifests() ([][]byte, error) {
+	var manifests [][]byte
+
+	searchFromDir := k.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if k.spec.RootDir != "" && !path.IsAbs(k.spec.RootDir) {
+		searchFromDir = filepath.Join(k.rootDir, k.spec.RootDir)
+	}
+
+	foundFiles, err := searchKustomizeFiles(searchFromDir, k.files)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+		logrus.Debugf("parsing file %q", foundFile)
+
+		relativeFoundFile, err := filepath.Rel(k.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		data, err := loadKustomization(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		for i, image := range data.Images {
+			manifest, err := k.generateImageManifest(image, fmt.Sprintf("$.images[%d]", i), relativeFoundFile)
+			if err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			if manifest != nil {
+				manifests = append(manifests, manifest)
+			}
+		}
+
+		for i, chart := range data.HelmCharts {
+			manifest, err := k.generateHelmChartManifest(chart, fmt.Sprintf("$.helmCharts[%d]", i), relativeFoundFile)
+			if err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			if manifest != nil {
+				manifests = append(manifests, manifest)
+			}
+		}
+
+		for i, resource := range data.Resources {
+			// Local resources can't be pinned to a git ref
+			if !strings.Contains(resource, "?") {
+				continue
+			}
+
+			manifest, err := k.generateResourceManifest(resource, fmt.Sprintf("$.resources[%d]", i), relativeFoundFile)
+			if err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			if manifest != nil {
+				manifests = append(manifests, manifest)
+			}
+		}
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+func (k Kustomize) generateImageManifest(image kustomizeImage, targetKey, relativeFoundFile string) ([]byte, error) {
+	var err error
+
+	imageName := image.NewName
+	if imageName == "" {
+		imageName = image.Name
+	}
+
+	// An image only pinned to a digest doesn't tell which tag to follow,
+	// and following "latest" could move it to a new major version
+	if imageName == "" || image.NewTag == "" {
+		return nil, fmt.Errorf("ignoring image %q because no tag is specified", imageName)
+	}
+
+	if image.Digest != "" && !k.digest {
+		return nil, fmt.Errorf("ignoring image %q because it has a digest but digest is disabled", imageName)
+	}
+
+	if len(k.spec.Ignore) > 0 {
+		if k.spec.Ignore.isMatchingRules(k.rootDir, relativeFoundFile, imageKind, imageName, image.NewTag) {
+			logrus.Debugf("Ignoring container image %q from %q, as matching ignore rule(s)\n", imageName, relativeFoundFile)
+			return nil, nil
+		}
+	}
+
+	if len(k.spec.Only) > 0 {
+		if !k.spec.Only.isMatchingRules(k.rootDir, relativeFoundFile, imageKind, imageName, image.NewTag) {
+			logrus.Debugf("Ignoring container image %q from %q, as not matching only rule(s)\n", imageName, relativeFoundFile)
+			return nil, nil
+		}
+	}
+
+	sourceSpec := dockerimage.NewDockerImageSpecFromImage(imageName, image.NewTag, k.spec.Auths)
+	if sourceSpec == nil {
+		return nil, fmt.Errorf("ignoring image %q because tag %q is not supported", imageName, image.NewTag)
+	}
+
+	versionFilterKind := sourceSpec.VersionFilter.Kind
+	versionFilterPattern := sourceSpec.VersionFilter.Pattern
+	tagFilter := sourceSpec.TagFilter
+
+	// If a versionfilter is specified in the manifest then we want to be sure that it takes precedence
+	if !k.spec.VersionFilter.IsZero() {
+		versionFilterKind = k.versionFilter.Kind
+		versionFilterPattern, err = k.versionFilter.GreaterThanPattern(image.NewTag)
+		if err != nil {
+			versionFilterPattern = "*"
+			logrus.Debugf("building version filter pattern: %s", err)
+		}
+	}
+
+	tmpl, err := template.New("manifest").Parse(imageManifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	params := struct {
+		ImageName            string
+		Digest               bool
+		SourceID             string
+		SourceTagFilter      string
+		VersionFilterKind    string
+		VersionFilterPattern string
+		TargetID             string
+		TargetKey            string
+		File                 string
+		ScmID                string
+	}{
+		ImageName:            imageName,
+		Digest:               image.Digest != "",
+		SourceID:             imageKind,
+		SourceTagFilter:      tagFilter,
+		VersionFilterKind:    versionFilterKind,
+		VersionFilterPattern: versionFilterPattern,
+		TargetID:             imageKind,
+		TargetKey:            targetKey,
+		File:                 relativeFoundFile,
+		ScmID:                k.scmID,
+	}
+
+	manifest := bytes.Buffer{}
+	if err := tmpl.Execute(&manifest, params); err != nil {
+		return nil, err
+	}
+
+	return manifest.Bytes(), nil
+}
+
+func (k Kustomize) generateHelmChartManifest(chart helmChart, targetKey, relativeFoundFile string) ([]byte, error) {
+
+	if chart.Name == "" || chart.Repo == "" || chart.Version == "" {
+		return nil, fmt.Errorf("ignoring Helm chart %q because no repository or version is specified", chart.Name)
+	}
+
+	// If the Helm chart version is not a valid semver, we skip the pipeline
+	if _, err := semver.NewVersion(chart.Version); err != nil {
+		return nil, fmt.Errorf("ignoring Helm chart %q, as %q not a valid semver version", chart.Name, chart.Version)
+	}
+
+	if len(k.spec.Ignore) > 0 {
+		if k.spec.Ignore.isMatchingRules(k.rootDir, relativeFoundFile, helmChartKind, chart.Name, chart.Version) {
+			logrus.Debugf("Ignoring Helm chart %q from %q, as matching ignore rule(s)\n", chart.Name, relativeFoundFile)
+			return nil, nil
+		}
+	}
+
+	if len(k.spec.Only) > 0 {
+		if !k.spec.Only.isMatchingRules(k.rootDir, relativeFoundFile, helmChartKind, chart.Name, chart.Version) {
+			logrus.Debugf("Ignoring Helm chart %q from %q, as not matching only rule(s)\n", chart.Name, relativeFoundFile)
+			return nil, nil
+		}
+	}
+
+	versionFilterKind, versionFilterPattern := k.getVersionFilter(chart.Version)
+
+	tmpl, err := template.New("manifest").Parse(helmChartManifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	params := struct {
+		ChartName            string
+		ChartRepository      string
+		SourceID             string
+		VersionFilterKind    string
+		VersionFilterPattern string
+		TargetID             string
+		TargetKey            string
+		File                 string
+		ScmID                string
+	}{
+		ChartName:            chart.Name,
+		ChartRepository:      chart.Repo,
+		SourceID:             helmChartKind,
+		VersionFilterKind:    versionFilterKind,
+		VersionFilterPattern: versionFilterPattern,
+		TargetID:             helmChartKind,
+		TargetKey:            targetKey,
+		File:                 relativeFoundFile,
+		ScmID:                k.scmID,
+	}
+
+	manifest := bytes.Buffer{}
+	if err := tmpl.Execute(&manifest, params); err != nil {
+		return nil, err
+	}
+
+	return manifest.Bytes(), nil
+}
+
+func (k Kustomize) generateResourceManifest(resource, targetKey, relativeFoundFile string) ([]byte, error) {
+
+	r, err := parseRemoteResource(resource)
+	if err != nil {
+		return nil, fmt.Errorf("ignoring resource %q: %s", resource, err)
+	}
+
+	// Resources pinned to a branch, or a commit, are not updated
+	if _, err := semver.NewVersion(r.Ref); err != nil {
+		return nil, fmt.Errorf("ignoring resource %q, as %q not a valid semver version", resource, r.Ref)
+	}
+
+	if len(k.spec.Ignore) > 0 {
+		if k.spec.Ignore.isMatchingRules(k.rootDir, relativeFoundFile, resourceKind, r.URL, r.Ref) {
+			logrus.Debugf("Ignoring remote resource %q from %q, as matching ignore rule(s)\n", r.URL, relativeFoundFile)
+			return nil, nil
+		}
+	}
+
+	if len(k.spec.Only) > 0 {
+		if !k.spec.Only.isMatchingRules(k.rootDir, relativeFoundFile, resourceKind, r.URL, r.Ref) {
+			logrus.Debugf("Ignoring remote resource %q from %q, as not matching only rule(s)\n", r.URL, relativeFoundFile)
+			return nil, nil
+		}
+	}
+
+	versionFilterKind, versionFilterPattern := k.getVersionFilter(r.Ref)
+
+	tmpl, err := template.New("manifest").Parse(resourceManifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	params := struct {
+		ResourceURL          string
+		ResourceScmID        string
+		SourceID             string
+		VersionFilterKind    string
+		VersionFilterPattern string
+		TargetID             string
+		TargetKey            string
+		TargetPrefix         string
+		TargetSuffix         string
+		File                 string
+		ScmID                string
+	}{
+		ResourceURL:          r.URL,
+		ResourceScmID:        resourceKind,
+		SourceID:             resourceKind,
+		VersionFilterKind:    versionFilterKind,
+		VersionFilterPattern: versionFilterPattern,
+		TargetID:             resourceKind,
+		TargetKey:            targetKey,
+		TargetPrefix:         escapeSingleQuote(r.Prefix),
+		TargetSuffix:         escapeSingleQuote(r.Suffix),
+		File:                 relativeFoundFile,
+		ScmID:                k.scmID,
+	}
+
+	manifest := bytes.Buffer{}
+	if err := tmpl.Execute(&manifest, params); err != nil {
+		return nil, err
+	}
+
+	return manifest.Bytes(), nil
+}
+
+// getVersionFilter returns the version filter used to retrieve the newest version,
+// the current version is converted to ">=x.y.z" or to the version filter defined in the manifest.
+func (k Kustomize) getVersionFilter(currentVersion string) (kind, pattern string) {
+	kind = k.versionFilter.Kind
+	pattern = ">=" + currentVersion
+
+	if !k.spec.VersionFilter.IsZero() {
+		var err error
+		pattern, err = k.versionFilter.GreaterThanPattern(currentVersion)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			pattern = "*"
+		}
+	}
+
+	return kind, pattern
+}
+
+// escapeSingleQuote escapes single quotes from a value used in a yaml single-quoted string
+func escapeSingleQuote(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
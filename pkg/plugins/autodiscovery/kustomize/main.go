@@ -0,0 +1,148 @@
+package kustomize
+
+import (
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/docker"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+var (
+	// DefaultKustomizeFiles specifies accepted Kustomization file names
+	DefaultKustomizeFiles []string = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+)
+
+// Spec defines the parameters which can be provided to the Kustomize crawler.
+type Spec struct {
+	// auths provides a map of registry credentials where the key is the registry URL without scheme
+	Auths map[string]docker.InlineKeyChain `yaml:",omitempty"`
+	// digest allows to update, using the dockerdigest resource, the digest of images pinned with the "digest" field.
+	//
+	// default: false
+	//
+	// remark:
+	//   Kustomize ignores the "newTag" field when the "digest" field is set,
+	//   so images pinned with a digest are ignored unless digest is enabled.
+	//   Images only pinned with a digest, without "newTag", are always ignored as their tag is unknown.
+	Digest *bool `yaml:",omitempty"`
+	// files allows to override default kustomization files
+	//
+	// default: ["kustomization.yaml", "kustomization.yml", "Kustomization"]
+	Files []string `yaml:",omitempty"`
+	// ignore allows to specify rule to ignore autodiscovery a specific Kustomize image, Helm chart, or remote resource based on a rule
+	//
+	// default: empty
+	//
+	Ignore MatchingRules `yaml:",omitempty"`
+	// only allows to specify rule to only autodiscover manifest for a specific Kustomize image, Helm chart, or remote resource based on a rule
+	//
+	// default: empty
+	//
+	Only MatchingRules `yaml:",omitempty"`
+	// rootDir defines the root directory used to recursively search for Kustomization files
+	//
+	// default: . (current working directory) or scm root directory
+	//
+	RootDir string `yaml:",omitempty"`
+	// versionfilter provides parameters to specify the version pattern used when generating manifest.
+	//
+	// kind - semver
+	//		versionfilter of kind `semver` uses semantic versioning as version filtering
+	//		pattern accepts one of:
+	//			`patch` - patch only update patch version
+	//			`minor` - minor only update minor version
+	//			`major` - major only update major versions
+	//			`a version constraint` such as `>= 1.0.0`
+	//
+	//	kind - regex
+	//		versionfilter of kind `regex` uses regular expression as version filtering
+	//		pattern accepts a valid regular expression
+	//
+	//	example:
+	//	```
+	//		versionfilter:
+	//			kind: semver
+	//			pattern: minor
+	//	```
+	//
+	//	and its type like regex, semver, or just latest.
+	//
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+// Kustomize holds all information needed to generate Kustomize manifests.
+type Kustomize struct {
+	// digest defines if the generated manifest should update image digests
+	digest bool
+	// files defines the accepted Kustomization file names
+	files []string
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for Kustomization files
+	rootDir string
+	// scmID hold the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid Kustomize object.
+func New(spec interface{}, rootDir, scmID string) (Kustomize, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Kustomize{}, err
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	// If no RootDir have been provided via settings,
+	// then fallback to the current process path.
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Kustomize{}, err
+	}
+
+	digest := false
+	if s.Digest != nil {
+		digest = *s.Digest
+	}
+
+	files := DefaultKustomizeFiles
+	if len(s.Files) > 0 {
+		files = s.Files
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		newFilter.Kind = version.SEMVERVERSIONKIND
+		newFilter.Pattern = "*"
+	}
+
+	return Kustomize{
+		digest:        digest,
+		files:         files,
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+	}, nil
+}
+
+func (k Kustomize) DiscoverManifests() ([][]byte, error) {
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle("Kustomize"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("Kustomize")+1))
+
+	return k.discoverKustomizeManifests()
+}
@@ -0,0 +1,211 @@
+package kustomize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	enabled := true
+
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "Image override, image pinned to a digest ignored",
+			rootDir: "testdata",
+			spec: Spec{
+				Only: MatchingRules{
+					{
+						Path:   "base/*",
+						Images: []string{"nginx", "alpine"},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'deps(kustomize): bump container image "nginx"'
+sources:
+  image:
+    name: 'get latest container image tag for "nginx"'
+    kind: 'dockerimage'
+    spec:
+      image: 'nginx'
+      tagfilter: '^\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=1.25.2'
+targets:
+  image:
+    name: 'deps(kustomize): bump container image "nginx" tag to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: 'base/kustomization.yaml'
+      key: '$.images[0].newTag'
+    sourceid: 'image'
+`},
+		},
+		{
+			name:    "Image override with digest",
+			rootDir: "testdata",
+			spec: Spec{
+				Digest: &enabled,
+				Only: MatchingRules{
+					{
+						Images: []string{"alpine"},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'deps(kustomize): bump container image "alpine"'
+sources:
+  image:
+    name: 'get latest container image tag for "alpine"'
+    kind: 'dockerimage'
+    spec:
+      image: 'alpine'
+      tagfilter: '^\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=3.18.4'
+  image-digest:
+    name: 'get latest container image digest for "alpine"'
+    kind: 'dockerdigest'
+    spec:
+      image: 'alpine'
+      tag: '{{ source "image" }}'
+      hidetag: true
+    dependson:
+      - 'image'
+targets:
+  image:
+    name: 'deps(kustomize): bump container image "alpine" tag to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: 'base/kustomization.yaml'
+      key: '$.images[2].newTag'
+    sourceid: 'image'
+  image-digest:
+    name: 'deps(kustomize): bump container image "alpine" digest'
+    kind: 'yaml'
+    spec:
+      file: 'base/kustomization.yaml'
+      key: '$.images[2].digest'
+    sourceid: 'image-digest'
+    transformers:
+      - trimprefix: '@'
+`},
+		},
+		{
+			name:    "Image override only pinned to a digest",
+			rootDir: "testdata",
+			spec: Spec{
+				Digest: &enabled,
+				Only: MatchingRules{
+					{
+						Images: []string{"redis"},
+					},
+				},
+			},
+		},
+		{
+			name:    "Helm chart",
+			rootDir: "testdata",
+			spec: Spec{
+				Only: MatchingRules{
+					{
+						HelmCharts: map[string]string{
+							"cert-manager": ">=1",
+						},
+					},
+				},
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+			},
+			expectedPipelines: []string{`name: 'deps(kustomize): bump Helm chart "cert-manager"'
+sources:
+  helmchart:
+    name: 'get latest "cert-manager" Helm chart version'
+    kind: 'helmchart'
+    spec:
+      name: 'cert-manager'
+      url: 'https://charts.jetstack.io'
+      versionfilter:
+        kind: 'semver'
+        pattern: '1.x'
+targets:
+  helmchart:
+    name: 'deps(kustomize): bump Helm chart "cert-manager" to {{ source "helmchart" }}'
+    kind: 'yaml'
+    spec:
+      file: 'base/kustomization.yaml'
+      key: '$.helmCharts[0].version'
+    sourceid: 'helmchart'
+`},
+		},
+		{
+			name:    "Remote resource",
+			rootDir: "testdata",
+			spec: Spec{
+				Only: MatchingRules{
+					{
+						Resources: map[string]string{
+							"https://gitlab.com/group/subgroup/manifests.git": "",
+						},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'deps(kustomize): bump remote resource "https://gitlab.com/group/subgroup/manifests.git"'
+scms:
+  resource:
+    kind: 'git'
+    spec:
+      url: 'https://gitlab.com/group/subgroup/manifests.git'
+sources:
+  resource:
+    name: 'get latest "https://gitlab.com/group/subgroup/manifests.git" git tag'
+    kind: 'gittag'
+    scmid: 'resource'
+    spec:
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=2.1.0'
+targets:
+  resource:
+    name: 'deps(kustomize): bump remote resource "https://gitlab.com/group/subgroup/manifests.git" ref to {{ source "resource" }}'
+    kind: 'yaml'
+    spec:
+      file: 'overlays/prod/kustomization.yml'
+      key: '$.resources[1]'
+    sourceid: 'resource'
+    transformers:
+      - addprefix: 'git::https://gitlab.com/group/subgroup/manifests.git/overlays/prod?timeout=120&ref='
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := New(tt.spec, tt.rootDir, "")
+			require.NoError(t, err)
+
+			var pipelines []string
+			rawPipelines, err := k.DiscoverManifests()
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tt.expectedPipelines), len(rawPipelines))
+
+			for i := range rawPipelines {
+				// We expect manifest generated by the autodiscovery to use the yaml syntax
+				pipelines = append(pipelines, string(rawPipelines[i]))
+				assert.Equal(t, tt.expectedPipelines[i], pipelines[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package kustomize
+
+const (
+	// imageManifestTemplate is the Go template used to generate Kustomize manifests updating an image override
+	imageManifestTemplate string = `name: 'deps(kustomize): bump container image "{{ .ImageName }}"'
+sources:
+  {{ .SourceID }}:
+    name: 'get latest container image tag for "{{ .ImageName }}"'
+    kind: 'dockerimage'
+    spec:
+      image: '{{ .ImageName }}'
+      tagfilter: '{{ .SourceTagFilter }}'
+      versionfilter:
+        kind: '{{ .VersionFilterKind }}'
+        pattern: '{{ .VersionFilterPattern }}'
+{{- if .Digest }}
+  {{ .SourceID }}-digest:
+    name: 'get latest container image digest for "{{ .ImageName }}"'
+    kind: 'dockerdigest'
+    spec:
+      image: '{{ .ImageName }}'
+      tag: '{{ "{{" }} source "{{ .SourceID }}" {{ "}}" }}'
+      hidetag: true
+    dependson:
+      - '{{ .SourceID }}'
+{{- end }}
+targets:
+  {{ .TargetID }}:
+    name: 'deps(kustomize): bump container image "{{ .ImageName }}" tag to {{ "{{" }} source "{{ .SourceID }}" {{ "}}" }}'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      file: '{{ .File }}'
+      key: '{{ .TargetKey }}.newTag'
+    sourceid: '{{ .SourceID }}'
+{{- if .Digest }}
+  {{ .TargetID }}-digest:
+    name: 'deps(kustomize): bump container image "{{ .ImageName }}" digest'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      file: '{{ .File }}'
+      key: '{{ .TargetKey }}.digest'
+    sourceid: '{{ .SourceID }}-digest'
+    transformers:
+      - trimprefix: '@'
+{{- end }}
+`
+	// helmChartManifestTemplate is the Go template used to generate Kustomize manifests updating a Helm chart
+	helmChartManifestTemplate string = `name: 'deps(kustomize): bump Helm chart "{{ .ChartName }}"'
+sources:
+  {{ .SourceID }}:
+    name: 'get latest "{{ .ChartName }}" Helm chart version'
+    kind: 'helmchart'
+    spec:
+      name: '{{ .ChartName }}'
+      url: '{{ .ChartRepository }}'
+      versionfilter:
+        kind: '{{ .VersionFilterKind }}'
+        pattern: '{{ .VersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: 'deps(kustomize): bump Helm chart "{{ .ChartName }}" to {{ "{{" }} source "{{ .SourceID }}" {{ "}}" }}'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      file: '{{ .File }}'
+      key: '{{ .TargetKey }}.version'
+    sourceid: '{{ .SourceID }}'
+`
+	// resourceManifestTemplate is the Go template used to generate Kustomize manifests updating a remote resource git ref
+	resourceManifestTemplate string = `name: 'deps(kustomize): bump remote resource "{{ .ResourceURL }}"'
+scms:
+  {{ .ResourceScmID }}:
+    kind: 'git'
+    spec:
+      url: '{{ .ResourceURL }}'
+sources:
+  {{ .SourceID }}:
+    name: 'get latest "{{ .ResourceURL }}" git tag'
+    kind: 'gittag'
+    scmid: '{{ .ResourceScmID }}'
+    spec:
+      versionfilter:
+        kind: '{{ .VersionFilterKind }}'
+        pattern: '{{ .VersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: 'deps(kustomize): bump remote resource "{{ .ResourceURL }}" ref to {{ "{{" }} source "{{ .SourceID }}" {{ "}}" }}'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      file: '{{ .File }}'
+      key: '{{ .TargetKey }}'
+    sourceid: '{{ .SourceID }}'
+    transformers:
+      - addprefix: '{{ .TargetPrefix }}'
+{{- if .TargetSuffix }}
+      - addsuffix: '{{ .TargetSuffix }}'
+{{- end }}
+`
+)
@@ -0,0 +1,150 @@
+package kustomize
+
+import (
+	"path/filepath"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// imageKind identifies a Kustomize image override
+	imageKind string = "image"
+	// helmChartKind identifies a Helm chart inflated by Kustomize
+	helmChartKind string = "helmchart"
+	// resourceKind identifies a remote Kustomize resource
+	resourceKind string = "resource"
+)
+
+// MatchingRule allows to specifies rules to identify manifest
+type MatchingRule struct {
+	// Path specifies a Kustomization file path pattern, the pattern requires to match all of name, not just a subpart of the path.
+	Path string
+	// Images specifies the list of container images, without tag, to check
+	Images []string
+	// HelmCharts specifies the list of Helm charts to check
+	//
+	// The key is the Helm chart name and the value is a semver constraint on the Helm chart version,
+	// if the value is empty, then the Helm chart name is enough to match
+	HelmCharts map[string]string
+	// Resources specifies the list of remote resource git repositories to check such as "https://github.com/kubernetes-sigs/kustomize"
+	//
+	// The key is the git repository url and the value is a semver constraint on the git ref,
+	// if the value is empty, then the git repository url is enough to match
+	Resources map[string]string
+}
+
+type MatchingRules []MatchingRule
+
+// isMatchingRules checks if a specific Kustomize image, Helm chart, or remote resource matches the rules
+func (m MatchingRules) isMatchingRules(rootDir, filePath, kind, name, version string) bool {
+	var ruleResults []bool
+
+	if len(m) > 0 {
+		for _, rule := range m {
+			/*
+				Check if rule.Path is matching. Path accepts wildcard path
+			*/
+
+			if rule.Path != "" {
+				if filepath.IsAbs(rule.Path) {
+					filePath = filepath.Join(rootDir, filePath)
+				}
+
+				match, err := filepath.Match(rule.Path, filePath)
+				if err != nil {
+					logrus.Errorf("%s - %q", err, rule.Path)
+					continue
+				}
+				ruleResults = append(ruleResults, match)
+				if match {
+					logrus.Debugf("file path %q matching rule %q", filePath, rule.Path)
+				}
+			}
+
+			/*
+				Checks if the container image is matching the policy constraint.
+			*/
+
+			if len(rule.Images) > 0 {
+				match := false
+				if kind == imageKind {
+					for i := range rule.Images {
+						if name == rule.Images[i] {
+							match = true
+							break
+						}
+					}
+				}
+				ruleResults = append(ruleResults, match)
+			}
+
+			/*
+				Checks if the Helm chart is matching the policy constraint.
+			*/
+
+			if len(rule.HelmCharts) > 0 {
+				match := false
+				if kind == helmChartKind {
+					match = isMatchingVersions(rule.HelmCharts, name, version)
+				}
+				ruleResults = append(ruleResults, match)
+			}
+
+			/*
+				Checks if the remote resource is matching the policy constraint.
+			*/
+
+			if len(rule.Resources) > 0 {
+				match := false
+				if kind == resourceKind {
+					match = isMatchingVersions(rule.Resources, name, version)
+				}
+				ruleResults = append(ruleResults, match)
+			}
+
+			/*
+				If at least one rule is failing then we return false
+			*/
+			isAllMatching := true
+			for i := range ruleResults {
+				if !ruleResults[i] {
+					isAllMatching = false
+				}
+			}
+			if isAllMatching {
+				return true
+			}
+			ruleResults = []bool{}
+		}
+		return false
+	}
+
+	return false
+}
+
+// isMatchingVersions checks if name is defined in rules and its version matches the associated constraint
+func isMatchingVersions(rules map[string]string, name, version string) bool {
+	constraint, found := rules[name]
+	if !found {
+		return false
+	}
+
+	if constraint == "" {
+		return true
+	}
+
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		logrus.Debugf("%q - %s", version, err)
+		return version == constraint
+	}
+
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		logrus.Debugf("%q %s", err, constraint)
+		return version == constraint
+	}
+
+	return c.Check(v)
+}
@@ -0,0 +1,109 @@
+package kustomize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRule(t *testing.T) {
+
+	dataset := []struct {
+		name           string
+		rules          MatchingRules
+		filePath       string
+		kind           string
+		artifact       string
+		version        string
+		expectedResult bool
+	}{
+		{
+			name: "Matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "base/*",
+				},
+			},
+			filePath:       "base/kustomization.yaml",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "base/*",
+				},
+			},
+			filePath:       "overlays/prod/kustomization.yaml",
+			expectedResult: false,
+		},
+		{
+			name: "Matching image",
+			rules: MatchingRules{
+				MatchingRule{
+					Images: []string{"nginx"},
+				},
+			},
+			filePath:       "base/kustomization.yaml",
+			kind:           imageKind,
+			artifact:       "nginx",
+			version:        "1.25.2",
+			expectedResult: true,
+		},
+		{
+			name: "Image rule not matching Helm chart",
+			rules: MatchingRules{
+				MatchingRule{
+					Images: []string{"nginx"},
+				},
+			},
+			filePath:       "base/kustomization.yaml",
+			kind:           helmChartKind,
+			artifact:       "nginx",
+			version:        "1.25.2",
+			expectedResult: false,
+		},
+		{
+			name: "Matching Helm chart version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					HelmCharts: map[string]string{
+						"cert-manager": ">=1",
+					},
+				},
+			},
+			filePath:       "base/kustomization.yaml",
+			kind:           helmChartKind,
+			artifact:       "cert-manager",
+			version:        "v1.13.1",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching remote resource version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Resources: map[string]string{
+						"https://github.com/kubernetes-sigs/kustomize": "<1",
+					},
+				},
+			},
+			filePath:       "base/kustomization.yaml",
+			kind:           resourceKind,
+			artifact:       "https://github.com/kubernetes-sigs/kustomize",
+			version:        "v1.0.6",
+			expectedResult: false,
+		},
+	}
+
+	for _, d := range dataset {
+		t.Run(d.name, func(t *testing.T) {
+			gotResult := d.rules.isMatchingRules(
+				"",
+				d.filePath,
+				d.kind,
+				d.artifact,
+				d.version)
+			assert.Equal(t, d.expectedResult, gotResult)
+		})
+	}
+}
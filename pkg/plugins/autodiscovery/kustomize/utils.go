@@ -0,0 +1,194 @@
+package kustomize
+
+import (
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// kustomization holds the Kustomization fields that could be automatically updated
+type kustomization struct {
+	// Images holds the image overrides
+	Images []kustomizeImage `yaml:"images"`
+	// HelmCharts holds the Helm charts inflated by Kustomize
+	HelmCharts []helmChart `yaml:"helmCharts"`
+	// Resources holds the local, or remote, resources
+	Resources []string `yaml:"resources"`
+}
+
+// kustomizeImage holds a Kustomization image override
+type kustomizeImage struct {
+	// Name holds the image name to override
+	Name string `yaml:"name"`
+	// NewName holds the image name replacing the original one
+	NewName string `yaml:"newName"`
+	// NewTag holds the image tag replacing the original one
+	NewTag string `yaml:"newTag"`
+	// Digest holds the image digest replacing the original tag
+	Digest string `yaml:"digest"`
+}
+
+// helmChart holds a Helm chart inflated by Kustomize
+type helmChart struct {
+	// Name holds the Helm chart name
+	Name string `yaml:"name"`
+	// Repo holds the Helm chart repository url
+	Repo string `yaml:"repo"`
+	// Version holds the Helm chart version
+	Version string `yaml:"version"`
+}
+
+// remoteResource holds a remote Kustomize resource pinned to a git ref such as
+// "https://github.com/kubernetes-sigs/kustomize//examples/multibases?ref=v1.0.6"
+type remoteResource struct {
+	// URL holds the git repository url
+	URL string
+	// Ref holds the git ref
+	Ref string
+	// Prefix holds the resource content located before the ref
+	Prefix string
+	// Suffix holds the resource content located after the ref
+	Suffix string
+}
+
+// searchKustomizeFiles will look, recursively, for every Kustomization files from a root directory.
+func searchKustomizeFiles(rootDir string, files []string) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for Kustomization file(s) in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		if d.IsDir() && (d.Name() == ".git" || d.Name() == "node_modules") {
+			return filepath.SkipDir
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		for _, f := range files {
+			match, err := filepath.Match(f, d.Name())
+			if err != nil {
+				logrus.Debugf("%s - %q", err, f)
+				continue
+			}
+
+			if match {
+				foundFiles = append(foundFiles, path)
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debugf("%d Kustomization file(s) found", len(foundFiles))
+	for _, foundFile := range foundFiles {
+		logrus.Debugf("    * %q", foundFile)
+	}
+
+	return foundFiles, nil
+}
+
+// loadKustomization reads a Kustomization file for information that could be automatically updated.
+func loadKustomization(filename string) (*kustomization, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var data kustomization
+	if err := yaml.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// parseRemoteResource parses a remote resource pinned to a git ref such as
+// "github.com/kubernetes-sigs/kustomize/examples/multibases?ref=v1.0.6"
+// or "https://github.com/kubernetes-sigs/kustomize//examples/multibases?ref=v1.0.6"
+func parseRemoteResource(resource string) (remoteResource, error) {
+
+	base, query, found := strings.Cut(resource, "?")
+	if !found {
+		return remoteResource{}, fmt.Errorf("no git ref specified")
+	}
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return remoteResource{}, err
+	}
+
+	ref := values.Get("ref")
+	if ref == "" {
+		return remoteResource{}, fmt.Errorf("no git ref specified")
+	}
+
+	// The ref value is the only part of the resource updated by the generated manifest
+	index := strings.Index(query, "ref="+ref)
+	if index < 0 || (index > 0 && query[index-1] != '&') {
+		return remoteResource{}, fmt.Errorf("ref %q not supported", ref)
+	}
+
+	r := remoteResource{
+		Ref:    ref,
+		Prefix: base + "?" + query[:index] + "ref=",
+		Suffix: query[index+len("ref="+ref):],
+	}
+
+	base = strings.TrimPrefix(base, "git::")
+
+	scheme := "https://"
+	switch {
+	case strings.HasPrefix(base, "https://"):
+		base = strings.TrimPrefix(base, "https://")
+	case strings.HasPrefix(base, "http://"):
+		scheme = "http://"
+		base = strings.TrimPrefix(base, "http://")
+	case strings.Contains(base, "://") || strings.HasPrefix(base, "git@"):
+		return remoteResource{}, fmt.Errorf("only http remote resources are supported")
+	}
+
+	repository := base
+	switch {
+	// The repository is explicitly separated from the path such as "github.com/owner/repository//path"
+	case strings.Contains(base, "//"):
+		repository, _, _ = strings.Cut(base, "//")
+	// The repository ends with ".git" such as "gitlab.com/group/subgroup/repository.git/path"
+	case strings.Contains(base, ".git/"):
+		repository, _, _ = strings.Cut(base, ".git/")
+		repository += ".git"
+	default:
+		segments := strings.Split(base, "/")
+		if len(segments) > 3 {
+			segments = segments[:3]
+		}
+		repository = strings.Join(segments, "/")
+	}
+
+	segments := strings.Split(strings.TrimSuffix(repository, "/"), "/")
+	if len(segments) < 3 || !strings.Contains(segments[0], ".") {
+		return remoteResource{}, fmt.Errorf("wrong remote resource %q", resource)
+	}
+
+	r.URL = scheme + strings.TrimSuffix(repository, "/")
+
+	return r, nil
+}
@@ -0,0 +1,77 @@
+package kustomize
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchKustomizeFiles(t *testing.T) {
+	foundFiles, err := searchKustomizeFiles("testdata", DefaultKustomizeFiles)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		filepath.Join("testdata", "base", "kustomization.yaml"),
+		filepath.Join("testdata", "overlays", "prod", "kustomization.yml"),
+	}, foundFiles)
+}
+
+func TestParseRemoteResource(t *testing.T) {
+	testdata := []struct {
+		resource         string
+		expectedResource remoteResource
+		expectedError    bool
+	}{
+		{
+			resource: "https://github.com/kubernetes-sigs/kustomize//examples/multibases?ref=v1.0.6",
+			expectedResource: remoteResource{
+				URL:    "https://github.com/kubernetes-sigs/kustomize",
+				Ref:    "v1.0.6",
+				Prefix: "https://github.com/kubernetes-sigs/kustomize//examples/multibases?ref=",
+			},
+		},
+		{
+			resource: "github.com/argoproj/argo-cd/manifests/cluster-install?ref=v2.8.4&timeout=90",
+			expectedResource: remoteResource{
+				URL:    "https://github.com/argoproj/argo-cd",
+				Ref:    "v2.8.4",
+				Prefix: "github.com/argoproj/argo-cd/manifests/cluster-install?ref=",
+				Suffix: "&timeout=90",
+			},
+		},
+		{
+			resource: "git::https://gitlab.com/group/subgroup/manifests.git/overlays/prod?ref=2.1.0",
+			expectedResource: remoteResource{
+				URL:    "https://gitlab.com/group/subgroup/manifests.git",
+				Ref:    "2.1.0",
+				Prefix: "git::https://gitlab.com/group/subgroup/manifests.git/overlays/prod?ref=",
+			},
+		},
+		{
+			resource:      "git@github.com:argoproj/argo-cd.git/manifests?ref=v2.8.4",
+			expectedError: true,
+		},
+		{
+			resource:      "github.com/argoproj/argo-cd/manifests?timeout=90",
+			expectedError: true,
+		},
+		{
+			resource:      "../base?ref=v1.0.0",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.resource, func(t *testing.T) {
+			got, err := parseRemoteResource(tt.resource)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResource, got)
+		})
+	}
+}
@@ -28,6 +28,14 @@ type Spec struct {
 				`major` - major only update major versions
 				`a version constraint` such as `>= 1.0.0`
 
+		kind - maven
+			versionfilter of kind `maven` uses the maven version ordering as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a version range` such as `[1.0,2.0)` or `>=1.0,<2`
+
 		kind - regex
 			versionfilter of kind `regex` uses regular expression as version filtering
 			pattern accepts a valid regular expression
@@ -0,0 +1,145 @@
+package nuget
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func (n Nuget) discoverPackageManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	searchFromDir := n.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if n.spec.RootDir != "" && !path.IsAbs(n.spec.RootDir) {
+		searchFromDir = filepath.Join(n.rootDir, n.spec.RootDir)
+	}
+
+	foundFiles, err := searchNugetFiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+
+		logrus.Debugf("parsing file %q", foundFile)
+
+		relativeFoundFile, err := filepath.Rel(n.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		dependencies, err := getDependencies(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		if len(dependencies) == 0 {
+			logrus.Debugf("no NuGet package found in %q\n", foundFile)
+			continue
+		}
+
+		for _, d := range dependencies {
+
+			if !isPinnedVersion(d.Version) {
+				logrus.Debugf("Ignoring NuGet package %q from %q. Version %q is not a specific version", d.Name, relativeFoundFile, d.Version)
+				continue
+			}
+
+			if len(n.spec.Ignore) > 0 {
+				if n.spec.Ignore.isMatchingRules(n.rootDir, relativeFoundFile, d.Name, d.Version) {
+					logrus.Debugf("Ignoring NuGet package %q from %q, as matching ignore rule(s)\n", d.Name, relativeFoundFile)
+					continue
+				}
+			}
+
+			if len(n.spec.Only) > 0 {
+				if !n.spec.Only.isMatchingRules(n.rootDir, relativeFoundFile, d.Name, d.Version) {
+					logrus.Debugf("Ignoring NuGet package %q from %q, as not matching only rule(s)\n", d.Name, relativeFoundFile)
+					continue
+				}
+			}
+
+			sourceVersionFilterKind, sourceVersionFilterPattern := n.getVersionFilter(d)
+
+			params := struct {
+				ManifestName               string
+				SourceID                   string
+				SourceName                 string
+				PackageName                string
+				SourceVersionFilterKind    string
+				SourceVersionFilterPattern string
+				TargetID                   string
+				TargetName                 string
+				TargetXMLPath              string
+				File                       string
+				ScmID                      string
+			}{
+				ManifestName:               fmt.Sprintf("Bump %q NuGet package version", d.Name),
+				SourceID:                   "nuget",
+				SourceName:                 fmt.Sprintf("Get %q NuGet package version", d.Name),
+				PackageName:                d.Name,
+				SourceVersionFilterKind:    sourceVersionFilterKind,
+				SourceVersionFilterPattern: sourceVersionFilterPattern,
+				TargetID:                   "nuget",
+				TargetName:                 fmt.Sprintf("Bump %q NuGet package version to {{ source \"nuget\" }}", d.Name),
+				TargetXMLPath:              d.Path,
+				File:                       relativeFoundFile,
+				ScmID:                      n.scmID,
+			}
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// getVersionFilter returns the version filter used to retrieve the newest package version
+func (n Nuget) getVersionFilter(d dependency) (kind, pattern string) {
+	kind = version.SEMVERVERSIONKIND
+	pattern = ">=" + d.Version
+
+	if !n.spec.VersionFilter.IsZero() {
+		var err error
+		kind = n.versionFilter.Kind
+		pattern, err = n.versionFilter.GreaterThanPattern(d.Version)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			pattern = "*"
+		}
+		return kind, pattern
+	}
+
+	// Some packages don't follow semantic versioning, such as "4.3.0.1"
+	if _, err := semver.NewConstraint(pattern); err != nil {
+		logrus.Debugf("NuGet package %q: version constraint %q not supported, fallback to latest version", d.Name, pattern)
+		return version.LATESTVERSIONKIND, version.LATESTVERSIONKIND
+	}
+
+	return kind, pattern
+}
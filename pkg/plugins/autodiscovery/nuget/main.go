@@ -0,0 +1,107 @@
+package nuget
+
+import (
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines the parameters which can be provided to the NuGet crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for .NET project files
+	RootDir string `yaml:",omitempty"`
+	// Ignore specifies rule to ignore .NET project files update.
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only specify required rule to restrict .NET project files update.
+	Only MatchingRules `yaml:",omitempty"`
+	/*
+		`versionfilter` provides parameters to specify the version pattern to use when generating manifest.
+
+		kind - semver
+			versionfilter of kind `semver` uses semantic versioning as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a version constraint` such as `>= 1.0.0`
+
+		kind - regex
+			versionfilter of kind `regex` uses regular expression as version filtering
+			pattern accepts a valid regular expression
+
+		example:
+		```
+			versionfilter:
+				kind: semver
+				pattern: minor
+		```
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+// Nuget holds all information needed to generate NuGet manifests.
+type Nuget struct {
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for .NET project files
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID string) (Nuget, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Nuget{}, err
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Nuget{}, err
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		// By default, NuGet versions follow semantic versioning
+		newFilter.Kind = version.SEMVERVERSIONKIND
+		newFilter.Pattern = "*"
+	}
+
+	return Nuget{
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+	}, nil
+}
+
+// DiscoverManifests returns the manifests updating NuGet package versions
+func (n Nuget) DiscoverManifests() ([][]byte, error) {
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle("NuGet"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("NuGet")+1))
+
+	manifests, err := n.discoverPackageManifests()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return manifests, nil
+}
@@ -0,0 +1,164 @@
+package nuget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "Central package management",
+			rootDir: "testdata/central",
+			expectedPipelines: []string{`name: 'Bump "Microsoft.Extensions.Logging" NuGet package version'
+sources:
+  nuget:
+    name: 'Get "Microsoft.Extensions.Logging" NuGet package version'
+    kind: 'nuget'
+    spec:
+      name: 'Microsoft.Extensions.Logging'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=8.0.0'
+targets:
+  nuget:
+    name: 'Bump "Microsoft.Extensions.Logging" NuGet package version to {{ source "nuget" }}'
+    kind: 'xml'
+    spec:
+      file: 'Directory.Packages.props'
+      path: "/Project/ItemGroup/PackageVersion[@Include='Microsoft.Extensions.Logging']/@Version"
+    sourceid: 'nuget'
+`},
+		},
+		{
+			name:    "Project files and packages.config",
+			rootDir: "testdata/src",
+			spec: Spec{
+				Ignore: MatchingRules{
+					{
+						Packages: map[string]string{
+							"newtonsoft.json": "<13",
+						},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump "Newtonsoft.Json" NuGet package version'
+sources:
+  nuget:
+    name: 'Get "Newtonsoft.Json" NuGet package version'
+    kind: 'nuget'
+    spec:
+      name: 'Newtonsoft.Json'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=13.0.1'
+targets:
+  nuget:
+    name: 'Bump "Newtonsoft.Json" NuGet package version to {{ source "nuget" }}'
+    kind: 'xml'
+    spec:
+      file: 'App/App.csproj'
+      path: "/Project/ItemGroup/PackageReference[@Include='Newtonsoft.Json']/@Version"
+    sourceid: 'nuget'
+`, `name: 'Bump "xunit" NuGet package version'
+sources:
+  nuget:
+    name: 'Get "xunit" NuGet package version'
+    kind: 'nuget'
+    spec:
+      name: 'xunit'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=2.6.6'
+targets:
+  nuget:
+    name: 'Bump "xunit" NuGet package version to {{ source "nuget" }}'
+    kind: 'xml'
+    spec:
+      file: 'App/App.csproj'
+      path: "/Project/ItemGroup/PackageReference[@Include='xunit']/Version"
+    sourceid: 'nuget'
+`, `name: 'Bump "NUnit" NuGet package version'
+sources:
+  nuget:
+    name: 'Get "NUnit" NuGet package version'
+    kind: 'nuget'
+    spec:
+      name: 'NUnit'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=3.14.0'
+targets:
+  nuget:
+    name: 'Bump "NUnit" NuGet package version to {{ source "nuget" }}'
+    kind: 'xml'
+    spec:
+      file: 'Legacy/packages.config'
+      path: "/packages/package[@id='NUnit']/@version"
+    sourceid: 'nuget'
+`},
+		},
+		{
+			name:    "Only rule with version filter",
+			rootDir: "testdata/src",
+			spec: Spec{
+				Only: MatchingRules{
+					{
+						Path: "Legacy/*",
+					},
+				},
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump "NUnit" NuGet package version'
+sources:
+  nuget:
+    name: 'Get "NUnit" NuGet package version'
+    kind: 'nuget'
+    spec:
+      name: 'NUnit'
+      versionfilter:
+        kind: 'semver'
+        pattern: '3.x'
+targets:
+  nuget:
+    name: 'Bump "NUnit" NuGet package version to {{ source "nuget" }}'
+    kind: 'xml'
+    spec:
+      file: 'Legacy/packages.config'
+      path: "/packages/package[@id='NUnit']/@version"
+    sourceid: 'nuget'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := New(tt.spec, tt.rootDir, "")
+			require.NoError(t, err)
+
+			var pipelines []string
+			rawPipelines, err := n.DiscoverManifests()
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tt.expectedPipelines), len(rawPipelines))
+
+			for i := range rawPipelines {
+				// We expect manifest generated by the autodiscovery to use the yaml syntax
+				pipelines = append(pipelines, string(rawPipelines[i]))
+				assert.Equal(t, tt.expectedPipelines[i], pipelines[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package nuget
+
+var (
+	// manifestTemplate is the Go template used to generate NuGet manifests
+	manifestTemplate string = `name: '{{ .ManifestName }}'
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'nuget'
+    spec:
+      name: '{{ .PackageName }}'
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+    kind: 'xml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      file: '{{ .File }}'
+      path: "{{ .TargetXMLPath }}"
+    sourceid: '{{ .SourceID }}'
+`
+)
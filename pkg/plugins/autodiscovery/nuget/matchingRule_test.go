@@ -0,0 +1,94 @@
+package nuget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRule(t *testing.T) {
+
+	dataset := []struct {
+		name           string
+		rules          MatchingRules
+		filePath       string
+		packageName    string
+		packageVersion string
+		expectedResult bool
+	}{
+		{
+			name: "Matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "src/*/*.csproj",
+				},
+			},
+			filePath:       "src/App/App.csproj",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "src/*/*.csproj",
+				},
+			},
+			filePath:       "Directory.Packages.props",
+			expectedResult: false,
+		},
+		{
+			name: "Matching package name ignoring case",
+			rules: MatchingRules{
+				MatchingRule{
+					Packages: map[string]string{
+						"newtonsoft.json": "",
+					},
+				},
+			},
+			filePath:       "src/App/App.csproj",
+			packageName:    "Newtonsoft.Json",
+			packageVersion: "13.0.1",
+			expectedResult: true,
+		},
+		{
+			name: "Matching package version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "src/*/*.csproj",
+					Packages: map[string]string{
+						"Newtonsoft.Json": ">=13",
+					},
+				},
+			},
+			filePath:       "src/App/App.csproj",
+			packageName:    "Newtonsoft.Json",
+			packageVersion: "13.0.1",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching package version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Packages: map[string]string{
+						"Newtonsoft.Json": "<13",
+					},
+				},
+			},
+			filePath:       "src/App/App.csproj",
+			packageName:    "Newtonsoft.Json",
+			packageVersion: "13.0.1",
+			expectedResult: false,
+		},
+	}
+
+	for _, d := range dataset {
+		t.Run(d.name, func(t *testing.T) {
+			gotResult := d.rules.isMatchingRules(
+				"",
+				d.filePath,
+				d.packageName,
+				d.packageVersion)
+			assert.Equal(t, d.expectedResult, gotResult)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package nuget
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// packagesPropsFile is the file used by the central package management to define package versions
+	packagesPropsFile string = "Directory.Packages.props"
+	// packagesConfigFile is the legacy file listing the packages used by a project
+	packagesConfigFile string = "packages.config"
+)
+
+var (
+	// projectFileExtensions lists the extensions of the project files using PackageReference
+	projectFileExtensions = []string{".csproj", ".fsproj", ".vbproj"}
+)
+
+// dependency describes a NuGet package referenced by a file
+type dependency struct {
+	Name    string
+	Version string
+	// Path is the xpath query selecting the package version
+	Path string
+}
+
+// searchNugetFiles looks, recursively, for every .NET project file, Directory.Packages.props,
+// and packages.config from a root directory.
+func searchNugetFiles(rootDir string) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for NuGet files in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		// Build outputs may contain copies of project files
+		if d.IsDir() && (d.Name() == "bin" || d.Name() == "obj" || d.Name() == "node_modules") {
+			return filepath.SkipDir
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		if d.Name() == packagesPropsFile || d.Name() == packagesConfigFile || isProjectFile(d.Name()) {
+			foundFiles = append(foundFiles, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return foundFiles, nil
+}
+
+// isProjectFile returns true if the filename is a .NET project file
+func isProjectFile(filename string) bool {
+	for _, ext := range projectFileExtensions {
+		if strings.EqualFold(filepath.Ext(filename), ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// getDependencies returns the NuGet packages, with their version, specified by a file
+func getDependencies(filename string) ([]dependency, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromFile(filename); err != nil {
+		return nil, err
+	}
+
+	switch filepath.Base(filename) {
+	case packagesConfigFile:
+		return getElementDependencies(doc, "/packages/package", "id", "version"), nil
+	case packagesPropsFile:
+		return getElementDependencies(doc, "/Project/ItemGroup/PackageVersion", "Include", "Version"), nil
+	}
+
+	return getElementDependencies(doc, "/Project/ItemGroup/PackageReference", "Include", "Version"), nil
+}
+
+// getElementDependencies returns the packages defined by the elements matching the path,
+// the version is either an attribute or a child element
+func getElementDependencies(doc *etree.Document, path, nameAttr, versionAttr string) []dependency {
+	var dependencies []dependency
+
+	found := map[string]bool{}
+
+	for _, elem := range doc.FindElements(path) {
+		name := elem.SelectAttrValue(nameAttr, "")
+		if name == "" {
+			continue
+		}
+
+		// The xml target only updates the first element matching the xpath query
+		if found[strings.ToLower(name)] {
+			logrus.Debugf("Ignoring duplicated NuGet package %q", name)
+			continue
+		}
+		found[strings.ToLower(name)] = true
+
+		d := dependency{
+			Name: name,
+		}
+
+		switch {
+		case elem.SelectAttr(versionAttr) != nil:
+			d.Version = elem.SelectAttrValue(versionAttr, "")
+			d.Path = fmt.Sprintf("%s[@%s='%s']/@%s", path, nameAttr, name, versionAttr)
+		case elem.SelectElement(versionAttr) != nil:
+			d.Version = elem.SelectElement(versionAttr).Text()
+			d.Path = fmt.Sprintf("%s[@%s='%s']/%s", path, nameAttr, name, versionAttr)
+		default:
+			// The version is defined by the central package management
+			continue
+		}
+
+		d.Version = strings.TrimSpace(d.Version)
+		dependencies = append(dependencies, d)
+	}
+
+	return dependencies
+}
+
+// isPinnedVersion returns true if the version isn't a version range, a floating version, or a MSBuild property
+// https://learn.microsoft.com/en-us/nuget/concepts/package-versioning#version-ranges
+func isPinnedVersion(v string) bool {
+	return v != "" && !strings.ContainsAny(v, "$[]()*,")
+}
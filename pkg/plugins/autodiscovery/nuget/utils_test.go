@@ -0,0 +1,73 @@
+package nuget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDependencies(t *testing.T) {
+	dependencies, err := getDependencies("testdata/src/App/App.csproj")
+	require.NoError(t, err)
+
+	expectedDependencies := []dependency{
+		{
+			Name:    "Newtonsoft.Json",
+			Version: "13.0.1",
+			Path:    "/Project/ItemGroup/PackageReference[@Include='Newtonsoft.Json']/@Version",
+		},
+		{
+			Name:    "Serilog",
+			Version: "$(SerilogVersion)",
+			Path:    "/Project/ItemGroup/PackageReference[@Include='Serilog']/@Version",
+		},
+		{
+			Name:    "Polly",
+			Version: "8.*",
+			Path:    "/Project/ItemGroup/PackageReference[@Include='Polly']/@Version",
+		},
+		{
+			Name:    "xunit",
+			Version: "2.6.6",
+			Path:    "/Project/ItemGroup/PackageReference[@Include='xunit']/Version",
+		},
+	}
+
+	assert.Equal(t, expectedDependencies, dependencies)
+}
+
+func TestIsPinnedVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected bool
+	}{
+		{version: "13.0.1", expected: true},
+		{version: "4.3.0.1", expected: true},
+		{version: "8.0.0-rc.1", expected: true},
+		{version: "8.*", expected: false},
+		{version: "[4.4.1,5.0)", expected: false},
+		{version: "$(SerilogVersion)", expected: false},
+		{version: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isPinnedVersion(tt.version))
+		})
+	}
+}
+
+func TestGetVersionFilter(t *testing.T) {
+	n, err := New(Spec{}, "testdata", "")
+	require.NoError(t, err)
+
+	kind, pattern := n.getVersionFilter(dependency{Name: "Newtonsoft.Json", Version: "13.0.1"})
+	assert.Equal(t, "semver", kind)
+	assert.Equal(t, ">=13.0.1", pattern)
+
+	// Versions with four segments don't follow semantic versioning
+	kind, pattern = n.getVersionFilter(dependency{Name: "System.Runtime", Version: "4.3.0.1"})
+	assert.Equal(t, "latest", kind)
+	assert.Equal(t, "latest", pattern)
+}
@@ -0,0 +1,225 @@
+package pip
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+)
+
+// pypiIndexURL is the PyPI package index
+const pypiIndexURL string = "https://pypi.org/simple"
+
+// lockFiles defines, for each file, the lock files that must be updated
+// with the command generating them
+var lockFiles = map[string][]struct {
+	File    string
+	Command string
+}{
+	PyprojectFile: {
+		{File: "poetry.lock", Command: "poetry"},
+		{File: "uv.lock", Command: "uv"},
+	},
+	PipFile: {
+		{File: "Pipfile.lock", Command: "pipenv"},
+	},
+}
+
+func (p Pip) discoverDependencyManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	searchFromDir := p.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if p.spec.RootDir != "" && !path.IsAbs(p.spec.RootDir) {
+		searchFromDir = filepath.Join(p.rootDir, p.spec.RootDir)
+	}
+
+	foundFiles, err := searchPythonFiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+
+		logrus.Debugf("parsing file %q", foundFile)
+
+		relativeFoundFile, err := filepath.Rel(p.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		// It doesn't make sense to update a file if Updatecli can't update its lock file
+		lockFile, lockCommand, found := "", "", false
+		for _, l := range lockFiles[filepath.Base(foundFile)] {
+			if _, err := os.Stat(filepath.Join(filepath.Dir(foundFile), l.File)); err != nil {
+				continue
+			}
+			found = true
+			if _, err := exec.LookPath(l.Command); err != nil {
+				logrus.Warningf("skipping, %s lock file detected but Updatecli couldn't detect the %s command to update it in case of a %s update", l.File, l.Command, filepath.Base(foundFile))
+				continue
+			}
+			lockFile, lockCommand = l.File, l.Command
+			break
+		}
+		if found && lockFile == "" {
+			continue
+		}
+
+		data, err := parseFile(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		if len(data.Dependencies) == 0 {
+			logrus.Debugf("no python package found in %q\n", foundFile)
+			continue
+		}
+
+		// The pypi resource already defaults to PyPI
+		indexURL := data.IndexURL
+		if strings.TrimSuffix(indexURL, "/") == pypiIndexURL {
+			indexURL = ""
+		}
+
+		for _, d := range data.Dependencies {
+			if d.Hashed {
+				logrus.Debugf("Ignoring python package %q from %q. Requirements pinned using hashes should be updated using pip-compile", d.Name, relativeFoundFile)
+				continue
+			}
+
+			sourceVersionFilterKind, sourceVersionFilterPattern, currentVersion, ok := p.getVersionFilter(d)
+			if !ok {
+				continue
+			}
+
+			if len(p.spec.Ignore) > 0 {
+				if p.spec.Ignore.isMatchingRules(p.rootDir, relativeFoundFile, d.Name, currentVersion) {
+					logrus.Debugf("Ignoring python package %q from %q, as matching ignore rule(s)\n", d.Name, relativeFoundFile)
+					continue
+				}
+			}
+
+			if len(p.spec.Only) > 0 {
+				if !p.spec.Only.isMatchingRules(p.rootDir, relativeFoundFile, d.Name, currentVersion) {
+					logrus.Debugf("Ignoring python package %q from %q, as not matching only rule(s)\n", d.Name, relativeFoundFile)
+					continue
+				}
+			}
+
+			params := struct {
+				ManifestName               string
+				SourceID                   string
+				SourceName                 string
+				PackageName                string
+				IndexURL                   string
+				SourceVersionFilterKind    string
+				SourceVersionFilterPattern string
+				TargetID                   string
+				TargetName                 string
+				File                       string
+				LockFile                   string
+				LockCommand                string
+				TargetWorkdir              string
+				ScmID                      string
+			}{
+				ManifestName:               fmt.Sprintf("Bump %q python package version", d.Name),
+				SourceID:                   "pypi",
+				SourceName:                 fmt.Sprintf("Get %q python package version", d.Name),
+				PackageName:                d.Name,
+				IndexURL:                   indexURL,
+				SourceVersionFilterKind:    sourceVersionFilterKind,
+				SourceVersionFilterPattern: sourceVersionFilterPattern,
+				TargetID:                   "pypi",
+				TargetName:                 fmt.Sprintf("Bump %q python package version to {{ source \"pypi\" }}", d.Name),
+				File:                       relativeFoundFile,
+				LockFile:                   lockFile,
+				LockCommand:                getLockCommand(lockCommand, d.Name),
+				TargetWorkdir:              filepath.Dir(relativeFoundFile),
+				ScmID:                      p.scmID,
+			}
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// getVersionFilter returns the version filter used to retrieve the newest package version
+// while respecting the constraint specified by the dependency
+func (p Pip) getVersionFilter(d dependency) (kind, pattern, currentVersion string, ok bool) {
+	m := constraintRegex.FindStringSubmatch(d.Constraint)
+	if m == nil {
+		logrus.Debugf("Ignoring python package %q. Its version constraint %q is handled by the package manager", d.Name, d.Constraint)
+		return "", "", "", false
+	}
+
+	operator, currentVersion := m[1], m[2]
+
+	switch operator {
+	case "~=":
+		return "pep440", "~=" + currentVersion, currentVersion, true
+
+	case "^", "~":
+		pattern, err := poetryConstraintToPEP440(operator, currentVersion)
+		if err != nil {
+			logrus.Debugf("Ignoring python package %q: %s", d.Name, err)
+			return "", "", "", false
+		}
+		return "pep440", pattern, currentVersion, true
+	}
+
+	// The package is pinned to a specific version
+	kind = "pep440"
+	pattern = ">=" + currentVersion
+
+	if !p.spec.VersionFilter.IsZero() {
+		var err error
+		kind = p.versionFilter.Kind
+		pattern, err = p.versionFilter.GreaterThanPattern(currentVersion)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			pattern = "*"
+		}
+	}
+
+	return kind, pattern, currentVersion, true
+}
+
+// getLockCommand returns the command updating the lock file once the package is updated
+func getLockCommand(cmd, packageName string) string {
+	switch cmd {
+	case "poetry":
+		return fmt.Sprintf("poetry update --lock %s", packageName)
+	case "uv":
+		return "uv lock"
+	case "pipenv":
+		return "pipenv lock"
+	}
+	return ""
+}
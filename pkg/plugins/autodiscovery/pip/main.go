@@ -0,0 +1,111 @@
+package pip
+
+import (
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines the parameters which can be provided to the pip crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for requirements*.txt, pyproject.toml and Pipfile
+	RootDir string `yaml:",omitempty"`
+	// Ignore allows to specify rule to ignore autodiscovery a specific python package based on a rule
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only allows to specify rule to only autodiscover manifest for a specific python package based on a rule
+	Only MatchingRules `yaml:",omitempty"`
+	/*
+		versionfilter provides parameters to specify the version pattern used when generating manifest.
+
+		kind - pep440
+			versionfilter of kind `pep440` uses python versioning as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a version specifier` such as `>=1.0.0`
+
+		kind - regex
+			versionfilter of kind `regex` uses regular expression as version filtering
+			pattern accepts a valid regular expression
+
+		example:
+		```
+			versionfilter:
+				kind: pep440
+				pattern: minor
+		```
+
+		remark:
+			The versionfilter only applies to pinned packages such as `requests==2.31.0`,
+			packages using a specifier such as `~=2.31.0` keep using that specifier.
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+// Pip holds all information needed to generate python package manifests.
+type Pip struct {
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for python files
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID string) (Pip, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Pip{}, err
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Pip{}, err
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		// By default, python packages follow PEP 440 versioning
+		newFilter.Kind = version.PEP440VERSIONKIND
+		newFilter.Pattern = "*"
+	}
+
+	return Pip{
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+	}, nil
+}
+
+// DiscoverManifests returns the manifests updating python packages
+func (p Pip) DiscoverManifests() ([][]byte, error) {
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle("pip"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("pip")+1))
+
+	manifests, err := p.discoverDependencyManifests()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return manifests, nil
+}
@@ -0,0 +1,184 @@
+package pip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "Pipfile",
+			rootDir: "testdata/pipenv",
+			expectedPipelines: []string{`name: 'Bump "black" python package version'
+sources:
+  pypi:
+    name: 'Get "black" python package version'
+    kind: 'pypi'
+    spec:
+      name: 'black'
+      versionfilter:
+        kind: 'pep440'
+        pattern: '>=23.7.0'
+targets:
+  pypi:
+    name: 'Bump "black" python package version to {{ source "pypi" }}'
+    kind: 'pypi'
+    spec:
+      name: 'black'
+      file: 'Pipfile'
+    sourceid: 'pypi'
+`, `name: 'Bump "requests" python package version'
+sources:
+  pypi:
+    name: 'Get "requests" python package version'
+    kind: 'pypi'
+    spec:
+      name: 'requests'
+      versionfilter:
+        kind: 'pep440'
+        pattern: '>=2.31.0'
+targets:
+  pypi:
+    name: 'Bump "requests" python package version to {{ source "pypi" }}'
+    kind: 'pypi'
+    spec:
+      name: 'requests'
+      file: 'Pipfile'
+    sourceid: 'pypi'
+`},
+		},
+		{
+			name:    "PEP 621 and Poetry with a versionfilter",
+			rootDir: "testdata/poetry",
+			spec: Spec{
+				Only: MatchingRules{
+					MatchingRule{
+						Packages: map[string]string{
+							"httpx":    "",
+							"pydantic": ">=2",
+							"rich":     "<13",
+						},
+					},
+				},
+				VersionFilter: version.Filter{
+					Kind:    "pep440",
+					Pattern: "minor",
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump "httpx" python package version'
+sources:
+  pypi:
+    name: 'Get "httpx" python package version'
+    kind: 'pypi'
+    spec:
+      name: 'httpx'
+      versionfilter:
+        kind: 'pep440'
+        pattern: '>=0.25.0,<1'
+targets:
+  pypi:
+    name: 'Bump "httpx" python package version to {{ source "pypi" }}'
+    kind: 'pypi'
+    spec:
+      name: 'httpx'
+      file: 'pyproject.toml'
+    sourceid: 'pypi'
+`, `name: 'Bump "pydantic" python package version'
+sources:
+  pypi:
+    name: 'Get "pydantic" python package version'
+    kind: 'pypi'
+    spec:
+      name: 'pydantic'
+      versionfilter:
+        kind: 'pep440'
+        pattern: '>=2.4.2,<3'
+targets:
+  pypi:
+    name: 'Bump "pydantic" python package version to {{ source "pypi" }}'
+    kind: 'pypi'
+    spec:
+      name: 'pydantic'
+      file: 'pyproject.toml'
+    sourceid: 'pypi'
+`},
+		},
+		{
+			name:    "Requirements file",
+			rootDir: "testdata",
+			spec: Spec{
+				Ignore: MatchingRules{
+					MatchingRule{
+						Path: "p*/*",
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump "requests" python package version'
+sources:
+  pypi:
+    name: 'Get "requests" python package version'
+    kind: 'pypi'
+    spec:
+      name: 'requests'
+      indexurl: 'https://pypi.example.com/simple/'
+      versionfilter:
+        kind: 'pep440'
+        pattern: '>=2.30.0'
+targets:
+  pypi:
+    name: 'Bump "requests" python package version to {{ source "pypi" }}'
+    kind: 'pypi'
+    spec:
+      name: 'requests'
+      file: 'requirements.txt'
+    sourceid: 'pypi'
+`, `name: 'Bump "Django" python package version'
+sources:
+  pypi:
+    name: 'Get "Django" python package version'
+    kind: 'pypi'
+    spec:
+      name: 'Django'
+      indexurl: 'https://pypi.example.com/simple/'
+      versionfilter:
+        kind: 'pep440'
+        pattern: '~=4.2.0'
+targets:
+  pypi:
+    name: 'Bump "Django" python package version to {{ source "pypi" }}'
+    kind: 'pypi'
+    spec:
+      name: 'Django'
+      file: 'requirements.txt'
+    sourceid: 'pypi'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(tt.spec, tt.rootDir, "")
+			require.NoError(t, err)
+
+			rawPipelines, err := p.DiscoverManifests()
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.expectedPipelines), len(rawPipelines))
+
+			for i := range rawPipelines {
+				// We expect manifest generated by the autodiscovery to use the yaml syntax
+				assert.Equal(t, tt.expectedPipelines[i], string(rawPipelines[i]))
+			}
+		})
+	}
+}
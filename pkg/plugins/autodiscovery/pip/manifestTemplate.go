@@ -0,0 +1,52 @@
+package pip
+
+var (
+	// manifestTemplate is the Go template used to generate python package manifests
+	manifestTemplate string = `name: '{{ .ManifestName }}'
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'pypi'
+    spec:
+      name: '{{ .PackageName }}'
+{{- if .IndexURL }}
+      indexurl: '{{ .IndexURL }}'
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+    kind: 'pypi'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      name: '{{ .PackageName }}'
+      file: '{{ .File }}'
+    sourceid: '{{ .SourceID }}'
+{{- if .LockFile }}
+  {{ .LockFile }}:
+    name: 'Update {{ .LockFile }}'
+    dependson:
+      - {{ .TargetID }}
+    disablesourceinput: true
+    kind: shell
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      command: '{{ .LockCommand }}'
+      environments:
+        - name: PATH
+        - name: HOME
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - '{{ .LockFile }}'
+      workdir: '{{ .TargetWorkdir }}'
+{{- end }}
+`
+)
@@ -0,0 +1,95 @@
+package pip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRule(t *testing.T) {
+
+	dataset := []struct {
+		name           string
+		rules          MatchingRules
+		filePath       string
+		packageName    string
+		packageVersion string
+		expectedResult bool
+	}{
+		{
+			name: "Matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "testdata/requirements.txt",
+				},
+			},
+			filePath:       "testdata/requirements.txt",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "testdata/requirements.txt",
+				},
+			},
+			filePath:       "./website/testdata/requirements.txt",
+			expectedResult: false,
+		},
+		{
+			name: "Matching normalized package name",
+			rules: MatchingRules{
+				MatchingRule{
+					Packages: map[string]string{
+						"Zope.Interface": "",
+					},
+				},
+			},
+			filePath:       "testdata/requirements.txt",
+			packageName:    "zope-interface",
+			packageVersion: "6.0",
+			expectedResult: true,
+		},
+		{
+			name: "Matching package version specifier",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "testdata/*.txt",
+					Packages: map[string]string{
+						"django": ">=4,<5",
+					},
+				},
+			},
+			filePath:       "testdata/requirements.txt",
+			packageName:    "django",
+			packageVersion: "4.2.0",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching package version specifier",
+			rules: MatchingRules{
+				MatchingRule{
+					Packages: map[string]string{
+						"django": ">=5",
+					},
+				},
+			},
+			filePath:       "testdata/requirements.txt",
+			packageName:    "django",
+			packageVersion: "4.2.0",
+			expectedResult: false,
+		},
+	}
+
+	for _, d := range dataset {
+		t.Run(d.name, func(t *testing.T) {
+			gotResult := d.rules.isMatchingRules(
+				"",
+				d.filePath,
+				d.packageName,
+				d.packageVersion)
+
+			assert.Equal(t, d.expectedResult, gotResult)
+		})
+	}
+}
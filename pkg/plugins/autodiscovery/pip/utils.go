@@ -0,0 +1,362 @@
+package pip
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// PyprojectFile is the file defining PEP 621 and Poetry dependencies
+	PyprojectFile string = "pyproject.toml"
+	// PipFile is the file defining Pipenv dependencies
+	PipFile string = "Pipfile"
+)
+
+var (
+	// nameSeparatorRegex matches the runs of characters replaced by PEP 503 name normalization
+	nameSeparatorRegex = regexp.MustCompile(`[-_.]+`)
+	// requirementRegex matches a PEP 508 requirement without environment markers
+	requirementRegex = regexp.MustCompile(`^([A-Za-z0-9](?:[A-Za-z0-9._-]*[A-Za-z0-9])?)\s*(?:\[[^\]]*\])?\s*\(?\s*([^)]*?)\s*\)?$`)
+	// constraintRegex matches a version constraint using a single version
+	constraintRegex = regexp.MustCompile(`^(===|==|~=|\^|~|=)?\s*([0-9][^\s,|*]*)$`)
+	// ignoredDirectories lists the directories containing installed packages
+	ignoredDirectories = []string{".git", ".tox", ".nox", ".venv", "venv", "node_modules", "site-packages", "__pycache__"}
+)
+
+// dependency describes a python package required by a file
+type dependency struct {
+	// Name is the package name as written in the file
+	Name string
+	// Constraint is the version constraint such as "==1.0.0", "~=1.0" or the Poetry "^1.0"
+	Constraint string
+	// Hashed is true if the requirement is pinned using hashes
+	Hashed bool
+}
+
+// pythonFile describes the dependencies defined by a file
+type pythonFile struct {
+	Dependencies []dependency
+	// IndexURL is the package index configured by the file
+	IndexURL string
+}
+
+// normalizeName returns the PEP 503 normalized form of a package name
+func normalizeName(name string) string {
+	return strings.ToLower(nameSeparatorRegex.ReplaceAllString(name, "-"))
+}
+
+// isRequirementsFile returns true if the file name looks like a pip requirements file
+func isRequirementsFile(name string) bool {
+	return strings.HasPrefix(name, "requirements") && strings.HasSuffix(name, ".txt")
+}
+
+// searchPythonFiles looks, recursively, for every requirements*.txt, pyproject.toml and Pipfile from a root directory.
+func searchPythonFiles(rootDir string) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for python files in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		if d.IsDir() {
+			for _, ignored := range ignoredDirectories {
+				if d.Name() == ignored {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		if d.Name() == PyprojectFile || d.Name() == PipFile || isRequirementsFile(d.Name()) {
+			foundFiles = append(foundFiles, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return foundFiles, nil
+}
+
+// parseFile returns the dependencies defined by a requirements*.txt, pyproject.toml or Pipfile
+func parseFile(filename string) (pythonFile, error) {
+	switch filepath.Base(filename) {
+	case PyprojectFile:
+		return parsePyprojectFile(filename)
+	case PipFile:
+		return parsePipFile(filename)
+	default:
+		return parseRequirementsFile(filename)
+	}
+}
+
+// parseRequirement parses a PEP 508 requirement
+func parseRequirement(requirement string) (dependency, bool) {
+	requirement, _, _ = strings.Cut(requirement, ";")
+	requirement = strings.TrimSpace(requirement)
+
+	// Direct references such as "name @ https://..." are not versioned
+	if strings.Contains(requirement, "@") {
+		logrus.Debugf("Ignoring requirement %q. Updating direct references is not supported at this time", requirement)
+		return dependency{}, false
+	}
+
+	m := requirementRegex.FindStringSubmatch(requirement)
+	if m == nil {
+		logrus.Debugf("Ignoring requirement %q. Not a valid requirement", requirement)
+		return dependency{}, false
+	}
+
+	return dependency{
+		Name:       m[1],
+		Constraint: strings.ReplaceAll(m[2], " ", ""),
+	}, true
+}
+
+// parseRequirementsFile parses a pip requirements file
+func parseRequirementsFile(filename string) (pythonFile, error) {
+	var result pythonFile
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return result, err
+	}
+
+	// Join continuation lines
+	content := strings.ReplaceAll(string(data), "\\\r\n", " ")
+	content = strings.ReplaceAll(content, "\\\n", " ")
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "-") {
+			option, value, _ := strings.Cut(line, " ")
+			if option == "-i" || option == "--index-url" {
+				result.IndexURL = strings.TrimSpace(value)
+			} else if strings.HasPrefix(option, "--index-url=") {
+				result.IndexURL = strings.TrimPrefix(option, "--index-url=")
+			}
+			continue
+		}
+
+		requirement, options, _ := strings.Cut(line, " --")
+		d, ok := parseRequirement(requirement)
+		if !ok {
+			continue
+		}
+		d.Hashed = strings.HasPrefix(options, "hash")
+
+		result.Dependencies = append(result.Dependencies, d)
+	}
+
+	return result, nil
+}
+
+// parsePyprojectFile parses PEP 621, PEP 735 and Poetry dependencies from a pyproject.toml file
+func parsePyprojectFile(filename string) (pythonFile, error) {
+	var result pythonFile
+	var data map[string]interface{}
+
+	if _, err := toml.DecodeFile(filename, &data); err != nil {
+		return result, err
+	}
+
+	addRequirements := func(requirements interface{}) {
+		list, ok := requirements.([]interface{})
+		if !ok {
+			return
+		}
+		for _, r := range list {
+			// Dependency groups can include other groups using a table
+			requirement, ok := r.(string)
+			if !ok {
+				continue
+			}
+			if d, ok := parseRequirement(requirement); ok {
+				result.Dependencies = append(result.Dependencies, d)
+			}
+		}
+	}
+
+	project := getTable(data, "project")
+	addRequirements(project["dependencies"])
+
+	optionalDependencies := getTable(project, "optional-dependencies")
+	for _, extra := range sortedKeys(optionalDependencies) {
+		addRequirements(optionalDependencies[extra])
+	}
+
+	dependencyGroups := getTable(data, "dependency-groups")
+	for _, group := range sortedKeys(dependencyGroups) {
+		addRequirements(dependencyGroups[group])
+	}
+
+	poetry := getTable(getTable(data, "tool"), "poetry")
+
+	poetryTables := []map[string]interface{}{
+		getTable(poetry, "dependencies"),
+		getTable(poetry, "dev-dependencies"),
+	}
+	groups := getTable(poetry, "group")
+	for _, group := range sortedKeys(groups) {
+		poetryTables = append(poetryTables, getTable(getTable(groups, group), "dependencies"))
+	}
+
+	for _, table := range poetryTables {
+		for _, name := range sortedKeys(table) {
+			if name == "python" {
+				continue
+			}
+			if constraint, ok := getVersion(table[name]); ok {
+				result.Dependencies = append(result.Dependencies, dependency{
+					Name:       name,
+					Constraint: constraint,
+				})
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// parsePipFile parses the packages of every Pipfile category
+func parsePipFile(filename string) (pythonFile, error) {
+	var result pythonFile
+	var data map[string]interface{}
+
+	if _, err := toml.DecodeFile(filename, &data); err != nil {
+		return result, err
+	}
+
+	if sources, ok := data["source"].([]map[string]interface{}); ok && len(sources) > 0 {
+		if url, ok := sources[0]["url"].(string); ok {
+			result.IndexURL = url
+		}
+	}
+
+	for _, category := range sortedKeys(data) {
+		switch category {
+		case "source", "requires", "pipenv", "scripts":
+			continue
+		}
+
+		packages := getTable(data, category)
+		for _, name := range sortedKeys(packages) {
+			if constraint, ok := getVersion(packages[name]); ok {
+				result.Dependencies = append(result.Dependencies, dependency{
+					Name:       name,
+					Constraint: constraint,
+				})
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// getTable returns the TOML table named key, or an empty one
+func getTable(data map[string]interface{}, key string) map[string]interface{} {
+	table, ok := data[key].(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{}
+	}
+	return table
+}
+
+// getVersion returns the version constraint of a Poetry or Pipfile dependency,
+// defined either as a string or as a table with a version key
+func getVersion(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return strings.ReplaceAll(v, " ", ""), true
+	case map[string]interface{}:
+		if constraint, ok := v["version"].(string); ok {
+			return strings.ReplaceAll(constraint, " ", ""), true
+		}
+	}
+	return "", false
+}
+
+// sortedKeys returns the keys of a TOML table in alphabetical order
+func sortedKeys(table map[string]interface{}) []string {
+	keys := make([]string, 0, len(table))
+	for key := range table {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// releaseSegments returns the leading numeric segments of a version such as [1 2 3] for "1.2.3rc1"
+func releaseSegments(version string) []int {
+	var segments []int
+	for _, s := range strings.Split(version, ".") {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			break
+		}
+		segments = append(segments, i)
+	}
+	return segments
+}
+
+// poetryConstraintToPEP440 converts a Poetry caret or tilde requirement into a PEP 440 specifier
+// https://python-poetry.org/docs/dependency-specification/#version-constraints
+func poetryConstraintToPEP440(operator, version string) (string, error) {
+	segments := releaseSegments(version)
+	if len(segments) == 0 {
+		return "", fmt.Errorf("version %q doesn't start with a number", version)
+	}
+
+	upper := []int{}
+	switch operator {
+	case "^":
+		// The first non-zero segment is bumped
+		i := 0
+		for i < len(segments)-1 && segments[i] == 0 {
+			i++
+		}
+		upper = append(upper, segments[:i]...)
+		upper = append(upper, segments[i]+1)
+	case "~":
+		// The minor version is bumped if specified, otherwise the major one
+		if len(segments) == 1 {
+			upper = append(upper, segments[0]+1)
+		} else {
+			upper = append(upper, segments[0], segments[1]+1)
+		}
+	default:
+		return "", fmt.Errorf("poetry operator %q not supported", operator)
+	}
+
+	upperSegments := []string{}
+	for _, s := range upper {
+		upperSegments = append(upperSegments, strconv.Itoa(s))
+	}
+
+	return fmt.Sprintf(">=%s,<%s", version, strings.Join(upperSegments, ".")), nil
+}
@@ -0,0 +1,110 @@
+package pip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRequirement(t *testing.T) {
+	tests := []struct {
+		requirement string
+		expected    dependency
+		expectedOK  bool
+	}{
+		{requirement: "requests==2.31.0", expected: dependency{Name: "requests", Constraint: "==2.31.0"}, expectedOK: true},
+		{requirement: `requests [security] == 2.31.0 ; python_version < "3.8"`, expected: dependency{Name: "requests", Constraint: "==2.31.0"}, expectedOK: true},
+		{requirement: "zope.interface (>=5.0,<6)", expected: dependency{Name: "zope.interface", Constraint: ">=5.0,<6"}, expectedOK: true},
+		{requirement: "flask", expected: dependency{Name: "flask"}, expectedOK: true},
+		{requirement: "mylib @ https://example.com/mylib-1.0.0.tar.gz"},
+		{requirement: "./local/path"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.requirement, func(t *testing.T) {
+			got, ok := parseRequirement(tt.requirement)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestParseFile(t *testing.T) {
+	tests := []struct {
+		file     string
+		expected pythonFile
+	}{
+		{
+			file: "testdata/requirements.txt",
+			expected: pythonFile{
+				IndexURL: "https://pypi.example.com/simple/",
+				Dependencies: []dependency{
+					{Name: "requests", Constraint: "==2.30.0"},
+					{Name: "Django", Constraint: "~=4.2.0"},
+					{Name: "flask", Constraint: ">=2.0,<3.0"},
+					{Name: "urllib3", Constraint: "==1.26.18", Hashed: true},
+				},
+			},
+		},
+		{
+			file: "testdata/poetry/pyproject.toml",
+			expected: pythonFile{
+				Dependencies: []dependency{
+					{Name: "httpx", Constraint: "==0.25.0"},
+					{Name: "pydantic", Constraint: "^2.4.2"},
+					{Name: "rich", Constraint: "~13.6"},
+					{Name: "pytest", Constraint: "7.4.0"},
+				},
+			},
+		},
+		{
+			file: "testdata/pipenv/Pipfile",
+			expected: pythonFile{
+				IndexURL: "https://pypi.org/simple",
+				Dependencies: []dependency{
+					{Name: "black", Constraint: "==23.7.0"},
+					{Name: "flask", Constraint: "*"},
+					{Name: "requests", Constraint: "==2.31.0"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			got, err := parseFile(tt.file)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestPoetryConstraintToPEP440(t *testing.T) {
+	tests := []struct {
+		operator string
+		version  string
+		expected string
+		wantErr  bool
+	}{
+		{operator: "^", version: "1.2.3", expected: ">=1.2.3,<2"},
+		{operator: "^", version: "0.2.3", expected: ">=0.2.3,<0.3"},
+		{operator: "^", version: "0.0.3", expected: ">=0.0.3,<0.0.4"},
+		{operator: "^", version: "0", expected: ">=0,<1"},
+		{operator: "~", version: "1.2.3", expected: ">=1.2.3,<1.3"},
+		{operator: "~", version: "1", expected: ">=1,<2"},
+		{operator: "~", version: "latest", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.operator+tt.version, func(t *testing.T) {
+			got, err := poetryConstraintToPEP440(tt.operator, tt.version)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
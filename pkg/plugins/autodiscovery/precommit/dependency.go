@@ -0,0 +1,185 @@
+package precommit
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultGitUsername is the username used to clone a git repository when only a token is provided
+	defaultGitUsername string = "oauth2"
+)
+
+func (p PreCommit) discoverHookManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	searchFromDir := p.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if p.spec.RootDir != "" && !path.IsAbs(p.spec.RootDir) {
+		searchFromDir = filepath.Join(p.rootDir, p.spec.RootDir)
+	}
+
+	foundFiles, err := searchConfigFiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+
+		logrus.Debugf("parsing file %q", foundFile)
+
+		relativeFoundFile, err := filepath.Rel(p.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		hooks, err := getHooks(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		if len(hooks) == 0 {
+			logrus.Debugf("no hook found in %q\n", foundFile)
+			continue
+		}
+
+		for _, h := range hooks {
+
+			if len(p.spec.Ignore) > 0 {
+				if p.spec.Ignore.isMatchingRules(p.rootDir, relativeFoundFile, h.Repo, h.Version) {
+					logrus.Debugf("Ignoring hook %q from %q, as matching ignore rule(s)\n", h.Repo, relativeFoundFile)
+					continue
+				}
+			}
+
+			if len(p.spec.Only) > 0 {
+				if !p.spec.Only.isMatchingRules(p.rootDir, relativeFoundFile, h.Repo, h.Version) {
+					logrus.Debugf("Ignoring hook %q from %q, as not matching only rule(s)\n", h.Repo, relativeFoundFile)
+					continue
+				}
+			}
+
+			params := struct {
+				ManifestName               string
+				HookScmID                  string
+				HookScmURL                 string
+				SourceID                   string
+				SourceName                 string
+				SourceKind                 string
+				SourceVersionFilterKind    string
+				SourceVersionFilterPattern string
+				CommitSourceID             string
+				CommitSourceName           string
+				Commit                     string
+				Owner                      string
+				Repository                 string
+				Username                   string
+				Token                      string
+				TargetID                   string
+				TargetName                 string
+				TargetKey                  string
+				TargetMatchPattern         string
+				TargetReplacePattern       string
+				File                       string
+				ScmID                      string
+			}{
+				ManifestName:     fmt.Sprintf("Bump pre-commit hook %q", h.Repo),
+				HookScmID:        "hook",
+				SourceID:         "release",
+				SourceName:       fmt.Sprintf("Get latest pre-commit hook %q version", h.Repo),
+				CommitSourceID:   "commit",
+				CommitSourceName: fmt.Sprintf("Get pre-commit hook %q commit for version {{ source \"release\" }}", h.Repo),
+				Commit:           h.Commit,
+				TargetID:         "hook",
+				TargetName:       fmt.Sprintf("Bump pre-commit hook %q to {{ source \"release\" }}", h.Repo),
+				TargetKey:        h.Key,
+				File:             relativeFoundFile,
+				ScmID:            p.scmID,
+			}
+
+			params.SourceVersionFilterKind, params.SourceVersionFilterPattern = p.getVersionFilter(h.Version)
+
+			credential, found := p.spec.Credentials[getHost(h.Repo)]
+			if found {
+				params.Username = credential.Username
+				params.Token = credential.Token
+			}
+
+			switch {
+			// GitHub releases don't provide the commit associated to a release
+			case found && credential.Kind == githubreleaseKind && h.Commit == "":
+				owner, repository, err := getOwnerRepository(h.Repo)
+				if err != nil {
+					logrus.Debugln(err)
+					continue
+				}
+				params.SourceKind = githubreleaseKind
+				params.Owner = owner
+				params.Repository = repository
+			default:
+				params.SourceKind = gittagKind
+				params.HookScmURL = h.Repo
+				if params.Token != "" && params.Username == "" {
+					params.Username = defaultGitUsername
+				}
+			}
+
+			if h.Commit != "" {
+				params.TargetMatchPattern = escapeSingleQuote(`(rev:\s*["']?)` + regexp.QuoteMeta(h.Commit) + `(["']?\s*#\s*frozen:\s*)\S+`)
+				params.TargetReplacePattern = `${1}{{ source "commit" }}${2}{{ source "release" }}`
+			}
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// getVersionFilter returns the version filter used to retrieve the newest hook version,
+// the current version is converted to ">=x.y.z" or to the version filter defined in the manifest.
+func (p PreCommit) getVersionFilter(currentVersion string) (kind, pattern string) {
+	kind = p.versionFilter.Kind
+	pattern = ">=" + currentVersion
+
+	if !p.spec.VersionFilter.IsZero() {
+		var err error
+		pattern, err = p.versionFilter.GreaterThanPattern(currentVersion)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			pattern = "*"
+		}
+	}
+
+	return kind, pattern
+}
+
+// escapeSingleQuote escapes single quotes from a value used in a yaml single-quoted string
+func escapeSingleQuote(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
@@ -0,0 +1,149 @@
+package precommit
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// gittagKind retrieves hook versions from the hook repository git tags
+	gittagKind string = "gittag"
+	// githubreleaseKind retrieves hook versions from the hook repository GitHub releases
+	githubreleaseKind string = "githubrelease"
+)
+
+// Spec defines the parameters which can be provided to the pre-commit crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for pre-commit configuration files
+	RootDir string `yaml:",omitempty"`
+	// Ignore specifies rule to ignore hook update.
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only specify required rule to restrict hook update.
+	Only MatchingRules `yaml:",omitempty"`
+	/*
+		`versionfilter` provides parameters to specify the version pattern to use when generating manifest.
+
+		kind - semver
+			versionfilter of kind `semver` uses semantic versioning as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a version constraint` such as `>= 1.0.0`
+
+		kind - regex
+			versionfilter of kind `regex` uses regular expression as version filtering
+			pattern accepts a valid regular expression
+
+		example:
+		```
+			versionfilter:
+				kind: semver
+				pattern: minor
+		```
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	/*
+		`credentials` defines the credentials used to retrieve hook versions, where the key is the git server hostname such as `github.com`
+
+		example:
+		```
+			credentials:
+				github.com:
+					kind: githubrelease
+					token: '{{ requiredEnv "GITHUB_TOKEN" }}'
+				gitlab.example.com:
+					username: 'oauth2'
+					token: '{{ requiredEnv "GITLAB_TOKEN" }}'
+		```
+
+		remark:
+			Hooks pinned to a frozen commit are always retrieved from the hook repository git tags,
+			as GitHub releases don't provide the commit associated to a release.
+	*/
+	Credentials map[string]Credential `yaml:",omitempty"`
+}
+
+// Credential defines the credentials used to interact with a git server
+type Credential struct {
+	// Kind specifies how hook versions are retrieved, accepted values are "gittag" and "githubrelease", default to "gittag"
+	Kind string `yaml:",omitempty"`
+	// Username specifies the username used to authenticate with the git server
+	Username string `yaml:",omitempty"`
+	// Token specifies the token used to authenticate with the git server
+	Token string `yaml:",omitempty"`
+}
+
+// PreCommit holds all information needed to generate pre-commit manifests.
+type PreCommit struct {
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for pre-commit configuration files
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID string) (PreCommit, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return PreCommit{}, err
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return PreCommit{}, err
+	}
+
+	for host, credential := range s.Credentials {
+		switch credential.Kind {
+		case "":
+			credential.Kind = gittagKind
+			s.Credentials[host] = credential
+		case gittagKind, githubreleaseKind:
+		default:
+			return PreCommit{}, fmt.Errorf("credential kind %q for %q not supported, accepted values are %q and %q",
+				credential.Kind, host, gittagKind, githubreleaseKind)
+		}
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		// By default, pre-commit hooks are versioned using semantic versioning
+		newFilter.Kind = version.SEMVERVERSIONKIND
+		newFilter.Pattern = "*"
+	}
+
+	return PreCommit{
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+	}, nil
+}
+
+func (p PreCommit) DiscoverManifests() ([][]byte, error) {
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle("pre-commit"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("pre-commit")+1))
+
+	return p.discoverHookManifests()
+}
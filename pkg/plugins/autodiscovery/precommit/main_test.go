@@ -0,0 +1,184 @@
+package precommit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "Hook pinned to a tag",
+			rootDir: "testdata",
+			spec: Spec{
+				Only: MatchingRules{
+					{
+						Path: "sub/*",
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump pre-commit hook "https://gitlab.com/vojko.pribudic.foss/pre-commit-update"'
+scms:
+  hook:
+    kind: 'git'
+    spec:
+      url: 'https://gitlab.com/vojko.pribudic.foss/pre-commit-update'
+sources:
+  release:
+    name: 'Get latest pre-commit hook "https://gitlab.com/vojko.pribudic.foss/pre-commit-update" version'
+    kind: 'gittag'
+    scmid: 'hook'
+    spec:
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=v0.1.1'
+targets:
+  hook:
+    name: 'Bump pre-commit hook "https://gitlab.com/vojko.pribudic.foss/pre-commit-update" to {{ source "release" }}'
+    kind: 'yaml'
+    spec:
+      file: 'sub/.pre-commit-config.yml'
+      key: '$.repos[0].rev'
+    sourceid: 'release'
+`},
+		},
+		{
+			name:    "Hook frozen to a commit",
+			rootDir: "testdata",
+			spec: Spec{
+				Credentials: map[string]Credential{
+					"github.com": {
+						Kind:  "githubrelease",
+						Token: "secret",
+					},
+				},
+				Only: MatchingRules{
+					{
+						Repos: map[string]string{
+							"https://github.com/psf/black": "",
+						},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump pre-commit hook "https://github.com/psf/black"'
+scms:
+  hook:
+    kind: 'git'
+    spec:
+      url: 'https://github.com/psf/black'
+      username: 'oauth2'
+      password: 'secret'
+sources:
+  release:
+    name: 'Get latest pre-commit hook "https://github.com/psf/black" version'
+    kind: 'gittag'
+    scmid: 'hook'
+    spec:
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=23.9.1'
+  commit:
+    name: 'Get pre-commit hook "https://github.com/psf/black" commit for version {{ source "release" }}'
+    kind: 'gittag'
+    scmid: 'hook'
+    spec:
+      key: 'commit'
+      versionfilter:
+        kind: 'latest'
+        pattern: '{{ source "release" }}'
+    dependson:
+      - 'release'
+targets:
+  hook:
+    name: 'Bump pre-commit hook "https://github.com/psf/black" to {{ source "release" }}'
+    kind: 'file'
+    spec:
+      file: '.pre-commit-config.yaml'
+      matchpattern: '(rev:\s*["'']?)8fe627072f15ff2e3d380887b92f7868efaf6d05(["'']?\s*#\s*frozen:\s*)\S+'
+      replacepattern: '${1}{{ source "commit" }}${2}{{ source "release" }}'
+    sourceid: 'release'
+`},
+		},
+		{
+			name:    "GitHub release",
+			rootDir: "testdata",
+			spec: Spec{
+				Credentials: map[string]Credential{
+					"github.com": {
+						Kind:  "githubrelease",
+						Token: "secret",
+					},
+				},
+				Only: MatchingRules{
+					{
+						Repos: map[string]string{
+							"https://github.com/pre-commit/pre-commit-hooks": ">=4",
+						},
+					},
+				},
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump pre-commit hook "https://github.com/pre-commit/pre-commit-hooks"'
+sources:
+  release:
+    name: 'Get latest pre-commit hook "https://github.com/pre-commit/pre-commit-hooks" version'
+    kind: 'githubrelease'
+    spec:
+      owner: 'pre-commit'
+      repository: 'pre-commit-hooks'
+      token: 'secret'
+      versionfilter:
+        kind: 'semver'
+        pattern: '4.x'
+targets:
+  hook:
+    name: 'Bump pre-commit hook "https://github.com/pre-commit/pre-commit-hooks" to {{ source "release" }}'
+    kind: 'yaml'
+    spec:
+      file: '.pre-commit-config.yaml'
+      key: '$.repos[0].rev'
+    sourceid: 'release'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(tt.spec, tt.rootDir, "")
+			require.NoError(t, err)
+
+			var pipelines []string
+			rawPipelines, err := p.DiscoverManifests()
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tt.expectedPipelines), len(rawPipelines))
+
+			for i := range rawPipelines {
+				// We expect manifest generated by the autodiscovery to use the yaml syntax
+				pipelines = append(pipelines, string(rawPipelines[i]))
+				assert.Equal(t, tt.expectedPipelines[i], pipelines[i])
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Spec{
+		Credentials: map[string]Credential{
+			"github.com": {Kind: "gitea"},
+		},
+	}, "testdata", "")
+	assert.Error(t, err)
+}
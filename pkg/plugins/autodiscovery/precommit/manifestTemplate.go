@@ -0,0 +1,70 @@
+package precommit
+
+var (
+	// manifestTemplate is the Go template used to generate pre-commit manifests
+	manifestTemplate string = `name: '{{ .ManifestName }}'
+{{- if .HookScmURL }}
+scms:
+  {{ .HookScmID }}:
+    kind: 'git'
+    spec:
+      url: '{{ .HookScmURL }}'
+{{- if .Token }}
+      username: '{{ .Username }}'
+      password: '{{ .Token }}'
+{{- end }}
+{{- end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: '{{ .SourceKind }}'
+{{- if .HookScmURL }}
+    scmid: '{{ .HookScmID }}'
+{{- end }}
+    spec:
+{{- if eq .SourceKind "githubrelease" }}
+      owner: '{{ .Owner }}'
+      repository: '{{ .Repository }}'
+{{- if .Username }}
+      username: '{{ .Username }}'
+{{- end }}
+      token: '{{ .Token }}'
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+{{- if .Commit }}
+  {{ .CommitSourceID }}:
+    name: '{{ .CommitSourceName }}'
+    kind: 'gittag'
+    scmid: '{{ .HookScmID }}'
+    spec:
+      key: 'commit'
+      versionfilter:
+        kind: 'latest'
+        pattern: '{{ "{{" }} source "{{ .SourceID }}" {{ "}}" }}'
+    dependson:
+      - '{{ .SourceID }}'
+{{- end }}
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+{{- if .Commit }}
+    kind: 'file'
+{{- else }}
+    kind: 'yaml'
+{{- end }}
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{- end }}
+    spec:
+      file: '{{ .File }}'
+{{- if .Commit }}
+      matchpattern: '{{ .TargetMatchPattern }}'
+      replacepattern: '{{ .TargetReplacePattern }}'
+{{- else }}
+      key: '{{ .TargetKey }}'
+{{- end }}
+    sourceid: '{{ .SourceID }}'
+`
+)
@@ -0,0 +1,104 @@
+package precommit
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// MatchingRule allows to specifies rules to identify manifest
+type MatchingRule struct {
+	// Path specifies a pre-commit configuration file path pattern, the pattern requires to match all of name, not just a substring.
+	Path string `yaml:",omitempty"`
+	// Repos specifies the list of hook repositories to check such as "https://github.com/psf/black",
+	// the value accepts a semantic versioning constraint such as ">=23"
+	Repos map[string]string `yaml:",omitempty"`
+}
+
+type MatchingRules []MatchingRule
+
+// isMatchingRules checks if a specific file content matches the "only" rule
+func (m MatchingRules) isMatchingRules(rootDir, filePath, repo, repoVersion string) bool {
+	var ruleResults []bool
+
+	if len(m) > 0 {
+		for _, rule := range m {
+			/*
+				Check if rule.Path is matching. Path accepts wildcard path
+			*/
+
+			if rule.Path != "" {
+				if filepath.IsAbs(rule.Path) {
+					filePath = filepath.Join(rootDir, filePath)
+				}
+
+				match, err := filepath.Match(rule.Path, filePath)
+				if err != nil {
+					logrus.Errorf("%s - %q", err, rule.Path)
+					continue
+				}
+				ruleResults = append(ruleResults, match)
+				if match {
+					logrus.Debugf("file path %q matching rule %q", filePath, rule.Path)
+				}
+			}
+
+			/*
+				Checks if hook repository is matching the policy constraint.
+			*/
+
+			if len(rule.Repos) > 0 {
+				match := false
+
+			outRepo:
+				for ruleRepo, ruleRepoVersion := range rule.Repos {
+
+					// Git server hostnames, and most repository names, are case insensitive
+					if strings.EqualFold(repo, ruleRepo) {
+						if ruleRepoVersion == "" {
+							match = true
+							break outRepo
+						}
+
+						v, err := semver.NewVersion(repoVersion)
+						if err != nil {
+							match = repoVersion == ruleRepoVersion
+							logrus.Debugf("%q - %s", repoVersion, err)
+							break outRepo
+						}
+
+						c, err := semver.NewConstraint(ruleRepoVersion)
+						if err != nil {
+							match = repoVersion == ruleRepoVersion
+							logrus.Debugf("%q %s", err, ruleRepoVersion)
+							break outRepo
+						}
+
+						match = c.Check(v)
+						break outRepo
+					}
+				}
+				ruleResults = append(ruleResults, match)
+			}
+
+			/*
+				If at least one rule is failing then we return false
+			*/
+			isAllMatching := true
+			for i := range ruleResults {
+				if !ruleResults[i] {
+					isAllMatching = false
+				}
+			}
+			if isAllMatching {
+				return true
+			}
+			ruleResults = []bool{}
+		}
+		return false
+	}
+
+	return false
+}
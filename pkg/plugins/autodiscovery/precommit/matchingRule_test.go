@@ -0,0 +1,94 @@
+package precommit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRule(t *testing.T) {
+
+	dataset := []struct {
+		name           string
+		rules          MatchingRules
+		filePath       string
+		repo           string
+		repoVersion    string
+		expectedResult bool
+	}{
+		{
+			name: "Matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "*.yaml",
+				},
+			},
+			filePath:       ".pre-commit-config.yaml",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "*.yaml",
+				},
+			},
+			filePath:       "sub/.pre-commit-config.yml",
+			expectedResult: false,
+		},
+		{
+			name: "Matching repository ignoring case",
+			rules: MatchingRules{
+				MatchingRule{
+					Repos: map[string]string{
+						"https://GitHub.com/PSF/black": "",
+					},
+				},
+			},
+			filePath:       ".pre-commit-config.yaml",
+			repo:           "https://github.com/psf/black",
+			repoVersion:    "23.9.1",
+			expectedResult: true,
+		},
+		{
+			name: "Matching repository version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "*.yaml",
+					Repos: map[string]string{
+						"https://github.com/psf/black": ">=23",
+					},
+				},
+			},
+			filePath:       ".pre-commit-config.yaml",
+			repo:           "https://github.com/psf/black",
+			repoVersion:    "23.9.1",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching repository version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Repos: map[string]string{
+						"https://github.com/psf/black": "<23",
+					},
+				},
+			},
+			filePath:       ".pre-commit-config.yaml",
+			repo:           "https://github.com/psf/black",
+			repoVersion:    "23.9.1",
+			expectedResult: false,
+		},
+	}
+
+	for _, d := range dataset {
+		t.Run(d.name, func(t *testing.T) {
+			gotResult := d.rules.isMatchingRules(
+				"",
+				d.filePath,
+				d.repo,
+				d.repoVersion)
+			assert.Equal(t, d.expectedResult, gotResult)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package precommit
+
+import (
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// configFiles lists the pre-commit configuration filenames
+	configFiles = []string{".pre-commit-config.yaml", ".pre-commit-config.yml"}
+	// localRepos lists the pre-commit repositories which aren't a git repository
+	localRepos = []string{"local", "meta"}
+
+	// commitRegex matches a full length git commit hash
+	commitRegex = regexp.MustCompile(`^[0-9a-f]{40}$`)
+	// frozenRegex matches the version set in a comment next to a frozen revision such as "# frozen: v4.5.0"
+	frozenRegex = regexp.MustCompile(`^#\s*frozen:\s*(\S+)`)
+)
+
+// hook represents a pre-commit hook repository
+type hook struct {
+	// Repo holds the hook repository url such as "https://github.com/psf/black"
+	Repo string
+	// Version holds the hook version, retrieved from the frozen comment for hooks pinned to a commit
+	Version string
+	// Commit holds the commit hash used to freeze the hook
+	Commit string
+	// Key holds the yaml path of the revision
+	Key string
+}
+
+// searchConfigFiles looks, recursively, for pre-commit configuration files from a root directory.
+func searchConfigFiles(rootDir string) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for pre-commit configuration files in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		if d.IsDir() && (d.Name() == "node_modules" || d.Name() == ".git") {
+			return filepath.SkipDir
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		for _, configFile := range configFiles {
+			if d.Name() == configFile {
+				foundFiles = append(foundFiles, path)
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return foundFiles, nil
+}
+
+// getHooks returns the hook repositories, pinned to a version, defined in a pre-commit configuration file
+func getHooks(filename string) ([]hook, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	var repos *yaml.Node
+	root := document.Content[0]
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "repos" {
+			repos = root.Content[i+1]
+		}
+	}
+
+	if repos == nil || repos.Kind != yaml.SequenceNode {
+		return nil, nil
+	}
+
+	var hooks []hook
+	for i, repo := range repos.Content {
+		h, err := parseHook(repo, fmt.Sprintf("$.repos[%d].rev", i))
+		if err != nil {
+			logrus.Debugf("skipping repository %d from %q: %s", i, filename, err)
+			continue
+		}
+		hooks = append(hooks, h)
+	}
+
+	return hooks, nil
+}
+
+// parseHook parses a pre-commit repository such as
+//
+//	repo: https://github.com/psf/black
+//	rev: 23.9.1
+func parseHook(node *yaml.Node, key string) (hook, error) {
+	if node.Kind != yaml.MappingNode {
+		return hook{}, fmt.Errorf("wrong repository definition")
+	}
+
+	h := hook{Key: key}
+
+	var rev *yaml.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		switch node.Content[i].Value {
+		case "repo":
+			h.Repo = node.Content[i+1].Value
+		case "rev":
+			rev = node.Content[i+1]
+		}
+	}
+
+	for _, localRepo := range localRepos {
+		if h.Repo == localRepo {
+			return hook{}, fmt.Errorf("%s repository not supported", localRepo)
+		}
+	}
+
+	if h.Repo == "" || rev == nil || rev.Value == "" {
+		return hook{}, fmt.Errorf("no repository or revision specified")
+	}
+
+	switch {
+	case commitRegex.MatchString(rev.Value):
+		m := frozenRegex.FindStringSubmatch(rev.LineComment)
+		if m == nil {
+			return hook{}, fmt.Errorf("revision pinned to a commit without frozen comment")
+		}
+		h.Commit = rev.Value
+		h.Version = m[1]
+	default:
+		h.Version = rev.Value
+	}
+
+	if _, err := semver.NewVersion(h.Version); err != nil {
+		return hook{}, fmt.Errorf("version %q not supported: %s", h.Version, err)
+	}
+
+	return h, nil
+}
+
+// getHost returns the hostname of a hook repository url, or an empty string if it's not an http url
+func getHost(repo string) string {
+	u, err := url.Parse(repo)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		return ""
+	}
+	return u.Host
+}
+
+// getOwnerRepository returns the owner, and repository, of a hook repository url such as "https://github.com/psf/black"
+func getOwnerRepository(repo string) (owner, repository string, err error) {
+	u, err := url.Parse(repo)
+	if err != nil {
+		return "", "", err
+	}
+
+	segments := strings.Split(strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git"), "/")
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", fmt.Errorf("wrong repository url %q", repo)
+	}
+
+	return segments[0], segments[1], nil
+}
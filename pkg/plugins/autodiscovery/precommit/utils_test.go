@@ -0,0 +1,65 @@
+package precommit
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchConfigFiles(t *testing.T) {
+	foundFiles, err := searchConfigFiles("testdata")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		filepath.Join("testdata", ".pre-commit-config.yaml"),
+		filepath.Join("testdata", "sub", ".pre-commit-config.yml"),
+	}, foundFiles)
+}
+
+func TestGetHooks(t *testing.T) {
+	hooks, err := getHooks(filepath.Join("testdata", ".pre-commit-config.yaml"))
+	require.NoError(t, err)
+
+	// Local, meta, not frozen commit, and branch revisions are ignored
+	assert.Equal(t, []hook{
+		{
+			Repo:    "https://github.com/pre-commit/pre-commit-hooks",
+			Version: "v4.5.0",
+			Key:     "$.repos[0].rev",
+		},
+		{
+			Repo:    "https://github.com/psf/black",
+			Version: "23.9.1",
+			Commit:  "8fe627072f15ff2e3d380887b92f7868efaf6d05",
+			Key:     "$.repos[1].rev",
+		},
+	}, hooks)
+}
+
+func TestGetOwnerRepository(t *testing.T) {
+	testdata := []struct {
+		repo               string
+		expectedOwner      string
+		expectedRepository string
+		expectedError      bool
+	}{
+		{repo: "https://github.com/psf/black", expectedOwner: "psf", expectedRepository: "black"},
+		{repo: "https://github.com/psf/black.git", expectedOwner: "psf", expectedRepository: "black"},
+		{repo: "https://gitlab.com/group/subgroup/project", expectedError: true},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.repo, func(t *testing.T) {
+			owner, repository, err := getOwnerRepository(tt.repo)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOwner, owner)
+			assert.Equal(t, tt.expectedRepository, repository)
+		})
+	}
+}
@@ -1,18 +1,21 @@
 package awsami
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition tests if an image matching the specific filters exists.
-func (a *AMI) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (a *AMI) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		logrus.Warningf("condition with SCM is not supported, please remove the scm block")
+		logger.Warningf("condition with SCM is not supported, please remove the scm block")
 		return false, "", errors.New("condition with SCM is not supported")
 	}
 
@@ -40,11 +43,11 @@ func (a *AMI) Condition(source string, scm scm.ScmHandler) (pass bool, message s
 		})
 	}
 
-	logrus.Debugf("Looking for latest AMI ID matching:\n  ---\n  %s\n  ---\n\n",
+	logger.Debugf("Looking for latest AMI ID matching:\n  ---\n  %s\n  ---\n\n",
 		strings.TrimRight(
 			strings.ReplaceAll(a.Spec.String(), "\n", "\n  "), "\n "))
 
-	foundAMI, err := a.getLatestAmiID()
+	foundAMI, err := a.getLatestAmiID(ctx)
 	if err != nil {
 		return false, "", fmt.Errorf("getting latest AMI ID: %w", err)
 	}
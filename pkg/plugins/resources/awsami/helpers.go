@@ -1,17 +1,20 @@
 package awsami
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 )
 
 // getLatestAmiID queries the AWS API to return the newest AMI image id.
-func (a *AMI) getLatestAmiID() (string, error) {
+func (a *AMI) getLatestAmiID(ctx context.Context) (string, error) {
+	logger := log.FromContext(ctx)
+
 	input := ec2.DescribeImagesInput{
 		DryRun:  &a.Spec.DryRun,
 		Filters: a.ec2Filters,
@@ -23,12 +26,12 @@ func (a *AMI) getLatestAmiID() (string, error) {
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
 			default:
-				logrus.Errorln(aerr.Error())
+				logger.Errorln(aerr.Error())
 			}
 		} else {
 			// Print the error, cast err to awserr.Error to get the Code and
 			// Message from an error.
-			logrus.Errorln(err.Error())
+			logger.Errorln(err.Error())
 		}
 		return "", err
 	}
@@ -42,7 +45,7 @@ func (a *AMI) getLatestAmiID() (string, error) {
 			sort.Sort(ByCreationDateDesc(result.Images))
 		}
 
-		logrus.Debugf("Latest AMI ID found:\n  ---\n  %s---\n\n",
+		logger.Debugf("Latest AMI ID found:\n  ---\n  %s---\n\n",
 			strings.ReplaceAll(
 				showShortDescription(result.Images[len(result.Images)-1]),
 				"\n",
@@ -1,6 +1,7 @@
 package awsami
 
 import (
+	"context"
 	"strings"
 	"testing"
 )
@@ -11,7 +12,7 @@ func TestGetLatestAmiID(t *testing.T) {
 		d.ami.apiClient = mockDescribeImagesOutput{
 			Resp: d.mockedResponse,
 		}
-		got, err := d.ami.getLatestAmiID()
+		got, err := d.ami.getLatestAmiID(context.Background())
 		if err != nil {
 			t.Errorf("Unexpected error: %q",
 				err)
@@ -1,16 +1,19 @@
 package awsami
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Source returns the latest AMI matching filter(s)
-func (a *AMI) Source(workingDir string, resultSource *result.Source) error {
-	logrus.Debugf("Looking for latest AMI ID matching:\n  ---\n  %s\n  ---\n\n",
+func (a *AMI) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
+
+	logger.Debugf("Looking for latest AMI ID matching:\n  ---\n  %s\n  ---\n\n",
 		strings.TrimRight(
 			strings.ReplaceAll(a.Spec.String(), "\n", "\n  "), "\n "))
 
@@ -20,7 +23,7 @@ func (a *AMI) Source(workingDir string, resultSource *result.Source) error {
 		return ErrNoFilter
 	}
 
-	foundAMI, err := a.getLatestAmiID()
+	foundAMI, err := a.getLatestAmiID(ctx)
 
 	if err != nil {
 		return fmt.Errorf("get latest AMI id: %w", err)
@@ -1,12 +1,13 @@
 package awsami
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (a *AMI) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (a *AMI) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
 	return fmt.Errorf("Target not supported for the plugin AWS/AMI")
 }
@@ -0,0 +1,6 @@
+package branch
+
+// Changelog returns the changelog for this resource, or an empty string if not supported
+func (a *AzureDevOps) Changelog() string {
+	return ""
+}
@@ -0,0 +1,35 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+func (a *AzureDevOps) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		return false, "", fmt.Errorf("Condition not supported for the plugin Azure DevOps branch")
+	}
+
+	branches, err := a.SearchBranches()
+	if err != nil {
+		return false, "", fmt.Errorf("looking for Azure DevOps branch: %w", err)
+	}
+
+	if len(branches) == 0 {
+		return false, "no Azure DevOps branch found", nil
+	}
+
+	branch := source
+	if a.spec.Branch != "" {
+		branch = a.spec.Branch
+	}
+	for _, b := range branches {
+		if b == branch {
+			return true, fmt.Sprintf("Azure DevOps branch %q found", b), nil
+		}
+	}
+
+	return false, fmt.Sprintf("no Azure DevOps branch %q found", branch), nil
+}
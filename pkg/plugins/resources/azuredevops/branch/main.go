@@ -0,0 +1,127 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines settings used to interact with Azure DevOps branches
+type Spec struct {
+	client.Spec `yaml:",inline,omitempty"`
+	// [S][C] Organization specifies the Azure DevOps organization
+	Organization string `yaml:",omitempty" jsonschema:"required"`
+	// [S][C] Project specifies the Azure DevOps project owning the repository
+	Project string `yaml:",omitempty" jsonschema:"required"`
+	// [S][C] Repository specifies the name of a repository for a specific project
+	Repository string `yaml:",omitempty" jsonschema:"required"`
+	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// [C] Branch specifies the branch name
+	Branch string `yaml:",omitempty"`
+}
+
+// AzureDevOps contains information to interact with Azure DevOps api
+type AzureDevOps struct {
+	// spec contains inputs coming from updatecli configuration
+	spec Spec
+	// client handle the api authentication
+	client        client.Client
+	foundVersion  version.Version
+	versionFilter version.Filter
+}
+
+// New returns a new valid AzureDevOps object.
+func New(spec interface{}) (*AzureDevOps, error) {
+
+	var s Spec
+	var clientSpec client.Spec
+
+	// mapstructure.Decode cannot handle embedded fields
+	// hence we decode it in two steps
+	err := mapstructure.Decode(spec, &clientSpec)
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+
+	err = mapstructure.Decode(spec, &s)
+	if err != nil {
+		return &AzureDevOps{}, nil
+	}
+
+	s.Spec = clientSpec
+
+	err = s.Validate()
+
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+
+	c, err := client.New(clientSpec)
+
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+
+	newFilter, err := s.VersionFilter.Init()
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+	s.VersionFilter = newFilter
+
+	a := AzureDevOps{
+		spec:          s,
+		client:        c,
+		versionFilter: newFilter,
+	}
+
+	return &a, nil
+
+}
+
+// SearchBranches retrieves git branches from a remote Azure DevOps repository
+func (a *AzureDevOps) SearchBranches() ([]string, error) {
+
+	// Timeout api query after 30sec
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	return client.ListRefs(ctx, a.client, a.spec.Organization, a.spec.Project, a.spec.Repository, "heads/")
+}
+
+func (s Spec) Validate() error {
+	gotError := false
+	missingParameters := []string{}
+
+	if len(s.Organization) == 0 {
+		gotError = true
+		missingParameters = append(missingParameters, "organization")
+	}
+
+	if len(s.Project) == 0 {
+		gotError = true
+		missingParameters = append(missingParameters, "project")
+	}
+
+	if len(s.Repository) == 0 {
+		gotError = true
+		missingParameters = append(missingParameters, "repository")
+	}
+
+	if len(missingParameters) > 0 {
+		logrus.Errorf("missing parameter(s) [%s]", strings.Join(missingParameters, ","))
+	}
+
+	if gotError {
+		return fmt.Errorf("wrong Azure DevOps configuration")
+	}
+
+	return nil
+}
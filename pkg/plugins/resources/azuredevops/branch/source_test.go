@@ -0,0 +1,91 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+func TestSource(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /updatecli/website/_apis/git/repositories/website/refs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"value": [
+			{"name": "refs/heads/main"},
+			{"name": "refs/heads/release/v1"},
+			{"name": "refs/heads/release/v2"}
+		]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		spec       map[string]interface{}
+		wantResult string
+		wantErr    bool
+	}{
+		{
+			name: "latest branch matching pattern",
+			spec: map[string]interface{}{
+				"url":          server.URL,
+				"organization": "updatecli",
+				"project":      "website",
+				"repository":   "website",
+				"versionfilter": map[string]interface{}{
+					"kind":    "regex",
+					"pattern": "^release/",
+				},
+			},
+			wantResult: "release/v2",
+		},
+		{
+			name: "no branch matching pattern",
+			spec: map[string]interface{}{
+				"url":          server.URL,
+				"organization": "updatecli",
+				"project":      "website",
+				"repository":   "website",
+				"versionfilter": map[string]interface{}{
+					"kind":    "regex",
+					"pattern": "^feature/",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown repository",
+			spec: map[string]interface{}{
+				"url":          server.URL,
+				"organization": "updatecli",
+				"project":      "website",
+				"repository":   "unknown",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = a.Source(context.Background(), "", &gotResult)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, gotResult.Information)
+		})
+	}
+}
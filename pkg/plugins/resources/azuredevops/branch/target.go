@@ -0,0 +1,14 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Target is not supported for the Azure DevOps branch resource
+func (a AzureDevOps) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	return fmt.Errorf("target not supported for the plugin Azure DevOps branch")
+}
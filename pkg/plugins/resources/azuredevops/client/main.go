@@ -0,0 +1,222 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/transport"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+)
+
+const (
+	// AZUREDEVOPSURL defines the default Azure DevOps url
+	AZUREDEVOPSURL string = "https://dev.azure.com"
+	// APIVersion defines the Azure DevOps REST api version used by Updatecli
+	APIVersion string = "7.0"
+	// defaultUsername is the username used by git when only a personal access token is provided
+	defaultUsername string = "updatecli"
+	// continuationTokenHeader is the http header used by Azure DevOps to paginate results
+	continuationTokenHeader string = "x-ms-continuationtoken"
+)
+
+type Client *scm.Client
+
+// Error represents an error returned by the Azure DevOps api
+type Error struct {
+	// StatusCode is the http status code returned by the Azure DevOps api
+	StatusCode int
+	// Message is the error message returned by the Azure DevOps api
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("unexpected http status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("unexpected http status %d: %s", e.StatusCode, e.Message)
+}
+
+// JSONPatch defines a json patch document, as expected by some Azure DevOps api such as the work item one
+type JSONPatch []JSONPatchOperation
+
+// JSONPatchOperation defines a single json patch operation
+type JSONPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// New returns a new Azure DevOps client authenticated with the spec personal access token
+func New(s Spec) (Client, error) {
+
+	base, err := url.Parse(EnsureValidURL(s.URL))
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path = base.Path + "/"
+	}
+
+	client := &scm.Client{
+		BaseURL: base,
+		Client: &http.Client{
+			Transport: httpclient.NewCachedTransport(http.DefaultTransport),
+		},
+	}
+
+	if len(s.Token) > 0 {
+		// Azure DevOps expects personal access tokens to be provided
+		// as the password of a basic authentication, the username is ignored.
+		client.Client = &http.Client{
+			Transport: &transport.BasicAuth{
+				Base:     httpclient.NewCachedTransport(http.DefaultTransport),
+				Username: s.Username,
+				Password: s.Token,
+			},
+		}
+	}
+
+	return client, nil
+}
+
+// EnsureValidURL returns a valid Azure DevOps url, without trailing slash
+func EnsureValidURL(u string) string {
+	if u == "" {
+		u = AZUREDEVOPSURL
+	}
+
+	if !strings.HasPrefix(u, "https://") && !strings.HasPrefix(u, "http://") {
+		u = "https://" + u
+	}
+
+	return strings.TrimSuffix(u, "/")
+}
+
+// RepositoryPath returns the api path of a git repository, relative to the Azure DevOps url
+func RepositoryPath(organization, project, repository string) string {
+	return fmt.Sprintf("%s/%s/_apis/git/repositories/%s",
+		url.PathEscape(organization),
+		url.PathEscape(project),
+		url.PathEscape(repository))
+}
+
+// ListRefs returns the name of every git reference of a repository matching the prefix "filter",
+// such as "heads/" for branches or "tags/" for tags. The prefix is trimmed from the returned names.
+func ListRefs(ctx context.Context, c Client, organization, project, repository, filter string) ([]string, error) {
+	type ref struct {
+		Name string `json:"name"`
+	}
+
+	results := []string{}
+	continuationToken := ""
+
+	// Query the Azure DevOps api until we visit all pages
+	for {
+		query := url.Values{}
+		query.Set("filter", filter)
+		query.Set("$top", "1000")
+		query.Set("api-version", APIVersion)
+		if continuationToken != "" {
+			query.Set("continuationToken", continuationToken)
+		}
+
+		var refs struct {
+			Value []ref `json:"value"`
+		}
+
+		res, err := Send(ctx, c, http.MethodGet,
+			RepositoryPath(organization, project, repository)+"/refs?"+query.Encode(),
+			nil)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.NewDecoder(res.Body).Decode(&refs)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range refs.Value {
+			results = append(results, strings.TrimPrefix(r.Name, "refs/"+filter))
+		}
+
+		continuationToken = res.Header.Get(continuationTokenHeader)
+		if continuationToken == "" {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// Do sends a request to the Azure DevOps api and decodes the json response in out, if not nil
+func Do(ctx context.Context, c Client, method, path string, in, out interface{}) error {
+	res, err := Send(ctx, c, method, path, in)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// Send sends a request to the Azure DevOps api using the authenticated client.
+// An *Error is returned if the api doesn't return a successful http status.
+func Send(ctx context.Context, c Client, method, path string, in interface{}) (*scm.Response, error) {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+		Header: map[string][]string{
+			"Accept": {"application/json"},
+		},
+	}
+
+	if in != nil {
+		body, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+
+		contentType := "application/json"
+		if _, ok := in.(JSONPatch); ok {
+			contentType = "application/json-patch+json"
+		}
+
+		req.Header["Content-Type"] = []string{contentType}
+		req.Body = bytes.NewReader(body)
+	}
+
+	res, err := (*scm.Client)(c).Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Status >= 300 {
+		defer res.Body.Close()
+		body, _ := io.ReadAll(res.Body)
+		logrus.Debugf("RC: %d\nBody:\n%s", res.Status, body)
+
+		apiErr := &Error{}
+		// The error message is optional so we ignore invalid json responses
+		_ = json.Unmarshal(body, apiErr)
+		apiErr.StatusCode = res.Status
+
+		return nil, apiErr
+	}
+
+	return res, nil
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRefs(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /updatecli/my%20project/_apis/git/repositories/website/refs", func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "", user)
+		assert.Equal(t, "pat", password)
+		assert.Equal(t, "tags/", r.URL.Query().Get("filter"))
+
+		// Tags are spread over two pages to ensure we follow the continuation token
+		switch r.URL.Query().Get("continuationToken") {
+		case "":
+			w.Header().Set("x-ms-continuationtoken", "page2")
+			fmt.Fprint(w, `{"value": [{"name": "refs/tags/v1.0.0"}, {"name": "refs/tags/v1.1.0"}]}`)
+		case "page2":
+			fmt.Fprint(w, `{"value": [{"name": "refs/tags/v2.0.0"}]}`)
+		}
+	})
+	mux.HandleFunc("GET /updatecli/my%20project/_apis/git/repositories/unknown/refs", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "TF401019: The Git repository with name or identifier unknown does not exist"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := New(Spec{URL: server.URL, Token: "pat"})
+	require.NoError(t, err)
+
+	refs, err := ListRefs(context.Background(), c, "updatecli", "my project", "website", "tags/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1.0.0", "v1.1.0", "v2.0.0"}, refs)
+
+	_, err = ListRefs(context.Background(), c, "updatecli", "my project", "unknown", "tags/")
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Contains(t, apiErr.Error(), "TF401019")
+}
+
+func TestGitCredentials(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         Spec
+		wantUsername string
+		wantPassword string
+	}{
+		{
+			name: "no credentials",
+		},
+		{
+			name:         "token without username",
+			spec:         Spec{Token: "pat"},
+			wantUsername: "updatecli",
+			wantPassword: "pat",
+		},
+		{
+			name:         "token with username",
+			spec:         Spec{Username: "alice", Token: "pat"},
+			wantUsername: "alice",
+			wantPassword: "pat",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotUsername, gotPassword := tt.spec.GitCredentials()
+			assert.Equal(t, tt.wantUsername, gotUsername)
+			assert.Equal(t, tt.wantPassword, gotPassword)
+		})
+	}
+}
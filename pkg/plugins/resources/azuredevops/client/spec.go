@@ -0,0 +1,49 @@
+package client
+
+// Spec defines a specification for an "Azure DevOps" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	//  "url" defines the Azure DevOps url to interact with
+	//
+	//  default:
+	//     "https://dev.azure.com"
+	//
+	//  remark:
+	//    For Azure DevOps Server, the url is the server url such as "https://devops.example.com"
+	//    and the organization is the collection name such as "DefaultCollection".
+	URL string `yaml:",omitempty"`
+	//  "username" defines the username used to authenticate with Azure DevOps
+	//
+	//  default:
+	//    "updatecli"
+	//
+	//  remark:
+	//    Azure DevOps ignores the username when authenticating with a personal access token,
+	//    it's only used by git which requires a non empty username.
+	Username string `yaml:",omitempty"`
+	//  "token" defines the personal access token used to authenticate with Azure DevOps
+	//
+	//  remark:
+	//    A token is a sensitive information, it's recommended to not set this value directly in the configuration file
+	//    but to use an environment variable or a SOPS file.
+	//
+	//    The value can be set to `{{ requiredEnv "AZURE_DEVOPS_TOKEN"}}` to retrieve the token from the environment variable `AZURE_DEVOPS_TOKEN`
+	//	  or `{{ .azuredevops.token }}` to retrieve the token from a SOPS file.
+	//
+	//	  For more information, about a SOPS file, please refer to the following documentation:
+	//    https://github.com/getsops/sops
+	Token string `yaml:",omitempty"`
+}
+
+// GitCredentials returns the username and password used to interact with git repositories
+func (s Spec) GitCredentials() (username, password string) {
+	if len(s.Token) == 0 {
+		return s.Username, ""
+	}
+
+	if len(s.Username) == 0 {
+		return defaultUsername, s.Token
+	}
+
+	return s.Username, s.Token
+}
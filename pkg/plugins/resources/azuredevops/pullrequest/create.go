@@ -0,0 +1,105 @@
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+
+	utils "github.com/updatecli/updatecli/pkg/plugins/utils/action"
+)
+
+// maxDescriptionLength is the maximum length of an Azure DevOps pullrequest description
+const maxDescriptionLength = 4000
+
+// azurePullRequestInput contains the attributes used to create an Azure DevOps pullrequest
+type azurePullRequestInput struct {
+	SourceRefName string `json:"sourceRefName"`
+	TargetRefName string `json:"targetRefName"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+}
+
+// CreateAction opens a Pull Request on Azure DevOps
+func (a *AzureDevOps) CreateAction(report reports.Action, resetDescription bool) error {
+
+	title := report.Title
+	if len(a.spec.Title) > 0 {
+		title = a.spec.Title
+	}
+
+	// One Azure DevOps pullrequest body can contain multiple action report
+	// It would be better to refactor CreateAction
+	// to be able to reuse existing pullrequest description.
+	// similar to what we did for github pullrequest.
+	body, err := utils.GeneratePullRequestBody("", report.ToActionsString())
+	if err != nil {
+		logrus.Warningf("something went wrong while generating Azure DevOps body: %s", err)
+		return fmt.Errorf("generate Azure DevOps body: %s", err.Error())
+	}
+
+	if len(a.spec.Body) > 0 {
+		body = a.spec.Body
+	}
+
+	if runes := []rune(body); len(runes) > maxDescriptionLength {
+		logrus.Warningf("Azure DevOps pullrequest description truncated to %d characters", maxDescriptionLength)
+		body = string(runes[:maxDescriptionLength])
+	}
+
+	// Check if a pull-request is already opened then only reconcile its reviewers, work items, and auto-complete.
+	existingPullRequest, err := a.getOpenPullRequest()
+	if err != nil {
+		return fmt.Errorf("check if a pullrequest already exist: %s", err.Error())
+	}
+
+	if existingPullRequest != nil {
+		logrus.Debugln("Azure DevOps pullrequest already exist, nothing else to do than updating it")
+		return a.updatePullRequest(existingPullRequest)
+	}
+
+	// Test that both sourceBranch and targetBranch exists on remote before creating a new one
+	ok, err := a.isRemoteBranchesExist()
+	if err != nil {
+		return fmt.Errorf("check if remote branches exist: %s", err.Error())
+	}
+
+	if !ok {
+		return fmt.Errorf("remote branches %q and %q do not exist, we can't open a pullrequest", a.SourceBranch, a.TargetBranch)
+	}
+
+	opts := azurePullRequestInput{
+		SourceRefName: "refs/heads/" + a.SourceBranch,
+		TargetRefName: "refs/heads/" + a.TargetBranch,
+		Title:         title,
+		Description:   body,
+	}
+
+	logrus.Debugf("Title:\t%q\nBody:\t%v\nSource branch:\t%q\nTarget branch:\t%q\n",
+		title,
+		body,
+		a.SourceBranch,
+		a.TargetBranch)
+
+	// Timeout api query after 30sec
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var pr azurePullRequest
+	err = client.Do(ctx, a.client, http.MethodPost,
+		a.repositoryPath()+"/pullrequests?api-version="+client.APIVersion,
+		opts,
+		&pr)
+	if err != nil {
+		return fmt.Errorf("create Azure DevOps pullrequest: %w", err)
+	}
+
+	logrus.Infof("Azure DevOps pullrequest successfully opened on %q", a.pullRequestURL(pr.PullRequestID))
+
+	return a.updatePullRequest(&pr)
+}
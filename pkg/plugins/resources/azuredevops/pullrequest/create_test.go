@@ -0,0 +1,190 @@
+package pullrequest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+)
+
+const repositoryAPI = "/updatecli/website/_apis/git/repositories/website"
+
+func TestCreateAction(t *testing.T) {
+	testData := []struct {
+		name string
+		spec map[string]interface{}
+		// pullRequests is the list of active pullrequests returned by the Azure DevOps api
+		pullRequests string
+		// workItems is the list of work items linked to the pullrequest returned by the Azure DevOps api
+		workItems string
+		// missingBranch specifies a branch that doesn't exist on the remote repository
+		missingBranch string
+		wantRequests  []string
+		wantErr       bool
+	}{
+		{
+			name: "New pullrequest with reviewers, work items and auto-complete",
+			spec: map[string]interface{}{
+				"reviewers":          []string{"alice@example.com", `[website]\maintainers`},
+				"workitems":          []int{42},
+				"autocomplete":       true,
+				"mergestrategy":      "squash",
+				"deletesourcebranch": true,
+			},
+			pullRequests: `{"value": []}`,
+			workItems:    `{"value": []}`,
+			wantRequests: []string{
+				`POST /updatecli/website/_apis/git/repositories/website/pullrequests {"description":"Custom body","sourceRefName":"refs/heads/updatecli_main","targetRefName":"refs/heads/main","title":"Bump version"}`,
+				`PUT /updatecli/website/_apis/git/repositories/website/pullrequests/7/reviewers/id-alice {"vote":0}`,
+				`PUT /updatecli/website/_apis/git/repositories/website/pullrequests/7/reviewers/id-maintainers {"vote":0}`,
+				`PATCH /updatecli/website/_apis/wit/workitems/42 [{"op":"add","path":"/relations/-","value":{"attributes":{"name":"Pull Request"},"rel":"ArtifactLink","url":"vstfs:///Git/PullRequestId/project-id%2Frepository-id%2F7"}}]`,
+				`PATCH /updatecli/website/_apis/git/repositories/website/pullrequests/7 {"autoCompleteSetBy":{"id":"id-updatecli"},"completionOptions":{"deleteSourceBranch":true,"mergeStrategy":"squash"}}`,
+			},
+		},
+		{
+			name: "Existing pullrequest partially up to date",
+			spec: map[string]interface{}{
+				"reviewers":    []string{"Alice@example.com", `[website]\maintainers`},
+				"workitems":    []int{42, 43},
+				"autocomplete": true,
+			},
+			pullRequests: `{"value": [{
+				"pullRequestId": 7,
+				"sourceRefName": "refs/heads/updatecli_main",
+				"targetRefName": "refs/heads/main",
+				"createdBy": {"id": "id-updatecli"},
+				"autoCompleteSetBy": {"id": "id-updatecli"},
+				"completionOptions": {"mergeStrategy": "noFastForward"},
+				"reviewers": [{"id": "id-alice", "uniqueName": "alice@example.com"}],
+				"repository": {"id": "repository-id", "project": {"id": "project-id"}}
+			}]}`,
+			workItems: `{"value": [{"id": "42"}]}`,
+			wantRequests: []string{
+				`PUT /updatecli/website/_apis/git/repositories/website/pullrequests/7/reviewers/id-maintainers {"vote":0}`,
+				`PATCH /updatecli/website/_apis/wit/workitems/43 [{"op":"add","path":"/relations/-","value":{"attributes":{"name":"Pull Request"},"rel":"ArtifactLink","url":"vstfs:///Git/PullRequestId/project-id%2Frepository-id%2F7"}}]`,
+			},
+		},
+		{
+			name: "Unknown reviewer",
+			spec: map[string]interface{}{
+				"reviewers": []string{"ghost@example.com"},
+			},
+			pullRequests: `{"value": []}`,
+			wantRequests: []string{
+				`POST /updatecli/website/_apis/git/repositories/website/pullrequests {"description":"Custom body","sourceRefName":"refs/heads/updatecli_main","targetRefName":"refs/heads/main","title":"Bump version"}`,
+			},
+			wantErr: true,
+		},
+		{
+			name:          "Missing source branch",
+			spec:          map[string]interface{}{},
+			pullRequests:  `{"value": []}`,
+			missingBranch: "updatecli_main",
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var gotRequests []string
+
+			// record stores the write requests sent to the Azure DevOps api,
+			// the json body is re-encoded so its keys are sorted
+			record := func(r *http.Request) {
+				var body interface{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				raw, err := json.Marshal(body)
+				require.NoError(t, err)
+
+				mu.Lock()
+				gotRequests = append(gotRequests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, raw))
+				mu.Unlock()
+			}
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET "+repositoryAPI+"/pullrequests", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "active", r.URL.Query().Get("searchCriteria.status"))
+				fmt.Fprint(w, tt.pullRequests)
+			})
+			mux.HandleFunc("GET "+repositoryAPI+"/refs", func(w http.ResponseWriter, r *http.Request) {
+				filter := r.URL.Query().Get("filter")
+				if filter == "heads/"+tt.missingBranch {
+					fmt.Fprint(w, `{"value": []}`)
+					return
+				}
+				fmt.Fprintf(w, `{"value": [{"name": "refs/%s"}, {"name": "refs/%s-2"}]}`, filter, filter)
+			})
+			mux.HandleFunc("POST "+repositoryAPI+"/pullrequests", func(w http.ResponseWriter, r *http.Request) {
+				record(r)
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{
+					"pullRequestId": 7,
+					"createdBy": {"id": "id-updatecli"},
+					"repository": {"id": "repository-id", "project": {"id": "project-id"}}
+				}`)
+			})
+			mux.HandleFunc("GET /updatecli/_apis/identities", func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Query().Get("filterValue") {
+				case "alice@example.com", "Alice@example.com":
+					fmt.Fprint(w, `{"value": [{"id": "id-alice", "providerDisplayName": "Alice", "properties": {"Account": {"$value": "alice@example.com"}}}]}`)
+				case `[website]\maintainers`:
+					fmt.Fprint(w, `{"value": [{"id": "id-maintainers", "providerDisplayName": "[website]\\maintainers"}]}`)
+				default:
+					fmt.Fprint(w, `{"value": []}`)
+				}
+			})
+			mux.HandleFunc("PUT "+repositoryAPI+"/pullrequests/7/reviewers/{id}", func(w http.ResponseWriter, r *http.Request) {
+				record(r)
+				fmt.Fprint(w, `{}`)
+			})
+			mux.HandleFunc("GET "+repositoryAPI+"/pullrequests/7/workitems", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, tt.workItems)
+			})
+			mux.HandleFunc("PATCH /updatecli/website/_apis/wit/workitems/{id}", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "application/json-patch+json", r.Header.Get("Content-Type"))
+				record(r)
+				fmt.Fprint(w, `{}`)
+			})
+			mux.HandleFunc("PATCH "+repositoryAPI+"/pullrequests/7", func(w http.ResponseWriter, r *http.Request) {
+				record(r)
+				fmt.Fprint(w, `{}`)
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			spec := map[string]interface{}{
+				"url":          server.URL,
+				"organization": "updatecli",
+				"project":      "website",
+				"repository":   "website",
+				"sourcebranch": "updatecli_main",
+				"targetbranch": "main",
+				"title":        "Bump version",
+				"body":         "Custom body",
+			}
+			for k, v := range tt.spec {
+				spec[k] = v
+			}
+
+			a, err := New(spec, nil)
+			require.NoError(t, err)
+
+			err = a.CreateAction(reports.Action{Title: "Bump version"}, false)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantRequests, gotRequests)
+		})
+	}
+}
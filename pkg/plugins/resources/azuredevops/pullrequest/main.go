@@ -0,0 +1,86 @@
+package pullrequest
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+
+	azuredevopsscm "github.com/updatecli/updatecli/pkg/plugins/scms/azuredevops"
+)
+
+// AzureDevOps contains information to interact with Azure DevOps api
+type AzureDevOps struct {
+	// spec contains inputs coming from updatecli configuration
+	spec Spec
+	// client handle the api authentication
+	client client.Client
+	// scm allows to interact with a scm object
+	scm *azuredevopsscm.AzureDevOps
+	// SourceBranch specifies the pullrequest source branch.
+	SourceBranch string `yaml:",inline,omitempty"`
+	// TargetBranch specifies the pullrequest target branch
+	TargetBranch string `yaml:",inline,omitempty"`
+	// Organization specifies the Azure DevOps organization
+	Organization string `yaml:",omitempty" jsonschema:"required"`
+	// Project specifies the Azure DevOps project owning the repository
+	Project string `yaml:",omitempty" jsonschema:"required"`
+	// Repository specifies the name of a repository for a specific project
+	Repository string `yaml:",omitempty" jsonschema:"required"`
+}
+
+// New returns a new valid AzureDevOps object.
+func New(spec interface{}, scm *azuredevopsscm.AzureDevOps) (AzureDevOps, error) {
+
+	var clientSpec client.Spec
+	var s Spec
+
+	// mapstructure.Decode cannot handle embedded fields
+	// hence we decode it in two steps
+	err := mapstructure.Decode(spec, &clientSpec)
+	if err != nil {
+		return AzureDevOps{}, err
+	}
+
+	err = mapstructure.Decode(spec, &s)
+	if err != nil {
+		return AzureDevOps{}, err
+	}
+
+	err = s.Validate()
+	if err != nil {
+		return AzureDevOps{}, err
+	}
+
+	if scm != nil {
+
+		if len(clientSpec.Token) == 0 && len(scm.Spec.Token) > 0 {
+			clientSpec.Token = scm.Spec.Token
+		}
+
+		if len(clientSpec.URL) == 0 && len(scm.Spec.URL) > 0 {
+			clientSpec.URL = scm.Spec.URL
+		}
+
+		if len(clientSpec.Username) == 0 && len(scm.Spec.Username) > 0 {
+			clientSpec.Username = scm.Spec.Username
+		}
+	}
+
+	s.Spec = clientSpec
+
+	c, err := client.New(clientSpec)
+
+	if err != nil {
+		return AzureDevOps{}, err
+	}
+
+	a := AzureDevOps{
+		spec:   s,
+		client: c,
+		scm:    scm,
+	}
+
+	a.inheritFromScm()
+
+	return a, nil
+
+}
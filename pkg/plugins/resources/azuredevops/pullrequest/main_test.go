@@ -0,0 +1,93 @@
+package pullrequest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	azuredevopsclient "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+	azuredevopsscm "github.com/updatecli/updatecli/pkg/plugins/scms/azuredevops"
+)
+
+func TestNew(t *testing.T) {
+	testData := []struct {
+		name                 string
+		spec                 Spec
+		scm                  *azuredevopsscm.AzureDevOps
+		expectedOrganization string
+		expectedProject      string
+		expectedRepository   string
+		expectedSourceBranch string
+		expectedTargetBranch string
+		wantErr              bool
+	}{
+		{
+			name: "Test basic scenario",
+			spec: Spec{
+				Organization: "updatecli",
+				Project:      "website",
+				Repository:   "website",
+				SourceBranch: "workingBranch",
+				TargetBranch: "main",
+			},
+			scm: &azuredevopsscm.AzureDevOps{
+				Spec: azuredevopsscm.Spec{
+					Spec: azuredevopsclient.Spec{
+						Token: "xxx",
+					},
+				},
+			},
+			expectedOrganization: "updatecli",
+			expectedProject:      "website",
+			expectedRepository:   "website",
+			expectedSourceBranch: "workingBranch",
+			expectedTargetBranch: "main",
+		},
+		{
+			name: "Test parameter inheritance",
+			spec: Spec{},
+			scm: &azuredevopsscm.AzureDevOps{
+				Spec: azuredevopsscm.Spec{
+					Organization: "tartempion",
+					Project:      "docs",
+					Repository:   "docs-site",
+					Branch:       "v2",
+				},
+			},
+			expectedOrganization: "tartempion",
+			expectedProject:      "docs",
+			expectedRepository:   "docs-site",
+			expectedSourceBranch: "v2",
+			expectedTargetBranch: "v2",
+		},
+		{
+			name: "Test unsupported merge strategy",
+			spec: Spec{
+				AutoComplete:  true,
+				MergeStrategy: "fastForward",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testData {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			a, gotErr := New(tt.spec, tt.scm)
+
+			if tt.wantErr {
+				require.Error(t, gotErr)
+				return
+			}
+
+			require.NoError(t, gotErr)
+
+			assert.Equal(t, tt.expectedOrganization, a.Organization)
+			assert.Equal(t, tt.expectedProject, a.Project)
+			assert.Equal(t, tt.expectedRepository, a.Repository)
+			assert.Equal(t, tt.expectedSourceBranch, a.SourceBranch)
+			assert.Equal(t, tt.expectedTargetBranch, a.TargetBranch)
+		})
+	}
+}
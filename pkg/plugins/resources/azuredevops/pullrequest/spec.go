@@ -0,0 +1,183 @@
+package pullrequest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+)
+
+// mergeStrategies contains the merge strategies accepted by Azure DevOps
+var mergeStrategies = []string{
+	"noFastForward",
+	"squash",
+	"rebase",
+	"rebaseMerge",
+}
+
+// Spec defines settings used to interact with Azure DevOps pullrequest
+// It's a mapping of user input from a Updatecli manifest and it shouldn't modified
+type Spec struct {
+	client.Spec
+	/*
+		"sourcebranch" defines the branch name used as a source to create the Azure DevOps pullrequest.
+
+		default:
+			"sourcebranch" inherits the value from the scm working branch if a scm of kind "azuredevops" is specified by the action.
+
+		remark:
+			unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+			the Azure DevOps scm will create and use a working branch such as updatecli_xxxx
+	*/
+	SourceBranch string `yaml:",omitempty"`
+	/*
+		"targetbranch" defines the branch name used as a target to create the Azure DevOps pullrequest.
+
+		default:
+			"targetbranch" inherits the value from the scm branch if a scm of kind "azuredevops" is specified by the action.
+
+		remark:
+			unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	*/
+	TargetBranch string `yaml:",omitempty"`
+	/*
+		"organization" defines the Azure DevOps organization.
+
+		remark:
+			unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	*/
+	Organization string `yaml:",omitempty"`
+	/*
+		"project" defines the Azure DevOps project owning the repository.
+
+		remark:
+			unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	*/
+	Project string `yaml:",omitempty"`
+	/*
+		"repository" defines the Azure DevOps repository for a specific project.
+
+		remark:
+			unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	*/
+	Repository string `yaml:",omitempty"`
+	/*
+		"title" defines the Azure DevOps pullrequest title
+
+		default:
+			An Azure DevOps pullrequest title is defined by one of the following location (first match)
+				1. title is defined by the spec such as:
+
+					actions:
+						default:
+							kind: azuredevops/pullrequest
+							scmid: default
+							spec:
+								title: This is my awesome title
+
+				2. title is defined by the action such as:
+
+					actions:
+						default:
+							kind: azuredevops/pullrequest
+							scmid: default
+							title: This is my awesome title
+
+				3. title is defined by the first associated target title
+
+				4. title is defined by the pipeline title
+
+		remark:
+			usually we prefer to go with option 2
+	*/
+	Title string `yaml:",omitempty"`
+	/*
+		"body" defines a custom pullrequest body
+
+		default:
+			By default a pullrequest body is generated out of a pipeline execution.
+
+		remark:
+			Unless you know what you are doing, you shouldn't set this value and rely on the sane default.
+			"body" is useful to provide additional information when reviewing pullrequest, such as changelog url.
+			Azure DevOps limits the pullrequest description to 4000 characters, a longer body is truncated.
+	*/
+	Body string `yaml:",omitempty"`
+	/*
+		"reviewers" defines the users or groups requested to review the pullrequest.
+
+		default:
+			empty
+
+		remark:
+			A reviewer is identified by its email, its unique name, or its display name such as "[my-project]\\my-team".
+			Reviewers are only added, existing pullrequest reviewers are kept.
+	*/
+	Reviewers []string `yaml:",omitempty"`
+	/*
+		"workitems" defines the IDs of the work items linked to the pullrequest.
+
+		default:
+			empty
+
+		remark:
+			Work items are only linked, existing pullrequest work items are kept.
+	*/
+	WorkItems []int `yaml:",omitempty"`
+	/*
+		"autocomplete" defines if the pullrequest is automatically completed once all policies are met.
+
+		default:
+			false
+
+		remark:
+			Auto-complete is set on behalf of the pullrequest author.
+	*/
+	AutoComplete bool `yaml:",omitempty"`
+	/*
+		"mergestrategy" defines the merge strategy used to auto-complete the pullrequest.
+
+		accepted values:
+			* noFastForward
+			* squash
+			* rebase
+			* rebaseMerge
+
+		default:
+			The Azure DevOps default merge strategy, "noFastForward"
+
+		remark:
+			"mergestrategy" is only used when "autocomplete" is set to true
+	*/
+	MergeStrategy string `yaml:",omitempty"`
+	/*
+		"deletesourcebranch" defines if the source branch is deleted once the pullrequest is auto-completed.
+
+		default:
+			false
+
+		remark:
+			"deletesourcebranch" is only used when "autocomplete" is set to true
+	*/
+	DeleteSourceBranch bool `yaml:",omitempty"`
+}
+
+// Validate validates that a spec contains good content
+func (s Spec) Validate() error {
+	if s.MergeStrategy == "" {
+		return nil
+	}
+
+	for _, strategy := range mergeStrategies {
+		if s.MergeStrategy == strategy {
+			return nil
+		}
+	}
+
+	logrus.Errorf("merge strategy %q not supported, accepted values are [%s]",
+		s.MergeStrategy,
+		strings.Join(mergeStrategies, ","))
+
+	return fmt.Errorf("wrong Azure DevOps pullrequest configuration")
+}
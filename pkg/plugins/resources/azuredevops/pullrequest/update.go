@@ -0,0 +1,291 @@
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+)
+
+// azureIdentity contains the Azure DevOps identity information returned by the Azure DevOps api
+type azureIdentity struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName,omitempty"`
+	UniqueName  string `json:"uniqueName,omitempty"`
+}
+
+// azureCompletionOptions contains the options used to complete an Azure DevOps pullrequest
+type azureCompletionOptions struct {
+	MergeStrategy      string `json:"mergeStrategy,omitempty"`
+	DeleteSourceBranch bool   `json:"deleteSourceBranch"`
+}
+
+// azurePullRequest contains the Azure DevOps pullrequest information returned by the Azure DevOps api
+type azurePullRequest struct {
+	PullRequestID     int                     `json:"pullRequestId"`
+	SourceRefName     string                  `json:"sourceRefName"`
+	TargetRefName     string                  `json:"targetRefName"`
+	CreatedBy         azureIdentity           `json:"createdBy"`
+	AutoCompleteSetBy *azureIdentity          `json:"autoCompleteSetBy"`
+	CompletionOptions *azureCompletionOptions `json:"completionOptions"`
+	Reviewers         []azureIdentity         `json:"reviewers"`
+	Repository        struct {
+		ID      string `json:"id"`
+		Project struct {
+			ID string `json:"id"`
+		} `json:"project"`
+	} `json:"repository"`
+}
+
+// azurePullRequestUpdate contains the pullrequest attributes updated by Updatecli
+type azurePullRequestUpdate struct {
+	AutoCompleteSetBy azureIdentity          `json:"autoCompleteSetBy"`
+	CompletionOptions azureCompletionOptions `json:"completionOptions"`
+}
+
+// azureIdentityProperties contains the Azure DevOps identity properties used to match a reviewer
+type azureIdentityProperties struct {
+	Account struct {
+		Value string `json:"$value"`
+	} `json:"Account"`
+	Mail struct {
+		Value string `json:"$value"`
+	} `json:"Mail"`
+}
+
+// azureIdentityDetails contains the Azure DevOps identity details returned by the identities api
+type azureIdentityDetails struct {
+	ID                  string                  `json:"id"`
+	ProviderDisplayName string                  `json:"providerDisplayName"`
+	Properties          azureIdentityProperties `json:"properties"`
+}
+
+// updatePullRequest ensures that the pullrequest reviewers, work items, and auto-complete
+// match the ones defined by the spec.
+// Reviewers and work items are only added, so we don't override the ones added manually.
+func (a *AzureDevOps) updatePullRequest(pr *azurePullRequest) error {
+
+	if len(a.spec.Reviewers) == 0 &&
+		len(a.spec.WorkItems) == 0 &&
+		!a.spec.AutoComplete {
+		return nil
+	}
+
+	// Timeout api query after 30sec
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if err := a.addReviewers(ctx, pr); err != nil {
+		return err
+	}
+
+	if err := a.linkWorkItems(ctx, pr); err != nil {
+		return err
+	}
+
+	if err := a.setAutoComplete(ctx, pr); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// addReviewers adds the spec reviewers which are not yet reviewing the pullrequest
+func (a *AzureDevOps) addReviewers(ctx context.Context, pr *azurePullRequest) error {
+	for _, reviewer := range a.spec.Reviewers {
+		if isReviewer(pr.Reviewers, reviewer) {
+			continue
+		}
+
+		identity, err := a.getIdentity(ctx, reviewer)
+		if err != nil {
+			return err
+		}
+
+		if isReviewer(pr.Reviewers, identity.ID) {
+			continue
+		}
+
+		path := fmt.Sprintf("%s/pullrequests/%d/reviewers/%s?api-version=%s",
+			a.repositoryPath(),
+			pr.PullRequestID,
+			url.PathEscape(identity.ID),
+			client.APIVersion)
+
+		err = client.Do(ctx, a.client, http.MethodPut, path, map[string]int{"vote": 0}, nil)
+		if err != nil {
+			return fmt.Errorf("add Azure DevOps pullrequest reviewer %q: %w", reviewer, err)
+		}
+
+		pr.Reviewers = append(pr.Reviewers, azureIdentity{ID: identity.ID, UniqueName: reviewer})
+		logrus.Infof("Azure DevOps reviewer %q added to pullrequest %d", reviewer, pr.PullRequestID)
+	}
+
+	return nil
+}
+
+// isReviewer returns true if the reviewer, identified by its ID, unique name, or display name, is part of reviewers
+func isReviewer(reviewers []azureIdentity, reviewer string) bool {
+	for _, r := range reviewers {
+		if strings.EqualFold(r.ID, reviewer) ||
+			strings.EqualFold(r.UniqueName, reviewer) ||
+			strings.EqualFold(r.DisplayName, reviewer) {
+			return true
+		}
+	}
+	return false
+}
+
+// getIdentity queries the Azure DevOps identities api to retrieve a user or a group
+func (a *AzureDevOps) getIdentity(ctx context.Context, name string) (azureIdentityDetails, error) {
+	var identities struct {
+		Value []azureIdentityDetails `json:"value"`
+	}
+
+	query := url.Values{}
+	query.Set("searchFilter", "General")
+	query.Set("filterValue", name)
+	query.Set("queryMembership", "None")
+	query.Set("api-version", client.APIVersion)
+
+	err := client.Do(ctx, a.client, http.MethodGet, a.identitiesPath()+"?"+query.Encode(), nil, &identities)
+	if err != nil {
+		return azureIdentityDetails{}, fmt.Errorf("get Azure DevOps identity %q: %w", name, err)
+	}
+
+	for _, identity := range identities.Value {
+		if strings.EqualFold(identity.ProviderDisplayName, name) ||
+			strings.EqualFold(identity.Properties.Account.Value, name) ||
+			strings.EqualFold(identity.Properties.Mail.Value, name) {
+			return identity, nil
+		}
+	}
+
+	return azureIdentityDetails{}, fmt.Errorf("Azure DevOps identity %q not found", name)
+}
+
+// identitiesPath returns the identities api path.
+// Azure DevOps Services exposes the identities api on a dedicated host.
+func (a *AzureDevOps) identitiesPath() string {
+	u, err := url.Parse(client.EnsureValidURL(a.spec.URL))
+	if err == nil && strings.EqualFold(u.Host, "dev.azure.com") {
+		return fmt.Sprintf("https://vssps.dev.azure.com/%s/_apis/identities", url.PathEscape(a.Organization))
+	}
+
+	return fmt.Sprintf("%s/_apis/identities", url.PathEscape(a.Organization))
+}
+
+// linkWorkItems links the spec work items which are not yet linked to the pullrequest
+func (a *AzureDevOps) linkWorkItems(ctx context.Context, pr *azurePullRequest) error {
+	if len(a.spec.WorkItems) == 0 {
+		return nil
+	}
+
+	var workItems struct {
+		Value []struct {
+			ID string `json:"id"`
+		} `json:"value"`
+	}
+
+	path := fmt.Sprintf("%s/pullrequests/%d/workitems?api-version=%s",
+		a.repositoryPath(),
+		pr.PullRequestID,
+		client.APIVersion)
+
+	err := client.Do(ctx, a.client, http.MethodGet, path, nil, &workItems)
+	if err != nil {
+		return fmt.Errorf("get Azure DevOps pullrequest %d work items: %w", pr.PullRequestID, err)
+	}
+
+	linked := map[string]bool{}
+	for _, workItem := range workItems.Value {
+		linked[workItem.ID] = true
+	}
+
+	// A pullrequest is linked to a work item through an artifact link added to the work item
+	artifactURL := fmt.Sprintf("vstfs:///Git/PullRequestId/%s%%2F%s%%2F%d",
+		pr.Repository.Project.ID,
+		pr.Repository.ID,
+		pr.PullRequestID)
+
+	for _, id := range a.spec.WorkItems {
+		if linked[strconv.Itoa(id)] {
+			continue
+		}
+
+		patch := client.JSONPatch{
+			{
+				Op:   "add",
+				Path: "/relations/-",
+				Value: map[string]interface{}{
+					"rel": "ArtifactLink",
+					"url": artifactURL,
+					"attributes": map[string]string{
+						"name": "Pull Request",
+					},
+				},
+			},
+		}
+
+		path := fmt.Sprintf("%s/%s/_apis/wit/workitems/%d?api-version=%s",
+			url.PathEscape(a.Organization),
+			url.PathEscape(a.Project),
+			id,
+			client.APIVersion)
+
+		err := client.Do(ctx, a.client, http.MethodPatch, path, patch, nil)
+		if err != nil {
+			return fmt.Errorf("link Azure DevOps work item %d: %w", id, err)
+		}
+
+		logrus.Infof("Azure DevOps work item %d linked to pullrequest %d", id, pr.PullRequestID)
+	}
+
+	return nil
+}
+
+// setAutoComplete enables the pullrequest auto-complete, if not already enabled with the same options
+func (a *AzureDevOps) setAutoComplete(ctx context.Context, pr *azurePullRequest) error {
+	if !a.spec.AutoComplete {
+		return nil
+	}
+
+	options := azureCompletionOptions{
+		MergeStrategy:      a.spec.MergeStrategy,
+		DeleteSourceBranch: a.spec.DeleteSourceBranch,
+	}
+
+	if pr.AutoCompleteSetBy != nil &&
+		pr.CompletionOptions != nil &&
+		(options.MergeStrategy == "" || strings.EqualFold(pr.CompletionOptions.MergeStrategy, options.MergeStrategy)) &&
+		pr.CompletionOptions.DeleteSourceBranch == options.DeleteSourceBranch {
+		logrus.Debugf("Azure DevOps pullrequest %d auto-complete already up to date", pr.PullRequestID)
+		return nil
+	}
+
+	update := azurePullRequestUpdate{
+		AutoCompleteSetBy: azureIdentity{ID: pr.CreatedBy.ID},
+		CompletionOptions: options,
+	}
+
+	path := fmt.Sprintf("%s/pullrequests/%d?api-version=%s",
+		a.repositoryPath(),
+		pr.PullRequestID,
+		client.APIVersion)
+
+	err := client.Do(ctx, a.client, http.MethodPatch, path, update, nil)
+	if err != nil {
+		return fmt.Errorf("set Azure DevOps pullrequest %d auto-complete: %w", pr.PullRequestID, err)
+	}
+
+	logrus.Infof("Azure DevOps pullrequest %d auto-complete enabled", pr.PullRequestID)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+)
+
+// getOpenPullRequest queries Azure DevOps to retrieve an already opened pullrequest.
+// It returns nil if no such pullrequest exists.
+func (a *AzureDevOps) getOpenPullRequest() (*azurePullRequest, error) {
+	ctx := context.Background()
+	// Timeout api query after 30sec
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	query := url.Values{}
+	query.Set("searchCriteria.status", "active")
+	query.Set("searchCriteria.sourceRefName", "refs/heads/"+a.SourceBranch)
+	query.Set("searchCriteria.targetRefName", "refs/heads/"+a.TargetBranch)
+	query.Set("api-version", client.APIVersion)
+
+	var pullrequests struct {
+		Value []azurePullRequest `json:"value"`
+	}
+
+	err := client.Do(ctx, a.client, http.MethodGet, a.repositoryPath()+"/pullrequests?"+query.Encode(), nil, &pullrequests)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range pullrequests.Value {
+		p := pullrequests.Value[i]
+		if p.SourceRefName == "refs/heads/"+a.SourceBranch &&
+			p.TargetRefName == "refs/heads/"+a.TargetBranch {
+
+			logrus.Infof("%s Our pullrequest already exist on:\n\t%s",
+				result.SUCCESS,
+				a.pullRequestURL(p.PullRequestID))
+
+			return &p, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// isRemoteBranchesExist queries Azure DevOps to know if both the pull-request source branch and the target branch exist.
+func (a *AzureDevOps) isRemoteBranchesExist() (bool, error) {
+
+	// Timeout api query after 30sec
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	foundRemoteBranches := true
+	for _, branch := range []string{a.SourceBranch, a.TargetBranch} {
+		// The filter is a prefix, trimmed from the returned names,
+		// so an exact match is returned as an empty name.
+		remoteBranches, err := client.ListRefs(ctx, a.client, a.Organization, a.Project, a.Repository, "heads/"+branch)
+		if err != nil {
+			return false, err
+		}
+
+		found := false
+		for _, remoteBranch := range remoteBranches {
+			if remoteBranch == "" {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			logrus.Debugf("Branch %q not found on remote repository %s/%s/%s",
+				branch,
+				a.Organization,
+				a.Project,
+				a.Repository)
+			foundRemoteBranches = false
+		}
+	}
+
+	return foundRemoteBranches, nil
+}
+
+// repositoryPath returns the api path of the pullrequest repository
+func (a *AzureDevOps) repositoryPath() string {
+	return client.RepositoryPath(a.Organization, a.Project, a.Repository)
+}
+
+// pullRequestURL returns the web url of a pullrequest
+func (a *AzureDevOps) pullRequestURL(id int) string {
+	return fmt.Sprintf("%s/%s/%s/_git/%s/pullrequest/%d",
+		client.EnsureValidURL(a.spec.URL),
+		url.PathEscape(a.Organization),
+		url.PathEscape(a.Project),
+		url.PathEscape(a.Repository),
+		id)
+}
+
+// inheritFromScm retrieve missing Azure DevOps settings from the Azure DevOps scm object.
+func (a *AzureDevOps) inheritFromScm() {
+
+	if a.scm != nil {
+		_, a.SourceBranch, a.TargetBranch = a.scm.GetBranches()
+		a.Organization = a.scm.Spec.Organization
+		a.Project = a.scm.Spec.Project
+		a.Repository = a.scm.Spec.Repository
+	}
+
+	if len(a.spec.SourceBranch) > 0 {
+		a.SourceBranch = a.spec.SourceBranch
+	}
+
+	if len(a.spec.TargetBranch) > 0 {
+		a.TargetBranch = a.spec.TargetBranch
+	}
+
+	if len(a.spec.Organization) > 0 {
+		a.Organization = a.spec.Organization
+	}
+
+	if len(a.spec.Project) > 0 {
+		a.Project = a.spec.Project
+	}
+
+	if len(a.spec.Repository) > 0 {
+		a.Repository = a.spec.Repository
+	}
+}
@@ -0,0 +1,38 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+func (a *AzureDevOps) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
+	if scm != nil {
+		logger.Warningf("scm is not supported for the plugin Azure DevOps tag condition")
+	}
+
+	tags, err := a.SearchTags()
+	if err != nil {
+		return false, "", fmt.Errorf("looking for Azure DevOps tags: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return false, "no Azure DevOps tag found", nil
+	}
+
+	tag := source
+	if a.spec.Tag != "" {
+		tag = a.spec.Tag
+	}
+	for _, t := range tags {
+		if t == tag {
+			return true, fmt.Sprintf("Azure DevOps tag %q found", t), nil
+		}
+	}
+
+	return false, fmt.Sprintf("no Azure DevOps tag %q found", tag), nil
+}
@@ -0,0 +1,62 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /updatecli/website/_apis/git/repositories/website/refs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"value": [{"name": "refs/tags/v1.0.0"}, {"name": "refs/tags/v1.1.0"}]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		tag        string
+		source     string
+		wantResult bool
+	}{
+		{
+			name:       "tag defined by the source exists",
+			source:     "v1.1.0",
+			wantResult: true,
+		},
+		{
+			name:       "tag defined by the spec exists",
+			tag:        "v1.0.0",
+			source:     "v2.0.0",
+			wantResult: true,
+		},
+		{
+			name:   "tag doesn't exist",
+			source: "v1.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := New(map[string]interface{}{
+				"url":          server.URL,
+				"organization": "updatecli",
+				"project":      "website",
+				"repository":   "website",
+				"tag":          tt.tag,
+			})
+			require.NoError(t, err)
+
+			gotResult, _, err := a.Condition(context.Background(), tt.source, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, gotResult)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func (a *AzureDevOps) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	versions, err := a.SearchTags()
+
+	if err != nil {
+		return fmt.Errorf("searching Azure DevOps tags: %w", err)
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("no Azure DevOps tags found")
+	}
+
+	a.foundVersion, err = a.spec.VersionFilter.Search(versions)
+
+	if err != nil {
+		switch err {
+		case version.ErrNoVersionFound:
+			return fmt.Errorf("no Azure DevOps tags found matching pattern %q of kind %q",
+				a.versionFilter.Pattern,
+				a.versionFilter.Kind,
+			)
+
+		default:
+			return fmt.Errorf("filtering Azure DevOps tags: %w", err)
+		}
+	}
+
+	value := a.foundVersion.GetVersion()
+
+	if len(value) == 0 {
+		return fmt.Errorf("no Azure DevOps tags found matching pattern %q of kind %q",
+			a.versionFilter.Pattern,
+			a.versionFilter.Kind,
+		)
+	}
+
+	resultSource.Result = result.SUCCESS
+	resultSource.Information = value
+	resultSource.Description = fmt.Sprintf("Azure DevOps tags %q found matching pattern %q of kind %q",
+		value,
+		a.versionFilter.Pattern,
+		a.versionFilter.Kind)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+func (g *Bitbucket) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
+	if scm != nil {
+		logger.Warningf("scm not supported, ignoring")
+	}
+
+	branches, err := g.SearchBranches(ctx)
+	if err != nil {
+		return false, "", err
+	}
+
+	branch := source
+	if g.spec.Branch != "" {
+		branch = g.spec.Branch
+	}
+
+	if len(branches) == 0 {
+		return false, fmt.Sprintf("no Bitbucket branch found for repository %s/%s", g.spec.Owner, g.spec.Repository), nil
+	}
+
+	for _, b := range branches {
+		if b == branch {
+			return true, fmt.Sprintf("Bitbucket branch %q found for repository %s/%s", branch, g.spec.Owner, g.spec.Repository), nil
+		}
+	}
+
+	return false, fmt.Sprintf("no Bitbucket branch found matching %q for repository %s/%s",
+		branch,
+		g.spec.Owner,
+		g.spec.Repository,
+	), nil
+}
@@ -0,0 +1,164 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/bitbucket/client"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines settings used to interact with Bitbucket Cloud branches
+type Spec struct {
+	client.Spec `yaml:",inline,omitempty"`
+	// [S][C] Owner specifies the Bitbucket workspace owning the repository
+	Owner string `yaml:",omitempty" jsonschema:"required"`
+	// [S][C] Repository specifies the name of a repository for a specific workspace
+	Repository string `yaml:",omitempty" jsonschema:"required"`
+	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// [C] Branch specifies the branch name
+	Branch string `yaml:",omitempty"`
+}
+
+// Bitbucket contains information to interact with Bitbucket Cloud api
+type Bitbucket struct {
+	// spec contains inputs coming from updatecli configuration
+	spec Spec
+	// client handle the api authentication
+	client        client.Client
+	HeadBranch    string
+	foundVersion  version.Version
+	versionFilter version.Filter
+}
+
+// New returns a new valid Bitbucket object.
+func New(spec interface{}) (*Bitbucket, error) {
+
+	var s Spec
+	var clientSpec client.Spec
+
+	// mapstructure.Decode cannot handle embedded fields
+	// hence we decode it in two steps
+	err := mapstructure.Decode(spec, &clientSpec)
+	if err != nil {
+		return &Bitbucket{}, err
+	}
+
+	err = mapstructure.Decode(spec, &s)
+	if err != nil {
+		return &Bitbucket{}, err
+	}
+
+	err = clientSpec.Sanitize()
+	if err != nil {
+		return &Bitbucket{}, err
+	}
+
+	s.Spec = clientSpec
+
+	err = s.Validate()
+
+	if err != nil {
+		return &Bitbucket{}, err
+	}
+
+	c, err := client.New(clientSpec)
+
+	if err != nil {
+		return &Bitbucket{}, err
+	}
+
+	newFilter, err := s.VersionFilter.Init()
+	if err != nil {
+		return &Bitbucket{}, err
+	}
+	s.VersionFilter = newFilter
+
+	g := Bitbucket{
+		spec:          s,
+		client:        c,
+		versionFilter: newFilter,
+	}
+
+	return &g, nil
+
+}
+
+// Retrieve bitbucket branches from a remote bitbucket repository
+func (g *Bitbucket) SearchBranches(ctx context.Context) (tags []string, err error) {
+	logger := log.FromContext(ctx)
+
+	// Timeout api query after 30sec
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	results := []string{}
+	page := 1
+	for {
+		branches, resp, err := g.client.Git.ListBranches(
+			ctx,
+			strings.Join([]string{g.spec.Owner, g.spec.Repository}, "/"),
+			scm.ListOptions{
+				Page: page,
+				Size: 100,
+			},
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.Status > 400 {
+			logger.Debugf("RC: %q\nBody:\n%s", resp.Status, resp.Body)
+		}
+
+		for _, branch := range branches {
+			results = append(results, branch.Name)
+		}
+
+		if resp.Page.Next == 0 || resp.Page.Next == page {
+			break
+		}
+		page = resp.Page.Next
+	}
+
+	return results, nil
+}
+
+func (s Spec) Validate() error {
+	gotError := false
+	missingParameters := []string{}
+
+	err := s.Spec.Validate()
+
+	if err != nil {
+		gotError = true
+	}
+
+	if len(s.Owner) == 0 {
+		gotError = true
+		missingParameters = append(missingParameters, "owner")
+	}
+
+	if len(s.Repository) == 0 {
+		gotError = true
+		missingParameters = append(missingParameters, "repository")
+	}
+
+	if len(missingParameters) > 0 {
+		logrus.Errorf("missing parameter(s) [%s]", strings.Join(missingParameters, ","))
+	}
+
+	if gotError {
+		return fmt.Errorf("wrong bitbucket configuration")
+	}
+
+	return nil
+}
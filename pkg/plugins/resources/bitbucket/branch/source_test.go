@@ -0,0 +1,83 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+func TestSource(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2.0/repositories/updatecli/updatecli/refs/branches", func(w http.ResponseWriter, r *http.Request) {
+		// Branches are spread over two pages to ensure we follow the pagination
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprintf(w, `{"values": [{"name": "main"}, {"name": "v1"}], "next": "http://%s%s?page=2"}`, r.Host, r.URL.Path)
+		case "2":
+			fmt.Fprint(w, `{"values": [{"name": "v2"}, {"name": "feature"}]}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		spec       map[string]interface{}
+		wantResult string
+		wantErr    bool
+	}{
+		{
+			name: "latest branch matching pattern",
+			spec: map[string]interface{}{
+				"url":        server.URL,
+				"owner":      "updatecli",
+				"repository": "updatecli",
+				"versionfilter": map[string]interface{}{
+					"kind":    "regex",
+					"pattern": "^v",
+				},
+			},
+			wantResult: "v2",
+		},
+		{
+			name: "no branch matching pattern",
+			spec: map[string]interface{}{
+				"url":        server.URL,
+				"owner":      "updatecli",
+				"repository": "updatecli",
+				"versionfilter": map[string]interface{}{
+					"kind":    "regex",
+					"pattern": "^release",
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = b.Source(context.Background(), "", &gotResult)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, gotResult.Information)
+		})
+	}
+}
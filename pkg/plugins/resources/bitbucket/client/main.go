@@ -0,0 +1,157 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/bitbucket"
+	"github.com/drone/go-scm/scm/transport"
+	"github.com/drone/go-scm/scm/transport/oauth2"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+)
+
+const (
+	// BITBUCKETAPIURL defines the default Bitbucket Cloud api url
+	BITBUCKETAPIURL string = "https://api.bitbucket.org"
+	// BITBUCKETURL defines the default Bitbucket Cloud url used to clone git repositories
+	BITBUCKETURL string = "https://bitbucket.org"
+	// tokenUsername is the username expected by Bitbucket Cloud when cloning a git repository using an access token
+	tokenUsername string = "x-token-auth"
+)
+
+// Spec defines a specification for a "bitbucket" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	//  "url" specifies the Bitbucket Cloud api url
+	//
+	//  default:
+	//    https://api.bitbucket.org
+	URL string `yaml:",omitempty"`
+	//  "username" specifies the username used to authenticate with Bitbucket Cloud API, it must be combined with "password"
+	Username string `yaml:",omitempty"`
+	//  "password" specifies the Bitbucket Cloud app password used to authenticate with Bitbucket Cloud API, it must be combined with "username"
+	//
+	//  remark:
+	//    A password is a sensitive information, it's recommended to not set this value directly in the configuration file
+	//    but to use an environment variable or a SOPS file.
+	//
+	//    The value can be set to `{{ requiredEnv "BITBUCKET_PASSWORD"}}` to retrieve the password from the environment variable `BITBUCKET_PASSWORD`
+	//	  or `{{ .bitbucket.password }}` to retrieve the password from a SOPS file.
+	//
+	//	  For more information, about a SOPS file, please refer to the following documentation:
+	//    https://github.com/getsops/sops
+	Password string `yaml:",omitempty"`
+	//  "token" specifies the workspace, project, or repository access token used to authenticate with Bitbucket Cloud API
+	//
+	//  remark:
+	//    A token is a sensitive information, it's recommended to not set this value directly in the configuration file
+	//    but to use an environment variable or a SOPS file.
+	//
+	//    The value can be set to `{{ requiredEnv "BITBUCKET_TOKEN"}}` to retrieve the token from the environment variable `BITBUCKET_TOKEN`
+	//	  or `{{ .bitbucket.token }}` to retrieve the token from a SOPS file.
+	//
+	//	  For more information, about a SOPS file, please refer to the following documentation:
+	//    https://github.com/getsops/sops
+	Token string `yaml:",omitempty"`
+}
+
+type Client *scm.Client
+
+func New(s Spec) (Client, error) {
+	client, err := bitbucket.New(s.URL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	client.Client = &http.Client{
+		Transport: httpclient.NewCachedTransport(http.DefaultTransport),
+	}
+
+	switch {
+	case len(s.Token) > 0:
+		client.Client = &http.Client{
+			Transport: &oauth2.Transport{
+				Base: httpclient.NewCachedTransport(http.DefaultTransport),
+				Source: oauth2.StaticTokenSource(
+					&scm.Token{
+						Token: s.Token,
+					},
+				),
+			},
+		}
+	case len(s.Username) > 0:
+		client.Client = &http.Client{
+			Transport: &transport.BasicAuth{
+				Base:     httpclient.NewCachedTransport(http.DefaultTransport),
+				Username: s.Username,
+				Password: s.Password,
+			},
+		}
+	}
+
+	return client, nil
+}
+
+// Validate validates that a spec contains good content
+func (s Spec) Validate() error {
+
+	if len(s.Token) > 0 && len(s.Password) > 0 {
+		logrus.Errorf("parameters %q and %q are mutually exclusive", "token", "password")
+		return fmt.Errorf("wrong configuration")
+	}
+
+	if len(s.Password) > 0 && len(s.Username) == 0 {
+		logrus.Errorf("parameter %q requires %q", "password", "username")
+		return fmt.Errorf("wrong configuration")
+	}
+
+	return nil
+}
+
+// Sanitize parse and update if needed a spec content
+func (s *Spec) Sanitize() error {
+
+	err := s.Validate()
+	if err != nil {
+		return err
+	}
+
+	if len(s.URL) == 0 {
+		s.URL = BITBUCKETAPIURL
+	}
+
+	if !strings.HasPrefix(s.URL, "https://") && !strings.HasPrefix(s.URL, "http://") {
+		s.URL = "https://" + s.URL
+	}
+
+	s.URL = strings.TrimSuffix(s.URL, "/")
+
+	return nil
+}
+
+// GitCredentials returns the username and password used to interact with git repositories
+func (s Spec) GitCredentials() (username, password string) {
+	if len(s.Token) > 0 {
+		return tokenUsername, s.Token
+	}
+	return s.Username, s.Password
+}
+
+// GitURL returns the Bitbucket Cloud url hosting git repositories based on the api url,
+// such as https://bitbucket.org for https://api.bitbucket.org
+func (s Spec) GitURL() string {
+	u, err := url.Parse(s.URL)
+	if err != nil || len(s.URL) == 0 {
+		return BITBUCKETURL
+	}
+
+	u.Host = strings.TrimPrefix(u.Host, "api.")
+	u.Path = ""
+
+	return u.String()
+}
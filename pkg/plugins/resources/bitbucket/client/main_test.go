@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        Spec
+		wantURL     string
+		wantGitURL  string
+		wantErr     bool
+		wantGitUser string
+		wantGitPass string
+	}{
+		{
+			name:       "default url",
+			spec:       Spec{},
+			wantURL:    "https://api.bitbucket.org",
+			wantGitURL: "https://bitbucket.org",
+		},
+		{
+			name: "app password",
+			spec: Spec{
+				URL:      "api.bitbucket.org/",
+				Username: "alice",
+				Password: "secret",
+			},
+			wantURL:     "https://api.bitbucket.org",
+			wantGitURL:  "https://bitbucket.org",
+			wantGitUser: "alice",
+			wantGitPass: "secret",
+		},
+		{
+			name: "access token",
+			spec: Spec{
+				Token: "token",
+			},
+			wantURL:     "https://api.bitbucket.org",
+			wantGitURL:  "https://bitbucket.org",
+			wantGitUser: "x-token-auth",
+			wantGitPass: "token",
+		},
+		{
+			name: "token and password are mutually exclusive",
+			spec: Spec{
+				Username: "alice",
+				Password: "secret",
+				Token:    "token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "password without username",
+			spec: Spec{
+				Password: "secret",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.Sanitize()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantURL, tt.spec.URL)
+			assert.Equal(t, tt.wantGitURL, tt.spec.GitURL())
+
+			gotUser, gotPass := tt.spec.GitCredentials()
+			assert.Equal(t, tt.wantGitUser, gotUser)
+			assert.Equal(t, tt.wantGitPass, gotPass)
+		})
+	}
+}
+
+func TestNewUsesHTTPCache(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		w.Header().Set("Cache-Control", "max-age=60")
+		_, _ = w.Write([]byte(`{"name": "main"}`))
+	}))
+	defer server.Close()
+
+	httpclient.CacheDirectory = t.TempDir()
+	defer func() { httpclient.CacheDirectory = "" }()
+
+	c, err := New(Spec{URL: server.URL, Token: "token"})
+	require.NoError(t, err)
+
+	for range 2 {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/2.0/repositories/updatecli/updatecli/refs/branches/main", nil)
+		require.NoError(t, err)
+
+		resp, err := c.Client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// tagsPage is a page of tags returned by the Bitbucket Cloud api
+type tagsPage struct {
+	Values []struct {
+		Name string `json:"name"`
+	} `json:"values"`
+	Next string `json:"next"`
+}
+
+// ListTags returns every tag name of a Bitbucket Cloud repository.
+// Bitbucket Cloud doesn't handle the page parameter for tags, and go-scm only
+// retrieves the first page, so we follow the "next" link returned with each page.
+func ListTags(ctx context.Context, c Client, repository string) ([]string, error) {
+	tags := []string{}
+
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags?pagelen=100", repository)
+	for path != "" {
+		resp, err := (*scm.Client)(c).Do(ctx, &scm.Request{
+			Method: http.MethodGet,
+			Path:   path,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.Status >= 300 {
+			return nil, fmt.Errorf("listing tags of %q: RC %d: %s", repository, resp.Status, body)
+		}
+
+		page := tagsPage{}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("parsing tags of %q: %w", repository, err)
+		}
+
+		for _, tag := range page.Values {
+			tags = append(tags, tag.Name)
+		}
+
+		path = page.Next
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,133 @@
+package pullrequest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+)
+
+func TestCreateAction(t *testing.T) {
+	testData := []struct {
+		name string
+		// pullRequests contains, for each page, the opened pullrequests returned by the Bitbucket api
+		pullRequests []string
+		// missingBranch specifies a branch that doesn't exist on the remote repository
+		missingBranch string
+		wantCreated   bool
+	}{
+		{
+			name:         "New pullrequest",
+			pullRequests: []string{`[]`},
+			wantCreated:  true,
+		},
+		{
+			name: "Existing pullrequest",
+			pullRequests: []string{`[{
+				"id": 4,
+				"state": "OPEN",
+				"source": {"branch": {"name": "updatecli_main"}},
+				"destination": {"branch": {"name": "main"}}
+			}]`},
+		},
+		{
+			name: "Existing pullrequest on the second page",
+			pullRequests: []string{
+				`[{
+					"id": 3,
+					"state": "OPEN",
+					"source": {"branch": {"name": "feature"}},
+					"destination": {"branch": {"name": "main"}}
+				}]`,
+				`[{
+					"id": 4,
+					"state": "OPEN",
+					"source": {"branch": {"name": "updatecli_main"}},
+					"destination": {"branch": {"name": "main"}}
+				}]`,
+			},
+		},
+		{
+			name: "Existing pullrequest for another branch",
+			pullRequests: []string{`[{
+				"id": 4,
+				"state": "OPEN",
+				"source": {"branch": {"name": "feature"}},
+				"destination": {"branch": {"name": "main"}}
+			}]`},
+			wantCreated: true,
+		},
+		{
+			name:          "Missing source branch",
+			pullRequests:  []string{`[]`},
+			missingBranch: "updatecli_main",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPullRequest map[string]interface{}
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /2.0/repositories/updatecli/updatecli/pullrequests", func(w http.ResponseWriter, r *http.Request) {
+				page, err := strconv.Atoi(r.URL.Query().Get("page"))
+				if err != nil || page < 1 || page > len(tt.pullRequests) {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				if page == len(tt.pullRequests) {
+					fmt.Fprintf(w, `{"values": %s}`, tt.pullRequests[page-1])
+					return
+				}
+				fmt.Fprintf(w, `{"values": %s, "next": "http://%s%s?page=%d"}`, tt.pullRequests[page-1], r.Host, r.URL.Path, page+1)
+			})
+			mux.HandleFunc("GET /2.0/repositories/updatecli/updatecli/refs/branches/{branch}", func(w http.ResponseWriter, r *http.Request) {
+				branch := r.PathValue("branch")
+				if branch == tt.missingBranch {
+					w.WriteHeader(http.StatusNotFound)
+					fmt.Fprint(w, `{"type": "error", "error": {"message": "Branch not found"}}`)
+					return
+				}
+				fmt.Fprintf(w, `{"name": %q}`, branch)
+			})
+			mux.HandleFunc("POST /2.0/repositories/updatecli/updatecli/pullrequests", func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&gotPullRequest))
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{"id": 5, "links": {"html": {"href": "https://bitbucket.org/updatecli/updatecli/pull-requests/5"}}}`)
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			b, err := New(map[string]interface{}{
+				"url":          server.URL,
+				"owner":        "updatecli",
+				"repository":   "updatecli",
+				"sourcebranch": "updatecli_main",
+				"targetbranch": "main",
+				"title":        "Bump version",
+			}, nil)
+			require.NoError(t, err)
+
+			err = b.CreateAction(reports.Action{Title: "Bump version"}, false)
+			require.NoError(t, err)
+
+			if !tt.wantCreated {
+				assert.Nil(t, gotPullRequest)
+				return
+			}
+
+			require.NotNil(t, gotPullRequest)
+			assert.Equal(t, "Bump version", gotPullRequest["title"])
+			assert.Equal(t, map[string]interface{}{"branch": map[string]interface{}{"name": "updatecli_main"}}, gotPullRequest["source"])
+			assert.Equal(t, map[string]interface{}{"branch": map[string]interface{}{"name": "main"}}, gotPullRequest["destination"])
+		})
+	}
+}
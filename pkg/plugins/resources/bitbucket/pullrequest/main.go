@@ -0,0 +1,97 @@
+package pullrequest
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/bitbucket/client"
+	bitbucketscm "github.com/updatecli/updatecli/pkg/plugins/scms/bitbucket"
+)
+
+// Spec defines settings used to interact with Bitbucket Cloud pullrequest
+// It's a mapping of user input from a Updatecli manifest and it shouldn't modified
+type Spec struct {
+	client.Spec
+	// SourceBranch specifies the pullrequest source branch
+	SourceBranch string `yaml:",inline,omitempty"`
+	// TargetBranch specifies the pullrequest target branch
+	TargetBranch string `yaml:",inline,omitempty"`
+	// Owner specifies the Bitbucket workspace owning the repository
+	Owner string `yaml:",omitempty"`
+	// Repository specifies the name of a repository for a specific workspace
+	Repository string `yaml:",omitempty"`
+	// Title defines the Bitbucket pullrequest title.
+	Title string `yaml:",inline,omitempty"`
+	// Body defines the Bitbucket pullrequest body
+	Body string `yaml:",inline,omitempty"`
+}
+
+// Bitbucket contains information to interact with Bitbucket Cloud api
+type Bitbucket struct {
+	// spec contains inputs coming from updatecli configuration
+	spec Spec
+	// client handle the api authentication
+	client client.Client
+	// scm allows to interact with a scm object
+	scm *bitbucketscm.Bitbucket
+	// SourceBranch specifies the pullrequest source branch.
+	SourceBranch string `yaml:",inline,omitempty"`
+	// TargetBranch specifies the pullrequest target branch
+	TargetBranch string `yaml:",inline,omitempty"`
+	// Owner specifies the Bitbucket workspace owning the repository
+	Owner string `yaml:",omitempty" jsonschema:"required"`
+	// Repository specifies the name of a repository for a specific workspace
+	Repository string `yaml:",omitempty" jsonschema:"required"`
+}
+
+// New returns a new valid Bitbucket object.
+func New(spec interface{}, scm *bitbucketscm.Bitbucket) (Bitbucket, error) {
+
+	var clientSpec client.Spec
+	var s Spec
+
+	// mapstructure.Decode cannot handle embedded fields
+	// hence we decode it in two steps
+	err := mapstructure.Decode(spec, &clientSpec)
+	if err != nil {
+		return Bitbucket{}, err
+	}
+
+	err = mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Bitbucket{}, err
+	}
+
+	if scm != nil {
+
+		if len(clientSpec.URL) == 0 && len(scm.Spec.URL) > 0 {
+			clientSpec.URL = scm.Spec.URL
+		}
+
+		// Credentials are inherited together to not mix an app password with an access token
+		if len(clientSpec.Token) == 0 && len(clientSpec.Password) == 0 {
+			clientSpec.Username = scm.Spec.Username
+			clientSpec.Password = scm.Spec.Password
+			clientSpec.Token = scm.Spec.Token
+		}
+	}
+
+	// Sanitize modifies the clientSpec so it must be done once initialization is completed
+	err = clientSpec.Sanitize()
+	if err != nil {
+		return Bitbucket{}, err
+	}
+
+	c, err := client.New(clientSpec)
+	if err != nil {
+		return Bitbucket{}, err
+	}
+
+	g := Bitbucket{
+		spec:   s,
+		client: c,
+		scm:    scm,
+	}
+
+	g.inheritFromScm()
+
+	return g, nil
+}
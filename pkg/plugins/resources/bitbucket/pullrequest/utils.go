@@ -0,0 +1,160 @@
+package pullrequest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// getOpenPullRequest queries Bitbucket Cloud to retrieve an already opened pullrequest.
+// It returns nil if no such pullrequest exists.
+func (b *Bitbucket) getOpenPullRequest() (*scm.PullRequest, error) {
+	ctx := context.Background()
+	// Timeout api query after 30sec
+	ctx, cancelList := context.WithTimeout(ctx, 30*time.Second)
+	defer cancelList()
+
+	page := 1
+	for {
+		optsSearch := scm.PullRequestListOptions{
+			Page:   page,
+			Size:   50,
+			Open:   true,
+			Closed: false,
+		}
+
+		pullrequests, resp, err := b.client.PullRequests.List(
+			ctx,
+			strings.Join([]string{
+				b.Owner,
+				b.Repository}, "/"),
+			optsSearch,
+		)
+
+		if err != nil {
+			if resp != nil {
+				logrus.Debugf("RC: %d\nBody:\n%s", resp.Status, resp.Body)
+			}
+			return nil, err
+		}
+
+		if resp.Status > 400 {
+			logrus.Debugf("RC: %d\nBody:\n%s", resp.Status, resp.Body)
+		}
+
+		for _, p := range pullrequests {
+			if p.Source == b.SourceBranch &&
+				p.Target == b.TargetBranch &&
+				!p.Closed &&
+				!p.Merged {
+
+				logrus.Infof("%s Nothing else to do, our pullrequest already exist on:\n\t%s",
+					result.SUCCESS,
+					p.Link)
+
+				return p, nil
+			}
+		}
+
+		if resp.Page.Next == 0 || resp.Page.Next == page {
+			break
+		}
+		page = resp.Page.Next
+	}
+
+	return nil, nil
+}
+
+// isRemoteBranchesExist queries Bitbucket Cloud to know if both the pull-request source branch and the target branch exist.
+func (b *Bitbucket) isRemoteBranchesExist() (bool, error) {
+
+	var sourceBranch string
+	var targetBranch string
+	var owner string
+	var repository string
+
+	if b.scm != nil {
+		_, sourceBranch, targetBranch = b.scm.GetBranches()
+		owner = b.scm.Spec.Owner
+		repository = b.scm.Spec.Repository
+	}
+
+	if len(b.spec.SourceBranch) > 0 {
+		sourceBranch = b.spec.SourceBranch
+	}
+
+	if len(b.spec.TargetBranch) > 0 {
+		targetBranch = b.spec.TargetBranch
+	}
+
+	if len(b.spec.Owner) > 0 {
+		owner = b.spec.Owner
+	}
+
+	if len(b.spec.Repository) > 0 {
+		repository = b.spec.Repository
+	}
+
+	// Timeout api query after 30sec
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	foundRemoteBranches := true
+	for _, branch := range []string{sourceBranch, targetBranch} {
+		_, resp, err := b.client.Git.FindBranch(
+			ctx,
+			strings.Join([]string{owner, repository}, "/"),
+			branch,
+		)
+
+		if resp != nil && resp.Status == http.StatusNotFound {
+			logrus.Debugf("Branch %q not found on remote repository %s/%s",
+				branch,
+				owner,
+				repository)
+			foundRemoteBranches = false
+			continue
+		}
+
+		if err != nil {
+			if resp != nil {
+				logrus.Debugf("RC: %d\nBody:\n%s", resp.Status, resp.Body)
+			}
+			return false, err
+		}
+	}
+
+	return foundRemoteBranches, nil
+}
+
+// inheritFromScm retrieve missing Bitbucket settings from the Bitbucket scm object.
+func (b *Bitbucket) inheritFromScm() {
+
+	if b.scm != nil {
+		_, b.SourceBranch, b.TargetBranch = b.scm.GetBranches()
+		b.Owner = b.scm.Spec.Owner
+		b.Repository = b.scm.Spec.Repository
+	}
+
+	if len(b.spec.SourceBranch) > 0 {
+		b.SourceBranch = b.spec.SourceBranch
+	}
+
+	if len(b.spec.TargetBranch) > 0 {
+		b.TargetBranch = b.spec.TargetBranch
+	}
+
+	if len(b.spec.Owner) > 0 {
+		b.Owner = b.spec.Owner
+	}
+
+	if len(b.spec.Repository) > 0 {
+		b.Repository = b.spec.Repository
+	}
+}
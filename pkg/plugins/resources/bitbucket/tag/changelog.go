@@ -0,0 +1,6 @@
+package tag
+
+// Changelog returns the changelog for this resource, or an empty string if not supported
+func (g *Bitbucket) Changelog() string {
+	return ""
+}
@@ -0,0 +1,39 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+func (g *Bitbucket) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
+	if scm != nil {
+		logger.Warningf("scm not supported, ignored")
+	}
+
+	tag := source
+	if g.spec.Tag != "" {
+		tag = g.spec.Tag
+	}
+
+	tags, err := g.SearchTags()
+	if err != nil {
+		return false, "", fmt.Errorf("looking for tag: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return false, fmt.Sprintf("no Bitbucket Tags found for %s/%s", g.spec.Owner, g.spec.Repository), nil
+	}
+
+	for _, t := range tags {
+		if t == tag {
+			return true, fmt.Sprintf("Bitbucket tag %q found", tag), nil
+		}
+	}
+
+	return false, fmt.Sprintf("no Bitbucket Tags found matching %q", tag), nil
+}
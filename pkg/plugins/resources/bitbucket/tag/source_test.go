@@ -0,0 +1,103 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+func TestSource(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2.0/repositories/updatecli/updatecli/refs/tags", func(w http.ResponseWriter, r *http.Request) {
+		// Tags are spread over two pages to ensure we follow the "next" link
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprintf(w, `{"values": [{"name": "v0.1.0"}, {"name": "v0.2.0"}], "next": "http://%s%s?page=2"}`, r.Host, r.URL.Path)
+		case "2":
+			fmt.Fprint(w, `{"values": [{"name": "v0.3.0"}, {"name": "nightly"}]}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	mux.HandleFunc("GET /2.0/repositories/updatecli/missing/refs/tags", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"type": "error", "error": {"message": "Repository not found"}}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		spec       map[string]interface{}
+		wantResult string
+		wantErr    bool
+	}{
+		{
+			name: "latest tag from the last page",
+			spec: map[string]interface{}{
+				"url":        server.URL,
+				"owner":      "updatecli",
+				"repository": "updatecli",
+				"versionfilter": map[string]interface{}{
+					"kind": "semver",
+				},
+			},
+			wantResult: "v0.3.0",
+		},
+		{
+			name: "no tag matching pattern",
+			spec: map[string]interface{}{
+				"url":        server.URL,
+				"owner":      "updatecli",
+				"repository": "updatecli",
+				"versionfilter": map[string]interface{}{
+					"kind":    "regex",
+					"pattern": "^release",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing repository",
+			spec: map[string]interface{}{
+				"url":        server.URL,
+				"owner":      "updatecli",
+				"repository": "missing",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = b.Source(context.Background(), "", &gotResult)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, gotResult.Information)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(map[string]interface{}{
+		"owner":      "updatecli",
+		"repository": []string{"updatecli"},
+	})
+	require.Error(t, err)
+}
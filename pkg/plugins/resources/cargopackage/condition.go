@@ -1,25 +1,28 @@
 package cargopackage
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition checks if a cargo package with a specific version is published
 // We assume that if we can't find the package version in the index, then it means it doesn't exist.
-func (cp *CargoPackage) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (cp *CargoPackage) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
 		path := scm.GetDirectory()
 		if cp.spec.Registry.RootDir != "" {
-			logrus.Warningf("Registry.RootDir is defined and set to %q but is overridden by the scm definition %q",
+			logger.Warningf("Registry.RootDir is defined and set to %q but is overridden by the scm definition %q",
 				cp.spec.Registry.RootDir,
 				path)
 		}
 		if cp.spec.Registry.URL != "" {
-			logrus.Warningf("Registry.URL is defined and set to %q but is overridden by the scm definition %q",
+			logger.Warningf("Registry.URL is defined and set to %q but is overridden by the scm definition %q",
 				cp.spec.Registry.URL,
 				path)
 		}
@@ -34,7 +37,7 @@ func (cp *CargoPackage) Condition(source string, scm scm.ScmHandler) (pass bool,
 		return false, "", errors.New("no version defined")
 	}
 
-	_, versions, err := cp.getVersions()
+	_, versions, err := cp.getVersions(ctx)
 	if err != nil {
 		return false, "", fmt.Errorf("getting cargo package version: %w", err)
 	}
@@ -2,6 +2,7 @@ package cargopackage
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/cargo"
 	httputils "github.com/updatecli/updatecli/pkg/plugins/utils/http"
 
@@ -108,8 +110,8 @@ func (cp *CargoPackage) Changelog() string {
 }
 
 // GetVersions fetch all versions of the Cargo package
-func (cp *CargoPackage) getVersions() (v string, versions []string, err error) {
-	cp.packageData, err = cp.getPackageData()
+func (cp *CargoPackage) getVersions(ctx context.Context) (v string, versions []string, err error) {
+	cp.packageData, err = cp.getPackageData(ctx)
 
 	if err != nil {
 		return "", nil, err
@@ -134,10 +136,12 @@ func (cp *CargoPackage) getVersions() (v string, versions []string, err error) {
 	return cp.foundVersion.GetVersion(), versions, nil
 }
 
-func getPackageFileDir(packageName string) (string, error) {
+func getPackageFileDir(ctx context.Context, packageName string) (string, error) {
+	logger := log.FromContext(ctx)
+
 	if packageName == "" {
 		err := errors.New("got empty package name")
-		logrus.Errorf("%q\n", err)
+		logger.Errorf("%q\n", err)
 		return "", err
 	}
 	switch packageNameLen := len(packageName); packageNameLen {
@@ -152,12 +156,14 @@ func getPackageFileDir(packageName string) (string, error) {
 	}
 }
 
-func (cp *CargoPackage) getPackageDataFromApi(name string, indexUrl string) (PackageData, error) {
+func (cp *CargoPackage) getPackageDataFromApi(ctx context.Context, name string, indexUrl string) (PackageData, error) {
+	logger := log.FromContext(ctx)
+
 	packageUrl := fmt.Sprintf("%s/%s", indexUrl, name)
 
 	req, err := http.NewRequest("GET", packageUrl, nil)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting cargo api data %q\n", err)
+		logger.Errorf("something went wrong while getting cargo api data %q\n", err)
 		return PackageData{}, err
 	}
 
@@ -173,7 +179,7 @@ func (cp *CargoPackage) getPackageDataFromApi(name string, indexUrl string) (Pac
 
 	res, err := cp.webClient.Do(req)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting cargo api data %q\n", err)
+		logger.Errorf("something went wrong while getting cargo api data %q\n", err)
 		return PackageData{}, err
 	}
 	defer res.Body.Close()
@@ -181,18 +187,20 @@ func (cp *CargoPackage) getPackageDataFromApi(name string, indexUrl string) (Pac
 	var d PackageData
 	err = json.NewDecoder(res.Body).Decode(&d)
 	if err != nil && err != io.EOF {
-		logrus.Errorf("something went wrong while reading cargo api data%q\n", err)
+		logger.Errorf("something went wrong while reading cargo api data%q\n", err)
 		return PackageData{}, err
 	}
 	return d, nil
 }
 
-func (cp *CargoPackage) getPackageDataFromFS(name string, indexDir string) (PackageData, error) {
+func (cp *CargoPackage) getPackageDataFromFS(ctx context.Context, name string, indexDir string) (PackageData, error) {
+	logger := log.FromContext(ctx)
+
 	var pd PackageData
 	pd.Crate.Name = name
-	packageDir, err := getPackageFileDir(name)
+	packageDir, err := getPackageFileDir(ctx, name)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting the package directory from its name %q\n", err)
+		logger.Errorf("something went wrong while getting the package directory from its name %q\n", err)
 		return pd, err
 	}
 	packageFilePath := filepath.Join(indexDir, packageDir, name)
@@ -203,7 +211,7 @@ func (cp *CargoPackage) getPackageDataFromFS(name string, indexDir string) (Pack
 	defer func(packageInfoFile *os.File) {
 		err := packageInfoFile.Close()
 		if err != nil {
-			logrus.Errorf("something went wrong while cleaning the package file %q\n", err)
+			logger.Errorf("something went wrong while cleaning the package file %q\n", err)
 		}
 	}(packageInfoFile)
 
@@ -212,7 +220,7 @@ func (cp *CargoPackage) getPackageDataFromFS(name string, indexDir string) (Pack
 		var packageVersion PackageVersion
 		err = json.Unmarshal(scanner.Bytes(), &packageVersion)
 		if err != nil {
-			logrus.Errorf("something went wrong while parsing the version %q\n", err)
+			logger.Errorf("something went wrong while parsing the version %q\n", err)
 		}
 		if packageVersion.Yanked {
 			continue
@@ -225,9 +233,9 @@ func (cp *CargoPackage) getPackageDataFromFS(name string, indexDir string) (Pack
 }
 
 // Get package data from Json API
-func (cp *CargoPackage) getPackageData() (PackageData, error) {
+func (cp *CargoPackage) getPackageData(ctx context.Context) (PackageData, error) {
 	if cp.registry.RootDir != "" {
-		return cp.getPackageDataFromFS(cp.spec.Package, cp.registry.RootDir)
+		return cp.getPackageDataFromFS(ctx, cp.spec.Package, cp.registry.RootDir)
 	}
-	return cp.getPackageDataFromApi(cp.spec.Package, cp.registry.URL)
+	return cp.getPackageDataFromApi(ctx, cp.spec.Package, cp.registry.URL)
 }
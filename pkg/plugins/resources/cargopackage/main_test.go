@@ -1,6 +1,7 @@
 package cargopackage
 
 import (
+	"context"
 	"testing"
 
 	"github.com/updatecli/updatecli/pkg/plugins/utils/cargo"
@@ -62,7 +63,7 @@ func TestPackageDir(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, gotErr := getPackageFileDir(tt.packageName)
+			got, gotErr := getPackageFileDir(context.Background(), tt.packageName)
 			if tt.wantErr {
 				require.Error(t, gotErr)
 				return
@@ -1,21 +1,24 @@
 package cargopackage
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Source returns the latest npm package version
-func (cp CargoPackage) Source(workingDir string, resultSource *result.Source) error {
-	logrus.Debugf("Registry RootDir: %s, workingDir: %s", cp.registry.RootDir, workingDir)
+func (cp CargoPackage) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
+
+	logger.Debugf("Registry RootDir: %s, workingDir: %s", cp.registry.RootDir, workingDir)
 	if cp.isSCM {
 		// We are in a scm context, workingDir is holding the data
 		cp.registry.RootDir = workingDir
 	}
 
-	version, _, err := cp.getVersions()
+	version, _, err := cp.getVersions(ctx)
 	if err != nil {
 		return fmt.Errorf("get cargo packages versions: %w", err)
 	}
@@ -1,12 +1,13 @@
 package cargopackage
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (cp *CargoPackage) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (cp *CargoPackage) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
 	return fmt.Errorf("Target not supported for the plugin Cargo Package")
 }
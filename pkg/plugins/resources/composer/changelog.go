@@ -0,0 +1,12 @@
+package composer
+
+import "fmt"
+
+// Changelog returns the link to the found package version on packagist.org
+func (c *Composer) Changelog() string {
+	if c.foundVersion.GetVersion() == "" || c.spec.URL != packagistDefaultURL {
+		return ""
+	}
+
+	return fmt.Sprintf("https://packagist.org/packages/%s#%s\n", c.spec.Name, c.foundVersion.GetVersion())
+}
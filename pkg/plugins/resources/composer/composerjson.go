@@ -0,0 +1,127 @@
+package composer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+)
+
+var (
+	// requireSections are the composer.json sections containing package version constraints
+	requireSections = []string{"require", "require-dev"}
+	// constraintRegex matches a version constraint supported by the target such as "^5.4"
+	constraintRegex = regexp.MustCompile(`^(\^|~|>=|==?)?(\s*)v?([0-9]+(?:\.[0-9]+){0,3})$`)
+)
+
+// sectionRange returns the offsets of the value of each top level key from a json document
+func sectionRange(content string) (map[string][2]int, error) {
+	ranges := map[string][2]int{}
+
+	decoder := json.NewDecoder(strings.NewReader(content))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if token != json.Delim('{') {
+		return nil, fmt.Errorf("expecting a json object")
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		key, ok := token.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected json token %v", token)
+		}
+
+		start := int(decoder.InputOffset())
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		ranges[key] = [2]int{start, int(decoder.InputOffset())}
+	}
+
+	return ranges, nil
+}
+
+// updateComposerJSON returns the composer.json content with the package version constraint set to the new version
+// and the constraints previously defined
+func updateComposerJSON(ctx context.Context, content, name, newVersion string) (string, []string, error) {
+	logger := log.FromContext(ctx)
+
+	ranges, err := sectionRange(content)
+	if err != nil {
+		return "", nil, fmt.Errorf("parsing composer.json: %w", err)
+	}
+
+	newVersion = strings.TrimPrefix(newVersion, "v")
+
+	packageRegex := regexp.MustCompile(`("` + regexp.QuoteMeta(name) + `"\s*:\s*")([^"]*)(")`)
+
+	var oldConstraints []string
+
+	for _, section := range requireSections {
+		r, ok := ranges[section]
+		if !ok {
+			continue
+		}
+
+		sectionContent := content[r[0]:r[1]]
+		m := packageRegex.FindStringSubmatchIndex(sectionContent)
+		if m == nil {
+			continue
+		}
+
+		oldConstraint := sectionContent[m[4]:m[5]]
+		c := constraintRegex.FindStringSubmatch(oldConstraint)
+		if c == nil {
+			logger.Warningf("composer package %q uses the version constraint %q not supported by Updatecli, skipping", name, oldConstraint)
+			continue
+		}
+
+		operator, space, oldVersion := c[1], c[2], c[3]
+		updatedVersion := newVersion
+		if operator == "^" || operator == "~" || operator == ">=" {
+			updatedVersion = keepPrecision(oldVersion, newVersion)
+		}
+
+		sectionContent = sectionContent[:m[4]] + operator + space + updatedVersion + sectionContent[m[5]:]
+		content = content[:r[0]] + sectionContent + content[r[1]:]
+		oldConstraints = append(oldConstraints, oldConstraint)
+
+		// Offsets of the following sections changed
+		ranges, err = sectionRange(content)
+		if err != nil {
+			return "", nil, fmt.Errorf("parsing composer.json: %w", err)
+		}
+	}
+
+	return content, oldConstraints, nil
+}
+
+// keepPrecision truncates the new version to the number of segments of the old one,
+// so a constraint like "^5.4" keeps allowing the same range of updates
+func keepPrecision(oldVersion, newVersion string) string {
+	// Drop the stability suffix such as "-RC1"
+	newVersion, _, _ = strings.Cut(newVersion, "-")
+
+	oldSegments := strings.Split(oldVersion, ".")
+	newSegments := strings.Split(newVersion, ".")
+
+	if len(newSegments) <= len(oldSegments) {
+		return newVersion
+	}
+
+	return strings.Join(newSegments[:len(oldSegments)], ".")
+}
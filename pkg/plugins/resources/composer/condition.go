@@ -0,0 +1,45 @@
+package composer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a package version exists on the composer repository
+func (c *Composer) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
+	if scm != nil {
+		logger.Warningf("SCM configuration is not supported for composer condition, aborting")
+	}
+
+	versionToCheck := c.spec.Version
+	if versionToCheck == "" {
+		versionToCheck = source
+	}
+	if len(versionToCheck) == 0 {
+		return false, "", errors.New("no version defined")
+	}
+
+	versions, err := c.getVersions(ctx)
+	if err != nil {
+		return false, "", err
+	}
+
+	// Tags are published with or without the "v" prefix
+	found := slices.ContainsFunc(versions, func(v string) bool {
+		return strings.TrimPrefix(v, "v") == strings.TrimPrefix(versionToCheck, "v")
+	})
+
+	if !found {
+		return false, fmt.Sprintf("version %q of composer package %q doesn't exist", versionToCheck, c.spec.Name), nil
+	}
+
+	return true, fmt.Sprintf("version %q of composer package %q available", versionToCheck, c.spec.Name), nil
+}
@@ -0,0 +1,72 @@
+package composer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition(t *testing.T) {
+	server := newTestRepository(t)
+
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		source   string
+		expected bool
+		wantErr  bool
+	}{
+		{
+			name: "Existing version",
+			spec: map[string]interface{}{
+				"name":    "symfony/console",
+				"url":     server.URL,
+				"version": "6.4.0",
+			},
+			expected: true,
+		},
+		{
+			name: "Existing version from source",
+			spec: map[string]interface{}{
+				"name": "symfony/console",
+				"url":  server.URL,
+			},
+			source:   "v5.4.32",
+			expected: true,
+		},
+		{
+			name: "Missing version",
+			spec: map[string]interface{}{
+				"name":    "symfony/console",
+				"url":     server.URL,
+				"version": "6.5.0",
+			},
+			expected: false,
+		},
+		{
+			name: "No version",
+			spec: map[string]interface{}{
+				"name": "symfony/console",
+				"url":  server.URL,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.spec)
+			require.NoError(t, err)
+
+			got, _, err := c.Condition(context.Background(), tt.source, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package composer
+
+import (
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/text"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// packagistDefaultURL is the default composer repository
+	packagistDefaultURL string = "https://repo.packagist.org"
+	// privatePackagistUsername is the username used by Private Packagist to authenticate with a token
+	privatePackagistUsername string = "token"
+)
+
+// Composer defines a resource of kind "composer"
+type Composer struct {
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different than the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	foundVersion  version.Version
+	webClient     httpclient.HTTPClient
+	// contentRetriever reads and writes the file updated by the target
+	contentRetriever text.TextRetriever
+}
+
+// New returns a new valid Composer object.
+func New(spec interface{}) (*Composer, error) {
+	var newSpec Spec
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return &Composer{}, err
+	}
+
+	err = newSpec.Validate()
+	if err != nil {
+		return &Composer{}, err
+	}
+
+	if len(newSpec.URL) == 0 {
+		newSpec.URL = packagistDefaultURL
+	}
+	newSpec.URL = strings.TrimSuffix(newSpec.URL, "/")
+
+	if len(newSpec.Token) > 0 && len(newSpec.Username) == 0 {
+		newSpec.Username = privatePackagistUsername
+	}
+
+	if len(newSpec.File) == 0 {
+		newSpec.File = "composer.json"
+	}
+	newSpec.File = strings.TrimPrefix(newSpec.File, "file://")
+
+	newFilter, err := newSpec.VersionFilter.Init()
+	if err != nil {
+		return &Composer{}, err
+	}
+
+	// Unset retry settings are taken from the global retry policy
+	webClient, err := httpclient.NewRetryClientWithPolicy(newSpec.Retry)
+	if err != nil {
+		return &Composer{}, err
+	}
+
+	return &Composer{
+		spec:             newSpec,
+		versionFilter:    newFilter,
+		webClient:        webClient,
+		contentRetriever: &text.Text{},
+	}, nil
+}
@@ -0,0 +1,256 @@
+package composer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+var (
+	// ErrPackageNotFound is returned when the composer repository doesn't know the package
+	ErrPackageNotFound error = errors.New("package not found")
+)
+
+// repositoryIndex is the packages.json file describing a composer repository
+// https://getcomposer.org/doc/05-repositories.md#packages
+type repositoryIndex struct {
+	// MetadataURL is the url template of the package metadata such as "/p2/%package%.json"
+	MetadataURL string `json:"metadata-url"`
+	// Packages contains the package versions when they are inlined like Satis does.
+	// Packagist returns an empty array
+	Packages json.RawMessage `json:"packages"`
+	// Includes lists additional files containing package versions
+	Includes map[string]interface{} `json:"includes"`
+}
+
+// packageMetadata is the composer v2 metadata of a package
+type packageMetadata struct {
+	Packages map[string][]struct {
+		Version string `json:"version"`
+	} `json:"packages"`
+}
+
+// get queries the composer repository and decodes the json response into v.
+// Credentials are only sent to the host serving the composer repository.
+func (c *Composer) get(ctx context.Context, URL string, v interface{}) error {
+	logger := log.FromContext(ctx)
+
+	req, err := http.NewRequest("GET", URL, nil)
+	if err != nil {
+		return err
+	}
+
+	if len(c.spec.Token) > 0 && isSameHost(c.spec.URL, URL) {
+		req.SetBasicAuth(c.spec.Username, c.spec.Token)
+	}
+
+	res, err := c.webClient.Do(req)
+	if err != nil {
+		logger.Errorf("something went wrong while querying the composer repository %q\n", err)
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrPackageNotFound
+	}
+
+	if res.StatusCode >= 400 {
+		body, _ := httputil.DumpResponse(res, false)
+		logger.Debugf("\n%v\n", string(body))
+		return fmt.Errorf("querying composer repository %q: %s", URL, res.Status)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding composer repository response %q: %w", URL, err)
+	}
+
+	return nil
+}
+
+// resolveURL returns the url of a file referenced by the composer repository
+func (c *Composer) resolveURL(ref string) (string, error) {
+	base, err := url.Parse(c.spec.URL + "/")
+	if err != nil {
+		return "", err
+	}
+
+	u, err := base.Parse(ref)
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
+// getVersions returns every stable and unstable package version, from the oldest to the newest.
+// Development branches such as "dev-main" are ignored.
+func (c *Composer) getVersions(ctx context.Context) ([]string, error) {
+	var index repositoryIndex
+
+	if err := c.get(ctx, c.spec.URL+"/packages.json", &index); err != nil {
+		if errors.Is(err, ErrPackageNotFound) {
+			return nil, fmt.Errorf("composer repository %q not found", c.spec.URL)
+		}
+		return nil, err
+	}
+
+	var versions []string
+	var err error
+
+	switch {
+	case index.MetadataURL != "":
+		versions, err = c.getMetadataVersions(ctx, index.MetadataURL)
+	default:
+		versions, err = c.getInlineVersions(ctx, index)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	versions = slices.DeleteFunc(versions, func(v string) bool {
+		return strings.HasPrefix(v, "dev-") || strings.HasSuffix(v, "-dev")
+	})
+
+	slices.SortStableFunc(versions, compareVersions)
+
+	return slices.Compact(versions), nil
+}
+
+// getMetadataVersions returns the package versions from the composer v2 metadata
+func (c *Composer) getMetadataVersions(ctx context.Context, metadataURL string) ([]string, error) {
+	URL, err := c.resolveURL(strings.ReplaceAll(metadataURL, "%package%", c.spec.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata packageMetadata
+	if err := c.get(ctx, URL, &metadata); err != nil {
+		if errors.Is(err, ErrPackageNotFound) {
+			return nil, fmt.Errorf("composer package %q: %w", c.spec.Name, err)
+		}
+		return nil, err
+	}
+
+	var versions []string
+	for _, v := range metadata.Packages[c.spec.Name] {
+		versions = append(versions, v.Version)
+	}
+
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("composer package %q: %w", c.spec.Name, ErrPackageNotFound)
+	}
+
+	return versions, nil
+}
+
+// getInlineVersions returns the package versions inlined in the repository index,
+// or in one of its included files, as generated by Satis
+func (c *Composer) getInlineVersions(ctx context.Context, index repositoryIndex) ([]string, error) {
+	if versions := index.packageVersions(c.spec.Name); len(versions) > 0 {
+		return versions, nil
+	}
+
+	includes := make([]string, 0, len(index.Includes))
+	for include := range index.Includes {
+		includes = append(includes, include)
+	}
+	slices.Sort(includes)
+
+	for _, include := range includes {
+		URL, err := c.resolveURL(include)
+		if err != nil {
+			return nil, err
+		}
+
+		var includedIndex repositoryIndex
+		if err := c.get(ctx, URL, &includedIndex); err != nil {
+			return nil, err
+		}
+
+		if versions := includedIndex.packageVersions(c.spec.Name); len(versions) > 0 {
+			return versions, nil
+		}
+	}
+
+	return nil, fmt.Errorf("composer package %q: %w", c.spec.Name, ErrPackageNotFound)
+}
+
+// packageVersions returns the versions of a package inlined in the repository index
+func (r repositoryIndex) packageVersions(name string) []string {
+	var packages map[string]map[string]struct {
+		Version string `json:"version"`
+	}
+
+	// Packagist returns an empty array when packages are not inlined
+	if err := json.Unmarshal(r.Packages, &packages); err != nil {
+		return nil
+	}
+
+	var versions []string
+	for key, p := range packages[name] {
+		v := p.Version
+		if v == "" {
+			v = key
+		}
+		versions = append(versions, v)
+	}
+
+	return versions
+}
+
+// compareVersions compares two composer versions,
+// versions not following semantic versioning are considered older
+func compareVersions(a, b string) int {
+	versionA, errA := semver.NewVersion(a)
+	versionB, errB := semver.NewVersion(b)
+
+	switch {
+	case errA == nil && errB == nil:
+		return versionA.Compare(versionB)
+	case errA == nil:
+		return 1
+	case errB == nil:
+		return -1
+	}
+
+	return strings.Compare(a, b)
+}
+
+// isPrerelease returns true if the version isn't stable such as "7.0.0-RC1"
+func isPrerelease(v string) bool {
+	return strings.Contains(v, "-")
+}
+
+// isSameHost returns true if both urls target the same host
+func isSameHost(a, b string) bool {
+	urlA, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	urlB, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(urlA.Host, urlB.Host)
+}
+
+// searchVersion returns the newest package version matching the version filter
+func (c *Composer) searchVersion(versions []string) (version.Version, error) {
+	if c.versionFilter.Kind == version.LATESTVERSIONKIND && c.versionFilter.Pattern == version.LATESTVERSIONKIND {
+		versions = slices.DeleteFunc(slices.Clone(versions), isPrerelease)
+	}
+
+	return c.versionFilter.Search(versions)
+}
@@ -0,0 +1,36 @@
+package composer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Source returns the newest package version matching the version filter
+func (c *Composer) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	versions, err := c.getVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("no version found for composer package %q", c.spec.Name)
+	}
+
+	c.foundVersion, err = c.searchVersion(versions)
+	if err != nil {
+		return err
+	}
+
+	foundVersion := c.foundVersion.GetVersion()
+	if foundVersion == "" {
+		return fmt.Errorf("no version found for composer package %q", c.spec.Name)
+	}
+
+	resultSource.Information = foundVersion
+	resultSource.Result = result.SUCCESS
+	resultSource.Description = fmt.Sprintf("version %q found for composer package %q", foundVersion, c.spec.Name)
+
+	return nil
+}
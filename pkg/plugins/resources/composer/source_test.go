@@ -0,0 +1,163 @@
+package composer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+const (
+	// consoleMetadata is the composer v2 metadata of the symfony/console package
+	consoleMetadata string = `{"packages": {"symfony/console": [
+		{"name": "symfony/console", "version": "v7.0.0-RC1", "version_normalized": "7.0.0.0-RC1"},
+		{"version": "v6.4.1", "version_normalized": "6.4.1.0"},
+		{"version": "v6.3.12", "version_normalized": "6.3.12.0"},
+		{"version": "v6.4.0", "version_normalized": "6.4.0.0"},
+		{"version": "v5.4.32", "version_normalized": "5.4.32.0"}
+	]}, "minified": "composer/2.0"}`
+	// satisIndex is the packages.json of a Satis repository inlining package versions
+	satisIndex string = `{"packages": {"acme/toolkit": {
+		"1.0.0": {"name": "acme/toolkit", "version": "1.0.0"},
+		"1.2.0": {"name": "acme/toolkit", "version": "1.2.0"},
+		"dev-main": {"name": "acme/toolkit", "version": "dev-main"}
+	}}}`
+)
+
+// newTestRepository returns a composer repository serving packages like Packagist,
+// the "private" repository requires the token "secret", and the "satis" repositories inline package versions
+func newTestRepository(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /packages.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"packages": [], "metadata-url": "/p2/%package%.json"}`))
+	})
+	mux.HandleFunc("GET /p2/symfony/console.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(consoleMetadata))
+	})
+	mux.HandleFunc("GET /private/packages.json", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "token" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"packages": [], "metadata-url": "p2/%package%.json"}`))
+	})
+	mux.HandleFunc("GET /private/p2/symfony/console.json", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "token" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(consoleMetadata))
+	})
+	mux.HandleFunc("GET /satis/packages.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(satisIndex))
+	})
+	mux.HandleFunc("GET /satis-includes/packages.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"packages": [], "includes": {"include/all$abc.json": {"sha1": "abc"}}}`)
+	})
+	mux.HandleFunc("GET /satis-includes/include/all$abc.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(satisIndex))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSource(t *testing.T) {
+	server := newTestRepository(t)
+
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "Latest stable version",
+			spec: map[string]interface{}{
+				"name": "symfony/console",
+				"url":  server.URL,
+			},
+			expected: "v6.4.1",
+		},
+		{
+			name: "Version matching a semver constraint",
+			spec: map[string]interface{}{
+				"name": "symfony/console",
+				"url":  server.URL + "/",
+				"versionfilter": map[string]interface{}{
+					"kind":    "semver",
+					"pattern": "~6.3",
+				},
+			},
+			expected: "v6.3.12",
+		},
+		{
+			name: "Private repository",
+			spec: map[string]interface{}{
+				"name":  "symfony/console",
+				"url":   server.URL + "/private",
+				"token": "secret",
+			},
+			expected: "v6.4.1",
+		},
+		{
+			name: "Private repository with wrong credentials",
+			spec: map[string]interface{}{
+				"name":  "symfony/console",
+				"url":   server.URL + "/private",
+				"token": "wrong",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Satis repository",
+			spec: map[string]interface{}{
+				"name": "acme/toolkit",
+				"url":  server.URL + "/satis",
+			},
+			expected: "1.2.0",
+		},
+		{
+			name: "Satis repository with includes",
+			spec: map[string]interface{}{
+				"name": "acme/toolkit",
+				"url":  server.URL + "/satis-includes",
+			},
+			expected: "1.2.0",
+		},
+		{
+			name: "Unknown package",
+			spec: map[string]interface{}{
+				"name": "acme/donotexist",
+				"url":  server.URL,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = c.Source(context.Background(), "", &gotResult)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, gotResult.Information)
+		})
+	}
+}
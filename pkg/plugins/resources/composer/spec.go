@@ -0,0 +1,84 @@
+package composer
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+var (
+	// ErrWrongSpec is returned when the Spec has wrong content
+	ErrWrongSpec error = errors.New("wrong spec content")
+)
+
+// Spec defines a specification for a "composer" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	// [S][C][T] Name defines the composer package name such as "symfony/console"
+	Name string `yaml:",omitempty" jsonschema:"required"`
+	// [C][T] Version defines a specific package version
+	Version string `yaml:",omitempty"`
+	/*
+		[S][C] URL defines the composer repository, such as Packagist, a Satis repository, or Private Packagist
+
+		default:
+			https://repo.packagist.org
+	*/
+	URL string `yaml:",omitempty"`
+	/*
+		[S][C] Username defines the username used to authenticate with the composer repository
+
+		default:
+			"token" when only a token is provided, as expected by Private Packagist
+	*/
+	Username string `yaml:",omitempty"`
+	/*
+		[S][C] Token defines the token, or password, used to authenticate with the composer repository
+
+		remark:
+			A token is a sensitive information, it's recommended to not set this value directly in the configuration file
+			but to use an environment variable or a SOPS file.
+
+			The value can be set to `{{ requiredEnv "COMPOSER_TOKEN"}}` to retrieve the token from the environment variable `COMPOSER_TOKEN`
+	*/
+	Token string `yaml:",omitempty"`
+	/*
+		[S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+
+		remark:
+			The kind "latest" ignores unstable versions such as "7.0.0-RC1"
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	/*
+		[T] File defines the composer.json file updated by the target.
+		The package version constraint is updated from the "require" and "require-dev" sections.
+
+		default:
+			composer.json
+	*/
+	File string `yaml:",omitempty"`
+	/*
+		retry defines how failed requests to the composer repository are retried.
+
+		default:
+			the global retry policy defined by the "--http-retry-*" flags
+	*/
+	Retry httpclient.RetryPolicy `yaml:",omitempty"`
+}
+
+// Validate run some validation on the Spec
+func (s Spec) Validate() error {
+	if len(s.Name) == 0 {
+		logrus.Errorf("composer package name not defined")
+		return ErrWrongSpec
+	}
+
+	if len(s.Username) > 0 && len(s.Token) == 0 {
+		logrus.Errorf("parameter %q requires %q", "username", "token")
+		return ErrWrongSpec
+	}
+
+	return nil
+}
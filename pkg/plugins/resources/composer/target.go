@@ -0,0 +1,86 @@
+package composer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/core/text"
+	"github.com/updatecli/updatecli/pkg/plugins/utils"
+)
+
+// Target updates the package version constraint from a composer.json file
+func (c *Composer) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
+
+	version := source
+	if c.spec.Version != "" {
+		version = c.spec.Version
+	}
+
+	if version == "" {
+		return fmt.Errorf("no version defined for composer package %q", c.spec.Name)
+	}
+
+	filename := c.spec.File
+	if scm != nil {
+		filename = utils.JoinFilePathWithWorkingDirectoryPath(filename, scm.GetDirectory())
+	}
+
+	if !c.contentRetriever.FileExists(filename) {
+		return fmt.Errorf("file %q does not exist", filename)
+	}
+
+	content, err := c.contentRetriever.ReadAll(filename)
+	if err != nil {
+		return fmt.Errorf("reading file: %w", err)
+	}
+
+	newContent, oldConstraints, err := updateComposerJSON(ctx, content, c.spec.Name, version)
+	if err != nil {
+		return err
+	}
+
+	if len(oldConstraints) == 0 {
+		return fmt.Errorf("composer package %q with a supported version constraint not found in %q", c.spec.Name, filename)
+	}
+
+	resultTarget.Information = oldConstraints[0]
+	resultTarget.NewInformation = version
+	resultTarget.Changed = newContent != content
+
+	if !resultTarget.Changed {
+		resultTarget.Result = result.SUCCESS
+		resultTarget.Description = fmt.Sprintf("%s already set composer package %q to version %q", filename, c.spec.Name, version)
+		return nil
+	}
+
+	resultTarget.Result = result.ATTENTION
+
+	if dryRun {
+		resultTarget.Description = fmt.Sprintf("%s should update composer package %q from %q to %q",
+			filename,
+			c.spec.Name,
+			resultTarget.Information,
+			resultTarget.NewInformation)
+		return nil
+	}
+
+	logger.Debugf("\n%s\n", text.Diff(filename, filename, content, newContent))
+
+	err = c.contentRetriever.WriteToFile(newContent, filename)
+	if err != nil {
+		return fmt.Errorf("writing file %q: %w", filename, err)
+	}
+
+	resultTarget.Files = append(resultTarget.Files, filename)
+	resultTarget.Description = fmt.Sprintf("%s updated composer package %q from %q to %q",
+		filename,
+		c.spec.Name,
+		resultTarget.Information,
+		resultTarget.NewInformation)
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package composer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+func TestTarget(t *testing.T) {
+	tests := []struct {
+		name                string
+		spec                map[string]interface{}
+		expectedInformation string
+		expectedChanged     bool
+		expectedLine        string
+		wantErr             bool
+	}{
+		{
+			name: "Caret constraint keeping its precision",
+			spec: map[string]interface{}{
+				"name":    "symfony/console",
+				"version": "v6.4.1",
+			},
+			expectedInformation: "^6.3",
+			expectedChanged:     true,
+			expectedLine:        `        "symfony/console": "^6.4",`,
+		},
+		{
+			name: "Exact constraint",
+			spec: map[string]interface{}{
+				"name":    "monolog/monolog",
+				"version": "3.5.0",
+			},
+			expectedInformation: "3.4.0",
+			expectedChanged:     true,
+			expectedLine:        `        "monolog/monolog": "3.5.0",`,
+		},
+		{
+			name: "Constraint already up to date",
+			spec: map[string]interface{}{
+				"name":    "monolog/monolog",
+				"version": "3.4.0",
+			},
+			expectedInformation: "3.4.0",
+		},
+		{
+			name: "Tilde constraint from require-dev",
+			spec: map[string]interface{}{
+				"name":    "phpunit/phpunit",
+				"version": "10.5.3",
+			},
+			expectedInformation: "~10.2.1",
+			expectedChanged:     true,
+			expectedLine:        `        "phpunit/phpunit": "~10.5.3"`,
+		},
+		{
+			name: "Constraint not supported",
+			spec: map[string]interface{}{
+				"name":    "guzzlehttp/guzzle",
+				"version": "7.8.1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Package not required",
+			spec: map[string]interface{}{
+				"name":    "laravel/framework",
+				"version": "10.0.0",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := os.ReadFile(filepath.Join("testdata", "composer.json"))
+			require.NoError(t, err)
+
+			filename := filepath.Join(t.TempDir(), "composer.json")
+			require.NoError(t, os.WriteFile(filename, content, 0600))
+
+			tt.spec["file"] = filename
+
+			c, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Target{}
+			err = c.Target(context.Background(), "", nil, false, &gotResult)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedInformation, gotResult.Information)
+			assert.Equal(t, tt.expectedChanged, gotResult.Changed)
+
+			gotContent, err := os.ReadFile(filename)
+			require.NoError(t, err)
+
+			if tt.expectedLine != "" {
+				assert.Contains(t, string(gotContent), tt.expectedLine+"\n")
+			}
+
+			// Other sections referencing the package are left untouched
+			assert.Contains(t, string(gotContent), `"symfony/console": "6.3.1"`)
+		})
+	}
+}
@@ -1,19 +1,22 @@
 package dockerdigest
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition checks if a Docker image tag digest exists in a registry
-func (ds *DockerDigest) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (ds *DockerDigest) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		logrus.Warningln("scm is not supported, ignoring")
+		logger.Warningln("scm is not supported, ignoring")
 	}
 
 	refName := ds.spec.Image
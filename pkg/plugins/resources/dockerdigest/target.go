@@ -1,6 +1,7 @@
 package dockerdigest
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
@@ -8,6 +9,6 @@ import (
 )
 
 // Target is not supported for the plugin Docker Digest
-func (ds *DockerDigest) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (ds *DockerDigest) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
 	return fmt.Errorf("Target not supported for the plugin Docker Digest")
 }
@@ -1,17 +1,20 @@
 package dockerfile
 
 import (
+	"context"
 	"fmt"
 	"path"
 	"path/filepath"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition test if the Dockerfile contains the correct key/value
-func (d *Dockerfile) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (d *Dockerfile) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
 	globalPass := true
 	descriptionList := []string{}
 
@@ -28,7 +31,7 @@ func (d *Dockerfile) Condition(source string, scm scm.ScmHandler) (pass bool, me
 			return false, "", fmt.Errorf("reading dockerfile: %w", err)
 		}
 
-		logrus.Debugf("\n🐋 On (Docker)file %q:\n\n", file)
+		logger.Debugf("\n🐋 On (Docker)file %q:\n\n", file)
 
 		found := d.parser.FindInstruction([]byte(dockerfileContent), d.spec.Stage)
 
@@ -1,16 +1,19 @@
 package dockerfile
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (df *Dockerfile) Source(workingDir string, resultSource *result.Source) error {
+func (df *Dockerfile) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
+
 	// By the default workingdir is set to the current working directory
 	// it would be better to have it empty by default but it must be changed in the
 	// source core codebase.
@@ -47,7 +50,7 @@ func (df *Dockerfile) Source(workingDir string, resultSource *result.Source) err
 			return fmt.Errorf("reading dockerfile: %w", err)
 		}
 
-		logrus.Debugf("\n🐋 On (Docker)file %q:\n\n", file)
+		logger.Debugf("\n🐋 On (Docker)file %q:\n\n", file)
 
 		value := df.parser.GetInstruction([]byte(dockerfileContent), df.spec.Stage)
 		stageInfo := "last stage"
@@ -1,18 +1,21 @@
 package dockerfile
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"sort"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Target updates a targeted Dockerfile from source control management system
-func (d *Dockerfile) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) (err error) {
+func (d *Dockerfile) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) (err error) {
+	logger := log.FromContext(ctx)
+
 	// At the moment, this plugin do not return the currently used value
 	// This could be a useful improvement for the source
 	resultTarget.Information = "unknown"
@@ -25,7 +28,7 @@ func (d *Dockerfile) Target(source string, scm scm.ScmHandler, dryRun bool, resu
 	for _, file := range d.files {
 		if !filepath.IsAbs(file) && scm != nil {
 			file = filepath.Join(scm.GetDirectory(), file)
-			logrus.Debugf("Relative path detected: changing to absolute path from SCM: %q", file)
+			logger.Debugf("Relative path detected: changing to absolute path from SCM: %q", file)
 		}
 
 		dockerfileContent, err := d.contentRetriever.ReadAll(file)
@@ -33,7 +36,7 @@ func (d *Dockerfile) Target(source string, scm scm.ScmHandler, dryRun bool, resu
 			return err
 		}
 
-		logrus.Debugf("\n🐋 On (Docker)file %q:\n\n", file)
+		logger.Debugf("\n🐋 On (Docker)file %q:\n\n", file)
 
 		newDockerfileContent, changedLines, err := d.parser.ReplaceInstructions([]byte(dockerfileContent), source, d.spec.Stage)
 		if err != nil {
@@ -41,7 +44,7 @@ func (d *Dockerfile) Target(source string, scm scm.ScmHandler, dryRun bool, resu
 		}
 
 		if len(changedLines) == 0 {
-			logrus.Debugf("no change detected %q, nothing else to do", file)
+			logger.Debugf("no change detected %q, nothing else to do", file)
 		} else {
 			resultTarget.Changed = true
 		}
@@ -1,6 +1,7 @@
 package dockerfile
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -215,7 +216,7 @@ CMD ["--help:golang"]
 				parser:           newParser,
 				files:            tt.files,
 			}
-			gotErr := d.Target(tt.inputSourceValue, tt.scm, tt.dryRun, &gotResult)
+			gotErr := d.Target(context.Background(), tt.inputSourceValue, tt.scm, tt.dryRun, &gotResult)
 			if tt.wantErr != nil {
 				assert.Equal(t, tt.wantErr, gotErr)
 				return
@@ -1,18 +1,21 @@
 package dockerimage
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition checks if a docker image with a specific tag is published
 // We assume that if we can't retrieve the docker image digest, then it means
 // it doesn't exist.
-func (di *DockerImage) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (di *DockerImage) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		logrus.Warningf("SCM configuration is not supported for condition of type dockerimage. Remove the `scm` directive from condition to remove this warning message")
+		logger.Warningf("SCM configuration is not supported for condition of type dockerimage. Remove the `scm` directive from condition to remove this warning message")
 	}
 
 	version := source
@@ -28,13 +31,13 @@ func (di *DockerImage) Condition(source string, scm scm.ScmHandler) (pass bool,
 	found := true
 
 	if len(di.spec.Architectures) == 0 {
-		found, err = di.checkImage(ref, "")
+		found, err = di.checkImage(ctx, ref, "")
 		if err != nil {
 			return false, "", err
 		}
 	} else {
 		for _, arch := range di.spec.Architectures {
-			foundArchitecture, err := di.checkImage(ref, arch)
+			foundArchitecture, err := di.checkImage(ctx, ref, arch)
 			if err != nil {
 				return false, "", err
 			}
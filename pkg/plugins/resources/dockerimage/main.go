@@ -1,6 +1,7 @@
 package dockerimage
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,7 +11,7 @@ import (
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/mitchellh/mapstructure"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
@@ -86,7 +87,9 @@ func (di *DockerImage) createRef(source string) (name.Reference, error) {
 }
 
 // checkImage checks if a container reference exists on the "remote" registry with a given set of options
-func (di *DockerImage) checkImage(ref name.Reference, arch string) (bool, error) {
+func (di *DockerImage) checkImage(ctx context.Context, ref name.Reference, arch string) (bool, error) {
+	logger := log.FromContext(ctx)
+
 	var remoteOptions []remote.Option = di.options
 	var queriedPlatform string
 
@@ -112,7 +115,7 @@ func (di *DockerImage) checkImage(ref name.Reference, arch string) (bool, error)
 
 		remoteOptions = append(remoteOptions, remote.WithPlatform(platform))
 
-		logrus.Debugf("Querying docker image %q, os: %q, arch: %q, variant %q", ref.Name(), platform.OS, platform.Architecture, platform.Variant)
+		logger.Debugf("Querying docker image %q, os: %q, arch: %q, variant %q", ref.Name(), platform.OS, platform.Architecture, platform.Variant)
 	}
 
 	descriptor, err := remote.Get(ref, remoteOptions...)
@@ -132,7 +135,7 @@ func (di *DockerImage) checkImage(ref name.Reference, arch string) (bool, error)
 		_, err = descriptor.Image()
 		if err != nil {
 			if strings.Contains(err.Error(), "no child with platform") {
-				logrus.Infof("The Docker image %s (%s) doesn't exist.",
+				logger.Infof("The Docker image %s (%s) doesn't exist.",
 					ref.Name(),
 					queriedPlatform,
 				)
@@ -1,21 +1,24 @@
 package dockerimage
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (di *DockerImage) Source(workingDir string, resultSource *result.Source) error {
+func (di *DockerImage) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
+
 	repo, err := name.NewRepository(di.spec.Image)
 	if err != nil {
 		return fmt.Errorf("invalid repository %s: %w", di.spec.Image, err)
 	}
-	logrus.Debugf(
+	logger.Debugf(
 		"Searching tags for the image %q",
 		repo,
 	)
@@ -27,10 +30,10 @@ func (di *DockerImage) Source(workingDir string, resultSource *result.Source) er
 
 	// apply tagFilter
 
-	logrus.Debugf("%d Docker image tag(s) found", len(tags))
+	logger.Debugf("%d Docker image tag(s) found", len(tags))
 
 	if di.spec.TagFilter != "" {
-		tags = di.filterTags(tags)
+		tags = di.filterTags(ctx, tags)
 	}
 
 	di.foundVersion, err = di.versionFilter.Search(tags)
@@ -54,7 +57,7 @@ func (di *DockerImage) Source(workingDir string, resultSource *result.Source) er
 		architecture = di.spec.Architectures[0]
 	}
 
-	found, err := di.checkImage(ref, architecture)
+	found, err := di.checkImage(ctx, ref, architecture)
 	if err != nil {
 		return err
 	}
@@ -70,12 +73,14 @@ func (di *DockerImage) Source(workingDir string, resultSource *result.Source) er
 	return nil
 }
 
-func (di *DockerImage) filterTags(tags []string) []string {
+func (di *DockerImage) filterTags(ctx context.Context, tags []string) []string {
+	logger := log.FromContext(ctx)
+
 	var results []string
 	re, err := regexp.Compile(di.spec.TagFilter)
 	if err != nil {
-		logrus.Errorln(err)
-		logrus.Debugln("=> something went wrong, falling back to latest versioning")
+		logger.Errorln(err)
+		logger.Debugln("=> something went wrong, falling back to latest versioning")
 		return []string{}
 	}
 	for _, tag := range tags {
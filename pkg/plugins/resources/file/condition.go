@@ -1,12 +1,12 @@
 package file
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
 
-	"github.com/sirupsen/logrus"
-
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/text"
@@ -14,21 +14,21 @@ import (
 
 // Condition test if a file content matches the content provided via configuration.
 // If the configuration doesn't specify a value then it fall back to the source output
-func (f *File) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (f *File) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
 
 	workDir := ""
 	if scm != nil {
 		workDir = scm.GetDirectory()
 	}
 
-	if err := f.initFiles(workDir); err != nil {
+	if err := f.initFiles(ctx, workDir); err != nil {
 		return false, "", fmt.Errorf("init files: %w", err)
 	}
 
 	files := f.spec.Files
 	files = append(files, f.spec.File)
 
-	passing, err := f.condition(source)
+	passing, err := f.condition(ctx, source)
 	if err != nil {
 		return false, "", fmt.Errorf("file condition: %w", err)
 	}
@@ -44,7 +44,9 @@ func (f *File) Condition(source string, scm scm.ScmHandler) (pass bool, message
 	return false, "", fmt.Errorf("Unexpected error happened on file. Please report to an issue.")
 }
 
-func (f *File) condition(source string) (bool, error) {
+func (f *File) condition(ctx context.Context, source string) (bool, error) {
+	logger := log.FromContext(ctx)
+
 	var validationErrors []string
 
 	if len(f.spec.ReplacePattern) > 0 {
@@ -59,9 +61,9 @@ func (f *File) condition(source string) (bool, error) {
 	}
 
 	// Start by retrieving the specified file's content
-	logrus.Debugf("Reading file(s) %q", f.files)
-	if err := f.Read(); err != nil {
-		logrus.Debugf("Error while reading file(s): %q", err.Error())
+	logger.Debugf("Reading file(s) %q", f.files)
+	if err := f.Read(ctx); err != nil {
+		logger.Debugf("Error while reading file(s): %q", err.Error())
 		return false, err
 	}
 
@@ -73,10 +75,10 @@ func (f *File) condition(source string) (bool, error) {
 
 		// If a matchPattern is specified, then return its result
 		if len(f.spec.MatchPattern) > 0 {
-			logrus.Debugf("Attribute 'matchpattern' found: %s", f.spec.MatchPattern)
+			logger.Debugf("Attribute 'matchpattern' found: %s", f.spec.MatchPattern)
 			reg, err := regexp.Compile(f.spec.MatchPattern)
 			if err != nil {
-				logrus.Errorf("Validation error in condition of type 'file': Unable to parse the regexp specified at f.spec.MatchPattern (%q)", f.spec.MatchPattern)
+				logger.Errorf("Validation error in condition of type 'file': Unable to parse the regexp specified at f.spec.MatchPattern (%q)", f.spec.MatchPattern)
 				return false, err
 			}
 
@@ -84,12 +86,12 @@ func (f *File) condition(source string) (bool, error) {
 				if f.spec.SearchPattern {
 					// When using both a file path pattern AND a content matching regex, then we want to ignore files that don't match the pattern
 					// as otherwise we trigger error for files we don't care about.
-					logrus.Debugf("No match found for pattern %q in file %q, removing it from the list of files to update", f.spec.MatchPattern, filePath)
+					logger.Debugf("No match found for pattern %q in file %q, removing it from the list of files to update", f.spec.MatchPattern, filePath)
 					delete(f.files, filePath)
 					continue
 				}
 
-				logrus.Infof(
+				logger.Infof(
 					"%s %s did not match the pattern %q",
 					result.FAILURE,
 					logMessage,
@@ -99,25 +101,25 @@ func (f *File) condition(source string) (bool, error) {
 			}
 
 			if len(f.files) == 0 {
-				logrus.Debugf("no file found matching criteria")
+				logger.Debugf("no file found matching criteria")
 				return false, nil
 			}
 
-			logrus.Infof("%s %s matched the pattern %q", result.SUCCESS, logMessage, f.spec.MatchPattern)
+			logger.Infof("%s %s matched the pattern %q", result.SUCCESS, logMessage, f.spec.MatchPattern)
 		}
 
 		// When a source is provided, try to compare the file content with the source
 		if len(source) > 0 {
-			logrus.Debugf("Using source input value: %q", source)
+			logger.Debugf("Using source input value: %q", source)
 			if len(f.spec.Content) > 0 {
 				validationError := fmt.Errorf("validation error in condition of type 'file': the attributes `sourceid` and `spec.content` are mutually exclusive")
-				logrus.Errorf(validationError.Error())
+				logger.Errorf(validationError.Error())
 				return false, validationError
 			}
 
 			// Compare the content of the file with the source's value
 			if file.content != source {
-				logrus.Infof(
+				logger.Infof(
 					"%s %s is different than the input source value:\n%s",
 					result.FAILURE,
 					logMessage,
@@ -126,37 +128,37 @@ func (f *File) condition(source string) (bool, error) {
 
 				return false, nil
 			}
-			logrus.Infof("%s %s is the same as the input source value.", result.SUCCESS, logMessage)
+			logger.Infof("%s %s is the same as the input source value.", result.SUCCESS, logMessage)
 		}
 
 		// No sourceID provided: the specified attribute must be used to determine which content to compare the file with
-		logrus.Debug("No source input value (disabled or empty)")
+		logger.Debug("No source input value (disabled or empty)")
 		if len(f.spec.Content) == 0 {
-			logrus.Debug("No attribute 'content' provided")
+			logger.Debug("No attribute 'content' provided")
 			// No content + no source input values means the user only want to check if the line "exists" (e.g. is not empty) and that's all
 			if f.spec.Line > 0 {
 				if file.content == "" {
-					logrus.Infof("%s %s is empty or the file does not exist.", result.FAILURE, logMessage)
+					logger.Infof("%s %s is empty or the file does not exist.", result.FAILURE, logMessage)
 					return false, nil
 				}
-				logrus.Infof("%s %s is not empty and the file exists.", result.SUCCESS, logMessage)
+				logger.Infof("%s %s is not empty and the file exists.", result.SUCCESS, logMessage)
 			}
 
 			// No source, no content, no line: Only check for existence of the file
 			return f.contentRetriever.FileExists(file.path), nil
 		}
 
-		logrus.Debug("Attribute `content` detected")
+		logger.Debug("Attribute `content` detected")
 
 		if f.spec.Content != file.content {
-			logrus.Infof("%s %s is different than the specified content: \n%s",
+			logger.Infof("%s %s is different than the specified content: \n%s",
 				result.FAILURE,
 				logMessage,
 				text.Diff(filePath, filePath, file.content, f.spec.Content),
 			)
 			return false, nil
 		}
-		logrus.Infof("%s %s is the same as the specified content.", result.SUCCESS, logMessage)
+		logger.Infof("%s %s is the same as the specified content.", result.SUCCESS, logMessage)
 
 		f.files[filePath] = file
 	}
@@ -1,11 +1,12 @@
 package file
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/mitchellh/mapstructure"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/text"
 	"github.com/updatecli/updatecli/pkg/plugins/utils"
@@ -168,7 +169,9 @@ func hasDuplicates(values []string) bool {
 }
 
 // initFiles initializes the f.files map
-func (f *File) initFiles(workDir string) error {
+func (f *File) initFiles(ctx context.Context, workDir string) error {
+	logger := log.FromContext(ctx)
+
 	f.files = make(map[string]fileMetadata)
 
 	// File as unique element of newResource.files
@@ -223,7 +226,7 @@ func (f *File) initFiles(workDir string) error {
 			file := f.files[filePath]
 			file.path = joinPathWithWorkingDirectoryPath(file.originalPath, workDir)
 
-			logrus.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", file.originalPath, file.path)
+			logger.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", file.originalPath, file.path)
 			f.files[filePath] = file
 		}
 	}
@@ -231,13 +234,15 @@ func (f *File) initFiles(workDir string) error {
 	return nil
 }
 
-func (f *File) UpdateAbsoluteFilePath(workDir string) {
+func (f *File) UpdateAbsoluteFilePath(ctx context.Context, workDir string) {
+	logger := log.FromContext(ctx)
+
 	for filePath := range f.files {
 		if workDir != "" {
 			file := f.files[filePath]
 			file.path = joinPathWithWorkingDirectoryPath(file.originalPath, workDir)
 
-			logrus.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", file.originalPath, file.path)
+			logger.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", file.originalPath, file.path)
 			f.files[filePath] = file
 		}
 	}
@@ -289,7 +294,9 @@ func (s *Spec) Validate() error {
 }
 
 // Read puts the content of the file(s) as value of the f.files map if the file(s) exist(s) or log the non existence of the file
-func (f *File) Read() error {
+func (f *File) Read(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
 	var err error
 
 	// Retrieve files content
@@ -315,7 +322,7 @@ func (f *File) Read() error {
 		} else {
 			if f.spec.ForceCreate {
 				// f.files[filePath] is already set to "", no need for more except logging
-				logrus.Infof("Creating a new file at %q", file.originalPath)
+				logger.Infof("Creating a new file at %q", file.originalPath)
 			} else {
 				if f.spec.Line > 0 {
 					return fmt.Errorf("%s The specified line %d of the file %q does not exist", result.FAILURE, f.spec.Line, file.originalPath)
@@ -1,6 +1,7 @@
 package file
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -259,7 +260,7 @@ func TestFile_Read(t *testing.T) {
 				files:            tt.files,
 			}
 
-			gotErr := f.Read()
+			gotErr := f.Read(context.Background())
 
 			if tt.wantedErr {
 				require.Error(t, gotErr)
@@ -1,18 +1,21 @@
 package file
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"regexp"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Source return a file content
-func (f *File) Source(workingDir string, resultSource *result.Source) error {
+func (f *File) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
+
 	var validationErrors []string
 	var foundContent string
 
@@ -45,11 +48,11 @@ func (f *File) Source(workingDir string, resultSource *result.Source) error {
 		workingDir = ""
 	}
 
-	if err := f.initFiles(workingDir); err != nil {
+	if err := f.initFiles(ctx, workingDir); err != nil {
 		return fmt.Errorf("init files: %w", err)
 	}
 
-	if err := f.Read(); err != nil {
+	if err := f.Read(ctx); err != nil {
 		return fmt.Errorf("reading file: %w", err)
 	}
 
@@ -65,7 +68,7 @@ func (f *File) Source(workingDir string, resultSource *result.Source) error {
 			reg, err := regexp.Compile(f.spec.MatchPattern)
 
 			if err != nil {
-				logrus.Errorf("validation error in source of type 'file': Unable to parse the regexp specified at f.spec.MatchPattern (%q)", f.spec.MatchPattern)
+				logger.Errorf("validation error in source of type 'file': Unable to parse the regexp specified at f.spec.MatchPattern (%q)", f.spec.MatchPattern)
 				return fmt.Errorf("compiling regex: %w", err)
 			}
 
@@ -74,7 +77,7 @@ func (f *File) Source(workingDir string, resultSource *result.Source) error {
 				if f.spec.SearchPattern {
 					// When using both a file path pattern AND a content matching regex, then we want to ignore files that don't match the pattern
 					// as otherwise we trigger error for files we don't care about.
-					logrus.Debugf("No match found for pattern %q in file %q, removing it from the list of files to update", f.spec.MatchPattern, filePath)
+					logger.Debugf("No match found for pattern %q in file %q, removing it from the list of files to update", f.spec.MatchPattern, filePath)
 					delete(f.files, filePath)
 					continue
 				}
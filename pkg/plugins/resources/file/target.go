@@ -1,13 +1,13 @@
 package file
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"sort"
 	"strings"
 
-	"github.com/sirupsen/logrus"
-
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/text"
@@ -15,14 +15,15 @@ import (
 
 // Target creates or updates a file from a source control management system.
 // The default content is the value retrieved from source
-func (f *File) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (f *File) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
 
 	workDir := ""
 	if scm != nil {
 		workDir = scm.GetDirectory()
 	}
 
-	if err := f.initFiles(workDir); err != nil {
+	if err := f.initFiles(ctx, workDir); err != nil {
 		return fmt.Errorf("init files: %w", err)
 	}
 
@@ -30,7 +31,7 @@ func (f *File) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 
 	if f.spec.Line > 0 && f.spec.ForceCreate {
 		validationError := fmt.Errorf("validation error in target of type 'file': 'spec.line' and 'spec.forcecreate' are mutually exclusive")
-		logrus.Errorf(validationError.Error())
+		logger.Errorf(validationError.Error())
 		return validationError
 	}
 
@@ -39,13 +40,13 @@ func (f *File) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 	for filePath := range f.files {
 		if text.IsURL(f.files[filePath].path) {
 			validationError := fmt.Errorf("validation error in target of type 'file': spec.files item value (%q) is an URL which is not supported for a target", filePath)
-			logrus.Errorf(validationError.Error())
+			logger.Errorf(validationError.Error())
 			return validationError
 		}
 	}
 
 	// Retrieving content of file(s) in memory (nothing in case of spec.forceCreate)
-	if err := f.Read(); err != nil {
+	if err := f.Read(ctx); err != nil {
 		return err
 	}
 
@@ -79,7 +80,7 @@ func (f *File) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 
 		reg, err := regexp.Compile(f.spec.MatchPattern)
 		if err != nil {
-			logrus.Errorf("Validation error in target of type 'file': Unable to parse the regexp specified at f.spec.MatchPattern (%q)", f.spec.MatchPattern)
+			logger.Errorf("Validation error in target of type 'file': Unable to parse the regexp specified at f.spec.MatchPattern (%q)", f.spec.MatchPattern)
 			return err
 		}
 
@@ -89,14 +90,14 @@ func (f *File) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 				if f.spec.SearchPattern {
 					// When using both a file path pattern AND a content matching regex, then we want to ignore files that don't match the pattern
 					// as otherwise we trigger error for files we don't care about.
-					logrus.Debugf("No match found for pattern %q in file %q, removing it from the list of files to update", f.spec.MatchPattern, filePath)
+					logger.Debugf("No match found for pattern %q in file %q, removing it from the list of files to update", f.spec.MatchPattern, filePath)
 					delete(f.files, filePath)
 					continue
 				}
 				// We allow the possibility to match only some files. In that case, just a warning here
 				return fmt.Errorf("no line matched in file %q for pattern %q", filePath, f.spec.MatchPattern)
 			}
-			logrus.Debugf("Match found for pattern %q in file %q", f.spec.MatchPattern, filePath)
+			logger.Debugf("Match found for pattern %q in file %q", f.spec.MatchPattern, filePath)
 
 			// Keep the original content for later comparison
 			originalContents[filePath] = file.content
@@ -126,7 +127,7 @@ func (f *File) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 	for filePath, file := range f.files {
 		if file.content == originalContents[filePath] {
 			notChanged++
-			logrus.Debugf("content from file %q already up to date", file.originalPath)
+			logger.Debugf("content from file %q already up to date", file.originalPath)
 		} else {
 			files = append(files, file.path)
 		}
@@ -175,7 +176,7 @@ func (f *File) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 			contentType,
 			inputContent)
 
-		logrus.Infof("%s\n\n```\n%s\n```\n\n",
+		logger.Infof("%s\n\n```\n%s\n```\n\n",
 			description,
 			text.Diff(filePath, filePath, originalContents[filePath], file.content),
 		)
@@ -1,6 +1,7 @@
 package file
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -439,7 +440,7 @@ func TestFile_TargetMultiples(t *testing.T) {
 			}
 
 			gotResultTarget := result.Target{}
-			gotErr := f.Target(tt.inputSourceValue, nil, tt.dryRun, &gotResultTarget)
+			gotErr := f.Target(context.Background(), tt.inputSourceValue, nil, tt.dryRun, &gotResultTarget)
 
 			if tt.wantedErr {
 				assert.Error(t, gotErr)
@@ -608,7 +609,7 @@ func TestFile_TargetFromSCM(t *testing.T) {
 
 			gotResultTarget := result.Target{}
 
-			gotErr := f.Target(tt.inputSourceValue, tt.scm, tt.dryRun, &gotResultTarget)
+			gotErr := f.Target(context.Background(), tt.inputSourceValue, tt.scm, tt.dryRun, &gotResultTarget)
 
 			if tt.wantedErr {
 				assert.Error(t, gotErr)
@@ -1,20 +1,22 @@
 package gitbranch
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition checks that a git branch exists
-func (gt *GitBranch) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (gt *GitBranch) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
 
 	if scm != nil {
 		path := scm.GetDirectory()
 
 		if len(gt.spec.Path) > 0 {
-			logrus.Warningf("Path is defined and set to %q but is overridden by the scm definition %q",
+			logger.Warningf("Path is defined and set to %q but is overridden by the scm definition %q",
 				gt.spec.Path,
 				path)
 		}
@@ -24,7 +26,7 @@ func (gt *GitBranch) Condition(source string, scm scm.ScmHandler) (pass bool, me
 	gt.branch = gt.spec.Branch
 	// If source input is empty, then it means that it was disabled by the user with `disablesourceinput: true`
 	if source != "" {
-		logrus.Infof("Source input value detected")
+		logger.Infof("Source input value detected")
 		gt.branch = source
 	}
 
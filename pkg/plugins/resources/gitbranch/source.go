@@ -1,13 +1,14 @@
 package gitbranch
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Source returns the latest git tag based on create time
-func (gt *GitBranch) Source(workingDir string, resultSource *result.Source) error {
+func (gt *GitBranch) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
 
 	if len(gt.spec.Path) == 0 && len(workingDir) > 0 {
 		gt.spec.Path = workingDir
@@ -1,19 +1,21 @@
 package gitbranch
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Target creates and pushes a git tag based on the SCM configuration
-func (gt *GitBranch) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) (err error) {
+func (gt *GitBranch) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) (err error) {
+	logger := log.FromContext(ctx)
 
 	if scm != nil {
 		if len(gt.spec.Path) > 0 {
-			logrus.Warningf("Path setting value %q overridden by the scm configuration (value %q)",
+			logger.Warningf("Path setting value %q overridden by the scm configuration (value %q)",
 				gt.spec.Path,
 				scm.GetDirectory())
 		}
@@ -32,7 +34,7 @@ func (gt *GitBranch) Target(source string, scm scm.ScmHandler, dryRun bool, resu
 		return fmt.Errorf("empty branch specified")
 	}
 
-	err = gt.target(dryRun, resultTarget)
+	err = gt.target(ctx, dryRun, resultTarget)
 	if err != nil {
 		return err
 	}
@@ -48,7 +50,7 @@ func (gt *GitBranch) Target(source string, scm scm.ScmHandler, dryRun bool, resu
 		return nil
 	}
 
-	logrus.Printf("git branch %q has been created.", gt.branch)
+	logger.Printf("git branch %q has been created.", gt.branch)
 
 	if scm == nil {
 		resultTarget.Description = fmt.Sprintf("The git branch %q created but missing scm configuration to push it", gt.branch)
@@ -57,7 +59,7 @@ func (gt *GitBranch) Target(source string, scm scm.ScmHandler, dryRun bool, resu
 
 	err = scm.PushBranch(gt.branch)
 	if err != nil {
-		logrus.Errorf("Git push tag error: %s", err)
+		logger.Errorf("Git push tag error: %s", err)
 		return err
 	}
 
@@ -65,7 +67,8 @@ func (gt *GitBranch) Target(source string, scm scm.ScmHandler, dryRun bool, resu
 	return nil
 }
 
-func (gt *GitBranch) target(dryRun bool, resultTarget *result.Target) error {
+func (gt *GitBranch) target(ctx context.Context, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
 
 	// cfr https://github.com/updatecli/updatecli/issues/1126
 	// to know why the following line is needed at the moment
@@ -74,7 +77,7 @@ func (gt *GitBranch) target(dryRun bool, resultTarget *result.Target) error {
 	// Fail if the git tag resource cannot be validated
 	err := gt.Validate()
 	if err != nil {
-		logrus.Errorln(err)
+		logger.Errorln(err)
 		return err
 	}
 
@@ -105,7 +108,7 @@ func (gt *GitBranch) target(dryRun bool, resultTarget *result.Target) error {
 	resultTarget.Changed = true
 	resultTarget.Information = ""
 
-	logrus.Debugf("git branch %q does not exist: creating it.", gt.branch)
+	logger.Debugf("git branch %q does not exist: creating it.", gt.branch)
 
 	if dryRun {
 		// Dry run: no changes to apply.
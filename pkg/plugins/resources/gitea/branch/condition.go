@@ -1,15 +1,18 @@
 package branch
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
-func (g *Gitea) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (g *Gitea) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		logrus.Warningf("Condition not supported for the plugin GitHub Release")
+		logger.Warningf("Condition not supported for the plugin GitHub Release")
 	}
 
 	branch := source
@@ -17,7 +20,7 @@ func (g *Gitea) Condition(source string, scm scm.ScmHandler) (pass bool, message
 		branch = g.spec.Branch
 	}
 
-	branches, err := g.SearchBranches()
+	branches, err := g.SearchBranches(ctx)
 
 	if err != nil {
 		return false, "", err
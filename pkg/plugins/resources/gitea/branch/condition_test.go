@@ -1,6 +1,7 @@
 package branch
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -66,7 +67,7 @@ func TestCondition(t *testing.T) {
 			g, gotErr := New(tt.manifest)
 			require.NoError(t, gotErr)
 
-			gotPass, _, gotErr := g.Condition("", nil)
+			gotPass, _, gotErr := g.Condition(context.Background(), "", nil)
 
 			if tt.wantErr {
 				require.Error(t, gotErr)
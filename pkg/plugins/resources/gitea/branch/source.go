@@ -1,23 +1,26 @@
 package branch
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
-func (g *Gitea) Source(workingDir string, resultSource *result.Source) error {
-	versions, err := g.SearchBranches()
+func (g *Gitea) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
+
+	versions, err := g.SearchBranches(ctx)
 
 	if err != nil {
 		return fmt.Errorf("searching gitea branches: %w", err)
 	}
 
 	if len(versions) == 0 {
-		logrus.Infof("%s No Gitea branches found", result.FAILURE)
+		logger.Infof("%s No Gitea branches found", result.FAILURE)
 		return errors.New("no gitea branches found")
 	}
 
@@ -6,6 +6,7 @@ import (
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/gitea"
 	"github.com/drone/go-scm/scm/transport/oauth2"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
 )
 
 type Client *scm.Client
@@ -18,11 +19,14 @@ func New(s Spec) (Client, error) {
 		return nil, err
 	}
 
-	client.Client = &http.Client{}
+	client.Client = &http.Client{
+		Transport: httpclient.NewCachedTransport(http.DefaultTransport),
+	}
 
 	if len(s.Token) >= 0 {
 		client.Client = &http.Client{
 			Transport: &oauth2.Transport{
+				Base: httpclient.NewCachedTransport(http.DefaultTransport),
 				Source: oauth2.StaticTokenSource(
 					&scm.Token{
 						Token: s.Token,
@@ -33,14 +33,14 @@ func (g *Gitea) CreateAction(report reports.Action, resetDescription bool) error
 		title = g.spec.Title
 	}
 
-	// Check if a pull-request is already opened then exit early if it does.
-	exist, err := g.isPullRequestExist()
+	// Check if a pull-request is already opened then only reconcile its reviewers, assignees, and milestone.
+	existingPullRequest, err := g.getOpenPullRequest()
 	if err != nil {
 		return err
 	}
 
-	if exist {
-		return nil
+	if existingPullRequest != nil {
+		return g.updatePullRequest(existingPullRequest.Number)
 	}
 
 	// Test that both sourceBranch and targetBranch exists on remote before creating a new one
@@ -104,5 +104,5 @@ func (g *Gitea) CreateAction(report reports.Action, resetDescription bool) error
 
 	logrus.Infof("Gitea pullrequest successfully opened on %q", pr.Link)
 
-	return nil
+	return g.updatePullRequest(pr.Number)
 }
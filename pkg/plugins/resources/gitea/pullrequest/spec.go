@@ -84,4 +84,45 @@ type Spec struct {
 			"body" is useful to provide additional information when reviewing pullrequest, such as changelog url.
 	*/
 	Body string `yaml:",inline,omitempty"`
+	/*
+		"reviewers" defines the Gitea usernames requested to review the pullrequest.
+
+		default:
+			empty
+
+		remark:
+			Reviewers are only added, existing review requests are kept.
+			The pullrequest author can't be requested as a reviewer.
+	*/
+	Reviewers []string `yaml:",omitempty"`
+	/*
+		"teamreviewers" defines the Gitea teams requested to review the pullrequest.
+
+		default:
+			empty
+
+		remark:
+			Teams are identified by their name and must belong to the organization owning the repository.
+	*/
+	TeamReviewers []string `yaml:",omitempty"`
+	/*
+		"assignees" defines the Gitea usernames assigned to the pullrequest.
+
+		default:
+			empty
+
+		remark:
+			Assignees are only added, existing pullrequest assignees are kept.
+	*/
+	Assignees []string `yaml:",omitempty"`
+	/*
+		"milestone" defines the title of the milestone associated with the pullrequest.
+
+		default:
+			empty
+
+		remark:
+			The milestone must already exist and be open on the repository.
+	*/
+	Milestone string `yaml:",omitempty"`
 }
@@ -0,0 +1,243 @@
+package pullrequest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/sirupsen/logrus"
+)
+
+// giteaUser contains the Gitea user information returned by the Gitea api
+type giteaUser struct {
+	Login string `json:"login"`
+}
+
+// giteaTeam contains the Gitea team information returned by the Gitea api
+type giteaTeam struct {
+	Name string `json:"name"`
+}
+
+// giteaMilestone contains the Gitea milestone information returned by the Gitea api
+type giteaMilestone struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+// giteaPullRequest contains the Gitea pullrequest participants returned by the Gitea api
+type giteaPullRequest struct {
+	Number                  int             `json:"number"`
+	User                    giteaUser       `json:"user"`
+	Assignees               []giteaUser     `json:"assignees"`
+	RequestedReviewers      []giteaUser     `json:"requested_reviewers"`
+	RequestedReviewersTeams []giteaTeam     `json:"requested_reviewers_teams"`
+	Milestone               *giteaMilestone `json:"milestone"`
+}
+
+// giteaReview contains the Gitea pullrequest review information returned by the Gitea api
+type giteaReview struct {
+	User *giteaUser `json:"user"`
+	Team *giteaTeam `json:"team"`
+}
+
+// giteaReviewRequest contains the reviewers requested by Updatecli
+type giteaReviewRequest struct {
+	Reviewers     []string `json:"reviewers,omitempty"`
+	TeamReviewers []string `json:"team_reviewers,omitempty"`
+}
+
+// giteaPullRequestUpdate contains the pullrequest attributes updated by Updatecli
+type giteaPullRequestUpdate struct {
+	Assignees []string `json:"assignees,omitempty"`
+	Milestone int64    `json:"milestone,omitempty"`
+}
+
+// updatePullRequest ensures that the pullrequest reviewers, assignees, and milestone
+// match the ones defined by the spec.
+// Reviewers and assignees are only added, so we don't override people added manually.
+func (g *Gitea) updatePullRequest(number int) error {
+
+	if len(g.spec.Reviewers) == 0 &&
+		len(g.spec.TeamReviewers) == 0 &&
+		len(g.spec.Assignees) == 0 &&
+		g.spec.Milestone == "" {
+		return nil
+	}
+
+	// Timeout api query after 30sec
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	repository := fmt.Sprintf("api/v1/repos/%s/%s", url.PathEscape(g.Owner), url.PathEscape(g.Repository))
+
+	var pr giteaPullRequest
+	err := g.do(ctx, http.MethodGet, fmt.Sprintf("%s/pulls/%d", repository, number), nil, &pr)
+	if err != nil {
+		return fmt.Errorf("get Gitea pullrequest %d: %w", number, err)
+	}
+
+	if len(g.spec.Reviewers) > 0 || len(g.spec.TeamReviewers) > 0 {
+		var reviews []giteaReview
+		err := g.do(ctx, http.MethodGet, fmt.Sprintf("%s/pulls/%d/reviews", repository, number), nil, &reviews)
+		if err != nil {
+			return fmt.Errorf("get Gitea pullrequest %d reviews: %w", number, err)
+		}
+
+		reviewers := []string{}
+		teams := []string{}
+		for _, user := range pr.RequestedReviewers {
+			reviewers = append(reviewers, user.Login)
+		}
+		for _, team := range pr.RequestedReviewersTeams {
+			teams = append(teams, team.Name)
+		}
+		for _, review := range reviews {
+			if review.User != nil {
+				reviewers = append(reviewers, review.User.Login)
+			}
+			if review.Team != nil {
+				teams = append(teams, review.Team.Name)
+			}
+		}
+
+		request := giteaReviewRequest{}
+		for _, reviewer := range g.spec.Reviewers {
+			reviewer = strings.TrimPrefix(reviewer, "@")
+			if strings.EqualFold(reviewer, pr.User.Login) {
+				logrus.Debugf("Skipping reviewer %q as it's the pullrequest author", reviewer)
+				continue
+			}
+			if !containsFold(reviewers, reviewer) && !containsFold(request.Reviewers, reviewer) {
+				request.Reviewers = append(request.Reviewers, reviewer)
+			}
+		}
+		for _, team := range g.spec.TeamReviewers {
+			team = strings.TrimPrefix(team, "@")
+			if !containsFold(teams, team) && !containsFold(request.TeamReviewers, team) {
+				request.TeamReviewers = append(request.TeamReviewers, team)
+			}
+		}
+
+		if len(request.Reviewers) > 0 || len(request.TeamReviewers) > 0 {
+			err = g.do(ctx, http.MethodPost, fmt.Sprintf("%s/pulls/%d/requested_reviewers", repository, number), request, nil)
+			if err != nil {
+				return fmt.Errorf("request Gitea pullrequest %d reviews: %w", number, err)
+			}
+			logrus.Infof("Gitea pullrequest %d reviews requested", number)
+		}
+	}
+
+	update := giteaPullRequestUpdate{}
+	changed := false
+
+	assignees := []string{}
+	for _, assignee := range pr.Assignees {
+		assignees = append(assignees, assignee.Login)
+	}
+	for _, assignee := range g.spec.Assignees {
+		assignee = strings.TrimPrefix(assignee, "@")
+		if !containsFold(assignees, assignee) {
+			assignees = append(assignees, assignee)
+			changed = true
+		}
+	}
+	if changed {
+		update.Assignees = assignees
+	}
+
+	if g.spec.Milestone != "" && (pr.Milestone == nil || pr.Milestone.Title != g.spec.Milestone) {
+		milestone, err := g.getMilestone(ctx, repository)
+		if err != nil {
+			return err
+		}
+		update.Milestone = milestone.ID
+		changed = true
+	}
+
+	if !changed {
+		logrus.Debugf("Gitea pullrequest %d assignees and milestone already up to date", number)
+		return nil
+	}
+
+	err = g.do(ctx, http.MethodPatch, fmt.Sprintf("%s/pulls/%d", repository, number), update, nil)
+	if err != nil {
+		return fmt.Errorf("update Gitea pullrequest %d: %w", number, err)
+	}
+
+	logrus.Infof("Gitea pullrequest %d assignees and milestone updated", number)
+
+	return nil
+}
+
+// getMilestone queries the Gitea api to retrieve the open repository milestone defined by the spec
+func (g *Gitea) getMilestone(ctx context.Context, repository string) (giteaMilestone, error) {
+	var milestones []giteaMilestone
+
+	err := g.do(ctx, http.MethodGet, repository+"/milestones?name="+url.QueryEscape(g.spec.Milestone), nil, &milestones)
+	if err != nil {
+		return giteaMilestone{}, fmt.Errorf("get Gitea milestone %q: %w", g.spec.Milestone, err)
+	}
+
+	for _, milestone := range milestones {
+		if milestone.Title == g.spec.Milestone {
+			return milestone, nil
+		}
+	}
+
+	return giteaMilestone{}, fmt.Errorf("open Gitea milestone %q not found", g.spec.Milestone)
+}
+
+// do sends a request to the Gitea api and decodes the json response in out, if not nil
+func (g *Gitea) do(ctx context.Context, method, path string, in, out interface{}) error {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+
+	if in != nil {
+		body, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		req.Header = map[string][]string{
+			"Content-Type": {"application/json"},
+		}
+		req.Body = bytes.NewReader(body)
+	}
+
+	res, err := (*scm.Client)(g.client).Do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.Status >= 300 {
+		body, _ := io.ReadAll(res.Body)
+		logrus.Debugf("RC: %d\nBody:\n%s", res.Status, body)
+		return fmt.Errorf("unexpected http status %d", res.Status)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// containsFold reports whether s is in list, ignoring case
+func containsFold(list []string, s string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package pullrequest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitea/client"
+)
+
+func TestUpdatePullRequest(t *testing.T) {
+	testData := []struct {
+		name string
+		spec Spec
+		// pullRequest is the pullrequest returned by the Gitea api
+		pullRequest string
+		// reviews are the pullrequest reviews returned by the Gitea api
+		reviews               string
+		expectedReviewRequest *giteaReviewRequest
+		expectedUpdate        *giteaPullRequestUpdate
+		wantErr               bool
+	}{
+		{
+			name: "New pullrequest",
+			spec: Spec{
+				Reviewers:     []string{"alice", "updatecli-bot"},
+				TeamReviewers: []string{"maintainers"},
+				Assignees:     []string{"@carol"},
+				Milestone:     "v1.0.0",
+			},
+			pullRequest: `{"number": 4, "user": {"login": "updatecli-bot"}}`,
+			reviews:     `[]`,
+			expectedReviewRequest: &giteaReviewRequest{
+				Reviewers:     []string{"alice"},
+				TeamReviewers: []string{"maintainers"},
+			},
+			expectedUpdate: &giteaPullRequestUpdate{
+				Assignees: []string{"carol"},
+				Milestone: 42,
+			},
+		},
+		{
+			name: "Existing pullrequest with partial participants",
+			spec: Spec{
+				Reviewers:     []string{"Alice", "bob", "dave"},
+				TeamReviewers: []string{"maintainers"},
+				Assignees:     []string{"carol", "erin"},
+				Milestone:     "v1.0.0",
+			},
+			pullRequest: `{
+				"number": 4,
+				"user": {"login": "updatecli-bot"},
+				"assignees": [{"login": "carol"}],
+				"requested_reviewers": [{"login": "alice"}],
+				"milestone": {"id": 42, "title": "v1.0.0"}
+			}`,
+			reviews: `[{"user": {"login": "bob"}}, {"team": {"name": "maintainers"}}]`,
+			expectedReviewRequest: &giteaReviewRequest{
+				Reviewers: []string{"dave"},
+			},
+			expectedUpdate: &giteaPullRequestUpdate{
+				Assignees: []string{"carol", "erin"},
+			},
+		},
+		{
+			name: "Existing pullrequest already up to date",
+			spec: Spec{
+				Reviewers:     []string{"alice"},
+				TeamReviewers: []string{"maintainers"},
+				Assignees:     []string{"carol"},
+			},
+			pullRequest: `{
+				"number": 4,
+				"assignees": [{"login": "carol"}],
+				"requested_reviewers": [{"login": "alice"}],
+				"requested_reviewers_teams": [{"name": "maintainers"}]
+			}`,
+			reviews: `[]`,
+		},
+		{
+			name: "Unknown milestone",
+			spec: Spec{
+				Milestone: "v2.0.0",
+			},
+			pullRequest: `{"number": 4}`,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotReviewRequest *giteaReviewRequest
+			var gotUpdate *giteaPullRequestUpdate
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v1/repos/updatecli/updatecli/pulls/4", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, tt.pullRequest)
+			})
+			mux.HandleFunc("GET /api/v1/repos/updatecli/updatecli/pulls/4/reviews", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, tt.reviews)
+			})
+			mux.HandleFunc("POST /api/v1/repos/updatecli/updatecli/pulls/4/requested_reviewers", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer xxx", r.Header.Get("Authorization"))
+				gotReviewRequest = &giteaReviewRequest{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(gotReviewRequest))
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `[]`)
+			})
+			mux.HandleFunc("PATCH /api/v1/repos/updatecli/updatecli/pulls/4", func(w http.ResponseWriter, r *http.Request) {
+				gotUpdate = &giteaPullRequestUpdate{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(gotUpdate))
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, tt.pullRequest)
+			})
+			mux.HandleFunc("GET /api/v1/repos/updatecli/updatecli/milestones", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `[{"id": 42, "title": "v1.0.0"}, {"id": 43, "title": "v1.0.0-rc"}]`)
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			c, err := client.New(client.Spec{URL: server.URL, Token: "xxx"})
+			require.NoError(t, err)
+
+			g := Gitea{
+				spec:       tt.spec,
+				client:     c,
+				Owner:      "updatecli",
+				Repository: "updatecli",
+			}
+
+			err = g.updatePullRequest(4)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedReviewRequest, gotReviewRequest)
+			assert.Equal(t, tt.expectedUpdate, gotUpdate)
+		})
+	}
+}
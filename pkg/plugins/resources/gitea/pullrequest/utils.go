@@ -10,8 +10,9 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-// isPullRequestExist queries a remote Gitea instance to know if a pullrequest already exists.
-func (g *Gitea) isPullRequestExist() (bool, error) {
+// getOpenPullRequest queries a remote Gitea instance to retrieve an already opened pullrequest.
+// It returns nil if no such pullrequest exists.
+func (g *Gitea) getOpenPullRequest() (*scm.PullRequest, error) {
 	ctx := context.Background()
 
 	page := 0
@@ -36,7 +37,7 @@ func (g *Gitea) isPullRequestExist() (bool, error) {
 
 		if err != nil {
 			logrus.Debugf("RC: %d\nBody:\n%s", resp.Status, resp.Body)
-			return false, err
+			return nil, err
 		}
 
 		if resp.Status > 400 {
@@ -49,11 +50,11 @@ func (g *Gitea) isPullRequestExist() (bool, error) {
 				!p.Closed &&
 				!p.Merged {
 
-				logrus.Infof("%s Nothing else to do, our pullrequest already exist on:\n\t%s",
+				logrus.Infof("%s Our pullrequest already exist on:\n\t%s",
 					result.SUCCESS,
 					p.Link)
 
-				return true, nil
+				return p, nil
 			}
 		}
 
@@ -63,7 +64,7 @@ func (g *Gitea) isPullRequestExist() (bool, error) {
 		page++
 	}
 
-	return false, nil
+	return nil, nil
 }
 
 // isRemoteBranchesExist queries a remote Gitea instance to know if both the pull-request source branch and the target branch exist.
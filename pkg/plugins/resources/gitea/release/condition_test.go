@@ -1,6 +1,7 @@
 package release
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -105,7 +106,7 @@ func TestCondition(t *testing.T) {
 			g, gotErr := New(tt.manifest)
 			require.NoError(t, gotErr)
 
-			gotResult, _, gotErr := g.Condition("", nil)
+			gotResult, _, gotErr := g.Condition(context.Background(), "", nil)
 
 			if tt.wantErr {
 				if assert.Error(t, gotErr) {
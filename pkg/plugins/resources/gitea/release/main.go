@@ -9,6 +9,7 @@ import (
 	"github.com/drone/go-scm/scm"
 	"github.com/mitchellh/mapstructure"
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/gitea/client"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
@@ -110,9 +111,8 @@ func New(spec interface{}) (*Gitea, error) {
 }
 
 // Retrieve git tags from a remote gitea repository
-func (g *Gitea) SearchReleases() ([]string, error) {
-
-	ctx := context.Background()
+func (g *Gitea) SearchReleases(ctx context.Context) ([]string, error) {
+	logger := log.FromContext(ctx)
 
 	results := []string{}
 	page := 0
@@ -136,7 +136,7 @@ func (g *Gitea) SearchReleases() ([]string, error) {
 		}
 
 		if resp.Status > 400 {
-			logrus.Debugf("Gitea Api Response:\n%+v", resp)
+			logger.Debugf("Gitea Api Response:\n%+v", resp)
 		}
 
 		for i := len(releases) - 1; i >= 0; i-- {
@@ -7,13 +7,15 @@ import (
 	"time"
 
 	goscm "github.com/drone/go-scm/scm"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Target ensure that a specific release exist on gitea, otherwise creates it
-func (g Gitea) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (g Gitea) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
+
 	if len(g.spec.Tag) == 0 {
 		g.spec.Tag = source
 	}
@@ -25,19 +27,17 @@ func (g Gitea) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 	}
 
 	if len(g.spec.Commitish) == 0 {
-		logrus.Warningf("No commitish provided, fallback to branch %q\n", "main")
+		logger.Warningf("No commitish provided, fallback to branch %q\n", "main")
 		g.spec.Commitish = "main"
 	}
 
 	// Ensure that a release doesn't exist yet
-
-	ctx := context.Background()
 	// Timeout api query after 30 second
-	ctx, cancelListQuery := context.WithTimeout(ctx, 30*time.Second)
+	listCtx, cancelListQuery := context.WithTimeout(ctx, 30*time.Second)
 	defer cancelListQuery()
 
 	releases, resp, err := g.client.Releases.List(
-		ctx,
+		listCtx,
 		strings.Join([]string{g.spec.Owner, g.spec.Repository}, "/"),
 		goscm.ReleaseListOptions{
 			Page:   1,
@@ -48,7 +48,7 @@ func (g Gitea) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 	)
 
 	if err != nil {
-		logrus.Debugf("Gitea Api Response:\nReturn Code: %q\nBody:\n%s", resp.Status, resp.Body)
+		logger.Debugf("Gitea Api Response:\nReturn Code: %q\nBody:\n%s", resp.Status, resp.Body)
 		return err
 	}
 
@@ -75,13 +75,12 @@ func (g Gitea) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 
 	// Create a new release as it doesn't exist yet
 
-	ctx = context.Background()
 	// Timeout api query after 30 second
-	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	createCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	release, resp, err := g.client.Releases.Create(
-		ctx,
+		createCtx,
 		strings.Join([]string{g.spec.Owner, g.spec.Repository}, "/"),
 		&goscm.ReleaseInput{
 			Title:       g.spec.Title,
@@ -98,7 +97,7 @@ func (g Gitea) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 	}
 
 	if resp.Status >= 400 {
-		logrus.Debugf("RC: %q\nBody:\n%s", resp.Status, resp.Body)
+		logger.Debugf("RC: %q\nBody:\n%s", resp.Status, resp.Body)
 		return fmt.Errorf("error from Gitea api: %v", resp.Status)
 	}
 
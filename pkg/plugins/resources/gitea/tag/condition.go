@@ -1,15 +1,18 @@
 package tag
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
-func (g *Gitea) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (g *Gitea) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		logrus.Warningf("Condition not supported for the plugin Gitea tag")
+		logger.Warningf("Condition not supported for the plugin Gitea tag")
 	}
 
 	tag := source
@@ -17,7 +20,7 @@ func (g *Gitea) Condition(source string, scm scm.ScmHandler) (pass bool, message
 		tag = g.spec.Tag
 	}
 
-	tags, err := g.SearchTags()
+	tags, err := g.SearchTags(ctx)
 	if err != nil {
 		return false, "", fmt.Errorf("looking for Gitea tag: %w", err)
 	}
@@ -1,18 +1,21 @@
 package tag
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
-func (g *Gitea) Source(workingDir string, resultSource *result.Source) error {
-	versions, err := g.SearchTags()
+func (g *Gitea) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
+
+	versions, err := g.SearchTags(ctx)
 
 	if err != nil {
-		logrus.Error(err)
+		logger.Error(err)
 		return fmt.Errorf("search gitea tags: %w", err)
 	}
 
@@ -1,17 +1,19 @@
 package githubrelease
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (gr GitHubRelease) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (gr GitHubRelease) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
 
 	if scm != nil {
-		logrus.Warningf("condition not supported for plugin GitHub Release used with scm")
+		logger.Warningf("condition not supported for plugin GitHub Release used with scm")
 	}
 
 	expectedValue := source
@@ -27,7 +29,7 @@ func (gr GitHubRelease) Condition(source string, scm scm.ScmHandler) (pass bool,
 	if len(versions) == 0 {
 		switch gr.spec.TypeFilter.IsZero() {
 		case true:
-			logrus.Warningf("%s No GitHub Release found, we fallback to published git tags", result.ATTENTION)
+			logger.Warningf("%s No GitHub Release found, we fallback to published git tags", result.ATTENTION)
 
 			versions, err = gr.ghHandler.SearchTags()
 			if err != nil {
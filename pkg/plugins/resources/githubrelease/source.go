@@ -1,14 +1,16 @@
 package githubrelease
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Source retrieves a specific version tag from GitHub Releases.
-func (gr *GitHubRelease) Source(workingDir string, resultSource *result.Source) error {
+func (gr *GitHubRelease) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
 
 	versions, err := gr.ghHandler.SearchReleases(gr.typeFilter)
 	if err != nil {
@@ -18,7 +20,7 @@ func (gr *GitHubRelease) Source(workingDir string, resultSource *result.Source)
 	if len(versions) == 0 {
 		switch gr.spec.TypeFilter.IsZero() {
 		case true:
-			logrus.Warningf("%s No GitHub Release found, we fallback to published git tags", result.ATTENTION)
+			logger.Warningf("%s No GitHub Release found, we fallback to published git tags", result.ATTENTION)
 
 			versions, err = gr.ghHandler.SearchTags()
 			if err != nil {
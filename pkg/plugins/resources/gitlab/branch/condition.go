@@ -1,17 +1,18 @@
 package branch
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
-func (g *Gitlab) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (g *Gitlab) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
 	if scm != nil {
 		return false, "", fmt.Errorf("Condition not supported for the plugin GitLab branch")
 	}
 
-	branches, err := g.SearchBranches()
+	branches, err := g.SearchBranches(ctx)
 	if err != nil {
 		return false, "", fmt.Errorf("looking for GitLab branch: %w", err)
 	}
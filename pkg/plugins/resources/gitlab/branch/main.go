@@ -9,6 +9,7 @@ import (
 	"github.com/drone/go-scm/scm"
 	"github.com/mitchellh/mapstructure"
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/client"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
@@ -86,10 +87,10 @@ func New(spec interface{}) (*Gitlab, error) {
 }
 
 // Retrieve GitLab branches from a remote GitLab repository
-func (g *Gitlab) SearchBranches() (tags []string, err error) {
+func (g *Gitlab) SearchBranches(ctx context.Context) (tags []string, err error) {
+	logger := log.FromContext(ctx)
 
 	// Timeout api query after 30sec
-	ctx := context.Background()
 
 	results := []string{}
 	page := 0
@@ -111,7 +112,7 @@ func (g *Gitlab) SearchBranches() (tags []string, err error) {
 		}
 
 		if resp.Status > 400 {
-			logrus.Debugf("RC: %q\nBody:\n%s", resp.Status, resp.Body)
+			logger.Debugf("RC: %q\nBody:\n%s", resp.Status, resp.Body)
 		}
 
 		for _, branch := range branches {
@@ -1,14 +1,15 @@
 package branch
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
-func (g *Gitlab) Source(workingDir string, resultSource *result.Source) error {
-	versions, err := g.SearchBranches()
+func (g *Gitlab) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	versions, err := g.SearchBranches(ctx)
 
 	if err != nil {
 		return fmt.Errorf("searching GitLab branches: %q", err)
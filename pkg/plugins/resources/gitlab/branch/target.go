@@ -1,6 +1,7 @@
 package branch
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
@@ -8,6 +9,6 @@ import (
 )
 
 // Target ensure that a specific release exist on GitLab, otherwise creates it
-func (g Gitlab) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (g Gitlab) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
 	return fmt.Errorf("target not supported for the plugin GitLab branch")
 }
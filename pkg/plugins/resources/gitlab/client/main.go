@@ -7,6 +7,7 @@ import (
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/gitlab"
 	"github.com/drone/go-scm/scm/transport"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
 )
 
 const (
@@ -27,7 +28,9 @@ func New(s Spec) (Client, error) {
 		return nil, err
 	}
 
-	client.Client = &http.Client{}
+	client.Client = &http.Client{
+		Transport: httpclient.NewCachedTransport(http.DefaultTransport),
+	}
 
 	if len(s.Token) >= 0 {
 		// provide a custom http.Client with a transport
@@ -35,6 +38,7 @@ func New(s Spec) (Client, error) {
 		// the PRIVATE_TOKEN header variable.
 		client.Client = &http.Client{
 			Transport: &transport.PrivateToken{
+				Base:  httpclient.NewCachedTransport(http.DefaultTransport),
 				Token: s.Token,
 			},
 		}
@@ -35,15 +35,15 @@ func (g *Gitlab) CreateAction(report reports.Action, resetDescription bool) erro
 		body = g.spec.Body
 	}
 
-	// Check if a merge-request is already opened then exit early if it does.
-	exist, err := g.isMergeRequestExist()
+	// Check if a merge-request is already opened then only reconcile its reviewers, assignees, and milestone.
+	existingMergeRequest, err := g.getOpenMergeRequest()
 	if err != nil {
 		return fmt.Errorf("check if a mergerequest already exist: %s", err.Error())
 	}
 
-	if exist {
-		logrus.Debugln("GitLab mergerequest already exist, nothing to do")
-		return nil
+	if existingMergeRequest != nil {
+		logrus.Debugln("GitLab mergerequest already exist, nothing else to do than updating its participants")
+		return g.updateMergeRequest(existingMergeRequest.Number)
 	}
 
 	// Test that both sourceBranch and targetBranch exists on remote before creating a new one
@@ -107,5 +107,5 @@ func (g *Gitlab) CreateAction(report reports.Action, resetDescription bool) erro
 
 	logrus.Infof("GitLab mergerequest successfully opened on %q", pr.Link)
 
-	return nil
+	return g.updateMergeRequest(pr.Number)
 }
@@ -84,4 +84,44 @@ type Spec struct {
 			"body" is useful to provide additional information when reviewing mergerequest, such as changelog url.
 	*/
 	Body string `yaml:",omitempty"`
+	/*
+		"reviewers" defines the GitLab usernames requested to review the mergerequest.
+
+		default:
+			empty
+
+		remark:
+			Reviewers are only added, existing mergerequest reviewers are kept.
+	*/
+	Reviewers []string `yaml:",omitempty"`
+	/*
+		"teamreviewers" defines the GitLab groups, such as "updatecli/maintainers", whose members are requested to review the mergerequest.
+
+		default:
+			empty
+
+		remark:
+			GitLab doesn't support group reviewers, so every member of the group, including inherited members, is added as a reviewer.
+	*/
+	TeamReviewers []string `yaml:",omitempty"`
+	/*
+		"assignees" defines the GitLab usernames assigned to the mergerequest.
+
+		default:
+			empty
+
+		remark:
+			Assignees are only added, existing mergerequest assignees are kept.
+	*/
+	Assignees []string `yaml:",omitempty"`
+	/*
+		"milestone" defines the title of the milestone associated with the mergerequest.
+
+		default:
+			empty
+
+		remark:
+			The milestone must already exist on the project or on one of its parent groups.
+	*/
+	Milestone string `yaml:",omitempty"`
 }
@@ -0,0 +1,293 @@
+package mergerequest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/sirupsen/logrus"
+)
+
+// gitlabUser contains the GitLab user information returned by the GitLab api
+type gitlabUser struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+// gitlabMilestone contains the GitLab milestone information returned by the GitLab api
+type gitlabMilestone struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+// gitlabMergeRequest contains the GitLab mergerequest participants returned by the GitLab api
+type gitlabMergeRequest struct {
+	IID       int              `json:"iid"`
+	Assignees []gitlabUser     `json:"assignees"`
+	Reviewers []gitlabUser     `json:"reviewers"`
+	Milestone *gitlabMilestone `json:"milestone"`
+}
+
+// gitlabMergeRequestUpdate contains the mergerequest attributes updated by Updatecli
+type gitlabMergeRequestUpdate struct {
+	AssigneeIDs []int `json:"assignee_ids,omitempty"`
+	ReviewerIDs []int `json:"reviewer_ids,omitempty"`
+	MilestoneID int   `json:"milestone_id,omitempty"`
+}
+
+// updateMergeRequest ensures that the mergerequest reviewers, assignees, and milestone
+// match the ones defined by the spec.
+// Reviewers and assignees are only added, so we don't override people added manually.
+func (g *Gitlab) updateMergeRequest(iid int) error {
+
+	if len(g.spec.Reviewers) == 0 &&
+		len(g.spec.TeamReviewers) == 0 &&
+		len(g.spec.Assignees) == 0 &&
+		g.spec.Milestone == "" {
+		return nil
+	}
+
+	// Timeout api query after 30sec
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	project := url.PathEscape(strings.Join([]string{g.Owner, g.Repository}, "/"))
+
+	var mr gitlabMergeRequest
+	err := g.do(ctx, http.MethodGet, fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", project, iid), nil, &mr)
+	if err != nil {
+		return fmt.Errorf("get GitLab mergerequest %d: %w", iid, err)
+	}
+
+	// knownUsers caches user IDs so we only query unknown usernames
+	knownUsers := map[string]int{}
+
+	reviewers := g.spec.Reviewers
+	for _, group := range g.spec.TeamReviewers {
+		members, err := g.getGroupMembers(ctx, group)
+		if err != nil {
+			return err
+		}
+		for _, member := range members {
+			knownUsers[strings.ToLower(member.Username)] = member.ID
+			reviewers = append(reviewers, member.Username)
+		}
+	}
+
+	update := gitlabMergeRequestUpdate{}
+	changed := false
+
+	reviewerIDs, reviewersChanged, err := g.mergeUsers(ctx, mr.Reviewers, reviewers, knownUsers)
+	if err != nil {
+		return err
+	}
+	if reviewersChanged {
+		update.ReviewerIDs = reviewerIDs
+		changed = true
+	}
+
+	assigneeIDs, assigneesChanged, err := g.mergeUsers(ctx, mr.Assignees, g.spec.Assignees, knownUsers)
+	if err != nil {
+		return err
+	}
+	if assigneesChanged {
+		update.AssigneeIDs = assigneeIDs
+		changed = true
+	}
+
+	if g.spec.Milestone != "" && (mr.Milestone == nil || mr.Milestone.Title != g.spec.Milestone) {
+		milestone, err := g.getMilestone(ctx, project)
+		if err != nil {
+			return err
+		}
+		update.MilestoneID = milestone.ID
+		changed = true
+	}
+
+	if !changed {
+		logrus.Debugf("GitLab mergerequest %d participants already up to date", iid)
+		return nil
+	}
+
+	err = g.do(ctx, http.MethodPut, fmt.Sprintf("api/v4/projects/%s/merge_requests/%d", project, iid), update, nil)
+	if err != nil {
+		return fmt.Errorf("update GitLab mergerequest %d: %w", iid, err)
+	}
+
+	logrus.Infof("GitLab mergerequest %d reviewers, assignees, and milestone updated", iid)
+
+	return nil
+}
+
+// mergeUsers returns the IDs of the current users extended with the missing wanted usernames.
+func (g *Gitlab) mergeUsers(ctx context.Context, current []gitlabUser, wanted []string, knownUsers map[string]int) ([]int, bool, error) {
+	ids := []int{}
+	usernames := map[string]bool{}
+	for _, user := range current {
+		ids = append(ids, user.ID)
+		usernames[strings.ToLower(user.Username)] = true
+	}
+
+	changed := false
+	for _, username := range wanted {
+		username = strings.ToLower(strings.TrimPrefix(username, "@"))
+		if usernames[username] {
+			continue
+		}
+
+		id, found := knownUsers[username]
+		if !found {
+			user, err := g.getUser(ctx, username)
+			if err != nil {
+				return nil, false, err
+			}
+			id = user.ID
+			knownUsers[username] = id
+		}
+
+		ids = append(ids, id)
+		usernames[username] = true
+		changed = true
+	}
+
+	return ids, changed, nil
+}
+
+// getUser queries the GitLab api to retrieve a user from its username
+func (g *Gitlab) getUser(ctx context.Context, username string) (gitlabUser, error) {
+	var users []gitlabUser
+
+	err := g.do(ctx, http.MethodGet, "api/v4/users?username="+url.QueryEscape(username), nil, &users)
+	if err != nil {
+		return gitlabUser{}, fmt.Errorf("get GitLab user %q: %w", username, err)
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.Username, username) {
+			return user, nil
+		}
+	}
+
+	return gitlabUser{}, fmt.Errorf("GitLab user %q not found", username)
+}
+
+// getGroupMembers queries the GitLab api to retrieve every member of a group, including inherited members
+func (g *Gitlab) getGroupMembers(ctx context.Context, group string) ([]gitlabUser, error) {
+	var members []gitlabUser
+
+	group = strings.TrimPrefix(group, "@")
+
+	for page := 1; page != 0; {
+		var result []gitlabUser
+		path := fmt.Sprintf("api/v4/groups/%s/members/all?per_page=100&page=%d", url.PathEscape(group), page)
+
+		next, err := g.doWithNextPage(ctx, path, &result)
+		if err != nil {
+			return nil, fmt.Errorf("get GitLab group %q members: %w", group, err)
+		}
+
+		members = append(members, result...)
+		page = next
+	}
+
+	return members, nil
+}
+
+// getMilestone queries the GitLab api to retrieve the project milestone defined by the spec.
+// Milestones from parent groups are also considered.
+func (g *Gitlab) getMilestone(ctx context.Context, project string) (gitlabMilestone, error) {
+	var milestones []gitlabMilestone
+
+	path := fmt.Sprintf("api/v4/projects/%s/milestones?include_ancestors=true&title=%s",
+		project,
+		url.QueryEscape(g.spec.Milestone))
+
+	err := g.do(ctx, http.MethodGet, path, nil, &milestones)
+	if err != nil {
+		return gitlabMilestone{}, fmt.Errorf("get GitLab milestone %q: %w", g.spec.Milestone, err)
+	}
+
+	for _, milestone := range milestones {
+		if milestone.Title == g.spec.Milestone {
+			return milestone, nil
+		}
+	}
+
+	return gitlabMilestone{}, fmt.Errorf("GitLab milestone %q not found", g.spec.Milestone)
+}
+
+// doWithNextPage sends a GET request to the GitLab api and returns the next page number, 0 if it's the last page
+func (g *Gitlab) doWithNextPage(ctx context.Context, path string, out interface{}) (int, error) {
+	res, err := g.send(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return 0, err
+	}
+
+	next := 0
+	if _, err := fmt.Sscan(res.Header.Get("X-Next-Page"), &next); err != nil {
+		return 0, nil
+	}
+
+	return next, nil
+}
+
+// do sends a request to the GitLab api and decodes the json response in out, if not nil
+func (g *Gitlab) do(ctx context.Context, method, path string, in, out interface{}) error {
+	res, err := g.send(ctx, method, path, in)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// send sends a request to the GitLab api using the authenticated client
+func (g *Gitlab) send(ctx context.Context, method, path string, in interface{}) (*scm.Response, error) {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+
+	if in != nil {
+		body, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		req.Header = map[string][]string{
+			"Content-Type": {"application/json"},
+		}
+		req.Body = bytes.NewReader(body)
+	}
+
+	res, err := (*scm.Client)(g.client).Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Status >= 300 {
+		defer res.Body.Close()
+		body, _ := io.ReadAll(res.Body)
+		logrus.Debugf("RC: %d\nBody:\n%s", res.Status, body)
+		return nil, fmt.Errorf("unexpected http status %d", res.Status)
+	}
+
+	return res, nil
+}
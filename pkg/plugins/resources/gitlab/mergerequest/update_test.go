@@ -0,0 +1,153 @@
+package mergerequest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/client"
+)
+
+func TestUpdateMergeRequest(t *testing.T) {
+	testData := []struct {
+		name string
+		spec Spec
+		// mergeRequest is the mergerequest returned by the GitLab api
+		mergeRequest   string
+		expectedUpdate *gitlabMergeRequestUpdate
+		wantErr        bool
+	}{
+		{
+			name: "New mergerequest",
+			spec: Spec{
+				Reviewers:     []string{"alice"},
+				TeamReviewers: []string{"updatecli/maintainers"},
+				Assignees:     []string{"@carol"},
+				Milestone:     "v1.0.0",
+			},
+			mergeRequest: `{"iid": 4}`,
+			expectedUpdate: &gitlabMergeRequestUpdate{
+				ReviewerIDs: []int{1, 2, 3},
+				AssigneeIDs: []int{4},
+				MilestoneID: 42,
+			},
+		},
+		{
+			name: "Existing mergerequest with partial participants",
+			spec: Spec{
+				Reviewers: []string{"Alice", "bob"},
+				Assignees: []string{"carol"},
+				Milestone: "v1.0.0",
+			},
+			mergeRequest: `{
+				"iid": 4,
+				"reviewers": [{"id": 1, "username": "alice"}, {"id": 10, "username": "frank"}],
+				"assignees": [{"id": 4, "username": "carol"}],
+				"milestone": {"id": 42, "title": "v1.0.0"}
+			}`,
+			expectedUpdate: &gitlabMergeRequestUpdate{
+				ReviewerIDs: []int{1, 10, 2},
+			},
+		},
+		{
+			name: "Existing mergerequest already up to date",
+			spec: Spec{
+				Reviewers: []string{"alice"},
+				Milestone: "v1.0.0",
+			},
+			mergeRequest: `{
+				"iid": 4,
+				"reviewers": [{"id": 1, "username": "alice"}],
+				"milestone": {"id": 42, "title": "v1.0.0"}
+			}`,
+		},
+		{
+			name: "Unknown user",
+			spec: Spec{
+				Assignees: []string{"ghost"},
+			},
+			mergeRequest: `{"iid": 4}`,
+			wantErr:      true,
+		},
+		{
+			name: "Unknown milestone",
+			spec: Spec{
+				Milestone: "v2.0.0",
+			},
+			mergeRequest: `{"iid": 4}`,
+			wantErr:      true,
+		},
+	}
+
+	users := map[string]int{"alice": 1, "bob": 2, "dave": 3, "carol": 4}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUpdate *gitlabMergeRequestUpdate
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v4/projects/updatecli%2Fupdatecli/merge_requests/4", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, tt.mergeRequest)
+			})
+			mux.HandleFunc("PUT /api/v4/projects/updatecli%2Fupdatecli/merge_requests/4", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "xxx", r.Header.Get("Private-Token"))
+				gotUpdate = &gitlabMergeRequestUpdate{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(gotUpdate))
+				fmt.Fprint(w, tt.mergeRequest)
+			})
+			mux.HandleFunc("GET /api/v4/users", func(w http.ResponseWriter, r *http.Request) {
+				username := r.URL.Query().Get("username")
+				id, found := users[username]
+				if !found {
+					fmt.Fprint(w, `[]`)
+					return
+				}
+				fmt.Fprintf(w, `[{"id": %d, "username": %q}]`, id, username)
+			})
+			mux.HandleFunc("GET /api/v4/groups/updatecli%2Fmaintainers/members/all", func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Query().Get("page") {
+				case "1":
+					w.Header().Set("X-Next-Page", "2")
+					fmt.Fprint(w, `[{"id": 1, "username": "alice"}, {"id": 2, "username": "bob"}]`)
+				case "2":
+					w.Header().Set("X-Next-Page", "")
+					fmt.Fprint(w, `[{"id": 3, "username": "dave"}]`)
+				}
+			})
+			mux.HandleFunc("GET /api/v4/projects/updatecli%2Fupdatecli/milestones", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "true", r.URL.Query().Get("include_ancestors"))
+				if r.URL.Query().Get("title") == "v1.0.0" {
+					fmt.Fprint(w, `[{"id": 42, "title": "v1.0.0"}]`)
+					return
+				}
+				fmt.Fprint(w, `[]`)
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			c, err := client.New(client.Spec{URL: server.URL, Token: "xxx"})
+			require.NoError(t, err)
+
+			g := Gitlab{
+				spec:       tt.spec,
+				client:     c,
+				Owner:      "updatecli",
+				Repository: "updatecli",
+			}
+
+			err = g.updateMergeRequest(4)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedUpdate, gotUpdate)
+		})
+	}
+}
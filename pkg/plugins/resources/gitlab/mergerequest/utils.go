@@ -10,8 +10,9 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-// isMergeRequestExist queries a remote GitLab instance to know if a pullrequest already exists.
-func (g *Gitlab) isMergeRequestExist() (bool, error) {
+// getOpenMergeRequest queries a remote GitLab instance to retrieve an already opened mergerequest.
+// It returns nil if no such mergerequest exists.
+func (g *Gitlab) getOpenMergeRequest() (*scm.PullRequest, error) {
 	ctx := context.Background()
 	// Timeout api query after 30sec
 	ctx, cancelList := context.WithTimeout(ctx, 30*time.Second)
@@ -36,7 +37,7 @@ func (g *Gitlab) isMergeRequestExist() (bool, error) {
 
 		if err != nil {
 			logrus.Debugf("RC: %d\nBody:\n%s", resp.Status, resp.Body)
-			return false, err
+			return nil, err
 		}
 
 		page = resp.Page.Next
@@ -51,11 +52,11 @@ func (g *Gitlab) isMergeRequestExist() (bool, error) {
 				!p.Closed &&
 				!p.Merged {
 
-				logrus.Infof("%s Nothing else to do, our pullrequest already exist on:\n\t%s",
+				logrus.Infof("%s Our mergerequest already exist on:\n\t%s",
 					result.SUCCESS,
 					p.Link)
 
-				return true, nil
+				return p, nil
 			}
 		}
 		if page == 0 {
@@ -63,7 +64,7 @@ func (g *Gitlab) isMergeRequestExist() (bool, error) {
 		}
 	}
 
-	return false, nil
+	return nil, nil
 }
 
 // isRemoteBranchesExist queries a remote GitLab instance to know if both the pull-request source branch and the target branch exist.
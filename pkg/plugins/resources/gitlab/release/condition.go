@@ -1,18 +1,21 @@
 package release
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
-func (g *Gitlab) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (g *Gitlab) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		logrus.Warningf("Condition not supported for the plugin GitLab release")
+		logger.Warningf("Condition not supported for the plugin GitLab release")
 	}
 
-	releases, err := g.SearchReleases()
+	releases, err := g.SearchReleases(ctx)
 	if err != nil {
 		return false, "", fmt.Errorf("looking for GitLab release: %w", err)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/drone/go-scm/scm"
 	"github.com/mitchellh/mapstructure"
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/client"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
@@ -100,9 +101,9 @@ func New(spec interface{}) (*Gitlab, error) {
 }
 
 // Retrieve git tags from a remote GitLab repository
-func (g *Gitlab) SearchReleases() ([]string, error) {
+func (g *Gitlab) SearchReleases(ctx context.Context) ([]string, error) {
+	logger := log.FromContext(ctx)
 
-	ctx := context.Background()
 	// Timeout api query after 30sec
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
@@ -127,7 +128,7 @@ func (g *Gitlab) SearchReleases() ([]string, error) {
 		}
 
 		if resp.Status > 400 {
-			logrus.Debugf("GitLab Api Response:\n%+v", resp)
+			logger.Debugf("GitLab Api Response:\n%+v", resp)
 		}
 
 		for i := len(releases) - 1; i >= 0; i-- {
@@ -1,18 +1,21 @@
 package tag
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
-func (g *Gitlab) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (g *Gitlab) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		logrus.Warningf("Condition not supported for the plugin GitHub Release")
+		logger.Warningf("Condition not supported for the plugin GitHub Release")
 	}
 
-	tags, err := g.SearchTags()
+	tags, err := g.SearchTags(ctx)
 	if err != nil {
 		return false, "", fmt.Errorf("looking for GitLab tags: %w", err)
 	}
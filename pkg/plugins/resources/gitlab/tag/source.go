@@ -1,14 +1,15 @@
 package tag
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
-func (g *Gitlab) Source(workingDir string, resultSource *result.Source) error {
-	versions, err := g.SearchTags()
+func (g *Gitlab) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	versions, err := g.SearchTags(ctx)
 
 	if err != nil {
 		return fmt.Errorf("searching GitLab tags: %w", err)
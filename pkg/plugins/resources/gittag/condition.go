@@ -1,20 +1,22 @@
 package gittag
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition checks that a git tag exists
-func (gt *GitTag) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (gt *GitTag) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
 
 	if scm != nil {
 		path := scm.GetDirectory()
 
 		if len(gt.spec.Path) > 0 {
-			logrus.Debugf("path is defined and set to %q but is overridden by the scm definition %q",
+			logger.Debugf("path is defined and set to %q but is overridden by the scm definition %q",
 				gt.spec.Path,
 				path)
 		}
@@ -23,7 +25,7 @@ func (gt *GitTag) Condition(source string, scm scm.ScmHandler) (pass bool, messa
 
 	// If source input is empty, then it means that it was disabled by the user with `disablesourceinput: true`
 	if source != "" {
-		logrus.Infof("Source input value detected: using it as spec.versionfilter.pattern")
+		logger.Infof("Source input value detected: using it as spec.versionfilter.pattern")
 		gt.versionFilter.Pattern = source
 	}
 
@@ -108,7 +108,7 @@ func TestValidate(t *testing.T) {
 			name: "Bad Key",
 			spec: Spec{
 				Path: "github.com/updatecli/updatecli",
-				Key:  "commit",
+				Key:  "sha",
 			},
 			want: GitTag{
 				spec: Spec{},
@@ -1,13 +1,14 @@
 package gittag
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Source returns the latest git tag based on create time
-func (gt *GitTag) Source(workingDir string, resultSource *result.Source) error {
+func (gt *GitTag) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
 
 	if len(gt.spec.Path) == 0 && len(workingDir) > 0 {
 		gt.spec.Path = workingDir
@@ -38,16 +39,20 @@ func (gt *GitTag) Source(workingDir string, resultSource *result.Source) error {
 	}
 
 	name := gt.foundVersion.GetVersion()
-	var hash string
+	var hash, commit string
 
 	for i := range refs {
 		if refs[i].Name == name {
 			hash = refs[i].Hash
+			commit = refs[i].Commit
 		}
 	}
 	resultSource.Information = name
-	if gt.spec.Key == "hash" {
+	switch gt.spec.Key {
+	case "hash":
 		resultSource.Information = hash
+	case "commit":
+		resultSource.Information = commit
 	}
 
 	if len(resultSource.Information) == 0 {
@@ -1,6 +1,7 @@
 package gittag
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -274,6 +275,34 @@ func TestGitTag_Source(t *testing.T) {
 			wantValue: "mno345",
 			wantErr:   false,
 		},
+		{
+			name:       "annotated tag found, return the referenced commit",
+			workingDir: "github.com/updatecli/updatecli",
+			mockedNativeGitHandler: &mockNativeGitHandler{
+				tagRefs: []gitgeneric.DatedTag{
+					{
+						Name:   "v1.0.0",
+						Hash:   "abc123",
+						Commit: "abc123",
+					},
+					{
+						Name:   "v1.1.0",
+						Hash:   "def456",
+						Commit: "ghi789",
+					},
+				},
+			},
+			versionFilter: version.Filter{
+				Kind:    "semver",
+				Pattern: "*",
+			},
+			spec: Spec{
+				Key:  "commit",
+				Path: "github.com/updatecli/updatecli",
+			},
+			wantValue: "ghi789",
+			wantErr:   false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -287,7 +316,7 @@ func TestGitTag_Source(t *testing.T) {
 			}
 
 			gotResult := result.Source{}
-			err := gr.Source(tt.workingDir, &gotResult)
+			err := gr.Source(context.Background(), tt.workingDir, &gotResult)
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
@@ -1,26 +1,29 @@
 package gittag
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
 // Target creates a tag if needed from a local git repository, without pushing the tag
-func (gt *GitTag) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (gt *GitTag) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
 		if len(gt.spec.Path) > 0 {
-			logrus.Warningf("Path setting value %q overridden by the scm configuration (value %q)",
+			logger.Warningf("Path setting value %q overridden by the scm configuration (value %q)",
 				gt.spec.Path,
 				scm.GetDirectory())
 		}
 		gt.spec.Path = scm.GetDirectory()
 	}
 
-	if err := gt.target(source, dryRun, resultTarget); err != nil {
+	if err := gt.target(ctx, source, dryRun, resultTarget); err != nil {
 		return err
 	}
 
@@ -30,7 +33,7 @@ func (gt *GitTag) Target(source string, scm scm.ScmHandler, dryRun bool, resultT
 
 	if scm != nil {
 		if err := scm.PushTag(source); err != nil {
-			logrus.Errorf("Git push tag error: %s", err)
+			logger.Errorf("Git push tag error: %s", err)
 			return err
 		}
 	}
@@ -43,12 +46,14 @@ func (gt *GitTag) Target(source string, scm scm.ScmHandler, dryRun bool, resultT
 	return nil
 }
 
-func (gt *GitTag) target(source string, dryRun bool, resultTarget *result.Target) error {
+func (gt *GitTag) target(ctx context.Context, source string, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
+
 	// Ensure that a git message is present to annotate the tag to create
 	if len(gt.spec.Message) == 0 {
 		// absence of a message is not blocking: warn the user and continue
 		gt.spec.Message = "Generated by updatecli"
-		logrus.Warningf("No specified message for gittag target. Using default value %q", gt.spec.Message)
+		logger.Warningf("No specified message for gittag target. Using default value %q", gt.spec.Message)
 	}
 
 	// cfr https://github.com/updatecli/updatecli/issues/1126
@@ -1,6 +1,7 @@
 package gomod
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
@@ -9,7 +10,7 @@ import (
 )
 
 // Target is not supported for the Golang resource
-func (g *GoMod) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) (err error) {
+func (g *GoMod) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) (err error) {
 
 	version := source
 	if g.spec.Version != "" {
@@ -23,7 +24,7 @@ func (g *GoMod) Target(source string, scm scm.ScmHandler, dryRun bool, resultTar
 		filename = utils.JoinFilePathWithWorkingDirectoryPath(g.filename, scm.GetDirectory())
 	}
 
-	resultTarget.Information, resultTarget.NewInformation, resultTarget.Changed, err = g.setVersion(version, filename, dryRun)
+	resultTarget.Information, resultTarget.NewInformation, resultTarget.Changed, err = g.setVersion(ctx, version, filename, dryRun)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package gomod
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -73,7 +74,7 @@ func TestTarget(t *testing.T) {
 			require.NoError(t, err)
 			gotResult := result.Target{}
 
-			err = got.Target("", nil, true, &gotResult)
+			err = got.Target(context.Background(), "", nil, true, &gotResult)
 			if tt.expectedError {
 				assert.Error(t, err)
 				return
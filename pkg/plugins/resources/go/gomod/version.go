@@ -1,6 +1,7 @@
 package gomod
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -10,7 +11,7 @@ import (
 	"github.com/hexops/gotextdiff"
 	"github.com/hexops/gotextdiff/myers"
 	"github.com/hexops/gotextdiff/span"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"golang.org/x/mod/modfile"
 )
 
@@ -22,7 +23,8 @@ var (
 )
 
 // version retrieve the version specified by a GO module
-func (g *GoMod) version(filename string) (string, error) {
+func (g *GoMod) version(ctx context.Context, filename string) (string, error) {
+	logger := log.FromContext(ctx)
 
 	// Test at runtime if a file exist
 	if !g.contentRetriever.FileExists(filename) {
@@ -37,7 +39,7 @@ func (g *GoMod) version(filename string) (string, error) {
 
 	modfile, err := modfile.Parse(filename, []byte(data), nil)
 	if err != nil {
-		logrus.Errorln(err)
+		logger.Errorln(err)
 		return "", fmt.Errorf("failed reading %q", filename)
 	}
 
@@ -54,7 +56,7 @@ func (g *GoMod) version(filename string) (string, error) {
 				return r.Mod.Version, nil
 			}
 		}
-		logrus.Errorf("GO module %q not found in %q", g.spec.Module, filename)
+		logger.Errorf("GO module %q not found in %q", g.spec.Module, filename)
 		return "", ErrModuleNotFound
 	}
 
@@ -62,18 +64,19 @@ func (g *GoMod) version(filename string) (string, error) {
 }
 
 // setVersion update a go.mod file with the version specified by a GO module
-func (g *GoMod) setVersion(version, filename string, dryrun bool) (oldVersion, newVersion string, changed bool, err error) {
+func (g *GoMod) setVersion(ctx context.Context, version, filename string, dryrun bool) (oldVersion, newVersion string, changed bool, err error) {
+	logger := log.FromContext(ctx)
 
 	oldContent, err := os.ReadFile(filename)
 
 	if err != nil {
-		logrus.Errorln(err)
+		logger.Errorln(err)
 		return "", "", false, fmt.Errorf("failed reading %q", filename)
 	}
 
 	modFile, err := modfile.Parse(filename, oldContent, nil)
 	if err != nil {
-		logrus.Errorln(err)
+		logger.Errorln(err)
 		return "", "", false, fmt.Errorf("failed reading %q", filename)
 	}
 
@@ -83,14 +86,14 @@ func (g *GoMod) setVersion(version, filename string, dryrun bool) (oldVersion, n
 		oldVersion = modFile.Go.Version
 		newVersion, err := getNewVersion(oldVersion, version)
 		if err != nil {
-			logrus.Errorln(err)
+			logger.Errorln(err)
 			return "", "", false, fmt.Errorf("failed parsing go version %q", version)
 		}
 
 		if oldVersion != newVersion {
 			err = modFile.AddGoStmt(newVersion)
 			if err != nil {
-				logrus.Errorln(err)
+				logger.Errorln(err)
 				return "", "", false, fmt.Errorf("failed updating go version %q\n%w", version, err)
 			}
 
@@ -112,7 +115,7 @@ func (g *GoMod) setVersion(version, filename string, dryrun bool) (oldVersion, n
 
 					err = modFile.AddRequire(r.Mod.Path, version)
 					if err != nil {
-						logrus.Errorln(err)
+						logger.Errorln(err)
 						return "", "", false, fmt.Errorf("failed updating go module %q to %q\n%w", g.spec.Module, version, err)
 					}
 
@@ -127,7 +130,7 @@ func (g *GoMod) setVersion(version, filename string, dryrun bool) (oldVersion, n
 
 		}
 	default:
-		logrus.Errorf("kind %q is not supported", g.kind)
+		logger.Errorf("kind %q is not supported", g.kind)
 		return "", "", false, fmt.Errorf("something unexpected happened, kind %q not supported", g.kind)
 	}
 
@@ -135,12 +138,12 @@ func (g *GoMod) setVersion(version, filename string, dryrun bool) (oldVersion, n
 	newContent, err := modFile.Format()
 
 	if err != nil {
-		logrus.Errorln(err)
+		logger.Errorln(err)
 		return oldVersion, newVersion, changed, fmt.Errorf("failed formatting %q", filename)
 	}
 
 	edits := myers.ComputeEdits(span.URIFromPath(filename), string(oldContent), string(newContent))
-	logrus.Debugf("\n---\n%v\n---\n", gotextdiff.ToUnified("old", "new", string(oldContent), edits))
+	logger.Debugf("\n---\n%v\n---\n", gotextdiff.ToUnified("old", "new", string(oldContent), edits))
 
 	if !changed || dryrun {
 		return oldVersion, newVersion, changed, nil
@@ -148,18 +151,18 @@ func (g *GoMod) setVersion(version, filename string, dryrun bool) (oldVersion, n
 
 	f, err := os.Create(filename)
 	if err != nil {
-		logrus.Errorln(err)
+		logger.Errorln(err)
 		return oldVersion, newVersion, changed, fmt.Errorf("failed opening file %q", filename)
 	}
 	defer f.Close()
 
 	_, err = f.Write(newContent)
 	if err != nil {
-		logrus.Errorln(err)
+		logger.Errorln(err)
 		return oldVersion, newVersion, changed, fmt.Errorf("failed writing data to %q", filename)
 	}
 
-	logrus.Debugf("%q updated\n", filename)
+	logger.Debugf("%q updated\n", filename)
 
 	return oldVersion, newVersion, changed, nil
 }
@@ -1,16 +1,19 @@
 package language
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition checks if a specific stable Golang version is published
-func (l *Language) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (l *Language) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		logrus.Debugln("scm is not supported")
+		logger.Debugln("scm is not supported")
 	}
 	versionToCheck := l.Spec.Version
 	if versionToCheck == "" {
@@ -20,7 +23,7 @@ func (l *Language) Condition(source string, scm scm.ScmHandler) (pass bool, mess
 		return false, "", fmt.Errorf("no version defined")
 	}
 
-	versions, err := l.versions()
+	versions, err := l.versions(ctx)
 	if err != nil {
 		return false, "", fmt.Errorf("searching golang version: %w", err)
 	}
@@ -1,8 +1,6 @@
 package language
 
 import (
-	"net/http"
-
 	"github.com/mitchellh/mapstructure"
 	"github.com/updatecli/updatecli/pkg/core/httpclient"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
@@ -38,6 +36,6 @@ func New(spec interface{}) (*Language, error) {
 	return &Language{
 		Spec:          newSpec,
 		versionFilter: newFilter,
-		webClient:     http.DefaultClient,
+		webClient:     httpclient.NewClient(),
 	}, nil
 }
@@ -1,14 +1,15 @@
 package language
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Source returns the latest go module version
-func (g *Language) Source(workingDir string, resultSource *result.Source) error {
-	_, err := g.versions()
+func (g *Language) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	_, err := g.versions(ctx)
 	if err != nil {
 		return fmt.Errorf("retrieving golang version: %w", err)
 	}
@@ -1,6 +1,7 @@
 package language
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
@@ -8,6 +9,6 @@ import (
 )
 
 // Target is not supported for the Golang resource
-func (l *Language) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (l *Language) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
 	return fmt.Errorf("Target not supported for the plugin Go")
 }
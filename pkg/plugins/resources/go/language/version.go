@@ -1,6 +1,7 @@
 package language
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -9,7 +10,7 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 )
 
 var (
@@ -22,35 +23,36 @@ type Info struct {
 }
 
 // versions fetch all stable Golang version
-func (l *Language) versions() (versions []string, err error) {
+func (l *Language) versions(ctx context.Context) (versions []string, err error) {
+	logger := log.FromContext(ctx)
 
 	if err != nil {
-		logrus.Errorf("something went wrong while generating the go url to retrieve versions %q\n", err)
+		logger.Errorf("something went wrong while generating the go url to retrieve versions %q\n", err)
 		return []string{}, err
 	}
 
 	req, err := http.NewRequest("GET", "https://go.dev/dl/?mode=json&include=all", nil)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting go version data %q\n", err)
+		logger.Errorf("something went wrong while getting go version data %q\n", err)
 		return []string{}, err
 	}
 
 	res, err := l.webClient.Do(req)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting go version data %q\n", err)
+		logger.Errorf("something went wrong while getting go version data %q\n", err)
 		return []string{}, err
 	}
 
 	defer res.Body.Close()
 	if res.StatusCode >= 400 {
 		body, err := httputil.DumpResponse(res, false)
-		logrus.Errorf("something went wrong while getting golang version data %q\n", err)
-		logrus.Debugf("\n%v\n", string(body))
+		logger.Errorf("something went wrong while getting golang version data %q\n", err)
+		logger.Debugf("\n%v\n", string(body))
 	}
 
 	data, err := io.ReadAll(res.Body)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting npm api data%q\n", err)
+		logger.Errorf("something went wrong while getting npm api data%q\n", err)
 		return []string{}, err
 	}
 
@@ -58,7 +60,7 @@ func (l *Language) versions() (versions []string, err error) {
 
 	err = json.Unmarshal(data, &versionsInfo)
 	if err != nil {
-		logrus.Errorf("error unmarshalling json: %q", err)
+		logger.Errorf("error unmarshalling json: %q", err)
 		return []string{}, err
 	}
 
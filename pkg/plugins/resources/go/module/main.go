@@ -1,8 +1,6 @@
 package gomodule
 
 import (
-	"net/http"
-
 	"github.com/mitchellh/mapstructure"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/httpclient"
@@ -47,6 +45,6 @@ func New(spec interface{}) (*GoModule, error) {
 	return &GoModule{
 		Spec:          newSpec,
 		versionFilter: newFilter,
-		webClient:     http.DefaultClient,
+		webClient:     httpclient.NewClient(),
 	}, nil
 }
@@ -1,6 +1,7 @@
 package gomodule
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
@@ -8,6 +9,6 @@ import (
 )
 
 // Target is not support for gomodule
-func (g *GoModule) Target(source string, scm scm.ScmHandler, dryRun bool, releaseTarget *result.Target) error {
+func (g *GoModule) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, releaseTarget *result.Target) error {
 	return fmt.Errorf("Target not supported for the plugin GO module")
 }
@@ -1,10 +1,11 @@
 package gomodule
 
 import (
+	"context"
 	"strings"
 	"unicode"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 )
 
 // sanitizeGoModuleNameForProxy is used to lowercase any uppercase character with a ! prefix as explained on https://go.dev/ref/mod#goproxy-protocol
@@ -21,13 +22,15 @@ func sanitizeGoModuleNameForProxy(module string) string {
 	return result
 }
 
-func isSupportedGoProxy(proxy string) bool {
+func isSupportedGoProxy(ctx context.Context, proxy string) bool {
+	logger := log.FromContext(ctx)
+
 	if proxy == "direct" || proxy == "off" {
-		logrus.Debugf("proxy %q has no meaning from an Updatecli stand point", proxy)
+		logger.Debugf("proxy %q has no meaning from an Updatecli stand point", proxy)
 		return false
 	}
 	if strings.HasPrefix(proxy, "file://") {
-		logrus.Debugln("updatecli do not support proxy using file protocol at this time. Feel free to open a pullrequest")
+		logger.Debugln("updatecli do not support proxy using file protocol at this time. Feel free to open a pullrequest")
 		return false
 	}
 
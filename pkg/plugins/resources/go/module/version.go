@@ -1,6 +1,7 @@
 package gomodule
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -10,11 +11,12 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 )
 
 // GetVersions fetch all versions of a Golang module
-func (g *GoModule) versions() (v string, versions []string, err error) {
+func (g *GoModule) versions(ctx context.Context) (v string, versions []string, err error) {
+	logger := log.FromContext(ctx)
 
 	var GOPROXY string
 	if g.Spec.Proxy != "" {
@@ -26,7 +28,7 @@ func (g *GoModule) versions() (v string, versions []string, err error) {
 	}
 
 	for _, proxy := range strings.Split(GOPROXY, ",") {
-		if !isSupportedGoProxy(proxy) {
+		if !isSupportedGoProxy(ctx, proxy) {
 			continue
 		}
 
@@ -35,34 +37,34 @@ func (g *GoModule) versions() (v string, versions []string, err error) {
 			sanitizeGoModuleNameForProxy(g.Spec.Module),
 			"@v", "list")
 		if err != nil {
-			logrus.Errorf("something went wrong while getting go module api data %q\n", err)
+			logger.Errorf("something went wrong while getting go module api data %q\n", err)
 			return "", []string{}, err
 		}
 
 		req, err := http.NewRequest("GET", URL, nil)
 		if err != nil {
-			logrus.Errorf("something went wrong while getting go module api data %q\n", err)
+			logger.Errorf("something went wrong while getting go module api data %q\n", err)
 			return "", []string{}, err
 		}
 
 		res, err := g.webClient.Do(req)
 		if err != nil {
-			logrus.Errorf("something went wrong while getting go module api data %q\n", err)
+			logger.Errorf("something went wrong while getting go module api data %q\n", err)
 			return "", []string{}, err
 		}
 
 		defer res.Body.Close()
 		if res.StatusCode >= 400 {
 			body, err := httputil.DumpResponse(res, false)
-			logrus.Errorf("something went wrong while getting golang module data %q\n", err)
-			logrus.Debugf("skipping proxy %q due to %q\n", proxy, err)
-			logrus.Debugf("\n%v\n", string(body))
+			logger.Errorf("something went wrong while getting golang module data %q\n", err)
+			logger.Debugf("skipping proxy %q due to %q\n", proxy, err)
+			logger.Debugf("\n%v\n", string(body))
 			continue
 		}
 
 		data, err := io.ReadAll(res.Body)
 		if err != nil {
-			logrus.Errorf("something went wrong while getting npm api data%q\n", err)
+			logger.Errorf("something went wrong while getting npm api data%q\n", err)
 			return "", []string{}, err
 		}
 
@@ -2,13 +2,14 @@ package hcl
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/minamijoyo/hcledit/editor"
 	"github.com/mitchellh/mapstructure"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/text"
 	"github.com/updatecli/updatecli/pkg/plugins/utils"
@@ -138,13 +139,15 @@ func (h *Hcl) Read() error {
 	return nil
 }
 
-func (h *Hcl) UpdateAbsoluteFilePath(workDir string) {
+func (h *Hcl) UpdateAbsoluteFilePath(ctx context.Context, workDir string) {
+	logger := log.FromContext(ctx)
+
 	for filePath := range h.files {
 		if workDir != "" {
 			f := h.files[filePath]
 			f.filePath = utils.JoinFilePathWithWorkingDirectoryPath(f.originalFilePath, workDir)
-			logrus.Debugf(workDir)
-			logrus.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", f.originalFilePath, f.filePath)
+			logger.Debugf(workDir)
+			logger.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", f.originalFilePath, f.filePath)
 			h.files[filePath] = f
 		}
 	}
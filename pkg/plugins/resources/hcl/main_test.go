@@ -1,6 +1,7 @@
 package hcl
 
 import (
+	"context"
 	"fmt"
 	"slices"
 	"testing"
@@ -160,7 +161,7 @@ func TestUpdateAbsoluteFilePath(t *testing.T) {
 
 			require.NoError(t, err)
 
-			h.UpdateAbsoluteFilePath(tt.workingDir)
+			h.UpdateAbsoluteFilePath(context.Background(), tt.workingDir)
 
 			for _, v := range h.files {
 				assert.True(t, slices.Contains(tt.expectedResult, v.filePath), fmt.Sprintf("%s not in %v", v.filePath, tt.expectedResult))
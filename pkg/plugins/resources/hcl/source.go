@@ -1,17 +1,18 @@
 package hcl
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (h *Hcl) Source(workingDir string, resultSource *result.Source) error {
+func (h *Hcl) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
 	if len(h.files) > 1 {
 		return fmt.Errorf("%s HCL source only supports one file", result.FAILURE)
 	}
 
-	h.UpdateAbsoluteFilePath(workingDir)
+	h.UpdateAbsoluteFilePath(ctx, workingDir)
 
 	if err := h.Read(); err != nil {
 		return fmt.Errorf("reading hcl file: %w", err)
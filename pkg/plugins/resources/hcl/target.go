@@ -1,18 +1,21 @@
 package hcl
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (h *Hcl) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (h *Hcl) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		h.UpdateAbsoluteFilePath(scm.GetDirectory())
+		h.UpdateAbsoluteFilePath(ctx, scm.GetDirectory())
 	}
 
 	for _, f := range h.files {
@@ -34,7 +37,7 @@ func (h *Hcl) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarge
 	valueToWrite := source
 	if h.spec.Value != "" {
 		valueToWrite = h.spec.Value
-		logrus.Debug("Using spec.Value instead of source input value.")
+		logger.Debug("Using spec.Value instead of source input value.")
 	}
 
 	resultTarget.NewInformation = valueToWrite
@@ -2,6 +2,7 @@ package helm
 
 import (
 	"bytes"
+	"context"
 	"html/template"
 
 	"github.com/sirupsen/logrus"
@@ -35,7 +36,7 @@ URL:
 
 // Changelog returns a rendered template with this chart version information
 func (c Chart) Changelog() string {
-	index, err := c.GetRepoIndexFromURL()
+	index, err := c.GetRepoIndexFromURL(context.Background())
 
 	if err != nil {
 		return ""
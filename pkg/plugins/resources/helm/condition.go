@@ -1,24 +1,26 @@
 package helm
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"helm.sh/helm/v3/pkg/repo"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition checks if a specific chart version exist
-func (c *Chart) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (c *Chart) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
 
 	if strings.HasPrefix(c.spec.URL, "oci://") {
 		return c.OCICondition(source, scm)
 	}
 
 	if c.spec.Version != "" {
-		logrus.Infof("Version %v, already defined from configuration file", c.spec.Version)
+		logger.Infof("Version %v, already defined from configuration file", c.spec.Version)
 	} else {
 		c.spec.Version = source
 	}
@@ -26,7 +28,7 @@ func (c *Chart) Condition(source string, scm scm.ScmHandler) (pass bool, message
 	var index repo.IndexFile
 
 	if strings.HasPrefix(c.spec.URL, "https://") || strings.HasPrefix(c.spec.URL, "http://") {
-		index, err = c.GetRepoIndexFromURL()
+		index, err = c.GetRepoIndexFromURL(ctx)
 		if err != nil {
 			return false, "", err
 		}
@@ -1,18 +1,20 @@
 package helm
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"strings"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // OCISource return a Helm Chart version hosted on a OCI registry
-func (c *Chart) OCISource(workingDir string, resultSource *result.Source) error {
+func (c *Chart) OCISource(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
 
 	refName := filepath.Join(strings.TrimPrefix(c.spec.URL, "oci://"), c.spec.Name)
 
@@ -21,7 +23,7 @@ func (c *Chart) OCISource(workingDir string, resultSource *result.Source) error
 		return fmt.Errorf("invalid OCI Helm chart %s: %w", refName, err)
 	}
 
-	logrus.Debugf("Searching versions for Helm chart %q", repo)
+	logger.Debugf("Searching versions for Helm chart %q", repo)
 
 	versions, err := remote.List(repo, c.options...)
 	if err != nil {
@@ -2,10 +2,11 @@ package helm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"path/filepath"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/yaml"
@@ -13,9 +14,11 @@ import (
 
 // Target updates helm chart, it receives the default source value and a "dry-run" flag
 // then return if it changed something or failed
-func (c *Chart) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (c *Chart) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
+
 	var out bytes.Buffer
-	err := c.ValidateTarget()
+	err := c.ValidateTarget(ctx)
 	if err != nil {
 		return err
 	}
@@ -29,12 +32,12 @@ func (c *Chart) Target(source string, scm scm.ScmHandler, dryRun bool, resultTar
 		yamlSpec.Value = c.spec.Value
 	}
 
-	yamlResource, err := yaml.New(yamlSpec)
+	yamlResource, err := yaml.New(ctx, yamlSpec)
 	if err != nil {
 		return err
 	}
 
-	err = yamlResource.Target(source, scm, dryRun, resultTarget)
+	err = yamlResource.Target(ctx, source, scm, dryRun, resultTarget)
 
 	if err != nil {
 		return fmt.Errorf("unable to update chart %s: %s", c.spec.Name, err)
@@ -45,7 +48,7 @@ func (c *Chart) Target(source string, scm scm.ScmHandler, dryRun bool, resultTar
 		chartPath = filepath.Join(scm.GetDirectory(), c.spec.Name)
 	}
 
-	err = c.MetadataUpdate(resultTarget.NewInformation, scm, dryRun, resultTarget)
+	err = c.MetadataUpdate(ctx, resultTarget.NewInformation, scm, dryRun, resultTarget)
 	if err != nil {
 		return fmt.Errorf("unable to update chart metadata: %s", err)
 	}
@@ -58,7 +61,7 @@ func (c *Chart) Target(source string, scm scm.ScmHandler, dryRun bool, resultTar
 	if !dryRun && !c.spec.SkipPackaging {
 		err = c.DependencyUpdate(&out, chartPath)
 
-		logrus.Debug(out.String())
+		logger.Debug(out.String())
 
 		if err != nil {
 			return err
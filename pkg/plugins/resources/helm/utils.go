@@ -2,6 +2,7 @@ package helm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,7 +13,8 @@ import (
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/yaml"
@@ -102,7 +104,9 @@ func (c *Chart) GetRepoIndexFromFile(rootDir string) (repo.IndexFile, error) {
 
 // GetRepoIndexFromUrl loads an index file and does minimal validity checking.
 // It fails if API Version isn't set (ErrNoAPIVersion) or if the "unmarshal" operation fails.
-func (c *Chart) GetRepoIndexFromURL() (repo.IndexFile, error) {
+func (c *Chart) GetRepoIndexFromURL(ctx context.Context) (repo.IndexFile, error) {
+	logger := log.FromContext(ctx)
+
 	var err error
 
 	URL := c.spec.URL
@@ -119,7 +123,7 @@ func (c *Chart) GetRepoIndexFromURL() (repo.IndexFile, error) {
 		return repo.IndexFile{}, err
 	}
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := httpclient.NewClient().Do(req)
 	if err != nil {
 		return repo.IndexFile{}, err
 	}
@@ -129,8 +133,8 @@ func (c *Chart) GetRepoIndexFromURL() (repo.IndexFile, error) {
 	if res.StatusCode >= 400 {
 		body, err := httputil.DumpResponse(res, false)
 
-		logrus.Errorf("something went wrong while contacting %q\n", URL)
-		logrus.Debugf("\n%v\n", string(body))
+		logger.Errorf("something went wrong while contacting %q\n", URL)
+		logger.Debugf("\n%v\n", string(body))
 
 		return *repo.NewIndexFile(), err
 
@@ -157,7 +161,9 @@ func (c *Chart) GetRepoIndexFromURL() (repo.IndexFile, error) {
 }
 
 // MetadataUpdate updates a metadata if necessary and it bump the ChartVersion
-func (c *Chart) MetadataUpdate(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (c *Chart) MetadataUpdate(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
+
 	var err error
 
 	/*
@@ -209,7 +215,7 @@ func (c *Chart) MetadataUpdate(source string, scm scm.ScmHandler, dryRun bool, r
 	}
 
 	if len(currentChartMetadata.AppVersion) > 0 && c.spec.AppVersion {
-		if err := c.metadataYamlPathUpdate("$.appVersion", source, scm, dryRun, resultTarget); err != nil {
+		if err := c.metadataYamlPathUpdate(ctx, "$.appVersion", source, scm, dryRun, resultTarget); err != nil {
 			return err
 		}
 	}
@@ -282,7 +288,7 @@ forLoop:
 				computedVersion = v.IncMinor().String()
 			}
 		default:
-			logrus.Warningf("Wrong increment rule %q, ignoring", inc)
+			logger.Warningf("Wrong increment rule %q, ignoring", inc)
 		}
 	}
 
@@ -315,10 +321,10 @@ forLoop:
 			resultTarget.Result = result.ATTENTION
 		}
 
-		logrus.Debugf("Updating chart version from %q to %q", currentChartMetadata.Version, computedVersion)
+		logger.Debugf("Updating chart version from %q to %q", currentChartMetadata.Version, computedVersion)
 	}
 
-	if err := c.metadataYamlPathUpdate("$.version", computedVersion, scm, dryRun, resultTarget); err != nil {
+	if err := c.metadataYamlPathUpdate(ctx, "$.version", computedVersion, scm, dryRun, resultTarget); err != nil {
 		return err
 	}
 
@@ -326,19 +332,19 @@ forLoop:
 }
 
 // metadataYamlPathUpdate updates the Chart.yaml
-func (c *Chart) metadataYamlPathUpdate(key string, value string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (c *Chart) metadataYamlPathUpdate(ctx context.Context, key string, value string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
 	yamlSpec := yaml.Spec{
 		File: filepath.Join(c.spec.Name, "Chart.yaml"),
 		Key:  key,
 	}
 
-	yamlResource, err := yaml.New(yamlSpec)
+	yamlResource, err := yaml.New(ctx, yamlSpec)
 	if err != nil {
 		return err
 	}
 
 	metadataResultTarget := result.Target{}
-	if err := yamlResource.Target(value, scm, dryRun, &metadataResultTarget); err != nil {
+	if err := yamlResource.Target(ctx, value, scm, dryRun, &metadataResultTarget); err != nil {
 		return err
 	}
 
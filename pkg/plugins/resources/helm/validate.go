@@ -1,11 +1,12 @@
 package helm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 )
 
 var (
@@ -14,7 +15,8 @@ var (
 )
 
 // ValidateTarget validates target struct fields.
-func (c *Chart) ValidateTarget() error {
+func (c *Chart) ValidateTarget(ctx context.Context) error {
+	logger := log.FromContext(ctx)
 
 	var errs []error
 
@@ -34,7 +36,7 @@ func (c *Chart) ValidateTarget() error {
 
 	if len(errs) > 0 {
 		for _, e := range errs {
-			logrus.Errorln(e)
+			logger.Errorln(e)
 		}
 		return ErrWrongConfig
 	}
@@ -1,6 +1,7 @@
 package helm
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -47,7 +48,7 @@ func TestValidateTarget(t *testing.T) {
 
 	for _, d := range dataset {
 		t.Run("x", func(t *testing.T) {
-			gotErr := d.chart.ValidateTarget()
+			gotErr := d.chart.ValidateTarget(context.Background())
 			if d.wantErr {
 				require.Error(t, gotErr)
 				assert.EqualError(t, gotErr, d.expectedError)
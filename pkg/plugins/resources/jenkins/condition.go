@@ -1,18 +1,20 @@
 package jenkins
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition checks that a Jenkins version exists and that the version
 // match a valid release type
-func (j Jenkins) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (j Jenkins) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
 
 	if scm != nil {
-		logrus.Warningf("SCM configuration is not supported for Jenkins condition")
+		logger.Warningf("SCM configuration is not supported for Jenkins condition")
 	}
 
 	versionToCheck := j.spec.Version
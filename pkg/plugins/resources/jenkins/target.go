@@ -1,12 +1,13 @@
 package jenkins
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (j Jenkins) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (j Jenkins) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
 	return fmt.Errorf("Target not supported for the plugin Jenkins")
 }
@@ -1,13 +1,14 @@
 package json
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
-func (j *Json) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (j *Json) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
 	conditionResult := true
 	partialMessage := ""
 
@@ -1,18 +1,19 @@
 package maven
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
-
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition tests if a specific version exist on the maven repository
-func (m *Maven) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (m *Maven) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
 
 	if scm != nil {
-		logrus.Warningf("SCM configuration is not supported for maven condition, aborting")
+		logger.Warningf("SCM configuration is not supported for maven condition, aborting")
 	}
 
 	if m.spec.Version == "" {
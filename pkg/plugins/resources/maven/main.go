@@ -9,6 +9,7 @@ import (
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/mavenmetadata"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
@@ -35,8 +36,18 @@ type Spec struct {
 	ArtifactID string `yaml:",omitempty"`
 	// Specifies the maven artifact version
 	Version string `yaml:",omitempty"`
-	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, maven, or just latest.
+	// The kind "maven" sorts versions using the maven comparison rules and accepts ranges like "[1.2,2.0)" or ">=1.2,<2"
+	// By default, the newest release is retrieved using the kind "maven", ignoring pre-releases and snapshots.
+	// The kind "latest" returns the version flagged as latest in the maven metadata file.
 	VersionFilter version.Filter `yaml:",omitempty"`
+	/*
+		retry defines how failed requests to the maven repositories are retried.
+
+		default:
+			the global retry policy defined by the "--http-retry-*" flags
+	*/
+	Retry httpclient.RetryPolicy `yaml:",omitempty"`
 }
 
 // Maven defines a resource of kind "maven"
@@ -64,6 +75,31 @@ func New(spec interface{}) (*Maven, error) {
 		spec: newSpec,
 	}
 
+	var httpClient httpclient.HTTPClient
+	if !newSpec.Retry.IsZero() {
+		httpClient, err = httpclient.NewRetryClientWithPolicy(newSpec.Retry)
+		if err != nil {
+			return &Maven{}, err
+		}
+	}
+
+	// The maven metadata "latest" field is the last deployed version, which isn't necessarily the newest one
+	versionFilter := newSpec.VersionFilter
+	if versionFilter.IsZero() {
+		versionFilter = version.Filter{
+			Kind:    version.MAVENVERSIONKIND,
+			Pattern: "*",
+		}
+	}
+
+	newMetadataHandler := func(metadataURL string) mavenmetadata.Handler {
+		handler := mavenmetadata.New(metadataURL, versionFilter)
+		if httpClient != nil {
+			handler.SetHttpClient(httpClient)
+		}
+		return handler
+	}
+
 	if len(newSpec.Repository) > 0 {
 
 		u, err := url.Parse(newSpec.Repository)
@@ -79,7 +115,7 @@ func New(spec interface{}) (*Maven, error) {
 
 		newResource.metadataHandlers = append(
 			newResource.metadataHandlers,
-			mavenmetadata.New(u.String(), newSpec.VersionFilter))
+			newMetadataHandler(u.String()))
 
 		return newResource, nil
 	}
@@ -98,7 +134,7 @@ func New(spec interface{}) (*Maven, error) {
 
 		newResource.metadataHandlers = append(
 			newResource.metadataHandlers,
-			mavenmetadata.New(u.String(), newSpec.VersionFilter))
+			newMetadataHandler(u.String()))
 	}
 
 	mavenCentralNotFound, err := isRepositoriesContainsMavenCentral(newSpec.Repositories)
@@ -117,7 +153,7 @@ func New(spec interface{}) (*Maven, error) {
 
 		newResource.metadataHandlers = append(
 			newResource.metadataHandlers,
-			mavenmetadata.New(u.String(), newSpec.VersionFilter))
+			newMetadataHandler(u.String()))
 	}
 
 	return newResource, nil
@@ -1,20 +1,22 @@
 package maven
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Source return the latest version
-func (m *Maven) Source(workingDir string, resultSource *result.Source) error {
+func (m *Maven) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
 
 	for _, metadataHandler := range m.metadataHandlers {
 		// metadataURL contains the URL without username/password
 		metadataURL, err := trimUsernamePasswordFromURL(metadataHandler.GetMetadataURL())
 		if err != nil {
-			logrus.Errorf("Trying to parse Maven metadata url: %s", err)
+			logger.Errorf("Trying to parse Maven metadata url: %s", err)
 		}
 
 		latestVersion, err := metadataHandler.GetLatestVersion()
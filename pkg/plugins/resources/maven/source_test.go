@@ -1,7 +1,10 @@
 package maven
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -66,7 +69,7 @@ func TestSource(t *testing.T) {
 			}
 
 			gotResult := result.Source{}
-			gotErr := sut.Source(tt.workingDir, &gotResult)
+			gotErr := sut.Source(context.Background(), tt.workingDir, &gotResult)
 			if tt.wantErr {
 				require.Error(t, gotErr)
 				return
@@ -77,3 +80,36 @@ func TestSource(t *testing.T) {
 		})
 	}
 }
+
+func TestSourceDefaultVersionFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<metadata>
+  <groupId>org.example</groupId>
+  <artifactId>example</artifactId>
+  <versioning>
+    <latest>1.2.5</latest>
+    <versions>
+      <version>1.2.4</version>
+      <version>1.10.0</version>
+      <version>2.0.0-RC1</version>
+      <version>2.0.0-SNAPSHOT</version>
+      <version>1.2.5</version>
+    </versions>
+  </versioning>
+</metadata>`))
+	}))
+	defer server.Close()
+
+	sut, err := New(Spec{
+		Repository: server.URL,
+		GroupID:    "org.example",
+		ArtifactID: "example",
+	})
+	require.NoError(t, err)
+
+	gotResult := result.Source{}
+	require.NoError(t, sut.Source(context.Background(), "", &gotResult))
+
+	assert.Equal(t, "1.10.0", gotResult.Information)
+}
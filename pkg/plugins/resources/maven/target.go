@@ -1,12 +1,13 @@
 package maven
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (m Maven) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (m Maven) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
 	return fmt.Errorf("Target not supported for the plugin Maven")
 }
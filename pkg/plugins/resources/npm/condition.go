@@ -1,17 +1,20 @@
 package npm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition checks that an Npm package version exist
-func (n Npm) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (n Npm) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		logrus.Warningf("SCM configuration is not supported for npm condition, aborting")
+		logger.Warningf("SCM configuration is not supported for npm condition, aborting")
 
 	}
 
@@ -23,7 +26,7 @@ func (n Npm) Condition(source string, scm scm.ScmHandler) (pass bool, message st
 		return false, "", errors.New("no version defined")
 	}
 
-	_, versions, err := n.getVersions()
+	_, versions, err := n.getVersions(ctx)
 	if err != nil {
 		return false, "", err
 	}
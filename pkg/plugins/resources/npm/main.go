@@ -1,6 +1,7 @@
 package npm
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/log"
 
 	"gopkg.in/ini.v1"
 
@@ -97,7 +99,7 @@ func New(spec interface{}) (*Npm, error) {
 		spec:          newSpec,
 		versionFilter: newFilter,
 		rcConfig:      rcConfig,
-		webClient:     http.DefaultClient,
+		webClient:     httpclient.NewClient(),
 	}, nil
 }
 
@@ -179,8 +181,8 @@ func getNpmrcConfig(path string, defaultUrl string, defaultToken string) (RcConf
 }
 
 // GetVersions fetch all versions of the Npm package
-func (n *Npm) getVersions() (v string, versions []string, err error) {
-	n.data, err = n.getPackageData(n.spec.Name)
+func (n *Npm) getVersions(ctx context.Context) (v string, versions []string, err error) {
+	n.data, err = n.getPackageData(ctx, n.spec.Name)
 
 	if err != nil {
 		return "", nil, err
@@ -204,7 +206,9 @@ func (n *Npm) getVersions() (v string, versions []string, err error) {
 }
 
 // Get package data from Json API
-func (n *Npm) getPackageData(packageName string) (Data, error) {
+func (n *Npm) getPackageData(ctx context.Context, packageName string) (Data, error) {
+	logger := log.FromContext(ctx)
+
 	var d Data
 	var registry Registry
 	// We need to find the registry URL to use for the package
@@ -226,7 +230,7 @@ func (n *Npm) getPackageData(packageName string) (Data, error) {
 
 	req, err := http.NewRequest("GET", URL, nil)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting npm api data %q\n", err)
+		logger.Errorf("something went wrong while getting npm api data %q\n", err)
 	}
 
 	if registry.AuthToken != "" {
@@ -235,28 +239,28 @@ func (n *Npm) getPackageData(packageName string) (Data, error) {
 
 	res, err := n.webClient.Do(req)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting npm api data %q\n", err)
+		logger.Errorf("something went wrong while getting npm api data %q\n", err)
 		return Data{}, err
 	}
 
 	defer res.Body.Close()
 	if res.StatusCode >= 400 {
 		body, err := httputil.DumpResponse(res, false)
-		logrus.Errorf("something went wrong while getting npm api data %q\n", URL)
-		logrus.Errorf("Error %q\n", err)
-		logrus.Debugf("\n%v\n", string(body))
+		logger.Errorf("something went wrong while getting npm api data %q\n", URL)
+		logger.Errorf("Error %q\n", err)
+		logger.Debugf("\n%v\n", string(body))
 		return Data{}, err
 	}
 
 	data, err := io.ReadAll(res.Body)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting npm api data%q\n", err)
+		logger.Errorf("something went wrong while getting npm api data%q\n", err)
 		return Data{}, err
 	}
 
 	err = json.Unmarshal(data, &d)
 	if err != nil {
-		logrus.Errorf("error unmarshalling json: %q", err)
+		logger.Errorf("error unmarshalling json: %q", err)
 		return Data{}, err
 	}
 
@@ -1,14 +1,15 @@
 package npm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Source returns the latest npm package version
-func (n Npm) Source(workingDir string, resultSource *result.Source) error {
-	version, _, err := n.getVersions()
+func (n Npm) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	version, _, err := n.getVersions(ctx)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,12 @@
+package nuget
+
+import "fmt"
+
+// Changelog returns the link to the found package version on nuget.org
+func (n *Nuget) Changelog() string {
+	if n.foundVersion.GetVersion() == "" || n.spec.URL != nugetDefaultURL {
+		return ""
+	}
+
+	return fmt.Sprintf("https://www.nuget.org/packages/%s/%s\n", n.spec.Name, n.foundVersion.GetVersion())
+}
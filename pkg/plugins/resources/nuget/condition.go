@@ -0,0 +1,41 @@
+package nuget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a package version exists on the NuGet feed
+func (n *Nuget) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
+	if scm != nil {
+		logger.Warningf("SCM configuration is not supported for nuget condition, aborting")
+	}
+
+	versionToCheck := n.spec.Version
+	if versionToCheck == "" {
+		versionToCheck = source
+	}
+	if len(versionToCheck) == 0 {
+		return false, "", errors.New("no version defined")
+	}
+
+	versions, err := n.getVersions(ctx)
+	if err != nil {
+		return false, "", err
+	}
+
+	// NuGet versions are case insensitive
+	if !slices.Contains(versions, strings.ToLower(versionToCheck)) {
+		return false, fmt.Sprintf("version %q of nuget package %q doesn't exist", versionToCheck, n.spec.Name), nil
+	}
+
+	return true, fmt.Sprintf("version %q of nuget package %q available", versionToCheck, n.spec.Name), nil
+}
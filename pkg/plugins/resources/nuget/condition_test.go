@@ -0,0 +1,72 @@
+package nuget
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition(t *testing.T) {
+	server := newTestFeed(t)
+
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		source   string
+		expected bool
+		wantErr  bool
+	}{
+		{
+			name: "Existing version",
+			spec: map[string]interface{}{
+				"name":    "Serilog",
+				"url":     server.URL + "/v3/index.json",
+				"version": "3.0.1",
+			},
+			expected: true,
+		},
+		{
+			name: "Existing version from source",
+			spec: map[string]interface{}{
+				"name": "Serilog",
+				"url":  server.URL + "/v3/index.json",
+			},
+			source:   "4.0.0-DEV-02108",
+			expected: true,
+		},
+		{
+			name: "Missing version",
+			spec: map[string]interface{}{
+				"name":    "Serilog",
+				"url":     server.URL + "/v3/index.json",
+				"version": "5.0.0",
+			},
+			expected: false,
+		},
+		{
+			name: "No version",
+			spec: map[string]interface{}{
+				"name": "Serilog",
+				"url":  server.URL + "/v3/index.json",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := New(tt.spec)
+			require.NoError(t, err)
+
+			got, _, err := n.Condition(context.Background(), tt.source, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package nuget
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// packageBaseAddressType is the service index resource listing package versions
+	// https://learn.microsoft.com/en-us/nuget/api/package-base-address-resource
+	packageBaseAddressType string = "PackageBaseAddress/3.0.0"
+)
+
+var (
+	// ErrPackageNotFound is returned when the feed doesn't know the package
+	ErrPackageNotFound error = errors.New("package not found")
+)
+
+// serviceIndex describes the resources exposed by a NuGet v3 feed
+// https://learn.microsoft.com/en-us/nuget/api/service-index
+type serviceIndex struct {
+	Version   string `json:"version"`
+	Resources []struct {
+		ID   string `json:"@id"`
+		Type string `json:"@type"`
+	} `json:"resources"`
+}
+
+// packageVersions is the list of versions returned by the PackageBaseAddress resource
+type packageVersions struct {
+	Versions []string `json:"versions"`
+}
+
+// get queries the feed and decodes the json response into v.
+// Credentials are only sent to the host serving the service index.
+func (n *Nuget) get(ctx context.Context, URL string, v interface{}) error {
+	logger := log.FromContext(ctx)
+
+	req, err := http.NewRequest("GET", URL, nil)
+	if err != nil {
+		return err
+	}
+
+	if len(n.spec.Token) > 0 && isSameHost(n.spec.URL, URL) {
+		req.SetBasicAuth(n.spec.Username, n.spec.Token)
+	}
+
+	res, err := n.webClient.Do(req)
+	if err != nil {
+		logger.Errorf("something went wrong while querying the NuGet feed %q\n", err)
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrPackageNotFound
+	}
+
+	if res.StatusCode >= 400 {
+		body, _ := httputil.DumpResponse(res, false)
+		logger.Debugf("\n%v\n", string(body))
+		return fmt.Errorf("querying NuGet feed %q: %s", URL, res.Status)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding NuGet feed response %q: %w", URL, err)
+	}
+
+	return nil
+}
+
+// packageBaseAddress returns the url of the PackageBaseAddress resource from the feed service index
+func (n *Nuget) packageBaseAddress(ctx context.Context) (string, error) {
+	var index serviceIndex
+
+	if err := n.get(ctx, n.spec.URL, &index); err != nil {
+		if errors.Is(err, ErrPackageNotFound) {
+			return "", fmt.Errorf("NuGet service index %q not found", n.spec.URL)
+		}
+		return "", err
+	}
+
+	if !strings.HasPrefix(index.Version, "3.") {
+		return "", fmt.Errorf("NuGet service index %q: version %q not supported, expecting a v3 feed", n.spec.URL, index.Version)
+	}
+
+	for _, resource := range index.Resources {
+		if resource.Type == packageBaseAddressType {
+			return strings.TrimSuffix(resource.ID, "/") + "/", nil
+		}
+	}
+
+	return "", fmt.Errorf("NuGet service index %q doesn't provide the %s resource", n.spec.URL, packageBaseAddressType)
+}
+
+// getVersions returns every published package version, from the oldest to the newest.
+// Versions are normalized, and lowercased, by the feed.
+func (n *Nuget) getVersions(ctx context.Context) ([]string, error) {
+	baseAddress, err := n.packageBaseAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The package ID must be lowercased
+	URL := baseAddress + url.PathEscape(strings.ToLower(n.spec.Name)) + "/index.json"
+
+	var versions packageVersions
+	if err := n.get(ctx, URL, &versions); err != nil {
+		if errors.Is(err, ErrPackageNotFound) {
+			return nil, fmt.Errorf("nuget package %q: %w", n.spec.Name, err)
+		}
+		return nil, err
+	}
+
+	return versions.Versions, nil
+}
+
+// isPrerelease returns true if the package version contains a prerelease label such as "8.0.0-rc.1"
+func isPrerelease(v string) bool {
+	v, _, _ = strings.Cut(v, "+")
+	return strings.Contains(v, "-")
+}
+
+// isSameHost returns true if both urls target the same host
+func isSameHost(a, b string) bool {
+	urlA, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	urlB, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(urlA.Host, urlB.Host)
+}
+
+// searchVersion returns the newest package version matching the version filter
+func (n *Nuget) searchVersion(versions []string) (version.Version, error) {
+	if n.versionFilter.Kind == version.LATESTVERSIONKIND && n.versionFilter.Pattern == version.LATESTVERSIONKIND {
+		versions = slices.DeleteFunc(slices.Clone(versions), isPrerelease)
+	}
+
+	return n.versionFilter.Search(versions)
+}
@@ -0,0 +1,57 @@
+package nuget
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// nugetDefaultURL is the service index of nuget.org
+	nugetDefaultURL string = "https://api.nuget.org/v3/index.json"
+)
+
+// Nuget defines a resource of kind "nuget"
+type Nuget struct {
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different than the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	foundVersion  version.Version
+	webClient     httpclient.HTTPClient
+}
+
+// New returns a new valid Nuget object.
+func New(spec interface{}) (*Nuget, error) {
+	var newSpec Spec
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return &Nuget{}, err
+	}
+
+	err = newSpec.Validate()
+	if err != nil {
+		return &Nuget{}, err
+	}
+
+	if len(newSpec.URL) == 0 {
+		newSpec.URL = nugetDefaultURL
+	}
+
+	newFilter, err := newSpec.VersionFilter.Init()
+	if err != nil {
+		return &Nuget{}, err
+	}
+
+	// Unset retry settings are taken from the global retry policy
+	webClient, err := httpclient.NewRetryClientWithPolicy(newSpec.Retry)
+	if err != nil {
+		return &Nuget{}, err
+	}
+
+	return &Nuget{
+		spec:          newSpec,
+		versionFilter: newFilter,
+		webClient:     webClient,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package nuget
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Source returns the newest package version matching the version filter
+func (n *Nuget) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	versions, err := n.getVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("no version found for nuget package %q", n.spec.Name)
+	}
+
+	n.foundVersion, err = n.searchVersion(versions)
+	if err != nil {
+		return err
+	}
+
+	foundVersion := n.foundVersion.GetVersion()
+	if foundVersion == "" {
+		return fmt.Errorf("no version found for nuget package %q", n.spec.Name)
+	}
+
+	resultSource.Information = foundVersion
+	resultSource.Result = result.SUCCESS
+	resultSource.Description = fmt.Sprintf("version %q found for nuget package %q", foundVersion, n.spec.Name)
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package nuget
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+const (
+	// serilogVersions is the list of versions returned for the Serilog package
+	serilogVersions string = `{"versions": ["2.12.0", "3.0.0-dev-01862", "3.0.0", "3.0.1", "3.1.1", "4.0.0-dev-02108"]}`
+)
+
+// newTestFeed returns a NuGet v3 feed serving the Serilog package,
+// the "private" feed requires the token "secret"
+func newTestFeed(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+
+	var server *httptest.Server
+
+	isAuthorized := func(r *http.Request) bool {
+		username, password, ok := r.BasicAuth()
+		return ok && username == "bot" && password == "secret"
+	}
+
+	mux.HandleFunc("GET /v3/index.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"version": "3.0.0", "resources": [
+			{"@id": "%s/v3/search", "@type": "SearchQueryService"},
+			{"@id": "%s/v3/flatcontainer", "@type": "PackageBaseAddress/3.0.0"}
+		]}`, server.URL, server.URL)
+	})
+	mux.HandleFunc("GET /v3/flatcontainer/serilog/index.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(serilogVersions))
+	})
+	mux.HandleFunc("GET /private/index.json", func(w http.ResponseWriter, r *http.Request) {
+		if !isAuthorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"version": "3.0.0", "resources": [
+			{"@id": "%s/private/download/", "@type": "PackageBaseAddress/3.0.0"}
+		]}`, server.URL)
+	})
+	mux.HandleFunc("GET /private/download/serilog/index.json", func(w http.ResponseWriter, r *http.Request) {
+		if !isAuthorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(serilogVersions))
+	})
+	mux.HandleFunc("GET /v2/index.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"version": "2.0.0", "resources": []}`))
+	})
+
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSource(t *testing.T) {
+	server := newTestFeed(t)
+
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "Latest version ignoring prereleases",
+			spec: map[string]interface{}{
+				"name": "Serilog",
+				"url":  server.URL + "/v3/index.json",
+			},
+			expected: "3.1.1",
+		},
+		{
+			name: "Version matching a semver constraint",
+			spec: map[string]interface{}{
+				"name": "Serilog",
+				"url":  server.URL + "/v3/index.json",
+				"versionfilter": map[string]interface{}{
+					"kind":    "semver",
+					"pattern": "~3.0",
+				},
+			},
+			expected: "3.0.1",
+		},
+		{
+			name: "Private feed",
+			spec: map[string]interface{}{
+				"name":     "Serilog",
+				"url":      server.URL + "/private/index.json",
+				"username": "bot",
+				"token":    "secret",
+			},
+			expected: "3.1.1",
+		},
+		{
+			name: "Private feed with wrong credentials",
+			spec: map[string]interface{}{
+				"name":     "Serilog",
+				"url":      server.URL + "/private/index.json",
+				"username": "bot",
+				"token":    "wrong",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unknown package",
+			spec: map[string]interface{}{
+				"name": "DoNotExist",
+				"url":  server.URL + "/v3/index.json",
+			},
+			wantErr: true,
+		},
+		{
+			name: "NuGet v2 feed",
+			spec: map[string]interface{}{
+				"name": "Serilog",
+				"url":  server.URL + "/v2/index.json",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = n.Source(context.Background(), "", &gotResult)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, gotResult.Information)
+		})
+	}
+}
+
+func TestIsSameHost(t *testing.T) {
+	assert.True(t, isSameHost("https://nuget.pkg.github.com/updatecli/index.json", "https://NuGet.pkg.github.com/download/serilog/index.json"))
+	assert.False(t, isSameHost("https://nuget.example.com/v3/index.json", "https://cdn.example.com/serilog/index.json"))
+}
@@ -0,0 +1,80 @@
+package nuget
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+var (
+	// ErrWrongSpec is returned when the Spec has wrong content
+	ErrWrongSpec error = errors.New("wrong spec content")
+)
+
+// Spec defines a specification for a "nuget" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	// [S][C] Name defines the NuGet package ID such as "Newtonsoft.Json"
+	Name string `yaml:",omitempty" jsonschema:"required"`
+	// [C] Version defines a specific package version
+	Version string `yaml:",omitempty"`
+	/*
+		[S][C] URL defines the NuGet v3 service index of the package feed
+
+		default:
+			https://api.nuget.org/v3/index.json
+
+		example:
+			* https://pkgs.dev.azure.com/<organization>/_packaging/<feed>/nuget/v3/index.json
+			* https://nuget.pkg.github.com/<owner>/index.json
+	*/
+	URL string `yaml:",omitempty"`
+	/*
+		[S][C] Username defines the username used to authenticate with a private feed
+
+		remark:
+			Some feeds, such as GitHub Packages, require the username associated with the token
+	*/
+	Username string `yaml:",omitempty"`
+	/*
+		[S][C] Token defines the token, or password, used to authenticate with a private feed
+
+		remark:
+			A token is a sensitive information, it's recommended to not set this value directly in the configuration file
+			but to use an environment variable or a SOPS file.
+
+			The value can be set to `{{ requiredEnv "NUGET_TOKEN"}}` to retrieve the token from the environment variable `NUGET_TOKEN`
+	*/
+	Token string `yaml:",omitempty"`
+	/*
+		[S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+
+		remark:
+			The kind "latest" ignores prerelease versions such as "8.0.0-rc.1"
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	/*
+		retry defines how failed requests to the NuGet feed are retried.
+
+		default:
+			the global retry policy defined by the "--http-retry-*" flags
+	*/
+	Retry httpclient.RetryPolicy `yaml:",omitempty"`
+}
+
+// Validate run some validation on the Spec
+func (s Spec) Validate() error {
+	if len(s.Name) == 0 {
+		logrus.Errorf("nuget package name not defined")
+		return ErrWrongSpec
+	}
+
+	if len(s.Username) > 0 && len(s.Token) == 0 {
+		logrus.Errorf("parameter %q requires %q", "username", "token")
+		return ErrWrongSpec
+	}
+
+	return nil
+}
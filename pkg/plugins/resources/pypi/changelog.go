@@ -0,0 +1,12 @@
+package pypi
+
+import "fmt"
+
+// Changelog returns the link to the found python package version on PyPI
+func (p *Pypi) Changelog() string {
+	if p.foundVersion.GetVersion() == "" || p.spec.IndexURL != pypiDefaultIndexURL {
+		return ""
+	}
+
+	return fmt.Sprintf("https://pypi.org/project/%s/%s/\n", normalizeName(p.spec.Name), p.foundVersion.GetVersion())
+}
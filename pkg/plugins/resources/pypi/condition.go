@@ -0,0 +1,44 @@
+package pypi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a python package version exists and is not yanked
+func (p *Pypi) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
+	if scm != nil {
+		logger.Warningf("SCM configuration is not supported for pypi condition, aborting")
+	}
+
+	versionToCheck := p.spec.Version
+	if versionToCheck == "" {
+		versionToCheck = source
+	}
+	if len(versionToCheck) == 0 {
+		return false, "", errors.New("no version defined")
+	}
+
+	releases, err := p.getReleases(ctx)
+	if err != nil {
+		return false, "", err
+	}
+
+	r, found := releases[versionToCheck]
+	switch {
+	case !found:
+		return false, fmt.Sprintf("version %q of python package %q doesn't exist", versionToCheck, p.spec.Name), nil
+	case r.Yanked:
+		return false, fmt.Sprintf("version %q of python package %q is yanked", versionToCheck, p.spec.Name), nil
+	case !p.isAvailable(ctx, r):
+		return false, fmt.Sprintf("version %q of python package %q doesn't support python %q", versionToCheck, p.spec.Name, p.spec.PythonVersion), nil
+	}
+
+	return true, fmt.Sprintf("version %q of python package %q available", versionToCheck, p.spec.Name), nil
+}
@@ -0,0 +1,81 @@
+package pypi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition(t *testing.T) {
+	server := newTestIndex(t)
+
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		source   string
+		expected bool
+	}{
+		{
+			name: "Existing version",
+			spec: map[string]interface{}{
+				"name":     "private-lib",
+				"indexurl": server.URL + "/simple/",
+				"token":    "secret",
+				"version":  "1.0.0",
+			},
+			expected: true,
+		},
+		{
+			name: "Existing version from source",
+			spec: map[string]interface{}{
+				"name":     "private-lib",
+				"indexurl": server.URL + "/html/",
+			},
+			source:   "1.2.0",
+			expected: true,
+		},
+		{
+			name: "Yanked version",
+			spec: map[string]interface{}{
+				"name":     "private-lib",
+				"indexurl": server.URL + "/simple/",
+				"token":    "secret",
+				"version":  "1.3.0",
+			},
+			expected: false,
+		},
+		{
+			name: "Version not supporting python 3.9",
+			spec: map[string]interface{}{
+				"name":          "private-lib",
+				"indexurl":      server.URL + "/simple/",
+				"token":         "secret",
+				"version":       "1.2.0",
+				"pythonversion": "3.9",
+			},
+			expected: false,
+		},
+		{
+			name: "Missing version",
+			spec: map[string]interface{}{
+				"name":     "private-lib",
+				"indexurl": server.URL + "/html/",
+				"version":  "9.9.9",
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(tt.spec)
+			require.NoError(t, err)
+
+			got, _, err := p.Condition(context.Background(), tt.source, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package pypi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// simpleJSONContentType is the content type of the PEP 691 JSON simple api
+	simpleJSONContentType string = "application/vnd.pypi.simple.v1+json"
+	// simpleHTMLContentType is the content type of the PEP 503 HTML simple api
+	simpleHTMLContentType string = "application/vnd.pypi.simple.v1+html"
+)
+
+var (
+	// nameSeparatorRegex matches the runs of characters replaced by PEP 503 name normalization
+	nameSeparatorRegex = regexp.MustCompile(`[-_.]+`)
+	// anchorRegex matches the links listed by a PEP 503 project page
+	anchorRegex = regexp.MustCompile(`(?is)<a\s([^>]*)>(.*?)</a>`)
+	// attributeRegex matches an html attribute and its optional value
+	attributeRegex = regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9_-]*)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+)))?`)
+	// sdistExtensions lists the source distribution extensions
+	sdistExtensions = []string{".tar.gz", ".tar.bz2", ".tar.xz", ".tar", ".zip", ".tgz"}
+)
+
+// indexFile describes a distribution file listed by the simple repository api
+type indexFile struct {
+	Filename       string `json:"filename"`
+	RequiresPython string `json:"requires-python"`
+	Yanked         yanked `json:"yanked"`
+}
+
+// yanked holds the PEP 592 yanked status which is either a boolean or the yank reason
+type yanked bool
+
+func (y *yanked) UnmarshalJSON(data []byte) error {
+	var b bool
+	if err := json.Unmarshal(data, &b); err == nil {
+		*y = yanked(b)
+		return nil
+	}
+
+	var reason string
+	if err := json.Unmarshal(data, &reason); err != nil {
+		return err
+	}
+	*y = true
+
+	return nil
+}
+
+// indexProject describes a project page returned by the PEP 691 JSON simple api
+type indexProject struct {
+	Name  string      `json:"name"`
+	Files []indexFile `json:"files"`
+}
+
+// release describes a package version aggregated from its distribution files
+type release struct {
+	// Yanked is true when every file of the release is yanked
+	Yanked bool
+	// RequiresPython contains the "requires-python" metadata of every non yanked file
+	RequiresPython []string
+}
+
+// normalizeName returns the PEP 503 normalized form of a package name
+func normalizeName(name string) string {
+	return strings.ToLower(nameSeparatorRegex.ReplaceAllString(name, "-"))
+}
+
+// projectURL returns the simple api url of the package
+func (p *Pypi) projectURL() string {
+	return p.spec.IndexURL + normalizeName(p.spec.Name) + "/"
+}
+
+// getReleases queries the package index and returns every release of the package
+func (p *Pypi) getReleases(ctx context.Context) (map[string]release, error) {
+	logger := log.FromContext(ctx)
+
+	URL := p.projectURL()
+
+	req, err := http.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", fmt.Sprintf("%s, %s;q=0.1, text/html;q=0.01", simpleJSONContentType, simpleHTMLContentType))
+
+	if len(p.spec.Token) > 0 {
+		req.SetBasicAuth(p.spec.Username, p.spec.Token)
+	}
+
+	res, err := p.webClient.Do(req)
+	if err != nil {
+		logger.Errorf("something went wrong while querying the package index %q\n", err)
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := httputil.DumpResponse(res, false)
+		logger.Debugf("\n%v\n", string(body))
+		return nil, fmt.Errorf("querying package index %q: %s", URL, res.Status)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []indexFile
+	contentType := res.Header.Get("Content-Type")
+	switch {
+	case strings.HasPrefix(contentType, simpleJSONContentType), strings.HasPrefix(contentType, "application/json"):
+		project := indexProject{}
+		if err := json.Unmarshal(data, &project); err != nil {
+			return nil, fmt.Errorf("parsing package index response: %w", err)
+		}
+		files = project.Files
+	default:
+		files = parseHTMLProjectPage(string(data))
+	}
+
+	return p.groupReleases(ctx, files), nil
+}
+
+// parseHTMLProjectPage parses a PEP 503 project page
+func parseHTMLProjectPage(content string) []indexFile {
+	var files []indexFile
+
+	for _, anchor := range anchorRegex.FindAllStringSubmatch(content, -1) {
+		file := indexFile{
+			Filename: strings.TrimSpace(html.UnescapeString(anchor[2])),
+		}
+
+		for _, attribute := range attributeRegex.FindAllStringSubmatch(anchor[1], -1) {
+			value := html.UnescapeString(attribute[2] + attribute[3] + attribute[4])
+			switch strings.ToLower(attribute[1]) {
+			case "data-yanked":
+				file.Yanked = true
+			case "data-requires-python":
+				file.RequiresPython = value
+			}
+		}
+
+		files = append(files, file)
+	}
+
+	return files
+}
+
+// groupReleases aggregates distribution files by version
+func (p *Pypi) groupReleases(ctx context.Context, files []indexFile) map[string]release {
+	logger := log.FromContext(ctx)
+
+	releases := map[string]release{}
+
+	for _, file := range files {
+		v := versionFromFilename(file.Filename, p.spec.Name)
+		if v == "" {
+			logger.Debugf("Skipping file %q, no version found", file.Filename)
+			continue
+		}
+
+		r, found := releases[v]
+		if !found {
+			r.Yanked = true
+		}
+
+		if !file.Yanked {
+			r.Yanked = false
+			r.RequiresPython = append(r.RequiresPython, file.RequiresPython)
+		}
+
+		releases[v] = r
+	}
+
+	return releases
+}
+
+// versionFromFilename extracts the package version from a wheel, egg or source distribution filename
+func versionFromFilename(filename, name string) string {
+	switch {
+	case strings.HasSuffix(filename, ".whl"), strings.HasSuffix(filename, ".egg"):
+		parts := strings.Split(filename, "-")
+		if len(parts) < 3 || normalizeName(parts[0]) != normalizeName(name) {
+			return ""
+		}
+		return parts[1]
+	}
+
+	for _, extension := range sdistExtensions {
+		if !strings.HasSuffix(filename, extension) {
+			continue
+		}
+
+		base := strings.TrimSuffix(filename, extension)
+		// Source distribution names may contain dashes
+		// so we look for the separator matching the package name
+		for i := range base {
+			if base[i] == '-' && normalizeName(base[:i]) == normalizeName(name) {
+				return base[i+1:]
+			}
+		}
+		return ""
+	}
+
+	return ""
+}
+
+// isAvailable returns true if the release is not yanked and supports the configured python version
+func (p *Pypi) isAvailable(ctx context.Context, r release) bool {
+	logger := log.FromContext(ctx)
+
+	if r.Yanked {
+		return false
+	}
+
+	if len(p.spec.PythonVersion) == 0 {
+		return true
+	}
+
+	for _, constraint := range r.RequiresPython {
+		ok, err := version.MatchPEP440(p.spec.PythonVersion, constraint)
+		if err != nil {
+			logger.Debugf("ignoring invalid requires-python %q: %s", constraint, err)
+			return true
+		}
+		if ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getVersions returns the available versions of the package
+func (p *Pypi) getVersions(ctx context.Context) (versions []string, err error) {
+	logger := log.FromContext(ctx)
+
+	releases, err := p.getReleases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for v, r := range releases {
+		if !p.isAvailable(ctx, r) {
+			logger.Debugf("Skipping version %q, yanked or not compatible with python %q", v, p.spec.PythonVersion)
+			continue
+		}
+		versions = append(versions, v)
+	}
+
+	return versions, nil
+}
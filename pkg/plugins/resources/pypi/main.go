@@ -0,0 +1,85 @@
+package pypi
+
+import (
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/text"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// pypiDefaultIndexURL is the default package index
+	pypiDefaultIndexURL string = "https://pypi.org/simple/"
+	// tokenUsername is the username expected by PyPI when authenticating with an API token
+	tokenUsername string = "__token__"
+)
+
+// Pypi defines a resource of kind "pypi"
+type Pypi struct {
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different than the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	foundVersion  version.Version
+	webClient     httpclient.HTTPClient
+	// contentRetriever reads and writes the file updated by the target
+	contentRetriever text.TextRetriever
+}
+
+// New returns a new valid Pypi package object.
+func New(spec interface{}) (*Pypi, error) {
+	var newSpec Spec
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return &Pypi{}, err
+	}
+
+	err = newSpec.Validate()
+	if err != nil {
+		return &Pypi{}, err
+	}
+
+	if len(newSpec.IndexURL) == 0 {
+		newSpec.IndexURL = pypiDefaultIndexURL
+	}
+
+	if !strings.HasSuffix(newSpec.IndexURL, "/") {
+		newSpec.IndexURL = newSpec.IndexURL + "/"
+	}
+
+	if len(newSpec.Token) > 0 && len(newSpec.Username) == 0 {
+		newSpec.Username = tokenUsername
+	}
+
+	if len(newSpec.File) == 0 {
+		newSpec.File = "requirements.txt"
+	}
+	newSpec.File = strings.TrimPrefix(newSpec.File, "file://")
+
+	// Python versions can only be ordered using PEP 440 rules,
+	// so "latest" means the newest final release according to PEP 440
+	if len(newSpec.VersionFilter.Kind) == 0 || newSpec.VersionFilter.Kind == version.LATESTVERSIONKIND {
+		newSpec.VersionFilter.Kind = version.PEP440VERSIONKIND
+		newSpec.VersionFilter.Pattern = ""
+	}
+
+	newFilter, err := newSpec.VersionFilter.Init()
+	if err != nil {
+		return &Pypi{}, err
+	}
+
+	// Unset retry settings are taken from the global retry policy
+	webClient, err := httpclient.NewRetryClientWithPolicy(newSpec.Retry)
+	if err != nil {
+		return &Pypi{}, err
+	}
+
+	return &Pypi{
+		spec:             newSpec,
+		versionFilter:    newFilter,
+		webClient:        webClient,
+		contentRetriever: &text.Text{},
+	}, nil
+}
@@ -0,0 +1,359 @@
+package pypi
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+)
+
+var (
+	// requirementRegex matches a PEP 508 requirement pinned using a single version specifier
+	requirementRegex = regexp.MustCompile(`^(\s*)([A-Za-z0-9](?:[A-Za-z0-9._-]*[A-Za-z0-9])?)(\s*(?:\[[^\]]*\])?\s*\(?\s*)(===|==|~=|>=)(\s*)([^\s,;#)\\'"]+)(.*)$`)
+	// constraintRegex matches a Poetry or Pipfile version constraint using a single version
+	constraintRegex = regexp.MustCompile(`^(\s*)(\^|~=|~|===|==|>=|=)?(\s*)([0-9][^\s,|*]*)(\s*)$`)
+	// inlineVersionRegex matches the version key of a TOML inline table
+	inlineVersionRegex = regexp.MustCompile(`(\bversion\s*=\s*)("[^"]*"|'[^']*')`)
+	// quotedRegex matches a TOML basic or literal string
+	quotedRegex = regexp.MustCompile(`"[^"\\]*"|'[^']*'`)
+	// tomlTableRegex matches a TOML table header
+	tomlTableRegex = regexp.MustCompile(`^\s*\[\[?\s*([^\]]+?)\s*\]\]?\s*(?:#.*)?$`)
+	// tomlKeyRegex matches a TOML key/value pair
+	tomlKeyRegex = regexp.MustCompile(`^\s*("[^"]*"|'[^']*'|[A-Za-z0-9_.-]+)\s*=(\s*)(.*)$`)
+	// iniSectionRegex matches a setup.cfg section
+	iniSectionRegex = regexp.MustCompile(`^\[([^\]]+)\]`)
+	// iniKeyRegex matches a setup.cfg option
+	iniKeyRegex = regexp.MustCompile(`^([A-Za-z0-9_.-]+)(\s*[=:]\s*)(.*)$`)
+	// poetryDependenciesTableRegex matches the Poetry tables listing dependencies
+	poetryDependenciesTableRegex = regexp.MustCompile(`^tool\.poetry\.(?:dependencies|dev-dependencies|group\.[^.]+\.dependencies)$`)
+)
+
+// updateContent returns the file content with every pin of the package set to the new version
+// and the versions previously pinned
+func updateContent(ctx context.Context, filename, content, name, newVersion string) (string, []string) {
+	switch filepath.Base(filename) {
+	case "pyproject.toml":
+		return updatePyproject(ctx, content, name, newVersion)
+	case "setup.cfg":
+		return updateSetupCfg(ctx, content, name, newVersion)
+	case "Pipfile":
+		return updatePipfile(ctx, content, name, newVersion)
+	default:
+		return updateRequirements(ctx, content, name, newVersion)
+	}
+}
+
+// updateRequirement updates the version of a PEP 508 requirement if it references the package
+func updateRequirement(ctx context.Context, requirement, name, newVersion string) (updated, oldVersion string, found bool) {
+	logger := log.FromContext(ctx)
+
+	m := requirementRegex.FindStringSubmatch(requirement)
+	if m == nil || normalizeName(m[2]) != normalizeName(name) {
+		return requirement, "", false
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(m[7]), ",") || strings.Contains(m[6], "*") {
+		logger.Warningf("requirement %q uses a constraint not supported by Updatecli, skipping", strings.TrimSpace(requirement))
+		return requirement, "", false
+	}
+
+	updatedVersion := newVersion
+	if m[4] == "~=" {
+		updatedVersion = keepPrecision(m[6], newVersion)
+	}
+
+	return m[1] + m[2] + m[3] + m[4] + m[5] + updatedVersion + m[7], m[6], true
+}
+
+// updateConstraint updates a Poetry or Pipfile version constraint while keeping its operator
+func updateConstraint(ctx context.Context, constraint, newVersion string) (updated, oldVersion string, found bool) {
+	logger := log.FromContext(ctx)
+
+	m := constraintRegex.FindStringSubmatch(constraint)
+	if m == nil {
+		logger.Warningf("version constraint %q not supported by Updatecli, skipping", constraint)
+		return constraint, "", false
+	}
+
+	updatedVersion := newVersion
+	if m[2] == "~=" || m[2] == "~" {
+		updatedVersion = keepPrecision(m[4], newVersion)
+	}
+
+	return m[1] + m[2] + m[3] + updatedVersion + m[5], m[4], true
+}
+
+// keepPrecision truncates the new version to the number of segments of the old one,
+// so a compatible release requirement like "~=1.4" keeps allowing the same range of updates
+func keepPrecision(oldVersion, newVersion string) string {
+	oldSegments := strings.Split(oldVersion, ".")
+	newSegments := strings.Split(newVersion, ".")
+
+	if len(newSegments) <= len(oldSegments) {
+		return newVersion
+	}
+
+	return strings.Join(newSegments[:len(oldSegments)], ".")
+}
+
+// updateQuoted applies fn to the content of a TOML string
+func updateQuoted(quoted string, fn func(string) (string, string, bool)) (updated, oldVersion string, found bool) {
+	updated, oldVersion, found = fn(quoted[1 : len(quoted)-1])
+	return quoted[:1] + updated + quoted[len(quoted)-1:], oldVersion, found
+}
+
+// updateTomlValue applies fn to a TOML value which is either a string
+// or an inline table containing a version key
+func updateTomlValue(value string, fn func(string) (string, string, bool)) (updated, oldVersion string, found bool) {
+	switch {
+	case strings.HasPrefix(value, `"`), strings.HasPrefix(value, `'`):
+		quoted := quotedRegex.FindString(value)
+		if quoted == "" {
+			return value, "", false
+		}
+		updatedQuoted, oldVersion, found := updateQuoted(quoted, fn)
+		if !found {
+			return value, "", false
+		}
+		return strings.Replace(value, quoted, updatedQuoted, 1), oldVersion, true
+
+	case strings.HasPrefix(value, "{"):
+		m := inlineVersionRegex.FindStringSubmatchIndex(value)
+		if m == nil {
+			return value, "", false
+		}
+		updatedQuoted, oldVersion, found := updateQuoted(value[m[4]:m[5]], fn)
+		if !found {
+			return value, "", false
+		}
+		return value[:m[4]] + updatedQuoted + value[m[5]:], oldVersion, true
+	}
+
+	return value, "", false
+}
+
+// updateRequirements updates a pip requirements file
+func updateRequirements(ctx context.Context, content, name, newVersion string) (string, []string) {
+	logger := log.FromContext(ctx)
+
+	var oldVersions []string
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "-") {
+			continue
+		}
+
+		updated, oldVersion, found := updateRequirement(ctx, line, name, newVersion)
+		if !found {
+			continue
+		}
+
+		// pip refuses to install a requirement whose hashes don't match its version,
+		// so hash-pinned requirements are left unchanged
+		if isHashPinned(lines, i) {
+			logger.Warningf("requirement %q is pinned using hashes which must be regenerated, for instance using pip-compile, skipping", trimmed)
+			continue
+		}
+
+		lines[i] = updated
+		oldVersions = append(oldVersions, oldVersion)
+	}
+
+	return strings.Join(lines, "\n"), oldVersions
+}
+
+// isHashPinned returns true if the requirement starting at line i is pinned using hashes.
+// Hashes are listed on the requirement line or on its continuation lines.
+func isHashPinned(lines []string, i int) bool {
+	for j := i; j < len(lines); j++ {
+		if strings.Contains(lines[j], "--hash") {
+			return true
+		}
+		if !strings.HasSuffix(strings.TrimSpace(lines[j]), `\`) {
+			return false
+		}
+	}
+
+	return false
+}
+
+// updatePyproject updates PEP 621, PEP 735, PEP 518 and Poetry dependencies from a pyproject.toml file
+func updatePyproject(ctx context.Context, content, name, newVersion string) (string, []string) {
+	var oldVersions []string
+
+	updateRequirementFn := func(s string) (string, string, bool) {
+		return updateRequirement(ctx, s, name, newVersion)
+	}
+	updateConstraintFn := func(s string) (string, string, bool) {
+		return updateConstraint(ctx, s, newVersion)
+	}
+
+	table := ""
+	key := ""
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+
+		if m := tomlTableRegex.FindStringSubmatch(line); m != nil {
+			table = normalizeTableName(m[1])
+			key = ""
+			continue
+		}
+
+		prefix := ""
+		value := line
+		isKeyLine := false
+		if m := tomlKeyRegex.FindStringSubmatch(line); m != nil {
+			key = strings.Trim(m[1], `"'`)
+			value = m[3]
+			prefix = line[:len(line)-len(value)]
+			isKeyLine = true
+		}
+
+		switch {
+		case table == "project" && key == "dependencies",
+			table == "project.optional-dependencies",
+			table == "dependency-groups",
+			table == "build-system" && key == "requires":
+			// PEP 508 strings, either inline or in a multi-line array
+			value = quotedRegex.ReplaceAllStringFunc(value, func(s string) string {
+				updated, oldVersion, found := updateQuoted(s, updateRequirementFn)
+				if found {
+					oldVersions = append(oldVersions, oldVersion)
+				}
+				return updated
+			})
+			lines[i] = prefix + value
+
+		case isKeyLine && poetryDependenciesTableRegex.MatchString(table) && normalizeName(key) == normalizeName(name),
+			isKeyLine && key == "version" && isPoetryDependencyTable(table, name):
+			updated, oldVersion, found := updateTomlValue(value, updateConstraintFn)
+			if found {
+				lines[i] = prefix + updated
+				oldVersions = append(oldVersions, oldVersion)
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n"), oldVersions
+}
+
+// updatePipfile updates the packages of every Pipfile category
+func updatePipfile(ctx context.Context, content, name, newVersion string) (string, []string) {
+	var oldVersions []string
+
+	updateConstraintFn := func(s string) (string, string, bool) {
+		return updateConstraint(ctx, s, newVersion)
+	}
+
+	table := ""
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+
+		if m := tomlTableRegex.FindStringSubmatch(line); m != nil {
+			table = normalizeTableName(m[1])
+			continue
+		}
+
+		// Every table but the following ones defines a package category
+		switch table {
+		case "", "source", "requires", "pipenv", "scripts":
+			continue
+		}
+
+		m := tomlKeyRegex.FindStringSubmatch(line)
+		if m == nil || normalizeName(strings.Trim(m[1], `"'`)) != normalizeName(name) {
+			continue
+		}
+
+		value := m[3]
+		prefix := line[:len(line)-len(value)]
+
+		updated, oldVersion, found := updateTomlValue(value, updateConstraintFn)
+		if !found {
+			continue
+		}
+
+		lines[i] = prefix + updated
+		oldVersions = append(oldVersions, oldVersion)
+	}
+
+	return strings.Join(lines, "\n"), oldVersions
+}
+
+// normalizeTableName removes quotes and spaces from a TOML table name
+func normalizeTableName(table string) string {
+	parts := strings.Split(table, ".")
+	for i := range parts {
+		parts[i] = strings.Trim(strings.TrimSpace(parts[i]), `"'`)
+	}
+	return strings.Join(parts, ".")
+}
+
+// isPoetryDependencyTable returns true if the table describes the package as a Poetry dependency
+// such as [tool.poetry.dependencies.requests]
+func isPoetryDependencyTable(table, name string) bool {
+	i := strings.LastIndex(table, ".")
+	if i < 0 {
+		return false
+	}
+	return poetryDependenciesTableRegex.MatchString(table[:i]) && normalizeName(table[i+1:]) == normalizeName(name)
+}
+
+// updateSetupCfg updates the setuptools requirements from a setup.cfg file
+func updateSetupCfg(ctx context.Context, content, name, newVersion string) (string, []string) {
+	var oldVersions []string
+
+	section := ""
+	key := ""
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, ";") {
+			continue
+		}
+
+		if m := iniSectionRegex.FindStringSubmatch(line); m != nil {
+			section = strings.TrimSpace(m[1])
+			key = ""
+			continue
+		}
+
+		prefix := ""
+		value := line
+		if m := iniKeyRegex.FindStringSubmatch(line); m != nil {
+			key = m[1]
+			value = m[3]
+			prefix = m[1] + m[2]
+		}
+
+		switch {
+		case section == "options" && (key == "install_requires" || key == "setup_requires" || key == "tests_require"):
+		case section == "options.extras_require":
+		default:
+			continue
+		}
+
+		updated, oldVersion, found := updateRequirement(ctx, value, name, newVersion)
+		if !found {
+			continue
+		}
+
+		lines[i] = prefix + updated
+		oldVersions = append(oldVersions, oldVersion)
+	}
+
+	return strings.Join(lines, "\n"), oldVersions
+}
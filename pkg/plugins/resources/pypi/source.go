@@ -0,0 +1,36 @@
+package pypi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Source returns the newest python package version matching the version filter
+func (p *Pypi) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	versions, err := p.getVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("no available release found for python package %q", p.spec.Name)
+	}
+
+	p.foundVersion, err = p.versionFilter.Search(versions)
+	if err != nil {
+		return err
+	}
+
+	foundVersion := p.foundVersion.GetVersion()
+	if foundVersion == "" {
+		return fmt.Errorf("no version found for python package %q", p.spec.Name)
+	}
+
+	resultSource.Information = foundVersion
+	resultSource.Result = result.SUCCESS
+	resultSource.Description = fmt.Sprintf("version %q found for python package %q", foundVersion, p.spec.Name)
+
+	return nil
+}
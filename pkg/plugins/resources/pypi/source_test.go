@@ -0,0 +1,203 @@
+package pypi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+const (
+	// jsonProjectPage is a PEP 691 project page
+	jsonProjectPage string = `{
+  "meta": {"api-version": "1.1"},
+  "name": "private-lib",
+  "files": [
+    {"filename": "private_lib-1.0.0-py3-none-any.whl", "requires-python": ">=3.7"},
+    {"filename": "private-lib-1.0.0.tar.gz", "requires-python": ">=3.7"},
+    {"filename": "private_lib-1.1.0-py3-none-any.whl", "requires-python": ">=3.8"},
+    {"filename": "private_lib-1.2.0-py3-none-any.whl", "requires-python": ">=3.10"},
+    {"filename": "private_lib-1.3.0-py3-none-any.whl", "requires-python": ">=3.8", "yanked": "broken release"},
+    {"filename": "private-lib-1.3.0.tar.gz", "requires-python": ">=3.8", "yanked": true},
+    {"filename": "private_lib-2.0.0rc1-py3-none-any.whl", "requires-python": ">=3.8"}
+  ]
+}`
+	// htmlProjectPage is a PEP 503 project page
+	htmlProjectPage string = `<!DOCTYPE html>
+<html>
+  <body>
+    <h1>Links for private-lib</h1>
+    <a href="/files/private_lib-1.0.0-py3-none-any.whl#sha256=abc" data-requires-python="&gt;=3.7">private_lib-1.0.0-py3-none-any.whl</a><br/>
+    <a href="/files/private_lib-1.1.0-py3-none-any.whl#sha256=abc" data-requires-python="&gt;=3.8">private_lib-1.1.0-py3-none-any.whl</a><br/>
+    <a href="/files/private_lib-1.2.0-py3-none-any.whl#sha256=abc" data-requires-python="&gt;=3.10">private_lib-1.2.0-py3-none-any.whl</a><br/>
+    <a href="/files/private-lib-1.3.0.tar.gz#sha256=abc" data-yanked="">private-lib-1.3.0.tar.gz</a><br/>
+  </body>
+</html>`
+)
+
+// newTestIndex returns a package index serving the private-lib project page
+// and requiring the token "secret"
+func newTestIndex(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /simple/private-lib/", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "__token__" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", simpleJSONContentType)
+		_, _ = w.Write([]byte(jsonProjectPage))
+	})
+	mux.HandleFunc("GET /html/private-lib/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(htmlProjectPage))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSource(t *testing.T) {
+	server := newTestIndex(t)
+
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "Latest release from the JSON api, ignoring yanked and pre-releases",
+			spec: map[string]interface{}{
+				"name":     "Private_Lib",
+				"indexurl": server.URL + "/simple",
+				"token":    "secret",
+			},
+			expected: "1.2.0",
+		},
+		{
+			name: "Latest release compatible with python 3.9",
+			spec: map[string]interface{}{
+				"name":          "private-lib",
+				"indexurl":      server.URL + "/simple/",
+				"token":         "secret",
+				"pythonversion": "3.9",
+			},
+			expected: "1.1.0",
+		},
+		{
+			name: "Release matching a pep440 constraint",
+			spec: map[string]interface{}{
+				"name":     "private-lib",
+				"indexurl": server.URL + "/simple/",
+				"token":    "secret",
+				"versionfilter": map[string]interface{}{
+					"kind":    "pep440",
+					"pattern": "~=1.0.0",
+				},
+			},
+			expected: "1.0.0",
+		},
+		{
+			name: "Latest release from the HTML api",
+			spec: map[string]interface{}{
+				"name":          "private-lib",
+				"indexurl":      server.URL + "/html/",
+				"pythonversion": "3.8",
+			},
+			expected: "1.1.0",
+		},
+		{
+			name: "Missing token",
+			spec: map[string]interface{}{
+				"name":     "private-lib",
+				"indexurl": server.URL + "/simple/",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unknown package",
+			spec: map[string]interface{}{
+				"name":     "unknown",
+				"indexurl": server.URL + "/html/",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = p.Source(context.Background(), "", &gotResult)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, gotResult.Information)
+		})
+	}
+}
+
+func TestSourceUsesGlobalRetryPolicy(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(htmlProjectPage))
+	}))
+	defer server.Close()
+
+	defaultRetryPolicy := httpclient.DefaultRetryPolicy
+	httpclient.DefaultRetryPolicy = httpclient.RetryPolicy{BaseDelay: "1ms", MaxDelay: "1ms"}
+	defer func() { httpclient.DefaultRetryPolicy = defaultRetryPolicy }()
+
+	p, err := New(map[string]interface{}{
+		"name":          "private-lib",
+		"indexurl":      server.URL + "/retry/",
+		"pythonversion": "3.8",
+	})
+	require.NoError(t, err)
+
+	gotResult := result.Source{}
+	require.NoError(t, p.Source(context.Background(), "", &gotResult))
+
+	assert.Equal(t, "1.1.0", gotResult.Information)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+}
+
+func TestVersionFromFilename(t *testing.T) {
+	tests := []struct {
+		filename string
+		name     string
+		expected string
+	}{
+		{filename: "requests-2.31.0-py3-none-any.whl", name: "requests", expected: "2.31.0"},
+		{filename: "zope.interface-6.0.tar.gz", name: "zope-interface", expected: "6.0"},
+		{filename: "my-project-1.0.post1.zip", name: "my_project", expected: "1.0.post1"},
+		{filename: "my_project-1.0-py3.8.egg", name: "my-project", expected: "1.0"},
+		{filename: "other-1.0.tar.gz", name: "my-project", expected: ""},
+		{filename: "my_project-1.0.exe", name: "my-project", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			assert.Equal(t, tt.expected, versionFromFilename(tt.filename, tt.name))
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package pypi
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+var (
+	// ErrWrongSpec is returned when the Spec has wrong content
+	ErrWrongSpec error = errors.New("wrong spec content")
+)
+
+// Spec defines a specification for a "pypi" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	// [S][C][T] Name defines the python package name
+	Name string `yaml:",omitempty" jsonschema:"required"`
+	// [C][T] Version defines a specific package version
+	Version string `yaml:",omitempty"`
+	/*
+		[S][C] IndexURL defines the package index implementing the simple repository API
+
+		default:
+			https://pypi.org/simple/
+	*/
+	IndexURL string `yaml:",omitempty"`
+	/*
+		[S][C] Username defines the username used to authenticate with the package index
+
+		default:
+			"__token__" when a token is provided
+	*/
+	Username string `yaml:",omitempty"`
+	/*
+		[S][C] Token defines the token, or password, used to authenticate with the package index
+
+		remark:
+			A token is a sensitive information, it's recommended to not set this value directly in the configuration file
+			but to use an environment variable or a SOPS file.
+
+			The value can be set to `{{ requiredEnv "PYPI_TOKEN"}}` to retrieve the token from the environment variable `PYPI_TOKEN`
+	*/
+	Token string `yaml:",omitempty"`
+	// [S][C] PythonVersion ignores releases whose "requires-python" metadata excludes this python version, such as "3.8"
+	PythonVersion string `yaml:",omitempty"`
+	/*
+		[S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, pep440, or just latest.
+
+		default:
+			kind: pep440
+
+		remark:
+			The kind "latest" is handled as "pep440" and returns the newest final release
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	/*
+		[T] File defines the file updated by the target.
+		Supported files are "requirements*.txt", "pyproject.toml" (PEP 621 and Poetry), "setup.cfg" and "Pipfile"
+		Requirements pinned using "--hash" are left unchanged, as their hashes must be regenerated, for instance using pip-compile
+
+		default:
+			requirements.txt
+	*/
+	File string `yaml:",omitempty"`
+	/*
+		retry defines how failed requests to the package index are retried.
+
+		default:
+			the global retry policy defined by the "--http-retry-*" flags
+	*/
+	Retry httpclient.RetryPolicy `yaml:",omitempty"`
+}
+
+// Validate run some validation on the Spec
+func (s Spec) Validate() error {
+	if len(s.Name) == 0 {
+		logrus.Errorf("pypi package name not defined")
+		return ErrWrongSpec
+	}
+
+	if len(s.PythonVersion) > 0 {
+		if _, err := version.MatchPEP440(s.PythonVersion, ""); err != nil {
+			logrus.Errorf("invalid python version %q: %s", s.PythonVersion, err)
+			return ErrWrongSpec
+		}
+	}
+
+	return nil
+}
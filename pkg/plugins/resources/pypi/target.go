@@ -0,0 +1,82 @@
+package pypi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/core/text"
+	"github.com/updatecli/updatecli/pkg/plugins/utils"
+)
+
+// Target updates the python package version pinned in a requirements, pyproject.toml, setup.cfg or Pipfile file
+func (p *Pypi) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
+
+	version := source
+	if p.spec.Version != "" {
+		version = p.spec.Version
+	}
+
+	if version == "" {
+		return fmt.Errorf("no version defined for python package %q", p.spec.Name)
+	}
+
+	filename := p.spec.File
+	if scm != nil {
+		filename = utils.JoinFilePathWithWorkingDirectoryPath(filename, scm.GetDirectory())
+	}
+
+	if !p.contentRetriever.FileExists(filename) {
+		return fmt.Errorf("file %q does not exist", filename)
+	}
+
+	content, err := p.contentRetriever.ReadAll(filename)
+	if err != nil {
+		return fmt.Errorf("reading file: %w", err)
+	}
+
+	newContent, oldVersions := updateContent(ctx, filename, content, p.spec.Name, version)
+	if len(oldVersions) == 0 {
+		return fmt.Errorf("python package %q not found in %q", p.spec.Name, filename)
+	}
+
+	resultTarget.Information = oldVersions[0]
+	resultTarget.NewInformation = version
+	resultTarget.Changed = newContent != content
+
+	if !resultTarget.Changed {
+		resultTarget.Result = result.SUCCESS
+		resultTarget.Description = fmt.Sprintf("%s already set python package %q to version %q", filename, p.spec.Name, version)
+		return nil
+	}
+
+	resultTarget.Result = result.ATTENTION
+
+	if dryRun {
+		resultTarget.Description = fmt.Sprintf("%s should update python package %q from %q to %q",
+			filename,
+			p.spec.Name,
+			resultTarget.Information,
+			resultTarget.NewInformation)
+		return nil
+	}
+
+	logger.Debugf("\n%s\n", text.Diff(filename, filename, content, newContent))
+
+	err = p.contentRetriever.WriteToFile(newContent, filename)
+	if err != nil {
+		return fmt.Errorf("writing file %q: %w", filename, err)
+	}
+
+	resultTarget.Files = append(resultTarget.Files, filename)
+	resultTarget.Description = fmt.Sprintf("%s updated python package %q from %q to %q",
+		filename,
+		p.spec.Name,
+		resultTarget.Information,
+		resultTarget.NewInformation)
+
+	return nil
+}
@@ -0,0 +1,249 @@
+package pypi
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+func TestTarget(t *testing.T) {
+	tests := []struct {
+		name                string
+		file                string
+		spec                map[string]interface{}
+		expectedInformation string
+		expectedChanged     bool
+		expectedLines       []string
+		wantErr             bool
+	}{
+		{
+			name: "Requirement with extras, marker and comment",
+			file: "requirements.txt",
+			spec: map[string]interface{}{
+				"name":    "requests",
+				"version": "2.31.0",
+			},
+			expectedInformation: "2.30.0",
+			expectedChanged:     true,
+			expectedLines:       []string{`requests[security]==2.31.0 ; python_version >= "3.8"  # http client`},
+		},
+		{
+			name: "Requirement pinned with hashes",
+			file: "requirements.txt",
+			spec: map[string]interface{}{
+				"name":    "urllib3",
+				"version": "2.0.7",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Compatible release requirement",
+			file: "requirements.txt",
+			spec: map[string]interface{}{
+				"name":    "django",
+				"version": "5.0.0",
+			},
+			expectedInformation: "4.2.0",
+			expectedChanged:     true,
+			expectedLines:       []string{`Django~=5.0.0`},
+		},
+		{
+			name: "Compatible release requirement keeping its precision",
+			file: "requirements.txt",
+			spec: map[string]interface{}{
+				"name":    "numpy",
+				"version": "1.9.3",
+			},
+			expectedInformation: "1.4",
+			expectedChanged:     true,
+			expectedLines:       []string{`numpy~=1.9`},
+		},
+		{
+			name: "Compatible release requirement already allowing the version",
+			file: "requirements.txt",
+			spec: map[string]interface{}{
+				"name":    "numpy",
+				"version": "1.4.2",
+			},
+			expectedInformation: "1.4",
+		},
+		{
+			name: "Requirement already up to date",
+			file: "requirements.txt",
+			spec: map[string]interface{}{
+				"name":    "requests",
+				"version": "2.30.0",
+			},
+			expectedInformation: "2.30.0",
+		},
+		{
+			name: "Requirement using a range",
+			file: "requirements.txt",
+			spec: map[string]interface{}{
+				"name":    "flask",
+				"version": "3.0.0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "PEP 621 and Poetry dependencies",
+			file: "pyproject.toml",
+			spec: map[string]interface{}{
+				"name":    "requests",
+				"version": "2.31.0",
+			},
+			expectedInformation: "2.30.0",
+			expectedChanged:     true,
+			expectedLines: []string{
+				`    "requests==2.31.0",`,
+				`requests = "^2.31.0"`,
+			},
+		},
+		{
+			name: "Optional dependencies and Poetry subtable",
+			file: "pyproject.toml",
+			spec: map[string]interface{}{
+				"name":    "black",
+				"version": "24.1.0",
+			},
+			expectedInformation: "23.7.0",
+			expectedChanged:     true,
+			expectedLines: []string{
+				`dev = ["pytest==7.4.0", "black==24.1.0"]`,
+				`version = "24.1.0"`,
+			},
+		},
+		{
+			name: "Dependency group",
+			file: "pyproject.toml",
+			spec: map[string]interface{}{
+				"name":    "ruff",
+				"version": "0.2.0",
+			},
+			expectedInformation: "0.1.0",
+			expectedChanged:     true,
+			expectedLines:       []string{`    "ruff==0.2.0",`},
+		},
+		{
+			name: "Poetry inline table",
+			file: "pyproject.toml",
+			spec: map[string]interface{}{
+				"name":    "Django",
+				"version": "4.2.7",
+			},
+			expectedInformation: "4.2.0",
+			expectedChanged:     true,
+			expectedLines:       []string{`django = { version = "~4.2.7", extras = ["bcrypt"] }`},
+		},
+		{
+			name: "Build system requirement",
+			file: "pyproject.toml",
+			spec: map[string]interface{}{
+				"name":    "setuptools",
+				"version": "69.0.0",
+			},
+			expectedInformation: "68.0",
+			expectedChanged:     true,
+			expectedLines:       []string{`requires = ["setuptools>=69.0.0", "wheel"]`},
+		},
+		{
+			name: "Commented dependency",
+			file: "pyproject.toml",
+			spec: map[string]interface{}{
+				"name":    "urllib3",
+				"version": "2.0.7",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Setuptools requirements",
+			file: "setup.cfg",
+			spec: map[string]interface{}{
+				"name":    "requests",
+				"version": "2.31.0",
+			},
+			expectedInformation: "2.30.0",
+			expectedChanged:     true,
+			expectedLines:       []string{`    requests==2.31.0`},
+		},
+		{
+			name: "Setuptools inline and extras requirements",
+			file: "setup.cfg",
+			spec: map[string]interface{}{
+				"name":    "setuptools",
+				"version": "69.0.0",
+			},
+			expectedInformation: "68.0.0",
+			expectedChanged:     true,
+			expectedLines:       []string{`setup_requires = setuptools==69.0.0`},
+		},
+		{
+			name: "Pipfile package",
+			file: "Pipfile",
+			spec: map[string]interface{}{
+				"name":    "requests",
+				"version": "2.31.0",
+			},
+			expectedInformation: "2.30.0",
+			expectedChanged:     true,
+			expectedLines:       []string{`requests = "==2.31.0"`},
+		},
+		{
+			name: "Pipfile inline table",
+			file: "Pipfile",
+			spec: map[string]interface{}{
+				"name":    "Django",
+				"version": "4.2.7",
+			},
+			expectedInformation: "4.2.0",
+			expectedChanged:     true,
+			expectedLines:       []string{`django = {version = "~=4.2.7", extras = ["bcrypt"]}`},
+		},
+		{
+			name: "Pipfile unpinned package",
+			file: "Pipfile",
+			spec: map[string]interface{}{
+				"name":    "flask",
+				"version": "3.0.0",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := os.ReadFile(filepath.Join("testdata", tt.file))
+			require.NoError(t, err)
+
+			filename := filepath.Join(t.TempDir(), tt.file)
+			require.NoError(t, os.WriteFile(filename, content, 0600))
+
+			tt.spec["file"] = filename
+
+			p, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Target{}
+			err = p.Target(context.Background(), "", nil, false, &gotResult)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedInformation, gotResult.Information)
+			assert.Equal(t, tt.expectedChanged, gotResult.Changed)
+
+			gotContent, err := os.ReadFile(filename)
+			require.NoError(t, err)
+			for _, line := range tt.expectedLines {
+				assert.Contains(t, string(gotContent), line+"\n")
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package rubygems
+
+import "fmt"
+
+// Changelog returns the link to the found gem version on rubygems.org
+func (r *Rubygems) Changelog() string {
+	if r.foundVersion.GetVersion() == "" || r.spec.URL != rubygemsDefaultURL {
+		return ""
+	}
+
+	return fmt.Sprintf("https://rubygems.org/gems/%s/versions/%s\n", r.spec.Name, r.foundVersion.GetVersion())
+}
@@ -0,0 +1,39 @@
+package rubygems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks that a gem version exists and is not yanked
+func (r *Rubygems) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
+	if scm != nil {
+		logger.Warningf("SCM configuration is not supported for rubygems condition, aborting")
+	}
+
+	versionToCheck := r.spec.Version
+	if versionToCheck == "" {
+		versionToCheck = source
+	}
+	if len(versionToCheck) == 0 {
+		return false, "", errors.New("no version defined")
+	}
+
+	versions, err := r.getVersions(ctx)
+	if err != nil {
+		return false, "", err
+	}
+
+	if !slices.Contains(versions, versionToCheck) {
+		return false, fmt.Sprintf("version %q of gem %q doesn't exist", versionToCheck, r.spec.Name), nil
+	}
+
+	return true, fmt.Sprintf("version %q of gem %q available", versionToCheck, r.spec.Name), nil
+}
@@ -0,0 +1,59 @@
+package rubygems
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition(t *testing.T) {
+	server := newTestGemServer(t)
+
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		source   string
+		expected bool
+	}{
+		{
+			name: "Existing version",
+			spec: map[string]interface{}{
+				"name":    "rails",
+				"url":     server.URL,
+				"version": "7.0.8",
+			},
+			expected: true,
+		},
+		{
+			name: "Existing platform specific version from source",
+			spec: map[string]interface{}{
+				"name": "rails",
+				"url":  server.URL,
+			},
+			source:   "7.1.2",
+			expected: true,
+		},
+		{
+			name: "Missing version",
+			spec: map[string]interface{}{
+				"name":    "rails",
+				"url":     server.URL,
+				"version": "8.0.0",
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := New(tt.spec)
+			require.NoError(t, err)
+
+			got, _, err := r.Condition(context.Background(), tt.source, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package rubygems
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+)
+
+var (
+	// gemDependencyRegex matches a Gemfile "gem" statement or a gemspec "add_dependency" statement
+	gemDependencyRegex = regexp.MustCompile(`^(\s*(?:gem|[A-Za-z_][A-Za-z0-9_]*\.add_(?:runtime_|development_)?dependency)\s*\(?\s*)(["'])([^"']+)(["'])(.*)$`)
+	// gemRequirementRegex matches the first version requirement following the gem name
+	gemRequirementRegex = regexp.MustCompile(`^(\s*,\s*)(["'])((?:~>|>=|<=|!=|=|>|<)?\s*)([0-9][0-9A-Za-z.]*)(["'])(.*)$`)
+	// additionalRequirementRegex matches a second version requirement
+	additionalRequirementRegex = regexp.MustCompile(`^\s*,\s*["']\s*(?:~>|>=|<=|!=|=|>|<)?\s*[0-9]`)
+)
+
+// updateGemfile returns the Gemfile or gemspec content with the gem requirements set to the new version
+// and the versions previously required
+func updateGemfile(ctx context.Context, content, name, newVersion string) (string, []string) {
+	logger := log.FromContext(ctx)
+
+	var oldVersions []string
+
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+
+		m := gemDependencyRegex.FindStringSubmatch(line)
+		if m == nil || m[2] != m[4] || m[3] != name {
+			continue
+		}
+
+		r := gemRequirementRegex.FindStringSubmatch(m[5])
+		if r == nil || r[2] != r[5] {
+			logger.Debugf("gem %q has no version requirement in %q", name, strings.TrimSpace(line))
+			continue
+		}
+
+		if additionalRequirementRegex.MatchString(r[6]) {
+			logger.Warningf("gem %q uses several version requirements not supported by Updatecli, skipping", name)
+			continue
+		}
+
+		operator := strings.TrimSpace(r[3])
+		updatedVersion := newVersion
+		if operator == "~>" {
+			updatedVersion = keepPrecision(r[4], newVersion)
+		}
+
+		lines[i] = m[1] + m[2] + m[3] + m[4] + r[1] + r[2] + r[3] + updatedVersion + r[5] + r[6]
+		oldVersions = append(oldVersions, r[4])
+	}
+
+	return strings.Join(lines, "\n"), oldVersions
+}
+
+// keepPrecision truncates the new version to the number of segments of the old one,
+// so a pessimistic requirement like "~> 7.1" keeps allowing the same range of updates
+func keepPrecision(oldVersion, newVersion string) string {
+	oldSegments := strings.Split(oldVersion, ".")
+	newSegments := strings.Split(newVersion, ".")
+
+	if len(newSegments) <= len(oldSegments) {
+		return newVersion
+	}
+
+	return strings.Join(newSegments[:len(oldSegments)], ".")
+}
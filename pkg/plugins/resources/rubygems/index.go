@@ -0,0 +1,142 @@
+package rubygems
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+var (
+	// gemSegmentRegex matches the segments of a gem version, like Gem::Version does
+	gemSegmentRegex = regexp.MustCompile(`[0-9]+|[A-Za-z]+`)
+)
+
+// infoURL returns the compact index url describing every version of the gem
+func (r *Rubygems) infoURL() string {
+	return fmt.Sprintf("%s/info/%s", r.spec.URL, url.PathEscape(r.spec.Name))
+}
+
+// getVersions queries the compact index of the gem server and returns
+// every published gem version, from the oldest to the newest.
+// Yanked versions are removed from the compact index.
+func (r *Rubygems) getVersions(ctx context.Context) ([]string, error) {
+	logger := log.FromContext(ctx)
+
+	URL := r.infoURL()
+
+	req, err := http.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(r.spec.Username) > 0:
+		req.SetBasicAuth(r.spec.Username, r.spec.Token)
+	case len(r.spec.Token) > 0:
+		req.SetBasicAuth(r.spec.Token, "")
+	}
+
+	res, err := r.webClient.Do(req)
+	if err != nil {
+		logger.Errorf("something went wrong while querying the gem server %q\n", err)
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, _ := httputil.DumpResponse(res, false)
+		logger.Debugf("\n%v\n", string(body))
+		return nil, fmt.Errorf("querying gem server %q: %s", URL, res.Status)
+	}
+
+	var versions []string
+
+	scanner := bufio.NewScanner(res.Body)
+	// Gems with many versions and dependencies have long lines
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line == "---" {
+			continue
+		}
+
+		// Each line looks like "VERSION[-PLATFORM] DEPENDENCIES|REQUIREMENTS"
+		v, _, _ := strings.Cut(line, " ")
+		v, _, _ = strings.Cut(v, "-")
+
+		if !slices.Contains(versions, v) {
+			versions = append(versions, v)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading gem server response: %w", err)
+	}
+
+	slices.SortStableFunc(versions, compareGemVersions)
+
+	return versions, nil
+}
+
+// isPrerelease returns true if the gem version contains a letter such as "1.0.0.rc1"
+func isPrerelease(v string) bool {
+	return strings.IndexFunc(v, func(r rune) bool {
+		return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+	}) >= 0
+}
+
+// compareGemVersions compares two gem versions using the Gem::Version rules,
+// string segments identify prereleases which are older than numeric segments
+func compareGemVersions(a, b string) int {
+	segmentsA := gemSegmentRegex.FindAllString(a, -1)
+	segmentsB := gemSegmentRegex.FindAllString(b, -1)
+
+	for i := 0; i < max(len(segmentsA), len(segmentsB)); i++ {
+		segmentA, segmentB := "0", "0"
+		if i < len(segmentsA) {
+			segmentA = segmentsA[i]
+		}
+		if i < len(segmentsB) {
+			segmentB = segmentsB[i]
+		}
+
+		numA, errA := strconv.Atoi(segmentA)
+		numB, errB := strconv.Atoi(segmentB)
+
+		switch {
+		case errA == nil && errB == nil:
+			if numA != numB {
+				return numA - numB
+			}
+		case errA == nil:
+			return 1
+		case errB == nil:
+			return -1
+		default:
+			if c := strings.Compare(segmentA, segmentB); c != 0 {
+				return c
+			}
+		}
+	}
+
+	return 0
+}
+
+// searchVersion returns the newest gem version matching the version filter
+func (r *Rubygems) searchVersion(versions []string) (version.Version, error) {
+	if r.versionFilter.Kind == version.LATESTVERSIONKIND && r.versionFilter.Pattern == version.LATESTVERSIONKIND {
+		versions = slices.DeleteFunc(slices.Clone(versions), isPrerelease)
+	}
+
+	return r.versionFilter.Search(versions)
+}
@@ -0,0 +1,69 @@
+package rubygems
+
+import (
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/text"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// rubygemsDefaultURL is the default gem server
+	rubygemsDefaultURL string = "https://rubygems.org"
+)
+
+// Rubygems defines a resource of kind "rubygems"
+type Rubygems struct {
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different than the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	foundVersion  version.Version
+	webClient     httpclient.HTTPClient
+	// contentRetriever reads and writes the file updated by the target
+	contentRetriever text.TextRetriever
+}
+
+// New returns a new valid Rubygems object.
+func New(spec interface{}) (*Rubygems, error) {
+	var newSpec Spec
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return &Rubygems{}, err
+	}
+
+	err = newSpec.Validate()
+	if err != nil {
+		return &Rubygems{}, err
+	}
+
+	if len(newSpec.URL) == 0 {
+		newSpec.URL = rubygemsDefaultURL
+	}
+	newSpec.URL = strings.TrimSuffix(newSpec.URL, "/")
+
+	if len(newSpec.File) == 0 {
+		newSpec.File = "Gemfile"
+	}
+	newSpec.File = strings.TrimPrefix(newSpec.File, "file://")
+
+	newFilter, err := newSpec.VersionFilter.Init()
+	if err != nil {
+		return &Rubygems{}, err
+	}
+
+	// Unset retry settings are taken from the global retry policy
+	webClient, err := httpclient.NewRetryClientWithPolicy(newSpec.Retry)
+	if err != nil {
+		return &Rubygems{}, err
+	}
+
+	return &Rubygems{
+		spec:             newSpec,
+		versionFilter:    newFilter,
+		webClient:        webClient,
+		contentRetriever: &text.Text{},
+	}, nil
+}
@@ -0,0 +1,36 @@
+package rubygems
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Source returns the newest gem version matching the version filter
+func (r *Rubygems) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	versions, err := r.getVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("no version found for gem %q", r.spec.Name)
+	}
+
+	r.foundVersion, err = r.searchVersion(versions)
+	if err != nil {
+		return err
+	}
+
+	foundVersion := r.foundVersion.GetVersion()
+	if foundVersion == "" {
+		return fmt.Errorf("no version found for gem %q", r.spec.Name)
+	}
+
+	resultSource.Information = foundVersion
+	resultSource.Result = result.SUCCESS
+	resultSource.Description = fmt.Sprintf("version %q found for gem %q", foundVersion, r.spec.Name)
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package rubygems
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+const (
+	// railsCompactIndex is the compact index describing the rails gem
+	railsCompactIndex string = `---
+7.0.8 actionpack:= 7.0.8,activesupport:= 7.0.8|checksum:abc,ruby:>= 2.7.0,rubygems:>= 1.8.11
+7.1.0.rc1 actionpack:= 7.1.0.rc1|checksum:abc,ruby:>= 2.7.0
+7.1.0 actionpack:= 7.1.0|checksum:abc,ruby:>= 2.7.0
+7.1.2 actionpack:= 7.1.2|checksum:abc,ruby:>= 2.7.0
+7.1.2-java actionpack:= 7.1.2|checksum:abc,ruby:>= 2.7.0
+6.1.7.6 actionpack:= 6.1.7.6|checksum:abc,ruby:>= 2.5.0
+7.2.0.beta1 actionpack:= 7.2.0.beta1|checksum:abc,ruby:>= 3.1.0
+`
+)
+
+// newTestGemServer returns a gem server serving the rails compact index,
+// the "private" path requires the token "secret"
+func newTestGemServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /info/rails", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(railsCompactIndex))
+	})
+	mux.HandleFunc("GET /private/info/rails", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "bot" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(railsCompactIndex))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSource(t *testing.T) {
+	server := newTestGemServer(t)
+
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		expected string
+		wantErr  bool
+	}{
+		{
+			name: "Latest version ignoring prereleases",
+			spec: map[string]interface{}{
+				"name": "rails",
+				"url":  server.URL,
+			},
+			expected: "7.1.2",
+		},
+		{
+			name: "Version matching a semver constraint",
+			spec: map[string]interface{}{
+				"name": "rails",
+				"url":  server.URL + "/",
+				"versionfilter": map[string]interface{}{
+					"kind":    "semver",
+					"pattern": "~7.0",
+				},
+			},
+			expected: "7.0.8",
+		},
+		{
+			name: "Private gem server",
+			spec: map[string]interface{}{
+				"name":     "rails",
+				"url":      server.URL + "/private",
+				"username": "bot",
+				"token":    "secret",
+			},
+			expected: "7.1.2",
+		},
+		{
+			name: "Private gem server without credentials",
+			spec: map[string]interface{}{
+				"name": "rails",
+				"url":  server.URL + "/private",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unknown gem",
+			spec: map[string]interface{}{
+				"name": "unknown",
+				"url":  server.URL,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = r.Source(context.Background(), "", &gotResult)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, gotResult.Information)
+		})
+	}
+}
+
+func TestCompareGemVersions(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "1.0", b: "1.0.0", expected: 0},
+		{a: "1.0.1", b: "1.0", expected: 1},
+		{a: "1.10", b: "1.9", expected: 1},
+		{a: "1.0.0.rc1", b: "1.0.0", expected: -1},
+		{a: "1.0.0.beta", b: "1.0.0.alpha", expected: 1},
+		{a: "1.0.0.rc2", b: "1.0.0.rc10", expected: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			got := compareGemVersions(tt.a, tt.b)
+			switch {
+			case tt.expected == 0:
+				assert.Zero(t, got)
+			case tt.expected > 0:
+				assert.Positive(t, got)
+			default:
+				assert.Negative(t, got)
+			}
+		})
+	}
+}
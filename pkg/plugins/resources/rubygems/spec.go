@@ -0,0 +1,78 @@
+package rubygems
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+var (
+	// ErrWrongSpec is returned when the Spec has wrong content
+	ErrWrongSpec error = errors.New("wrong spec content")
+)
+
+// Spec defines a specification for a "rubygems" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	// [S][C][T] Name defines the gem name
+	Name string `yaml:",omitempty" jsonschema:"required"`
+	// [C][T] Version defines a specific gem version
+	Version string `yaml:",omitempty"`
+	/*
+		[S][C] URL defines the gem server implementing the compact index api
+
+		default:
+			https://rubygems.org
+	*/
+	URL string `yaml:",omitempty"`
+	/*
+		[S][C] Username defines the username used to authenticate with the gem server
+
+		remark:
+			When only a token is provided, it is used as the username like Bundler does
+	*/
+	Username string `yaml:",omitempty"`
+	/*
+		[S][C] Token defines the token, or password, used to authenticate with the gem server
+
+		remark:
+			A token is a sensitive information, it's recommended to not set this value directly in the configuration file
+			but to use an environment variable or a SOPS file.
+
+			The value can be set to `{{ requiredEnv "GEM_SERVER_TOKEN"}}` to retrieve the token from the environment variable `GEM_SERVER_TOKEN`
+	*/
+	Token string `yaml:",omitempty"`
+	/*
+		[S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+
+		remark:
+			The kind "latest" ignores prerelease versions such as "7.1.0.rc1"
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	/*
+		[T] File defines the file updated by the target, either a Gemfile or a gemspec file
+
+		default:
+			Gemfile
+	*/
+	File string `yaml:",omitempty"`
+	/*
+		retry defines how failed requests to the gem server are retried.
+
+		default:
+			the global retry policy defined by the "--http-retry-*" flags
+	*/
+	Retry httpclient.RetryPolicy `yaml:",omitempty"`
+}
+
+// Validate run some validation on the Spec
+func (s Spec) Validate() error {
+	if len(s.Name) == 0 {
+		logrus.Errorf("gem name not defined")
+		return ErrWrongSpec
+	}
+
+	return nil
+}
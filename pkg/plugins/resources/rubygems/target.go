@@ -0,0 +1,82 @@
+package rubygems
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/core/text"
+	"github.com/updatecli/updatecli/pkg/plugins/utils"
+)
+
+// Target updates the gem version requirement from a Gemfile or a gemspec file
+func (r *Rubygems) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
+
+	version := source
+	if r.spec.Version != "" {
+		version = r.spec.Version
+	}
+
+	if version == "" {
+		return fmt.Errorf("no version defined for gem %q", r.spec.Name)
+	}
+
+	filename := r.spec.File
+	if scm != nil {
+		filename = utils.JoinFilePathWithWorkingDirectoryPath(filename, scm.GetDirectory())
+	}
+
+	if !r.contentRetriever.FileExists(filename) {
+		return fmt.Errorf("file %q does not exist", filename)
+	}
+
+	content, err := r.contentRetriever.ReadAll(filename)
+	if err != nil {
+		return fmt.Errorf("reading file: %w", err)
+	}
+
+	newContent, oldVersions := updateGemfile(ctx, content, r.spec.Name, version)
+	if len(oldVersions) == 0 {
+		return fmt.Errorf("gem %q with a version requirement not found in %q", r.spec.Name, filename)
+	}
+
+	resultTarget.Information = oldVersions[0]
+	resultTarget.NewInformation = version
+	resultTarget.Changed = newContent != content
+
+	if !resultTarget.Changed {
+		resultTarget.Result = result.SUCCESS
+		resultTarget.Description = fmt.Sprintf("%s already set gem %q to version %q", filename, r.spec.Name, version)
+		return nil
+	}
+
+	resultTarget.Result = result.ATTENTION
+
+	if dryRun {
+		resultTarget.Description = fmt.Sprintf("%s should update gem %q from %q to %q",
+			filename,
+			r.spec.Name,
+			resultTarget.Information,
+			resultTarget.NewInformation)
+		return nil
+	}
+
+	logger.Debugf("\n%s\n", text.Diff(filename, filename, content, newContent))
+
+	err = r.contentRetriever.WriteToFile(newContent, filename)
+	if err != nil {
+		return fmt.Errorf("writing file %q: %w", filename, err)
+	}
+
+	resultTarget.Files = append(resultTarget.Files, filename)
+	resultTarget.Description = fmt.Sprintf("%s updated gem %q from %q to %q",
+		filename,
+		r.spec.Name,
+		resultTarget.Information,
+		resultTarget.NewInformation)
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package rubygems
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+func TestTarget(t *testing.T) {
+	tests := []struct {
+		name                string
+		file                string
+		spec                map[string]interface{}
+		expectedInformation string
+		expectedChanged     bool
+		expectedLine        string
+		wantErr             bool
+	}{
+		{
+			name: "Pessimistic requirement",
+			file: "Gemfile",
+			spec: map[string]interface{}{
+				"name":    "rails",
+				"version": "7.1.2",
+			},
+			expectedInformation: "7.1.0",
+			expectedChanged:     true,
+			expectedLine:        `gem "rails", "~> 7.1.2"`,
+		},
+		{
+			name: "Pessimistic requirement keeping its precision",
+			file: "Gemfile",
+			spec: map[string]interface{}{
+				"name":    "rspec-rails",
+				"version": "6.1.0",
+			},
+			expectedInformation: "6.0",
+			expectedChanged:     true,
+			expectedLine:        `  gem "rspec-rails", "~> 6.1"`,
+		},
+		{
+			name: "Exact requirement",
+			file: "Gemfile",
+			spec: map[string]interface{}{
+				"name":    "pg",
+				"version": "1.5.4",
+			},
+			expectedInformation: "1.5.3",
+			expectedChanged:     true,
+			expectedLine:        `gem 'pg', '1.5.4'`,
+		},
+		{
+			name: "Requirement already up to date",
+			file: "Gemfile",
+			spec: map[string]interface{}{
+				"name":    "pg",
+				"version": "1.5.3",
+			},
+			expectedInformation: "1.5.3",
+		},
+		{
+			name: "Several requirements",
+			file: "Gemfile",
+			spec: map[string]interface{}{
+				"name":    "puma",
+				"version": "6.4.0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Gem without requirement",
+			file: "Gemfile",
+			spec: map[string]interface{}{
+				"name":    "bootsnap",
+				"version": "1.17.0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Commented gem",
+			file: "Gemfile",
+			spec: map[string]interface{}{
+				"name":    "sidekiq",
+				"version": "7.2.0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Gemspec runtime dependency",
+			file: "demo.gemspec",
+			spec: map[string]interface{}{
+				"name":    "faraday",
+				"version": "2.8.1",
+			},
+			expectedInformation: "2.7",
+			expectedChanged:     true,
+			expectedLine:        `  spec.add_runtime_dependency("faraday", "~> 2.8")`,
+		},
+		{
+			name: "Gemspec development dependency",
+			file: "demo.gemspec",
+			spec: map[string]interface{}{
+				"name":    "rake",
+				"version": "13.1.0",
+			},
+			expectedInformation: "13.0.6",
+			expectedChanged:     true,
+			expectedLine:        `  spec.add_development_dependency "rake", "= 13.1.0"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := os.ReadFile(filepath.Join("testdata", tt.file))
+			require.NoError(t, err)
+
+			filename := filepath.Join(t.TempDir(), tt.file)
+			require.NoError(t, os.WriteFile(filename, content, 0600))
+
+			tt.spec["file"] = filename
+
+			r, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Target{}
+			err = r.Target(context.Background(), "", nil, false, &gotResult)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedInformation, gotResult.Information)
+			assert.Equal(t, tt.expectedChanged, gotResult.Changed)
+
+			if tt.expectedLine != "" {
+				gotContent, err := os.ReadFile(filename)
+				require.NoError(t, err)
+				assert.Contains(t, string(gotContent), tt.expectedLine+"\n")
+			}
+		})
+	}
+}
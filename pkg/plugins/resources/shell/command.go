@@ -2,11 +2,12 @@ package shell
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"os/exec"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 )
 
 type command struct {
@@ -23,15 +24,17 @@ type commandResult struct {
 }
 
 type commandExecutor interface {
-	ExecuteCommand(cmd command) (commandResult, error)
+	ExecuteCommand(ctx context.Context, cmd command) (commandResult, error)
 }
 
 type nativeCommandExecutor struct{}
 
-func (nce *nativeCommandExecutor) ExecuteCommand(inputCmd command) (commandResult, error) {
+func (nce *nativeCommandExecutor) ExecuteCommand(ctx context.Context, inputCmd command) (commandResult, error) {
+	logger := log.FromContext(ctx)
+
 	var stdout, stderr bytes.Buffer
 
-	logrus.Debugf("\tcommand: %s\n", inputCmd.Cmd)
+	logger.Debugf("\tcommand: %s\n", inputCmd.Cmd)
 
 	cmdFields := strings.Fields(inputCmd.Cmd)
 	command := exec.Command(cmdFields[0], cmdFields[1:]...) //nolint: gosec
@@ -44,9 +47,9 @@ func (nce *nativeCommandExecutor) ExecuteCommand(inputCmd command) (commandResul
 	err := command.Run()
 
 	// Display environment variables in debug mode
-	logrus.Debugf("Environment variables\n")
+	logger.Debugf("Environment variables\n")
 	for _, env := range command.Env {
-		logrus.Debugf("\t* %s\n", env)
+		logger.Debugf("\t* %s\n", env)
 	}
 
 	// Remove line returns from stdout
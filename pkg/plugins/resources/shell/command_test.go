@@ -1,6 +1,7 @@
 package shell
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -53,7 +54,7 @@ func TestNativeCommandExecutor_ExecuteCommand(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := sut.ExecuteCommand(tt.cmd)
+			got, err := sut.ExecuteCommand(context.Background(), tt.cmd)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -1,13 +1,14 @@
 package shell
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
 // Condition tests if the provided command (concatenated with the source) is executed with success
-func (s *Shell) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (s *Shell) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
 	var workingDir string
 	if scm != nil {
 		workingDir = scm.GetDirectory()
@@ -16,7 +17,7 @@ func (s *Shell) Condition(source string, scm scm.ScmHandler) (pass bool, message
 	// Ensure environment variable(s) are up to date
 	// either it already has a value specified, or it retrieves
 	// the value from the Updatecli process
-	err = s.spec.Environments.Load()
+	err = s.spec.Environments.Load(ctx)
 	if err != nil {
 		return false, "", err
 	}
@@ -40,7 +41,7 @@ func (s *Shell) Condition(source string, scm scm.ScmHandler) (pass bool, message
 		return false, "", fmt.Errorf("failed initializing source script - %s", err)
 	}
 
-	err = s.executeCommand(command{
+	err = s.executeCommand(ctx, command{
 		Cmd: s.interpreter + " " + scriptFilename,
 		Dir: s.getWorkingDirPath(workingDir),
 		Env: env.ToStringSlice(),
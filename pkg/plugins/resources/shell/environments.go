@@ -1,10 +1,12 @@
 package shell
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 )
 
 type Environments []Environment
@@ -72,12 +74,14 @@ func (e Environments) Validate() error {
 }
 
 // Load updates all environment value based on Updatecli environment variables, at the condition that the value is not defined yet
-func (e *Environments) Load() error {
+func (e *Environments) Load(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
 	environments := *e
 	for id := range environments {
 		err := environments[id].Load()
 		if err != nil {
-			logrus.Errorln(err)
+			logger.Errorln(err)
 		}
 	}
 	return nil
@@ -1,12 +1,13 @@
 package shell
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"runtime"
 
 	"github.com/mitchellh/mapstructure"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 )
 
 // Spec defines a specification for a "shell" resource
@@ -96,17 +97,19 @@ func (s *Shell) appendSource(source string) string {
 
 // executeCommand call the shell command executor to execute its command
 // and sets the internal "result" to the command result
-func (s *Shell) executeCommand(inputCmd command) (err error) {
+func (s *Shell) executeCommand(ctx context.Context, inputCmd command) (err error) {
 
-	s.result, err = s.executor.ExecuteCommand(inputCmd)
+	s.result, err = s.executor.ExecuteCommand(ctx, inputCmd)
 	// Logs the result
-	s.report()
+	s.report(ctx)
 
 	return err
 }
 
 // report logs the result of the shell command to the end user.
-func (s *Shell) report() {
+func (s *Shell) report(ctx context.Context) {
+	logger := log.FromContext(ctx)
+
 	message := fmt.Sprintf("The shell 🐚 command %q", s.result.Cmd)
 	stdoutMessage := fmt.Sprintf("with the following output:\n%s", formatShellBlock(s.result.Stdout))
 	stderrMessage := fmt.Sprintf("command stderr output was:\n%s", formatShellBlock(s.result.Stderr))
@@ -115,15 +118,15 @@ func (s *Shell) report() {
 		// Shell command exited with an error: log everything as info, including exit code and stderr
 		message += fmt.Sprintf(" exited on error (exit code %d) %s\n\n%s", s.result.ExitCode, stdoutMessage, stderrMessage)
 
-		logrus.Info(message)
+		logger.Info(message)
 		return
 	}
 
 	// Shell command ran successfully: logs the command and its standard output as info, and stderr as debug
 	message += fmt.Sprintf(" ran successfully %s", stdoutMessage)
 
-	logrus.Info(message)
-	logrus.Debug(stderrMessage)
+	logger.Info(message)
+	logger.Debug(stderrMessage)
 }
 
 func formatShellBlock(content string) string {
@@ -1,5 +1,7 @@
 package shell
 
+import "context"
+
 // MockCommandExecutor is a stub implementation of the `commandExecutor` interface
 // to be used in our test suite.
 // It stores the received `command` and returns the preconfigured `result` and `err`.
@@ -9,7 +11,7 @@ type MockCommandExecutor struct {
 	Err        error
 }
 
-func (mce *MockCommandExecutor) ExecuteCommand(cmd command) (commandResult, error) {
+func (mce *MockCommandExecutor) ExecuteCommand(ctx context.Context, cmd command) (commandResult, error) {
 	mce.GotCommand = cmd
 	return mce.Result, mce.Err
 }
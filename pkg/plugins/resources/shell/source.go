@@ -1,6 +1,7 @@
 package shell
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/result"
@@ -8,12 +9,12 @@ import (
 
 // Source returns the stdout of the shell command if its exit code is 0
 // otherwise an error is returned with the content of stderr
-func (s *Shell) Source(workingDir string, resultSource *result.Source) error {
+func (s *Shell) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
 
 	// Ensure environment variable(s) are up to date
 	// either it already has a value specified, or it retrieves
 	// the value from the Updatecli process
-	err := s.spec.Environments.Load()
+	err := s.spec.Environments.Load(ctx)
 	if err != nil {
 		return &ExecutionFailedError{}
 	}
@@ -40,7 +41,7 @@ func (s *Shell) Source(workingDir string, resultSource *result.Source) error {
 		return fmt.Errorf("initializing source script: %w", err)
 	}
 
-	err = s.executeCommand(command{
+	err = s.executeCommand(ctx, command{
 		Cmd: s.interpreter + " " + scriptFilename,
 		Dir: s.getWorkingDirPath(workingDir),
 		Env: env.ToStringSlice(),
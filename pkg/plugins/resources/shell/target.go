@@ -1,20 +1,21 @@
 package shell
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (s *Shell) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (s *Shell) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
 	getDir := ""
 	if scm != nil {
 		getDir = scm.GetDirectory()
 	}
 
-	err := s.target(source, getDir, dryRun, resultTarget)
+	err := s.target(ctx, source, getDir, dryRun, resultTarget)
 	if err != nil {
 		return err
 	}
@@ -37,12 +38,13 @@ func (s *Shell) Target(source string, scm scm.ScmHandler, dryRun bool, resultTar
 //   - Any other exit code means "failed command with no change"
 //
 // The environment variable 'DRY_RUN' is set to true or false based on the input parameter (e.g. 'updatecli diff' or 'apply'?)
-func (s *Shell) target(source, workingDir string, dryRun bool, resultTarget *result.Target) error {
+func (s *Shell) target(ctx context.Context, source, workingDir string, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
 
 	// Ensure environment variable(s) are up to date
 	// either it already has a value specified, or it retrieves
 	// the value from the Updatecli process
-	err := s.spec.Environments.Load()
+	err := s.spec.Environments.Load(ctx)
 	if err != nil {
 		return err
 	}
@@ -72,7 +74,7 @@ func (s *Shell) target(source, workingDir string, dryRun bool, resultTarget *res
 		return fmt.Errorf("failed initializing source script - %s", err)
 	}
 
-	err = s.executeCommand(command{
+	err = s.executeCommand(ctx, command{
 		Cmd: s.interpreter + " " + scriptFilename,
 		Dir: s.getWorkingDirPath(workingDir),
 		Env: env.ToStringSlice(),
@@ -99,7 +101,7 @@ func (s *Shell) target(source, workingDir string, dryRun bool, resultTarget *res
 		resultTarget.Information = source
 
 		resultTarget.Result = result.SUCCESS
-		logrus.Info("No change detected")
+		logger.Info("No change detected")
 		return nil
 	}
 
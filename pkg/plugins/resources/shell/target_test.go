@@ -1,6 +1,7 @@
 package shell
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -84,7 +85,7 @@ func TestShell_Target(t *testing.T) {
 
 			gotResult := result.Target{}
 
-			err = s.Target(tt.source, nil, tt.dryrun, &gotResult)
+			err = s.Target(context.Background(), tt.source, nil, tt.dryrun, &gotResult)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -159,7 +160,7 @@ func TestShell_TargetFromSCM(t *testing.T) {
 			require.NoError(t, err)
 
 			gotResult := result.Target{}
-			err = s.Target(tt.source, &ms, tt.dryrun, &gotResult)
+			err = s.Target(context.Background(), tt.source, &ms, tt.dryrun, &gotResult)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -1,23 +1,25 @@
 package branch
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
-func (g *Stash) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (g *Stash) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
 
 	if scm != nil {
-		logrus.Warningf("scm not supported, ignoring")
+		logger.Warningf("scm not supported, ignoring")
 	}
 
 	if len(g.spec.Branch) == 0 {
 		g.spec.Branch = source
 	}
 
-	branches, err := g.SearchBranches()
+	branches, err := g.SearchBranches(ctx)
 	if err != nil {
 		return false, "", err
 	}
@@ -1,6 +1,7 @@
 package branch
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -8,8 +9,8 @@ import (
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
-func (g *Stash) Source(workingDir string, resultSource *result.Source) error {
-	versions, err := g.SearchBranches()
+func (g *Stash) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	versions, err := g.SearchBranches(ctx)
 
 	if err != nil {
 		return fmt.Errorf("searching Bitbucket branches: %w", err)
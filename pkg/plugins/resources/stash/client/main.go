@@ -10,6 +10,7 @@ import (
 	"github.com/drone/go-scm/scm/transport"
 	"github.com/drone/go-scm/scm/transport/oauth2"
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
 )
 
 // Spec defines a specification for a "bitbucket" resource
@@ -62,6 +63,7 @@ func New(s Spec) (Client, error) {
 		if len(s.Username) >= 0 {
 			client.Client = &http.Client{
 				Transport: &transport.BasicAuth{
+					Base:     httpclient.NewCachedTransport(http.DefaultTransport),
 					Username: s.Username,
 					Password: s.Token,
 				},
@@ -69,6 +71,7 @@ func New(s Spec) (Client, error) {
 		} else {
 			client.Client = &http.Client{
 				Transport: &oauth2.Transport{
+					Base: httpclient.NewCachedTransport(http.DefaultTransport),
 					Source: oauth2.StaticTokenSource(
 						&scm.Token{
 							Token: s.Token,
@@ -31,14 +31,14 @@ func (s *Stash) CreateAction(report reports.Action, resetDescription bool) error
 		body = s.spec.Body
 	}
 
-	// Check if a pull-request is already opened then exit early if it does.
-	exist, err := s.isPullRequestExist()
+	// Check if a pull-request is already opened then only reconcile its reviewers, assignees, and milestone.
+	existingPullRequest, err := s.getOpenPullRequest()
 	if err != nil {
 		return err
 	}
 
-	if exist {
-		return nil
+	if existingPullRequest != nil {
+		return s.updatePullRequest(existingPullRequest.Number)
 	}
 
 	// Test that both sourceBranch and targetBranch exists on remote before creating a new one
@@ -103,5 +103,5 @@ func (s *Stash) CreateAction(report reports.Action, resetDescription bool) error
 
 	logrus.Infof("Bitbucket pullrequest successfully opened on %q", pr.Link)
 
-	return nil
+	return s.updatePullRequest(pr.Number)
 }
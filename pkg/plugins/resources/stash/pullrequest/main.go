@@ -22,6 +22,16 @@ type Spec struct {
 	Title string `yaml:",inline,omitempty"`
 	// Body defines the Bitbucket pullrequest body
 	Body string `yaml:",inline,omitempty"`
+	// Reviewers defines the Bitbucket usernames requested to review the pullrequest.
+	// Reviewers are only added, existing pullrequest reviewers are kept.
+	Reviewers []string `yaml:",omitempty"`
+	// TeamReviewers defines the Bitbucket reviewer groups, configured on the repository or its project,
+	// whose users are requested to review the pullrequest.
+	TeamReviewers []string `yaml:",omitempty"`
+	// Assignees is not supported by Bitbucket Server and is ignored with a warning.
+	Assignees []string `yaml:",omitempty"`
+	// Milestone is not supported by Bitbucket Server and is ignored with a warning.
+	Milestone string `yaml:",omitempty"`
 }
 
 // Bitbucket contains information to interact with Bitbucket api
@@ -0,0 +1,201 @@
+package pullrequest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/sirupsen/logrus"
+)
+
+// stashUser contains the Bitbucket user information returned by the Bitbucket api
+type stashUser struct {
+	Name string `json:"name"`
+}
+
+// stashParticipant contains the Bitbucket pullrequest participant information returned by the Bitbucket api
+type stashParticipant struct {
+	User stashUser `json:"user"`
+	Role string    `json:"role,omitempty"`
+}
+
+// stashPullRequest contains the Bitbucket pullrequest participants returned by the Bitbucket api
+type stashPullRequest struct {
+	ID        int                `json:"id"`
+	Author    stashParticipant   `json:"author"`
+	Reviewers []stashParticipant `json:"reviewers"`
+}
+
+// stashReviewerGroups contains a page of reviewer groups returned by the Bitbucket api
+type stashReviewerGroups struct {
+	Values []struct {
+		Name  string      `json:"name"`
+		Users []stashUser `json:"users"`
+	} `json:"values"`
+	IsLastPage    bool `json:"isLastPage"`
+	NextPageStart int  `json:"nextPageStart"`
+}
+
+// updatePullRequest ensures that the pullrequest reviewers match the ones defined by the spec.
+// Reviewers are only added, so we don't override people added manually.
+func (s *Stash) updatePullRequest(id int) error {
+
+	if len(s.spec.Assignees) > 0 {
+		logrus.Warningln("Bitbucket Server doesn't support pullrequest assignees, ignoring them")
+	}
+
+	if len(s.spec.Milestone) > 0 {
+		logrus.Warningln("Bitbucket Server doesn't support pullrequest milestones, ignoring it")
+	}
+
+	if len(s.spec.Reviewers) == 0 && len(s.spec.TeamReviewers) == 0 {
+		return nil
+	}
+
+	// Timeout api query after 30sec
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	repository := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s", url.PathEscape(s.Owner), url.PathEscape(s.Repository))
+
+	var pr stashPullRequest
+	err := s.do(ctx, http.MethodGet, fmt.Sprintf("%s/pull-requests/%d", repository, id), nil, &pr)
+	if err != nil {
+		return fmt.Errorf("get Bitbucket pullrequest %d: %w", id, err)
+	}
+
+	reviewers := s.spec.Reviewers
+	for _, group := range s.spec.TeamReviewers {
+		users, err := s.getReviewerGroupUsers(ctx, group)
+		if err != nil {
+			return err
+		}
+		reviewers = append(reviewers, users...)
+	}
+
+	existingReviewers := []string{}
+	for _, reviewer := range pr.Reviewers {
+		existingReviewers = append(existingReviewers, reviewer.User.Name)
+	}
+
+	for _, reviewer := range reviewers {
+		reviewer = strings.TrimPrefix(reviewer, "@")
+
+		if strings.EqualFold(reviewer, pr.Author.User.Name) {
+			logrus.Debugf("Skipping reviewer %q as it's the pullrequest author", reviewer)
+			continue
+		}
+
+		if containsFold(existingReviewers, reviewer) {
+			continue
+		}
+
+		participant := stashParticipant{
+			User: stashUser{Name: reviewer},
+			Role: "REVIEWER",
+		}
+
+		err = s.do(ctx, http.MethodPost, fmt.Sprintf("%s/pull-requests/%d/participants", repository, id), participant, nil)
+		if err != nil {
+			return fmt.Errorf("add Bitbucket pullrequest %d reviewer %q: %w", id, reviewer, err)
+		}
+
+		existingReviewers = append(existingReviewers, reviewer)
+		logrus.Infof("Bitbucket pullrequest %d review requested to %q", id, reviewer)
+	}
+
+	return nil
+}
+
+// getReviewerGroupUsers queries the Bitbucket api to retrieve the users of a reviewer group
+// available for the repository
+func (s *Stash) getReviewerGroupUsers(ctx context.Context, group string) ([]string, error) {
+	start := 0
+	for {
+		var page stashReviewerGroups
+
+		path := fmt.Sprintf("rest/api/latest/projects/%s/repos/%s/settings/reviewer-groups?limit=100&start=%d",
+			url.PathEscape(s.Owner),
+			url.PathEscape(s.Repository),
+			start)
+
+		err := s.do(ctx, http.MethodGet, path, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf("get Bitbucket reviewer groups: %w", err)
+		}
+
+		for _, reviewerGroup := range page.Values {
+			if !strings.EqualFold(reviewerGroup.Name, group) {
+				continue
+			}
+
+			users := []string{}
+			for _, user := range reviewerGroup.Users {
+				users = append(users, user.Name)
+			}
+			return users, nil
+		}
+
+		if page.IsLastPage {
+			break
+		}
+		start = page.NextPageStart
+	}
+
+	return nil, fmt.Errorf("Bitbucket reviewer group %q not found", group)
+}
+
+// do sends a request to the Bitbucket api and decodes the json response in out, if not nil
+func (s *Stash) do(ctx context.Context, method, path string, in, out interface{}) error {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+
+	if in != nil {
+		body, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		req.Header = map[string][]string{
+			"Content-Type": {"application/json"},
+		}
+		req.Body = bytes.NewReader(body)
+	}
+
+	res, err := (*scm.Client)(s.client).Do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.Status >= 300 {
+		body, _ := io.ReadAll(res.Body)
+		logrus.Debugf("RC: %d\nBody:\n%s", res.Status, body)
+		return fmt.Errorf("unexpected http status %d", res.Status)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// containsFold reports whether s is in list, ignoring case
+func containsFold(list []string, s string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package pullrequest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/stash/client"
+)
+
+func TestUpdatePullRequest(t *testing.T) {
+	testData := []struct {
+		name string
+		spec Spec
+		// pullRequest is the pullrequest returned by the Bitbucket api
+		pullRequest          string
+		expectedParticipants []stashParticipant
+		wantErr              bool
+	}{
+		{
+			name: "New pullrequest",
+			spec: Spec{
+				Reviewers:     []string{"alice", "updatecli-bot"},
+				TeamReviewers: []string{"maintainers"},
+				Assignees:     []string{"carol"},
+				Milestone:     "v1.0.0",
+			},
+			pullRequest: `{"id": 4, "author": {"user": {"name": "updatecli-bot"}}}`,
+			expectedParticipants: []stashParticipant{
+				{User: stashUser{Name: "alice"}, Role: "REVIEWER"},
+				{User: stashUser{Name: "bob"}, Role: "REVIEWER"},
+				{User: stashUser{Name: "dave"}, Role: "REVIEWER"},
+			},
+		},
+		{
+			name: "Existing pullrequest with partial reviewers",
+			spec: Spec{
+				Reviewers:     []string{"Alice"},
+				TeamReviewers: []string{"Maintainers"},
+			},
+			pullRequest: `{
+				"id": 4,
+				"author": {"user": {"name": "updatecli-bot"}},
+				"reviewers": [{"user": {"name": "alice"}}, {"user": {"name": "bob"}}]
+			}`,
+			expectedParticipants: []stashParticipant{
+				{User: stashUser{Name: "dave"}, Role: "REVIEWER"},
+			},
+		},
+		{
+			name: "Unknown reviewer group",
+			spec: Spec{
+				TeamReviewers: []string{"security"},
+			},
+			pullRequest: `{"id": 4}`,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var gotParticipants []stashParticipant
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /rest/api/1.0/projects/UPD/repos/updatecli/pull-requests/4", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, tt.pullRequest)
+			})
+			mux.HandleFunc("POST /rest/api/1.0/projects/UPD/repos/updatecli/pull-requests/4/participants", func(w http.ResponseWriter, r *http.Request) {
+				var participant stashParticipant
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&participant))
+				mu.Lock()
+				gotParticipants = append(gotParticipants, participant)
+				mu.Unlock()
+				fmt.Fprint(w, `{}`)
+			})
+			mux.HandleFunc("GET /rest/api/latest/projects/UPD/repos/updatecli/settings/reviewer-groups", func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Query().Get("start") {
+				case "0":
+					fmt.Fprint(w, `{"values": [{"name": "frontend", "users": [{"name": "erin"}]}], "isLastPage": false, "nextPageStart": 1}`)
+				default:
+					fmt.Fprint(w, `{"values": [{"name": "maintainers", "users": [{"name": "bob"}, {"name": "dave"}]}], "isLastPage": true}`)
+				}
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			c, err := client.New(client.Spec{URL: server.URL, Username: "updatecli-bot", Token: "xxx"})
+			require.NoError(t, err)
+
+			s := Stash{
+				spec:       tt.spec,
+				client:     c,
+				Owner:      "UPD",
+				Repository: "updatecli",
+			}
+
+			err = s.updatePullRequest(4)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedParticipants, gotParticipants)
+		})
+	}
+}
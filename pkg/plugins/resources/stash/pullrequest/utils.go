@@ -10,8 +10,9 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-// isPullRequestExist queries a remote Bitbucket instance to know if a pullrequest already exists.
-func (s *Stash) isPullRequestExist() (bool, error) {
+// getOpenPullRequest queries a remote Bitbucket instance to retrieve an already opened pullrequest.
+// It returns nil if no such pullrequest exists.
+func (s *Stash) getOpenPullRequest() (*scm.PullRequest, error) {
 	ctx := context.Background()
 	// Timeout api query after 30sec
 	ctx, cancelList := context.WithTimeout(ctx, 30*time.Second)
@@ -34,7 +35,7 @@ func (s *Stash) isPullRequestExist() (bool, error) {
 
 	if err != nil {
 		logrus.Debugf("RC: %d\nBody:\n%s", resp.Status, resp.Body)
-		return false, err
+		return nil, err
 	}
 
 	if resp.Status > 400 {
@@ -47,14 +48,14 @@ func (s *Stash) isPullRequestExist() (bool, error) {
 			!p.Closed &&
 			!p.Merged {
 
-			logrus.Infof("%s Nothing else to do, our pullrequest already exist on:\n\t%s",
+			logrus.Infof("%s Our pullrequest already exist on:\n\t%s",
 				result.SUCCESS,
 				p.Link)
 
-			return true, nil
+			return p, nil
 		}
 	}
-	return false, nil
+	return nil, nil
 }
 
 // isRemoteBranchesExist queries a remote Bitbucket instance to know if both the pull-request source branch and the target branch exist.
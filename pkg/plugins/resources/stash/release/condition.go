@@ -1,20 +1,22 @@
 package release
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (g *Stash) Condition(source string, scm scm.ScmHandler, resultCondition *result.Condition) error {
+func (g *Stash) Condition(ctx context.Context, source string, scm scm.ScmHandler, resultCondition *result.Condition) error {
+	logger := log.FromContext(ctx)
 
 	if scm != nil {
-		logrus.Warningf("scm not supported, ignoring")
+		logger.Warningf("scm not supported, ignoring")
 	}
 
-	releases, err := g.SearchReleases()
+	releases, err := g.SearchReleases(ctx)
 	if err != nil {
 		return fmt.Errorf("looking for releases: %w", err)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/drone/go-scm/scm"
 	"github.com/mitchellh/mapstructure"
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/stash/client"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
@@ -110,9 +111,9 @@ func New(spec interface{}) (*Stash, error) {
 }
 
 // Retrieve git tags from a remote bitbucket repository
-func (g *Stash) SearchReleases() ([]string, error) {
+func (g *Stash) SearchReleases(ctx context.Context) ([]string, error) {
+	logger := log.FromContext(ctx)
 
-	ctx := context.Background()
 	// Timeout api query after 30sec
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
@@ -133,7 +134,7 @@ func (g *Stash) SearchReleases() ([]string, error) {
 	}
 
 	if resp.Status > 400 {
-		logrus.Debugf("Bitbucket Api Response:\n%+v", resp)
+		logger.Debugf("Bitbucket Api Response:\n%+v", resp)
 	}
 
 	results := []string{}
@@ -1,18 +1,21 @@
 package release
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
-func (g *Stash) Source(workingDir string, resultSource *result.Source) error {
-	versions, err := g.SearchReleases()
+func (g *Stash) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
+
+	versions, err := g.SearchReleases(ctx)
 
 	if err != nil {
-		logrus.Error(err)
+		logger.Error(err)
 		return fmt.Errorf("searching Bitbucket releases: %w", err)
 	}
 
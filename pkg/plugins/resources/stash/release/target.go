@@ -7,12 +7,14 @@ import (
 	"time"
 
 	goscm "github.com/drone/go-scm/scm"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (g Stash) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget result.Target) error {
+func (g Stash) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget result.Target) error {
+	logger := log.FromContext(ctx)
+
 	if len(g.spec.Tag) == 0 {
 		g.spec.Tag = source
 	}
@@ -22,19 +24,17 @@ func (g Stash) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 	}
 
 	if len(g.spec.Commitish) == 0 {
-		logrus.Warningf("No commitish provided, fallback to branch %q\n", "main")
+		logger.Warningf("No commitish provided, fallback to branch %q\n", "main")
 		g.spec.Commitish = "main"
 	}
 
 	// Ensure that a release doesn't exist yet
-
-	ctx := context.Background()
 	// Timeout api query after 30 second
-	ctx, cancelListQuery := context.WithTimeout(ctx, 30*time.Second)
+	listCtx, cancelListQuery := context.WithTimeout(ctx, 30*time.Second)
 	defer cancelListQuery()
 
 	releases, resp, err := g.client.Releases.List(
-		ctx,
+		listCtx,
 		strings.Join([]string{g.spec.Owner, g.spec.Repository}, "/"),
 		goscm.ReleaseListOptions{
 			Page:   1,
@@ -45,7 +45,7 @@ func (g Stash) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 	)
 
 	if err != nil {
-		logrus.Debugf("Bitbucket Api Response:\nReturn Code: %q\nBody:\n%s", resp.Status, resp.Body)
+		logger.Debugf("Bitbucket Api Response:\nReturn Code: %q\nBody:\n%s", resp.Status, resp.Body)
 		return err
 	}
 
@@ -74,13 +74,12 @@ func (g Stash) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 
 	// Create a new release as it doesn't exist yet
 
-	ctx = context.Background()
 	// Timeout api query after 30 second
-	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	createCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	release, resp, err := g.client.Releases.Create(
-		ctx,
+		createCtx,
 		strings.Join([]string{g.spec.Owner, g.spec.Repository}, "/"),
 		&goscm.ReleaseInput{
 			Title:       g.spec.Title,
@@ -97,7 +96,7 @@ func (g Stash) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 	}
 
 	if resp.Status >= 400 {
-		logrus.Debugf("RC: %q\nBody:\n%s", resp.Status, resp.Body)
+		logger.Debugf("RC: %q\nBody:\n%s", resp.Status, resp.Body)
 		return fmt.Errorf("error from Bitbucket api: %v", resp.Status)
 	}
 
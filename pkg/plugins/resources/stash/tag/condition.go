@@ -1,16 +1,18 @@
 package tag
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
-func (g *Stash) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (g *Stash) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
 
 	if scm != nil {
-		logrus.Warningf("scm not supported, ignored")
+		logger.Warningf("scm not supported, ignored")
 	}
 
 	tag := source
@@ -18,7 +20,7 @@ func (g *Stash) Condition(source string, scm scm.ScmHandler) (pass bool, message
 		tag = g.spec.Tag
 	}
 
-	tags, err := g.SearchTags()
+	tags, err := g.SearchTags(ctx)
 	if err != nil {
 		return false, "", fmt.Errorf("looking for tag: %w", err)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/drone/go-scm/scm"
 	"github.com/mitchellh/mapstructure"
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/stash/client"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
@@ -94,10 +95,10 @@ func New(spec interface{}) (*Stash, error) {
 }
 
 // Retrieve git tags from a remote bitbucket repository
-func (g *Stash) SearchTags() (tags []string, err error) {
+func (g *Stash) SearchTags(ctx context.Context) (tags []string, err error) {
+	logger := log.FromContext(ctx)
 
 	// Timeout api query after 30sec
-	ctx := context.Background()
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
@@ -116,7 +117,7 @@ func (g *Stash) SearchTags() (tags []string, err error) {
 	}
 
 	if resp.Status > 400 {
-		logrus.Debugf("RC: %q\nBody:\n%s", resp.Status, resp.Body)
+		logger.Debugf("RC: %q\nBody:\n%s", resp.Status, resp.Body)
 	}
 
 	for _, ref := range references {
@@ -1,6 +1,7 @@
 package temurin
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	httputils "github.com/updatecli/updatecli/pkg/plugins/utils/http"
 )
 
@@ -22,27 +24,29 @@ const signaturesEndpoint = "/signature/version"
 const parseVersionEndpoint = "/version"
 const releaseNamesEndpoint = "/info/release_names"
 
-func (t Temurin) apiPerformHttpReq(endpoint string, webClient httpclient.HTTPClient) (body []byte, locationHeader string, err error) {
+func (t Temurin) apiPerformHttpReq(ctx context.Context, endpoint string, webClient httpclient.HTTPClient) (body []byte, locationHeader string, err error) {
+	logger := log.FromContext(ctx)
+
 	url := temurinApiUrl + endpoint
 
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
-		logrus.Errorf("something went wrong while performing a request to %q :\n%q\n", url, err)
+		logger.Errorf("something went wrong while performing a request to %q :\n%q\n", url, err)
 		return []byte{}, "", err
 	}
 
 	req.Header.Set("User-Agent", httputils.UserAgent)
 
-	logrus.Debugf("[temurin] Performing an http GET request to %q...", url)
+	logger.Debugf("[temurin] Performing an http GET request to %q...", url)
 
 	res, err := webClient.Do(req)
 	if err != nil {
-		logrus.Errorf("something went wrong while performing a request to %q :\n%q\n", url, err)
+		logger.Errorf("something went wrong while performing a request to %q :\n%q\n", url, err)
 		return []byte{}, "", err
 	}
 	defer res.Body.Close()
 
-	logrus.Debugf("[temurin] API client returned the following response:\n%v\n", res)
+	logger.Debugf("[temurin] API client returned the following response:\n%v\n", res)
 
 	if res.StatusCode >= 400 {
 		_, _ = httputil.DumpResponse(res, false)
@@ -50,29 +54,29 @@ func (t Temurin) apiPerformHttpReq(endpoint string, webClient httpclient.HTTPCli
 	}
 
 	locationHeader = res.Header.Get("Location")
-	logrus.Debugf("[temurin] API client got the following Location header value: %q.", locationHeader)
+	logger.Debugf("[temurin] API client got the following Location header value: %q.", locationHeader)
 
 	body, err = io.ReadAll(res.Body)
 	if err != nil {
-		logrus.Errorf("something went wrong while performing a request to %q :\n%q\n", url, err)
+		logger.Errorf("something went wrong while performing a request to %q :\n%q\n", url, err)
 		return []byte{}, "", err
 	}
 
 	return body, locationHeader, nil
 }
 
-func (t Temurin) apiGetBody(endpoint string) (body []byte, err error) {
-	body, _, err = t.apiPerformHttpReq(endpoint, t.apiWebClient)
+func (t Temurin) apiGetBody(ctx context.Context, endpoint string) (body []byte, err error) {
+	body, _, err = t.apiPerformHttpReq(ctx, endpoint, t.apiWebClient)
 	return body, err
 }
 
-func (t Temurin) apiGetRedirectLocation(endpoint string) (redirectLocation string, err error) {
-	_, redirectLocation, err = t.apiPerformHttpReq(endpoint, t.apiWebRedirectionClient)
+func (t Temurin) apiGetRedirectLocation(ctx context.Context, endpoint string) (redirectLocation string, err error) {
+	_, redirectLocation, err = t.apiPerformHttpReq(ctx, endpoint, t.apiWebRedirectionClient)
 	return redirectLocation, err
 }
 
-func (t Temurin) apiGetLastFeatureRelease() (result int, err error) {
-	apiInfoReleases, err := t.apiGetInfoReleases()
+func (t Temurin) apiGetLastFeatureRelease(ctx context.Context) (result int, err error) {
+	apiInfoReleases, err := t.apiGetInfoReleases(ctx)
 	if err != nil {
 		return result, err
 	}
@@ -85,16 +89,18 @@ func (t Temurin) apiGetLastFeatureRelease() (result int, err error) {
 	return result, err
 }
 
-func (t Temurin) apiGetInfoReleases() (result *apiInfoReleases, err error) {
-	body, err := t.apiGetBody(availableReleasesEndpoint)
+func (t Temurin) apiGetInfoReleases(ctx context.Context) (result *apiInfoReleases, err error) {
+	logger := log.FromContext(ctx)
+
+	body, err := t.apiGetBody(ctx, availableReleasesEndpoint)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting Temurin API releases information %q\n", err)
+		logger.Errorf("something went wrong while getting Temurin API releases information %q\n", err)
 		return result, err
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		logrus.Errorf("something went wrong while decoding response from Temurin API releases information%q\n", err)
+		logger.Errorf("something went wrong while decoding response from Temurin API releases information%q\n", err)
 		return result, err
 	}
 
@@ -102,7 +108,7 @@ func (t Temurin) apiGetInfoReleases() (result *apiInfoReleases, err error) {
 }
 
 func (t Temurin) apiGetArchitectures() (result []string, err error) {
-	body, err := t.apiGetBody(architecturesEndpoint)
+	body, err := t.apiGetBody(context.Background(), architecturesEndpoint)
 	if err != nil {
 		logrus.Errorf("something went wrong while getting Temurin API available architectures %q\n", err)
 		return result, err
@@ -118,7 +124,7 @@ func (t Temurin) apiGetArchitectures() (result []string, err error) {
 }
 
 func (t Temurin) apiGetOperatingSystems() (result []string, err error) {
-	body, err := t.apiGetBody(osEndpoints)
+	body, err := t.apiGetBody(context.Background(), osEndpoints)
 	if err != nil {
 		logrus.Errorf("something went wrong while getting Temurin API available operating systems %q\n", err)
 		return result, err
@@ -133,34 +139,38 @@ func (t Temurin) apiGetOperatingSystems() (result []string, err error) {
 	return result, nil
 }
 
-func (t Temurin) apiParseVersion(version string) (result parsedVersion, err error) {
+func (t Temurin) apiParseVersion(ctx context.Context, version string) (result parsedVersion, err error) {
+	logger := log.FromContext(ctx)
+
 	apiEndpoint := fmt.Sprintf(
 		"%s/%s",
 		parseVersionEndpoint,
 		version,
 	)
 
-	body, err := t.apiGetBody(apiEndpoint)
+	body, err := t.apiGetBody(ctx, apiEndpoint)
 	if err != nil {
-		logrus.Errorf("something went wrong while parsing the version %q with the Temurin API available operating systems %q\n", version, err)
+		logger.Errorf("something went wrong while parsing the version %q with the Temurin API available operating systems %q\n", version, err)
 		return result, err
 	}
 
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		logrus.Errorf("something went wrong while decoding response from Temurin API %q\n", err)
+		logger.Errorf("something went wrong while decoding response from Temurin API %q\n", err)
 		return result, err
 	}
 
 	return result, nil
 }
 
-func (t Temurin) apiGetReleaseName() (result string, err error) {
+func (t Temurin) apiGetReleaseName(ctx context.Context) (result string, err error) {
+	logger := log.FromContext(ctx)
+
 	var versionRange string
 
 	// If user specified a custom version, we have to normalize and validate it
 	if t.spec.SpecificVersion != "" {
-		parsedVersion, err := t.apiParseVersion(t.spec.SpecificVersion)
+		parsedVersion, err := t.apiParseVersion(ctx, t.spec.SpecificVersion)
 		if err != nil {
 			return "", err
 		}
@@ -177,7 +187,7 @@ func (t Temurin) apiGetReleaseName() (result string, err error) {
 	} else {
 		featureVersion := t.spec.FeatureVersion
 		if featureVersion == 0 {
-			featureVersion, err = t.apiGetLastFeatureRelease()
+			featureVersion, err = t.apiGetLastFeatureRelease(ctx)
 			if err != nil {
 				return "", err
 			}
@@ -196,23 +206,23 @@ func (t Temurin) apiGetReleaseName() (result string, err error) {
 		url.QueryEscape(versionRange),
 	)
 
-	logrus.Debugf("[temurin] using API endpoint %q", apiEndpoint)
+	logger.Debugf("[temurin] using API endpoint %q", apiEndpoint)
 
-	body, err := t.apiGetBody(apiEndpoint)
+	body, err := t.apiGetBody(ctx, apiEndpoint)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting Temurin API latest release information %q\n", err)
+		logger.Errorf("something went wrong while getting Temurin API latest release information %q\n", err)
 		return result, err
 	}
 
 	var apiResult releaseInformation
 	err = json.Unmarshal(body, &apiResult)
 	if err != nil {
-		logrus.Debugf("[temurin] Failed decoding the response: %q\n", err)
+		logger.Debugf("[temurin] Failed decoding the response: %q\n", err)
 		return result, fmt.Errorf("[temurin] No release found matching provided criteria. Use '--debug' to get details.")
 	}
 
 	if len(apiResult.Releases) == 0 {
-		logrus.Debug("[temurin] empty response for 'release_names'.")
+		logger.Debug("[temurin] empty response for 'release_names'.")
 		return result, fmt.Errorf("[temurin] No release found matching provided criteria. Use '--debug' to get details.")
 	}
 
@@ -220,7 +230,9 @@ func (t Temurin) apiGetReleaseName() (result string, err error) {
 	return apiResult.Releases[0], nil
 }
 
-func (t Temurin) apiGetInstallerUrl(releaseName string) (result string, err error) {
+func (t Temurin) apiGetInstallerUrl(ctx context.Context, releaseName string) (result string, err error) {
+	logger := log.FromContext(ctx)
+
 	apiEndpoint := fmt.Sprintf(
 		"%s/%s/%s/%s/%s/hotspot/normal/eclipse?project=%s",
 		installersEndpoint,
@@ -231,17 +243,19 @@ func (t Temurin) apiGetInstallerUrl(releaseName string) (result string, err erro
 		t.spec.Project,
 	)
 
-	logrus.Debugf("[temurin] using API endpoint %q", apiEndpoint)
-	locationHeader, err := t.apiGetRedirectLocation(apiEndpoint)
+	logger.Debugf("[temurin] using API endpoint %q", apiEndpoint)
+	locationHeader, err := t.apiGetRedirectLocation(ctx, apiEndpoint)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting Temurin API latest release information %q\n", err)
+		logger.Errorf("something went wrong while getting Temurin API latest release information %q\n", err)
 		return result, err
 	}
 
 	return locationHeader, nil
 }
 
-func (t Temurin) apiGetChecksumUrl(releaseName string) (result string, err error) {
+func (t Temurin) apiGetChecksumUrl(ctx context.Context, releaseName string) (result string, err error) {
+	logger := log.FromContext(ctx)
+
 	apiEndpoint := fmt.Sprintf(
 		"%s/%s/%s/%s/%s/hotspot/normal/eclipse?project=%s",
 		checksumsEndpoint,
@@ -252,18 +266,20 @@ func (t Temurin) apiGetChecksumUrl(releaseName string) (result string, err error
 		t.spec.Project,
 	)
 
-	logrus.Debugf("[temurin] using API endpoint %q", apiEndpoint)
+	logger.Debugf("[temurin] using API endpoint %q", apiEndpoint)
 
-	installerChecksumUrl, err := t.apiGetRedirectLocation(apiEndpoint)
+	installerChecksumUrl, err := t.apiGetRedirectLocation(ctx, apiEndpoint)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting Temurin API latest release information %q\n", err)
+		logger.Errorf("something went wrong while getting Temurin API latest release information %q\n", err)
 		return result, err
 	}
 
 	return installerChecksumUrl, nil
 }
 
-func (t Temurin) apiGetSignatureUrl(releaseName string) (result string, err error) {
+func (t Temurin) apiGetSignatureUrl(ctx context.Context, releaseName string) (result string, err error) {
+	logger := log.FromContext(ctx)
+
 	apiEndpoint := fmt.Sprintf(
 		"%s/%s/%s/%s/%s/hotspot/normal/eclipse?project=%s",
 		signaturesEndpoint,
@@ -274,11 +290,11 @@ func (t Temurin) apiGetSignatureUrl(releaseName string) (result string, err erro
 		t.spec.Project,
 	)
 
-	logrus.Debugf("[temurin] using API endpoint %q", apiEndpoint)
+	logger.Debugf("[temurin] using API endpoint %q", apiEndpoint)
 
-	signatureUrl, err := t.apiGetRedirectLocation(apiEndpoint)
+	signatureUrl, err := t.apiGetRedirectLocation(ctx, apiEndpoint)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting Temurin API latest release information %q\n", err)
+		logger.Errorf("something went wrong while getting Temurin API latest release information %q\n", err)
 		return result, err
 	}
 
@@ -1,6 +1,7 @@
 package temurin
 
 // import (
+// 	"context"
 // 	"net/http"
 // 	"testing"
 
@@ -46,7 +47,7 @@ package temurin
 // 			// 	},
 // 			// }
 
-// 			got, _, gotErr := sut.Condition(tt.source, tt.scm)
+// 			got, _, gotErr := sut.Condition(context.Background(), tt.source, tt.scm)
 
 // 			if tt.wantErr != nil {
 // 				require.Error(t, gotErr)
@@ -57,7 +57,7 @@ func New(spec interface{}) (*Temurin, error) {
 
 	newResource := &Temurin{
 		spec:         newSpec,
-		apiWebClient: &http.Client{},
+		apiWebClient: httpclient.NewClient(),
 		apiWebRedirectionClient: &http.Client{
 			CheckRedirect: func(req *http.Request, via []*http.Request) error {
 				return http.ErrUseLastResponse
@@ -1,14 +1,15 @@
 package temurin
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (t *Temurin) Source(workingDir string, resultSource *result.Source) error {
+func (t *Temurin) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
 	// Start by getting the version (required in any case)
-	releaseName, err := t.apiGetReleaseName()
+	releaseName, err := t.apiGetReleaseName(ctx)
 	if err != nil {
 		resultSource.Result = result.FAILURE
 		return err
@@ -24,7 +25,7 @@ func (t *Temurin) Source(workingDir string, resultSource *result.Source) error {
 		return nil
 
 	case "installer_url":
-		installerUrl, err := t.apiGetInstallerUrl(releaseName)
+		installerUrl, err := t.apiGetInstallerUrl(ctx, releaseName)
 		if err != nil {
 			resultSource.Result = result.FAILURE
 			return err
@@ -35,7 +36,7 @@ func (t *Temurin) Source(workingDir string, resultSource *result.Source) error {
 		return nil
 
 	case "checksum_url":
-		installerChecksumUrl, err := t.apiGetChecksumUrl(releaseName)
+		installerChecksumUrl, err := t.apiGetChecksumUrl(ctx, releaseName)
 		if err != nil {
 			resultSource.Result = result.FAILURE
 			return err
@@ -46,7 +47,7 @@ func (t *Temurin) Source(workingDir string, resultSource *result.Source) error {
 		return nil
 
 	case "signature_url":
-		signatureUrl, err := t.apiGetSignatureUrl(releaseName)
+		signatureUrl, err := t.apiGetSignatureUrl(ctx, releaseName)
 		if err != nil {
 			resultSource.Result = result.FAILURE
 			return err
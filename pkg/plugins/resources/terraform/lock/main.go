@@ -10,7 +10,7 @@ import (
 	terraformRegistryAddress "github.com/hashicorp/terraform-registry-address"
 	"github.com/minamijoyo/tfupdate/lock"
 	"github.com/mitchellh/mapstructure"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/text"
 	terraformUtils "github.com/updatecli/updatecli/pkg/plugins/resources/terraform"
@@ -164,13 +164,15 @@ func (t *TerraformLock) Read() error {
 	return nil
 }
 
-func (t *TerraformLock) UpdateAbsoluteFilePath(workDir string) {
+func (t *TerraformLock) UpdateAbsoluteFilePath(ctx context.Context, workDir string) {
+	logger := log.FromContext(ctx)
+
 	for filePath := range t.files {
 		if workDir != "" {
 			f := t.files[filePath]
 			f.filePath = utils.JoinFilePathWithWorkingDirectoryPath(f.originalFilePath, workDir)
-			logrus.Debugf(workDir)
-			logrus.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", f.originalFilePath, f.filePath)
+			logger.Debugf(workDir)
+			logger.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", f.originalFilePath, f.filePath)
 			t.files[filePath] = f
 		}
 	}
@@ -1,19 +1,22 @@
 package lock
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sort"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (t *TerraformLock) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (t *TerraformLock) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		t.UpdateAbsoluteFilePath(scm.GetDirectory())
+		t.UpdateAbsoluteFilePath(ctx, scm.GetDirectory())
 	}
 
 	for _, resourceFile := range t.files {
@@ -33,7 +36,7 @@ func (t *TerraformLock) Target(source string, scm scm.ScmHandler, dryRun bool, r
 	valueToWrite := source
 	if t.spec.Value != "" {
 		valueToWrite = t.spec.Value
-		logrus.Debug("Using spec.Value instead of source input value.")
+		logger.Debug("Using spec.Value instead of source input value.")
 	}
 
 	resultTarget.NewInformation = valueToWrite
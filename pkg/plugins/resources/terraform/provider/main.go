@@ -9,7 +9,7 @@ import (
 	"github.com/hashicorp/hcl/v2/hclwrite"
 	"github.com/minamijoyo/tfupdate/tfupdate"
 	"github.com/mitchellh/mapstructure"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/text"
 	terraformUtils "github.com/updatecli/updatecli/pkg/plugins/resources/terraform"
@@ -161,13 +161,15 @@ func (t *TerraformProvider) Read() error {
 	return nil
 }
 
-func (t *TerraformProvider) UpdateAbsoluteFilePath(workDir string) {
+func (t *TerraformProvider) UpdateAbsoluteFilePath(ctx context.Context, workDir string) {
+	logger := log.FromContext(ctx)
+
 	for filePath := range t.files {
 		if workDir != "" {
 			f := t.files[filePath]
 			f.filePath = utils.JoinFilePathWithWorkingDirectoryPath(f.originalFilePath, workDir)
-			logrus.Debugf(workDir)
-			logrus.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", f.originalFilePath, f.filePath)
+			logger.Debugf(workDir)
+			logger.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", f.originalFilePath, f.filePath)
 			t.files[filePath] = f
 		}
 	}
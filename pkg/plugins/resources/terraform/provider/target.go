@@ -1,18 +1,21 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
-func (t *TerraformProvider) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (t *TerraformProvider) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		t.UpdateAbsoluteFilePath(scm.GetDirectory())
+		t.UpdateAbsoluteFilePath(ctx, scm.GetDirectory())
 	}
 
 	for _, resourceFile := range t.files {
@@ -32,7 +35,7 @@ func (t *TerraformProvider) Target(source string, scm scm.ScmHandler, dryRun boo
 	valueToWrite := source
 	if t.spec.Value != "" {
 		valueToWrite = t.spec.Value
-		logrus.Debug("Using spec.Value instead of source input value.")
+		logger.Debug("Using spec.Value instead of source input value.")
 	}
 
 	resultTarget.NewInformation = valueToWrite
@@ -1,18 +1,21 @@
 package registry
 
 import (
+	"context"
 	"fmt"
 	"slices"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Condition checks if a specific version is published
-func (t *TerraformRegistry) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (t *TerraformRegistry) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	logger := log.FromContext(ctx)
+
 	if scm != nil {
-		logrus.Debugln("scm is not supported")
+		logger.Debugln("scm is not supported")
 	}
 
 	versionToCheck := t.Spec.Version
@@ -24,7 +27,7 @@ func (t *TerraformRegistry) Condition(source string, scm scm.ScmHandler) (pass b
 		return false, "", fmt.Errorf("%s version undefined", result.FAILURE)
 	}
 
-	versions, err := t.versions()
+	versions, err := t.versions(ctx)
 	if err != nil {
 		return false, "", fmt.Errorf("%s retrieving terraform registry version: %w", result.FAILURE, err)
 	}
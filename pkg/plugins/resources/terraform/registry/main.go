@@ -1,8 +1,6 @@
 package registry
 
 import (
-	"net/http"
-
 	"github.com/mitchellh/mapstructure"
 	"github.com/updatecli/updatecli/pkg/core/httpclient"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
@@ -33,7 +31,7 @@ func New(spec interface{}) (*TerraformRegistry, error) {
 		newFilter.Pattern = "*"
 	}
 
-	webClient := http.DefaultClient
+	webClient := httpclient.NewClient()
 
 	registryAddress, err := newRegistryAddress(webClient, newSpec)
 	if err != nil {
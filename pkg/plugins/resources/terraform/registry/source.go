@@ -1,14 +1,15 @@
 package registry
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Source returns the latest version
-func (t *TerraformRegistry) Source(workingDir string, resultSource *result.Source) error {
-	_, err := t.versions()
+func (t *TerraformRegistry) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	_, err := t.versions(ctx)
 	if err != nil {
 		return fmt.Errorf("%s retrieving terraform registry version: %w", result.FAILURE, err)
 	}
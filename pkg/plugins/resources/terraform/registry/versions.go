@@ -1,6 +1,7 @@
 package registry
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -8,7 +9,7 @@ import (
 	"net/http/httputil"
 	"sort"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 )
 
 type versionItem struct {
@@ -55,7 +56,9 @@ type versionListing interface {
 	scm() (scm string, err error)
 }
 
-func (t *TerraformRegistry) versions() (versions []string, err error) {
+func (t *TerraformRegistry) versions(ctx context.Context) (versions []string, err error) {
+	logger := log.FromContext(ctx)
+
 	req, err := http.NewRequest("GET", t.registryAddress.API(), nil)
 	if err != nil {
 		return nil, err
@@ -69,7 +72,7 @@ func (t *TerraformRegistry) versions() (versions []string, err error) {
 	defer res.Body.Close()
 	if res.StatusCode >= 400 {
 		body, err := httputil.DumpResponse(res, false)
-		logrus.Debugf("\n%v\n", string(body))
+		logger.Debugf("\n%v\n", string(body))
 		return nil, err
 	}
 
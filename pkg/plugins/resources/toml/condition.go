@@ -1,13 +1,14 @@
 package toml
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 )
 
-func (t *Toml) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (t *Toml) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
 	conditionResult := true
 
 	resultMessage := ""
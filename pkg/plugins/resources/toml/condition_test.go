@@ -1,6 +1,7 @@
 package toml
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -99,7 +100,7 @@ func TestCondition(t *testing.T) {
 
 			require.NoError(t, err)
 
-			gotResult, _, gotErr := toml.Condition("", nil)
+			gotResult, _, gotErr := toml.Condition(context.Background(), "", nil)
 
 			if tt.wantErr {
 				assert.Equal(t, tt.expectedErrorMsg.Error(), gotErr.Error())
@@ -1,6 +1,7 @@
 package toml
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -133,7 +134,7 @@ func TestTarget(t *testing.T) {
 
 			gotResult := result.Target{}
 
-			err = j.Target(tt.sourceInput, nil, true, &gotResult)
+			err = j.Target(context.Background(), tt.sourceInput, nil, true, &gotResult)
 
 			if tt.wantErr {
 				assert.Equal(t, tt.expectedErrorMsg.Error(), err.Error())
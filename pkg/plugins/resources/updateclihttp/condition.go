@@ -1,6 +1,7 @@
 package updateclihttp
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -12,11 +13,11 @@ import (
 Condition tests if the response of the specified HTTP request meets assertion.
 If no assertion is specified, it only checks for successful HTTP response code (HTTP/1xx, HTTP/2xx or HTTP/3xx).
 */
-func (h *Http) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (h *Http) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
 	var failureMessages []string
 	conditionResult := true
 
-	httpRes, err := h.performHttpRequest()
+	httpRes, err := h.performHttpRequest(ctx)
 	if err != nil {
 		return false, "", err
 	}
@@ -1,6 +1,7 @@
 package updateclihttp
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/log"
 )
 
 // Http defines a resource of type "http"
@@ -43,8 +45,17 @@ func New(spec interface{}) (*Http, error) {
 		}
 	}
 
-	httpClient := http.DefaultClient
-	httpClient.Transport = httpclient.NewThrottledTransport(1*time.Second, 1, http.DefaultTransport)
+	// Unset retry settings are taken from the global retry policy
+	transport, err := httpclient.NewRetryTransport(
+		newSpec.Retry,
+		httpclient.NewThrottledTransport(1*time.Second, 1, http.DefaultTransport))
+	if err != nil {
+		return nil, fmt.Errorf("spec.retry: %w", err)
+	}
+
+	httpClient := &http.Client{
+		Transport: transport,
+	}
 
 	// Do not follow redirect as per https://pkg.go.dev/net/http when we want to get a header from original request
 	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
@@ -79,14 +90,16 @@ func (h *Http) Changelog() string {
 	return h.spec.Url
 }
 
-func (h *Http) performHttpRequest() (*http.Response, error) {
-	logrus.Debugf("[http] Request to execute: %v", h.httpReq)
+func (h *Http) performHttpRequest(ctx context.Context) (*http.Response, error) {
+	logger := log.FromContext(ctx)
+
+	logger.Debugf("[http] Request to execute: %v", h.httpReq)
 	httpRes, err := h.httpClient.Do(h.httpReq)
 	if err != nil {
 		return nil, err
 	}
 
-	logrus.Debugf("[http] Response received: %v", httpRes)
+	logger.Debugf("[http] Response received: %v", httpRes)
 
 	return httpRes, nil
 }
@@ -1,10 +1,16 @@
 package updateclihttp
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 func TestNew(t *testing.T) {
@@ -110,3 +116,28 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestNewUsesGlobalRetryPolicy(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("1.0.0"))
+	}))
+	defer server.Close()
+
+	defaultRetryPolicy := httpclient.DefaultRetryPolicy
+	httpclient.DefaultRetryPolicy = httpclient.RetryPolicy{BaseDelay: "1ms", MaxDelay: "1ms"}
+	defer func() { httpclient.DefaultRetryPolicy = defaultRetryPolicy }()
+
+	sut, err := New(Spec{Url: server.URL})
+	require.NoError(t, err)
+
+	got := result.Source{}
+	require.NoError(t, sut.Source(context.Background(), "", &got))
+
+	assert.Equal(t, "1.0.0", got.Information)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+}
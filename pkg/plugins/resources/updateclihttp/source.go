@@ -1,19 +1,22 @@
 package updateclihttp
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Source returns content from the response of the specified HTTP request (defaults to the body).
-func (h *Http) Source(workingDir string, resultSource *result.Source) error {
+func (h *Http) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
+
 	resultSource.Result = result.FAILURE
 
-	httpRes, err := h.performHttpRequest()
+	httpRes, err := h.performHttpRequest(ctx)
 	if err != nil {
 		return err
 	}
@@ -28,7 +31,7 @@ func (h *Http) Source(workingDir string, resultSource *result.Source) error {
 
 	if h.spec.ReturnResponseHeader != "" {
 		resultSource.Information = httpRes.Header.Get(h.spec.ReturnResponseHeader)
-		logrus.Debugf("[http] source: header %q found with %d characters", h.spec.ReturnResponseHeader, len(resultSource.Information))
+		logger.Debugf("[http] source: header %q found with %d characters", h.spec.ReturnResponseHeader, len(resultSource.Information))
 	} else {
 		b, err := io.ReadAll(httpRes.Body)
 		if err != nil {
@@ -36,7 +39,7 @@ func (h *Http) Source(workingDir string, resultSource *result.Source) error {
 		}
 		bodyContent := string(b)
 
-		logrus.Debugf("[http] source: response body received (with %d characters)", len(bodyContent))
+		logger.Debugf("[http] source: response body received (with %d characters)", len(bodyContent))
 
 		resultSource.Information = bodyContent
 	}
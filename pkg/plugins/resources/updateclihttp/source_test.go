@@ -1,6 +1,7 @@
 package updateclihttp
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
@@ -97,7 +98,7 @@ func TestSource(t *testing.T) {
 			}
 
 			got := result.Source{}
-			gotErr := sut.Source(tt.workingDir, &got)
+			gotErr := sut.Source(context.Background(), tt.workingDir, &got)
 
 			if tt.wantErr != nil {
 				require.Error(t, gotErr)
@@ -1,5 +1,7 @@
 package updateclihttp
 
+import "github.com/updatecli/updatecli/pkg/core/httpclient"
+
 /*
 Spec defines a specification for a "http" resource
 parsed from an updatecli manifest file.
@@ -21,6 +23,10 @@ type Spec struct {
 		[C] Specifies a set of custom assertions on the HTTP response for the condition.
 	*/
 	ResponseAsserts ResponseAsserts
+	/*
+		[S][C] Specifies how failed HTTP requests are retried. Unset settings are taken from the global "--http-retry-*" flags.
+	*/
+	Retry httpclient.RetryPolicy `yaml:",omitempty"`
 }
 
 type Request struct {
@@ -1,6 +1,7 @@
 package updateclihttp
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
@@ -8,6 +9,6 @@ import (
 )
 
 // Target is not implemented. If you ever feel the need, you can still open a GitHub issue with a valid usecase.
-func (h *Http) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (h *Http) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
 	return fmt.Errorf("Target not supported for the plugin http")
 }
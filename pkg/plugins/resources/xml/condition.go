@@ -1,6 +1,7 @@
 package xml
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/beevik/etree"
@@ -8,7 +9,7 @@ import (
 )
 
 // Condition checks that a specific xml path contains the correct value at the specified path
-func (x *XML) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (x *XML) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
 
 	resourceFile := x.spec.File
 	if scm != nil {
@@ -35,7 +36,7 @@ func (x *XML) Condition(source string, scm scm.ScmHandler) (pass bool, message s
 		return false, "", err
 	}
 
-	elem := doc.FindElement(x.spec.Path)
+	elem := findNode(doc, x.spec.Path)
 
 	if elem == nil {
 		return false, fmt.Sprintf("nothing found in path %q from file %q",
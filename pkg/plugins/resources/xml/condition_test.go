@@ -1,6 +1,7 @@
 package xml
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -77,16 +78,32 @@ func TestCondition(t *testing.T) {
 			},
 			expectedResult: false,
 		},
+		{
+			spec: Spec{
+				File:  "testdata/data_3.xml",
+				Path:  "/Project/ItemGroup/PackageReference[@Include='Newtonsoft.Json']/@Version",
+				Value: "13.0.1",
+			},
+			expectedResult: true,
+		},
+		{
+			spec: Spec{
+				File:  "testdata/data_3.xml",
+				Path:  "/Project/ItemGroup/PackageReference[@Include='Newtonsoft.Json']/@Version",
+				Value: "13.0.3",
+			},
+			expectedResult: false,
+		},
 	}
 
 	for _, tt := range testData {
 
 		t.Run(tt.name, func(t *testing.T) {
-			x, err := New(tt.spec)
+			x, err := New(context.Background(), tt.spec)
 
 			require.NoError(t, err)
 
-			gotResult, _, gotErr := x.Condition("", nil)
+			gotResult, _, gotErr := x.Condition(context.Background(), "", nil)
 
 			if tt.wantErr {
 				assert.Equal(t, tt.expectedErrorMsg.Error(), gotErr.Error())
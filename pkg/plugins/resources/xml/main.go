@@ -1,11 +1,12 @@
 package xml
 
 import (
+	"context"
 	"errors"
 	"strings"
 
 	"github.com/mitchellh/mapstructure"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/text"
 )
 
@@ -21,7 +22,7 @@ type XML struct {
 	currentContent   string
 }
 
-func New(spec interface{}) (*XML, error) {
+func New(ctx context.Context, spec interface{}) (*XML, error) {
 
 	newSpec := Spec{}
 
@@ -37,7 +38,7 @@ func New(spec interface{}) (*XML, error) {
 		contentRetriever: &text.Text{},
 	}
 
-	err = x.Validate()
+	err = x.Validate(ctx)
 
 	if err != nil {
 		return nil, err
@@ -57,12 +58,14 @@ func (x *XML) Read(filename string) error {
 }
 
 // Validate checks if the Spec is correctly defined
-func (x *XML) Validate() (err error) {
+func (x *XML) Validate(ctx context.Context) (err error) {
+	logger := log.FromContext(ctx)
+
 	errs := x.spec.Validate()
 
 	if len(errs) > 0 {
 		for _, e := range errs {
-			logrus.Errorf(e.Error())
+			logger.Errorf(e.Error())
 		}
 		return ErrWrongSpec
 	}
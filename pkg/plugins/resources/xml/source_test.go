@@ -1,6 +1,7 @@
 package xml
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -51,17 +52,34 @@ func TestSource(t *testing.T) {
 			},
 			expectedResult: "Belgian Waffles",
 		},
+		{
+			name: "scenario 4 - attribute",
+			spec: Spec{
+				File: "testdata/data_3.xml",
+				Path: "/Project/ItemGroup/PackageReference[@Include='Serilog']/@Version",
+			},
+			expectedResult: "3.1.1",
+		},
+		{
+			name: "scenario 5 - missing attribute",
+			spec: Spec{
+				File: "testdata/data_3.xml",
+				Path: "/Project/ItemGroup/PackageReference[@Include='Serilog']/@Exclude",
+			},
+			wantErr:          true,
+			expectedErrorMsg: "cannot find value for path \"/Project/ItemGroup/PackageReference[@Include='Serilog']/@Exclude\" from file \"testdata/data_3.xml\"",
+		},
 	}
 
 	for _, tt := range testData {
 
 		t.Run(tt.name, func(t *testing.T) {
-			x, err := New(tt.spec)
+			x, err := New(context.Background(), tt.spec)
 
 			require.NoError(t, err)
 
 			gotResult := result.Source{}
-			err = x.Source("", &gotResult)
+			err = x.Source(context.Background(), "", &gotResult)
 
 			switch tt.wantErr {
 			case true:
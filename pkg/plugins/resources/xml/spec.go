@@ -31,6 +31,10 @@ type Spec struct {
 			* path: "/project/parent/version"
 			* path: "//breakfast_menu/food[0]/name"
 			* path: "//book[@category='WEB']/title"
+			* path: "/Project/ItemGroup/PackageReference[@Include='Newtonsoft.Json']/@Version"
+
+		remark:
+			* a trailing "/@<name>" segment selects the attribute "<name>" of the matching element
 	*/
 	Path string `yaml:",omitempty"`
 	/*
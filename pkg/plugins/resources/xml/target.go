@@ -1,6 +1,7 @@
 package xml
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -11,7 +12,7 @@ import (
 )
 
 // Target updates a scm repository based on the modified yaml file.
-func (x *XML) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) (err error) {
+func (x *XML) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) (err error) {
 
 	if strings.HasPrefix(x.spec.File, "https://") ||
 		strings.HasPrefix(x.spec.File, "http://") {
@@ -45,7 +46,7 @@ func (x *XML) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarge
 		return err
 	}
 
-	elem := doc.FindElement(x.spec.Path)
+	elem := findNode(doc, x.spec.Path)
 	if elem == nil {
 		return fmt.Errorf("nothing found at path %q from file %q", x.spec.Path, resourceFile)
 	}
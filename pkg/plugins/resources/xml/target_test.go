@@ -1,6 +1,7 @@
 package xml
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -87,17 +88,35 @@ func TestTarget(t *testing.T) {
 			expectedResult:   false,
 			expectedErrorMsg: errors.New("URL scheme is not supported for XML target: \"https://raw.githubusercontent.com/updatecli/updatecli/main/pkg/plugins/resources/xml/testdata/data_2.xml\""),
 		},
+		{
+			name: "Test 8",
+			spec: Spec{
+				File:  "testdata/data_3.xml",
+				Path:  "/Project/ItemGroup/PackageReference[@Include='Serilog']/@Version",
+				Value: "3.1.1",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "Test 9",
+			spec: Spec{
+				File:  "testdata/data_3.xml",
+				Path:  "/Project/ItemGroup/PackageReference[@Include='Serilog']/@Version",
+				Value: "4.0.0",
+			},
+			expectedResult: true,
+		},
 	}
 
 	for _, tt := range testData {
 
 		t.Run(tt.name, func(t *testing.T) {
-			x, err := New(tt.spec)
+			x, err := New(context.Background(), tt.spec)
 
 			require.NoError(t, err)
 
 			gotResult := result.Target{}
-			err = x.Target("", nil, true, &gotResult)
+			err = x.Target(context.Background(), "", nil, true, &gotResult)
 
 			if tt.wantErr {
 				assert.Equal(t, tt.expectedErrorMsg.Error(), err.Error())
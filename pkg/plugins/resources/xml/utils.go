@@ -2,7 +2,15 @@ package xml
 
 import (
 	"path/filepath"
+	"regexp"
 	"strings"
+
+	"github.com/beevik/etree"
+)
+
+var (
+	// attributeSegmentRegex matches a xpath segment selecting an attribute such as "@Version"
+	attributeSegmentRegex = regexp.MustCompile(`^@[\w:.-]+$`)
 )
 
 // joinPathwithworkingDirectoryPath To merge File path with current working dire, unless file is an http url
@@ -16,3 +24,51 @@ func joinPathWithWorkingDirectoryPath(fileName, workingDir string) string {
 
 	return filepath.Join(workingDir, fileName)
 }
+
+// node is the result of a xpath query, either an element or one of its attributes
+type node struct {
+	elem *etree.Element
+	// attr is the name of the attribute selected by the xpath query, if any
+	attr string
+}
+
+// findNode returns the node matching the xpath query.
+// A trailing "/@name" segment, such as "/Project/ItemGroup/PackageReference[@Include='Newtonsoft.Json']/@Version",
+// selects an attribute of the matching element.
+func findNode(doc *etree.Document, path string) *node {
+	elemPath, attr := path, ""
+	if i := strings.LastIndex(path, "/"); i > 0 && attributeSegmentRegex.MatchString(path[i+1:]) {
+		elemPath, attr = path[:i], path[i+2:]
+	}
+
+	elem := doc.FindElement(elemPath)
+	if elem == nil {
+		return nil
+	}
+
+	if attr != "" && elem.SelectAttr(attr) == nil {
+		return nil
+	}
+
+	return &node{
+		elem: elem,
+		attr: attr,
+	}
+}
+
+// Text returns the element text or the attribute value
+func (n *node) Text() string {
+	if n.attr != "" {
+		return n.elem.SelectAttrValue(n.attr, "")
+	}
+	return n.elem.Text()
+}
+
+// SetText updates the element text or the attribute value
+func (n *node) SetText(value string) {
+	if n.attr != "" {
+		n.elem.CreateAttr(n.attr, value)
+		return
+	}
+	n.elem.SetText(value)
+}
@@ -1,6 +1,7 @@
 package yaml
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -14,7 +15,7 @@ import (
 )
 
 // Condition checks if a key exists in a yaml file
-func (y *Yaml) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+func (y *Yaml) Condition(ctx context.Context, source string, scm scm.ScmHandler) (pass bool, message string, err error) {
 
 	var errorMessages []error
 
@@ -29,7 +30,7 @@ func (y *Yaml) Condition(source string, scm scm.ScmHandler) (pass bool, message
 		workDir = scm.GetDirectory()
 	}
 
-	if err := y.initFiles(workDir); err != nil {
+	if err := y.initFiles(ctx, workDir); err != nil {
 		return false, "", fmt.Errorf("init yaml files: %w", err)
 	}
 
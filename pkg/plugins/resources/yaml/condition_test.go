@@ -1,6 +1,7 @@
 package yaml
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -534,13 +535,13 @@ repos:
 				Err:      tt.mockedError,
 			}
 
-			y, err := New(tt.spec)
+			y, err := New(context.Background(), tt.spec)
 			y.contentRetriever = &mockedText
 			y.files = tt.files
 
 			assert.NoError(t, err)
 
-			gotResult, _, gotErr := y.Condition(tt.inputSourceValue, nil)
+			gotResult, _, gotErr := y.Condition(context.Background(), tt.inputSourceValue, nil)
 			if tt.isErrorWanted {
 				assert.Error(t, gotErr)
 				return
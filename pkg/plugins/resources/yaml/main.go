@@ -1,11 +1,12 @@
 package yaml
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/mitchellh/mapstructure"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/text"
 	"github.com/updatecli/updatecli/pkg/plugins/utils"
@@ -143,7 +144,7 @@ type file struct {
 
 // New returns a reference to a newly initialized Yaml object from a Spec
 // or an error if the provided YamlSpec triggers a validation error.
-func New(spec interface{}) (*Yaml, error) {
+func New(ctx context.Context, spec interface{}) (*Yaml, error) {
 	newSpec := Spec{}
 
 	err := mapstructure.Decode(spec, &newSpec)
@@ -156,7 +157,7 @@ func New(spec interface{}) (*Yaml, error) {
 		contentRetriever: &text.Text{},
 	}
 
-	newResource.spec.Key = sanitizeYamlPathKey(newResource.spec.Key)
+	newResource.spec.Key = sanitizeYamlPathKey(ctx, newResource.spec.Key)
 
 	err = newResource.spec.Validate()
 	if err != nil {
@@ -246,7 +247,9 @@ func (y *Yaml) Changelog() string {
 }
 
 // initFiles initializes the f.files map
-func (y *Yaml) initFiles(workDir string) error {
+func (y *Yaml) initFiles(ctx context.Context, workDir string) error {
+	logger := log.FromContext(ctx)
+
 	y.files = make(map[string]file)
 
 	// File as unique element of newResource.files
@@ -301,7 +304,7 @@ func (y *Yaml) initFiles(workDir string) error {
 			file := y.files[filePath]
 			file.filePath = joinPathWithWorkingDirectoryPath(file.originalFilePath, workDir)
 
-			logrus.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", file.originalFilePath, file.filePath)
+			logger.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", file.originalFilePath, file.filePath)
 			y.files[filePath] = file
 		}
 	}
@@ -309,13 +312,15 @@ func (y *Yaml) initFiles(workDir string) error {
 	return nil
 }
 
-func (y *Yaml) UpdateAbsoluteFilePath(workDir string) {
+func (y *Yaml) UpdateAbsoluteFilePath(ctx context.Context, workDir string) {
+	logger := log.FromContext(ctx)
+
 	for filePath := range y.files {
 		if workDir != "" {
 			file := y.files[filePath]
 			file.filePath = joinPathWithWorkingDirectoryPath(file.originalFilePath, workDir)
 
-			logrus.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", file.originalFilePath, file.filePath)
+			logger.Debugf("Relative path detected: changing from %q to absolute path from SCM: %q", file.originalFilePath, file.filePath)
 			y.files[filePath] = file
 		}
 	}
@@ -1,13 +1,14 @@
 package yaml
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 
 	goyaml "github.com/goccy/go-yaml"
 	"github.com/goccy/go-yaml/parser"
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/result"
 
 	"github.com/vmware-labs/yaml-jsonpath/pkg/yamlpath"
@@ -15,7 +16,9 @@ import (
 )
 
 // Source return the latest version
-func (y *Yaml) Source(workingDir string, resultSource *result.Source) error {
+func (y *Yaml) Source(ctx context.Context, workingDir string, resultSource *result.Source) error {
+	logger := log.FromContext(ctx)
+
 	// By default workingDir is set to local directory
 	var filePath string
 
@@ -33,19 +36,19 @@ func (y *Yaml) Source(workingDir string, resultSource *result.Source) error {
 
 	if len(y.files) > 1 {
 		validationError := fmt.Errorf("validation error in sources of type 'yaml': the attributes `spec.files` can't contain more than one element for sources")
-		logrus.Errorf(validationError.Error())
+		logger.Errorf(validationError.Error())
 		return validationError
 	}
 
 	if y.spec.Value != "" {
-		logrus.Warnf("Key 'Value' is not used by source YAML")
+		logger.Warnf("Key 'Value' is not used by source YAML")
 	}
 
 	if workingDir == currentWorkingDirectory {
 		workingDir = ""
 	}
 
-	if err := y.initFiles(workingDir); err != nil {
+	if err := y.initFiles(ctx, workingDir); err != nil {
 		return fmt.Errorf("init files: %w", err)
 	}
 
@@ -64,7 +67,7 @@ func (y *Yaml) Source(workingDir string, resultSource *result.Source) error {
 
 		// Ideally currentWorkingDirectory should be empty
 		if workingDir != currentWorkingDirectory {
-			y.UpdateAbsoluteFilePath(workingDir)
+			y.UpdateAbsoluteFilePath(ctx, workingDir)
 		}
 	}
 
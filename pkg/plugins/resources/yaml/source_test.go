@@ -1,6 +1,7 @@
 package yaml
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -237,7 +238,7 @@ repos:
 				Err:      tt.mockedError,
 			}
 
-			y, err := New(tt.spec)
+			y, err := New(context.Background(), tt.spec)
 			y.contentRetriever = &mockedText
 			y.files = tt.files
 
@@ -247,7 +248,7 @@ repos:
 			for filePath := range y.files {
 				gotResult := result.Source{}
 
-				gotErr := y.Source("", &gotResult)
+				gotErr := y.Source(context.Background(), "", &gotResult)
 				if tt.isErrorWanted {
 					assert.Error(t, gotErr)
 					return
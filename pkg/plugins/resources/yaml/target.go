@@ -2,14 +2,14 @@ package yaml
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"sort"
 	"strings"
 
-	"github.com/sirupsen/logrus"
-
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/text"
@@ -22,7 +22,9 @@ import (
 )
 
 // Target updates a scm repository based on the modified yaml file.
-func (y *Yaml) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+func (y *Yaml) Target(ctx context.Context, source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	logger := log.FromContext(ctx)
+
 	var err error
 
 	workDir := ""
@@ -30,7 +32,7 @@ func (y *Yaml) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 		workDir = scm.GetDirectory()
 	}
 
-	if err := y.initFiles(workDir); err != nil {
+	if err := y.initFiles(ctx, workDir); err != nil {
 		return fmt.Errorf("init files: %w", err)
 	}
 
@@ -39,7 +41,7 @@ func (y *Yaml) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 	}
 
 	// Test if target reference a file with a prefix like https:// or file://, as we don't know how to update those files.
-	if err = y.validateTargetFilePath(); err != nil {
+	if err = y.validateTargetFilePath(ctx); err != nil {
 		return fmt.Errorf("filepath validation error: %w", err)
 	}
 
@@ -50,7 +52,7 @@ func (y *Yaml) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 	valueToWrite := source
 	if y.spec.Value != "" {
 		valueToWrite = y.spec.Value
-		logrus.Debug("Using spec.Value instead of source input value.")
+		logger.Debug("Using spec.Value instead of source input value.")
 	}
 
 	resultTarget.NewInformation = valueToWrite
@@ -62,13 +64,13 @@ func (y *Yaml) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 
 	switch y.spec.Engine {
 	case EngineGoYaml, EngineDefault, EngineUndefined:
-		notChanged, ignoredFiles, err = y.goYamlTarget(valueToWrite, resultTarget, dryRun)
+		notChanged, ignoredFiles, err = y.goYamlTarget(ctx, valueToWrite, resultTarget, dryRun)
 		if err != nil {
 			return fmt.Errorf("updating yaml file: %w", err)
 		}
 
 	case EngineYamlPath:
-		notChanged, ignoredFiles, err = y.goYamlPathTarget(valueToWrite, resultTarget, dryRun)
+		notChanged, ignoredFiles, err = y.goYamlPathTarget(ctx, valueToWrite, resultTarget, dryRun)
 		if err != nil {
 			return fmt.Errorf("updating yaml file: %w", err)
 		}
@@ -102,7 +104,9 @@ func (y *Yaml) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarg
 	return nil
 }
 
-func (y Yaml) validateTargetFilePath() error {
+func (y Yaml) validateTargetFilePath(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
 	var errs []error
 	for _, file := range y.files {
 		if text.IsURL(file.originalFilePath) {
@@ -122,14 +126,15 @@ func (y Yaml) validateTargetFilePath() error {
 
 	if len(errs) > 0 {
 		for i := range errs {
-			logrus.Errorln(errs[i])
+			logger.Errorln(errs[i])
 		}
 		return fmt.Errorf("invalid target file path")
 	}
 	return nil
 }
 
-func (y Yaml) goYamlTarget(valueToWrite string, resultTarget *result.Target, dryRun bool) (notChanged int, ignoredFiles int, err error) {
+func (y Yaml) goYamlTarget(ctx context.Context, valueToWrite string, resultTarget *result.Target, dryRun bool) (notChanged int, ignoredFiles int, err error) {
+	logger := log.FromContext(ctx)
 
 	urlPath, err := goyaml.PathString(y.spec.Key)
 	if err != nil {
@@ -151,7 +156,7 @@ func (y Yaml) goYamlTarget(valueToWrite string, resultTarget *result.Target, dry
 					ignoredFiles++
 					// If search pattern is true then we don't want to return an error
 					// as we are probably trying to identify a file matching the key
-					logrus.Debugf("ignoring file %q: %s", originFilePath, err)
+					logger.Debugf("ignoring file %q: %s", originFilePath, err)
 					continue
 				}
 				return 0, ignoredFiles, fmt.Errorf("couldn't find key %q from file %q",
@@ -224,7 +229,9 @@ func (y Yaml) goYamlTarget(valueToWrite string, resultTarget *result.Target, dry
 	return notChanged, ignoredFiles, nil
 }
 
-func (y *Yaml) goYamlPathTarget(valueToWrite string, resultTarget *result.Target, dryRun bool) (notChanged int, ignoredFiles int, err error) {
+func (y *Yaml) goYamlPathTarget(ctx context.Context, valueToWrite string, resultTarget *result.Target, dryRun bool) (notChanged int, ignoredFiles int, err error) {
+	logger := log.FromContext(ctx)
+
 	urlPath, err := yamlpath.NewPath(y.spec.Key)
 	if err != nil {
 		return 0, 0, fmt.Errorf("crafting yamlpath query: %w", err)
@@ -256,7 +263,7 @@ func (y *Yaml) goYamlPathTarget(valueToWrite string, resultTarget *result.Target
 				ignoredFiles++
 				// If search pattern is true then we don't want to return an error
 				// as we are probably trying to identify a file matching the key
-				logrus.Debugf("ignoring file %q as we couldn't find key %q", originFilePath, y.spec.Key)
+				logger.Debugf("ignoring file %q as we couldn't find key %q", originFilePath, y.spec.Key)
 				continue
 			}
 			return 0, ignoredFiles, fmt.Errorf("couldn't find key %q from file %q",
@@ -285,7 +292,7 @@ func (y *Yaml) goYamlPathTarget(valueToWrite string, resultTarget *result.Target
 		}
 
 		if notChangedNode == len(nodes) {
-			logrus.Debugf("no change detected for %s", originFilePath)
+			logger.Debugf("no change detected for %s", originFilePath)
 			continue
 		}
 
@@ -1,6 +1,7 @@
 package yaml
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -285,14 +286,14 @@ github:
 				Err:      tt.mockedError,
 			}
 
-			y, err := New(tt.spec)
+			y, err := New(context.Background(), tt.spec)
 			y.contentRetriever = &mockedText
 			y.files = tt.files
 
 			assert.NoError(t, err)
 
 			gotResult := result.Target{}
-			gotErr := y.Target(tt.inputSourceValue, nil, tt.dryRun, &gotResult)
+			gotErr := y.Target(context.Background(), tt.inputSourceValue, nil, tt.dryRun, &gotResult)
 			if tt.wantedError {
 				assert.Error(t, gotErr)
 				return
@@ -419,14 +420,14 @@ github:
 				Err:      tt.mockedError,
 			}
 
-			y, err := New(tt.spec)
+			y, err := New(context.Background(), tt.spec)
 			y.contentRetriever = &mockedText
 			y.files = tt.files
 
 			assert.NoError(t, err)
 
 			gotResult := result.Target{}
-			gotErr := y.Target(tt.inputSourceValue, tt.scm, tt.dryRun, &gotResult)
+			gotErr := y.Target(context.Background(), tt.inputSourceValue, tt.scm, tt.dryRun, &gotResult)
 			if tt.wantedError {
 				assert.Error(t, gotErr)
 				return
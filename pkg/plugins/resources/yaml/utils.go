@@ -1,10 +1,11 @@
 package yaml
 
 import (
+	"context"
 	"path/filepath"
 	"strings"
 
-	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
 )
 
 // joinPathwithworkingDirectoryPath To merge File path with current workingDir, unless file is an HTTP URL
@@ -24,7 +25,8 @@ sanitizeYamlPathKey is a helper function to migrate the deprecated yaml key
 to the new syntax. We start by displaying a warning message, and the next step,
 will be to return an error.
 */
-func sanitizeYamlPathKey(key string) string {
+func sanitizeYamlPathKey(ctx context.Context, key string) string {
+	logger := log.FromContext(ctx)
 
 	elements := []string{}
 	tmpElements := strings.Split(key, `.`)
@@ -56,7 +58,7 @@ func sanitizeYamlPathKey(key string) string {
 	}
 
 	if sanitizedKey != key {
-		logrus.Warningf("current yaml key is %q and should be updated to %q", key, sanitizedKey)
+		logger.Warningf("current yaml key is %q and should be updated to %q", key, sanitizedKey)
 	}
 
 	return sanitizedKey
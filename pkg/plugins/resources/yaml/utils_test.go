@@ -1,6 +1,7 @@
 package yaml
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -79,7 +80,7 @@ func TestSanitizeYamlPathKey(t *testing.T) {
 
 	for _, tt := range testData {
 		t.Run(tt.key, func(t *testing.T) {
-			gotResult := sanitizeYamlPathKey(tt.key)
+			gotResult := sanitizeYamlPathKey(context.Background(), tt.key)
 
 			assert.Equal(t, tt.expectedResult, gotResult)
 		})
@@ -0,0 +1,265 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/tmp"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/git/commit"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/git/sign"
+
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
+)
+
+// Spec defines settings used to interact with an Azure DevOps git repository
+type Spec struct {
+	client.Spec `yaml:",inline,omitempty"`
+	//  "commitMessage" is used to generate the final commit message.
+	//
+	//  compatible:
+	//    * scm
+	//
+	//  remark:
+	//    it's worth mentioning that the commit message settings is applied to all targets linked to the same scm.
+	CommitMessage commit.Commit `yaml:",omitempty"`
+	//	"directory" defines the local path where the git repository is cloned.
+	//
+	//	compatible:
+	//	  * scm
+	//
+	//	remark:
+	//    Unless you know what you are doing, it is recommended to use the default value.
+	//	  The reason is that Updatecli may automatically clean up the directory after a pipeline execution.
+	//
+	//	default:
+	// 	  The default value is based on your local temporary directory like: (on Linux)
+	//	  /tmp/updatecli/azuredevops/<organization>/<project>/<repository>
+	Directory string `yaml:",omitempty"`
+	//  "email" defines the email used to commit changes.
+	//
+	//  compatible:
+	//    * scm
+	//
+	//  default:
+	//    default set to your global git configuration
+	Email string `yaml:",omitempty"`
+	//  "force" is used during the git push phase to run `git push --force`.
+	//
+	//  compatible:
+	//    * scm
+	//
+	//  default:
+	//    false
+	//
+	//  remark:
+	//    When force is set to true, Updatecli also recreates the working branches that
+	//    diverged from their base branch.
+	Force *bool `yaml:",omitempty"`
+	//  "gpg" specifies the GPG key and passphrased used for commit signing.
+	//
+	//  compatible:
+	//	  * scm
+	GPG sign.GPGSpec `yaml:",omitempty"`
+	//  "organization" defines the Azure DevOps organization.
+	//
+	//  compatible:
+	//    * scm
+	Organization string `yaml:",omitempty" jsonschema:"required"`
+	//  "project" defines the Azure DevOps project owning the repository.
+	//
+	//  compatible:
+	//    * scm
+	Project string `yaml:",omitempty" jsonschema:"required"`
+	//  "repository" specifies the name of a repository for a specific project.
+	//
+	//  compatible:
+	//    * action
+	//    * scm
+	Repository string `yaml:",omitempty" jsonschema:"required"`
+	//  "user" specifies the user associated with new git commit messages created by Updatecli.
+	//
+	//  compatible:
+	//    * scm
+	User string `yaml:",omitempty"`
+	//  "branch" defines the git branch to work on.
+	//
+	//  compatible:
+	//    * scm
+	//
+	//  default:
+	//    main
+	//
+	//  remark:
+	//    depending on which resource references the Azure DevOps scm, the behavior will be different.
+	//
+	//    If the scm is linked to a source or a condition (using scmid), the branch will be used to retrieve
+	//    file(s) from that branch.
+	//
+	//    If the scm is linked to target then Updatecli creates a new "working branch" based on the branch value.
+	//    The working branch created by Updatecli looks like "updatecli_<pipelineID>".
+	// 	  The working branch can be disabled using the "workingBranch" parameter set to false.
+	Branch string `yaml:",omitempty"`
+	//  "submodules" defines if Updatecli should checkout submodules.
+	//
+	//  compatible:
+	//	  * scm
+	//
+	//  default: true
+	Submodules *bool `yaml:",omitempty"`
+	//  "workingBranch" defines if Updatecli should use a temporary branch to work on.
+	//  If set to `true`, Updatecli create a temporary branch to work on, based on the branch value.
+	//
+	//  compatible:
+	//    * scm
+	//
+	//  default: true
+	WorkingBranch *bool `yaml:",omitempty"`
+}
+
+// AzureDevOps contains information to interact with Azure DevOps api
+type AzureDevOps struct {
+	force bool
+	// Spec contains inputs coming from updatecli configuration
+	Spec Spec
+	// client handle the api authentication
+	client client.Client
+	// pipelineID is used to create a unique working branch
+	pipelineID string
+	// nativeGitHandler is used to interact with the local git repository
+	nativeGitHandler gitgeneric.GitHandler
+	workingBranch    bool
+}
+
+// New returns a new valid AzureDevOps object.
+func New(spec interface{}, pipelineID string) (*AzureDevOps, error) {
+	var s Spec
+	var clientSpec client.Spec
+
+	// mapstructure.Decode cannot handle embedded fields
+	// hence we decode it in two steps
+	err := mapstructure.Decode(spec, &clientSpec)
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+
+	err = mapstructure.Decode(spec, &s)
+	if err != nil {
+		return &AzureDevOps{}, nil
+	}
+
+	s.Spec = clientSpec
+
+	err = s.Validate()
+
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+
+	if s.Directory == "" {
+		s.Directory = path.Join(tmp.Directory, "azuredevops", s.Organization, s.Project, s.Repository)
+	}
+
+	if len(s.Branch) == 0 {
+		logrus.Warningf("no git branch specified, fallback to %q", "main")
+		s.Branch = "main"
+	}
+
+	workingBranch := true
+	if s.WorkingBranch != nil {
+		workingBranch = *s.WorkingBranch
+	}
+
+	force := true
+	if s.Force != nil {
+		force = *s.Force
+	}
+
+	if force {
+		if !workingBranch && s.Force == nil {
+			errorMsg := fmt.Sprintf(`
+Better safe than sorry.
+
+Updatecli may be pushing unwanted changes to the branch %q.
+
+The Azure DevOps scm plugin has by default the force option set to true,
+The scm force option set to true means that Updatecli is going to run "git push --force"
+Some target plugin, like the shell one, run "git commit -A" to catch all changes done by that target.
+
+If you know what you are doing, please set the force option to true in your configuration file to ignore this error message.
+`, s.Branch)
+
+			logrus.Errorln(errorMsg)
+			return nil, errors.New("unclear configuration, better safe than sorry")
+
+		}
+	}
+
+	c, err := client.New(clientSpec)
+
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+
+	nativeGitHandler := gitgeneric.GoGit{}
+	a := AzureDevOps{
+		force:            force,
+		Spec:             s,
+		client:           c,
+		pipelineID:       pipelineID,
+		nativeGitHandler: nativeGitHandler,
+		workingBranch:    workingBranch,
+	}
+
+	a.setDirectory()
+
+	return &a, nil
+
+}
+
+// SearchTags retrieves git tags from a remote Azure DevOps repository
+func (a *AzureDevOps) SearchTags() (tags []string, err error) {
+
+	// Timeout api query after 30sec
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	return client.ListRefs(ctx, a.client, a.Spec.Organization, a.Spec.Project, a.Spec.Repository, "tags/")
+}
+
+func (s *Spec) Validate() error {
+	gotError := false
+	missingParameters := []string{}
+
+	if len(s.Organization) == 0 {
+		gotError = true
+		missingParameters = append(missingParameters, "organization")
+	}
+
+	if len(s.Project) == 0 {
+		gotError = true
+		missingParameters = append(missingParameters, "project")
+	}
+
+	if len(s.Repository) == 0 {
+		gotError = true
+		missingParameters = append(missingParameters, "repository")
+	}
+
+	if len(missingParameters) > 0 {
+		logrus.Errorf("missing parameter(s) [%s]", strings.Join(missingParameters, ","))
+	}
+
+	if gotError {
+		return fmt.Errorf("wrong Azure DevOps configuration")
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package azuredevops
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+)
+
+// GetBranches returns the source, working and target branches.
+func (a *AzureDevOps) GetBranches() (sourceBranch, workingBranch, targetBranch string) {
+	sourceBranch = a.Spec.Branch
+	workingBranch = a.Spec.Branch
+	targetBranch = a.Spec.Branch
+
+	if len(a.pipelineID) > 0 && a.workingBranch {
+		workingBranch = a.nativeGitHandler.SanitizeBranchName(fmt.Sprintf("updatecli_%s_%s", targetBranch, a.pipelineID))
+	}
+
+	return sourceBranch, workingBranch, targetBranch
+}
+
+// GetURL returns an "Azure DevOps" git URL
+func (a *AzureDevOps) GetURL() string {
+	URL := fmt.Sprintf("%s/%s/%s/_git/%s",
+		client.EnsureValidURL(a.Spec.URL),
+		url.PathEscape(a.Spec.Organization),
+		url.PathEscape(a.Spec.Project),
+		url.PathEscape(a.Spec.Repository))
+
+	return URL
+}
+
+// GetDirectory returns the local git repository path.
+func (a *AzureDevOps) GetDirectory() (directory string) {
+	return a.Spec.Directory
+}
+
+// Clean deletes Azure DevOps working directory.
+func (a *AzureDevOps) Clean() error {
+	err := os.RemoveAll(a.Spec.Directory)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Clone run `git clone`.
+func (a *AzureDevOps) Clone() (string, error) {
+	username, password := a.Spec.GitCredentials()
+
+	a.setDirectory()
+
+	err := a.nativeGitHandler.Clone(
+		username,
+		password,
+		a.GetURL(),
+		a.GetDirectory(),
+		a.Spec.Submodules,
+	)
+
+	if err != nil {
+		logrus.Errorf("failed cloning Azure DevOps repository %q", a.GetURL())
+		return "", err
+	}
+
+	return a.Spec.Directory, nil
+}
+
+// Commit run `git commit`.
+func (a *AzureDevOps) Commit(message string) error {
+
+	// Generate the conventional commit message
+	commitMessage, err := a.Spec.CommitMessage.Generate(message)
+	if err != nil {
+		return err
+	}
+
+	err = a.nativeGitHandler.Commit(
+		a.Spec.User,
+		a.Spec.Email,
+		commitMessage,
+		a.GetDirectory(),
+		a.Spec.GPG.SigningKey,
+		a.Spec.GPG.Passphrase,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Checkout create and then uses a temporary git branch.
+func (a *AzureDevOps) Checkout() error {
+	username, password := a.Spec.GitCredentials()
+	sourceBranch, workingBranch, _ := a.GetBranches()
+
+	err := a.nativeGitHandler.Checkout(
+		username,
+		password,
+		sourceBranch,
+		workingBranch,
+		a.Spec.Directory,
+		a.force,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Add run `git add`.
+func (a *AzureDevOps) Add(files []string) error {
+
+	err := a.nativeGitHandler.Add(files, a.Spec.Directory)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsRemoteBranchUpToDate checks if the branch reference name is published on
+// on the default remote
+func (a *AzureDevOps) IsRemoteBranchUpToDate() (bool, error) {
+	username, password := a.Spec.GitCredentials()
+	sourceBranch, workingBranch, _ := a.GetBranches()
+
+	return a.nativeGitHandler.IsLocalBranchPublished(
+		sourceBranch,
+		workingBranch,
+		username,
+		password,
+		a.GetDirectory())
+}
+
+// Push run `git push` to the corresponding Azure DevOps remote branch if not already created.
+func (a *AzureDevOps) Push() (bool, error) {
+	username, password := a.Spec.GitCredentials()
+
+	return a.nativeGitHandler.Push(
+		username,
+		password,
+		a.GetDirectory(),
+		a.force,
+	)
+}
+
+// PushTag push tags
+func (a *AzureDevOps) PushTag(tag string) error {
+	username, password := a.Spec.GitCredentials()
+
+	err := a.nativeGitHandler.PushTag(
+		tag,
+		username,
+		password,
+		a.GetDirectory(),
+		a.force,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PushBranch push branch
+func (a *AzureDevOps) PushBranch(branch string) error {
+	username, password := a.Spec.GitCredentials()
+
+	err := a.nativeGitHandler.PushBranch(
+		branch,
+		username,
+		password,
+		a.GetDirectory(),
+		a.force)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetChangedFiles returns a list of changed files
+func (a *AzureDevOps) GetChangedFiles(workingDir string) ([]string, error) {
+	return a.nativeGitHandler.GetChangedFiles(workingDir)
+}
@@ -0,0 +1,84 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "valid spec",
+			spec: map[string]interface{}{
+				"owner":      "updatecli",
+				"repository": "updatecli",
+				"branch":     "main",
+			},
+		},
+		{
+			name: "undecodable spec",
+			spec: map[string]interface{}{
+				"owner":      "updatecli",
+				"repository": []string{"updatecli"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing repository",
+			spec: map[string]interface{}{
+				"owner": "updatecli",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.spec, "")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSearchTags(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2.0/repositories/updatecli/updatecli/refs/tags", func(w http.ResponseWriter, r *http.Request) {
+		// Tags are spread over two pages to ensure we follow the "next" link
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprintf(w, `{"values": [{"name": "v0.1.0"}, {"name": "v0.2.0"}], "next": "http://%s%s?page=2"}`, r.Host, r.URL.Path)
+		case "2":
+			fmt.Fprint(w, `{"values": [{"name": "v0.3.0"}]}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	b, err := New(map[string]interface{}{
+		"url":        server.URL,
+		"owner":      "updatecli",
+		"repository": "updatecli",
+		"branch":     "main",
+	}, "")
+	require.NoError(t, err)
+
+	gotTags, err := b.SearchTags()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v0.1.0", "v0.2.0", "v0.3.0"}, gotTags)
+}
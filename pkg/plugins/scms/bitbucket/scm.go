@@ -0,0 +1,174 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+)
+
+func (b *Bitbucket) GetBranches() (sourceBranch, workingBranch, targetBranch string) {
+	sourceBranch = b.Spec.Branch
+	workingBranch = b.Spec.Branch
+	targetBranch = b.Spec.Branch
+
+	if len(b.pipelineID) > 0 && b.workingBranch {
+		workingBranch = b.nativeGitHandler.SanitizeBranchName(fmt.Sprintf("updatecli_%s_%s", targetBranch, b.pipelineID))
+	}
+
+	return sourceBranch, workingBranch, targetBranch
+}
+
+// GetURL returns a "Bitbucket" git URL
+func (b *Bitbucket) GetURL() string {
+	URL := fmt.Sprintf("%v/%v/%v.git",
+		b.Spec.GitURL(),
+		b.Spec.Owner,
+		b.Spec.Repository)
+
+	return URL
+}
+
+// GetDirectory returns the local git repository path.
+func (b *Bitbucket) GetDirectory() (directory string) {
+	return b.Spec.Directory
+}
+
+// Clean deletes github working directory.
+func (b *Bitbucket) Clean() error {
+	err := os.RemoveAll(b.Spec.Directory)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Clone run `git clone`.
+func (b *Bitbucket) Clone() (string, error) {
+	username, password := b.Spec.GitCredentials()
+
+	b.setDirectory()
+
+	err := b.nativeGitHandler.Clone(
+		username,
+		password,
+		b.GetURL(),
+		b.GetDirectory(),
+		b.Spec.Submodules,
+	)
+
+	if err != nil {
+		logrus.Errorf("failed cloning Bitbucket repository %q", b.GetURL())
+		return "", err
+	}
+
+	return b.Spec.Directory, nil
+}
+
+// Commit run `git commit`.
+func (b *Bitbucket) Commit(message string) error {
+
+	// Generate the conventional commit message
+	commitMessage, err := b.Spec.CommitMessage.Generate(message)
+	if err != nil {
+		return err
+	}
+
+	err = b.nativeGitHandler.Commit(b.Spec.User, b.Spec.Email, commitMessage, b.GetDirectory(), b.Spec.GPG.SigningKey, b.Spec.GPG.Passphrase)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Checkout create and then uses a temporary git branch.
+func (b *Bitbucket) Checkout() error {
+	username, password := b.Spec.GitCredentials()
+	sourceBranch, workingBranch, _ := b.GetBranches()
+
+	err := b.nativeGitHandler.Checkout(
+		username,
+		password,
+		sourceBranch,
+		workingBranch,
+		b.Spec.Directory,
+		b.force)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Add run `git add`.
+func (b *Bitbucket) Add(files []string) error {
+
+	err := b.nativeGitHandler.Add(files, b.Spec.Directory)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsRemoteBranchUpToDate checks if the branch reference name is published on
+// on the default remote
+func (b *Bitbucket) IsRemoteBranchUpToDate() (bool, error) {
+	username, password := b.Spec.GitCredentials()
+	sourceBranch, workingBranch, _ := b.GetBranches()
+
+	return b.nativeGitHandler.IsLocalBranchPublished(
+		sourceBranch,
+		workingBranch,
+		username,
+		password,
+		b.GetDirectory())
+}
+
+// Push run `git push` to the corresponding Bitbucket remote branch if not already created.
+func (b *Bitbucket) Push() (bool, error) {
+	username, password := b.Spec.GitCredentials()
+
+	return b.nativeGitHandler.Push(
+		username,
+		password,
+		b.GetDirectory(),
+		b.force)
+}
+
+// PushTag push tags
+func (b *Bitbucket) PushTag(tag string) error {
+	username, password := b.Spec.GitCredentials()
+
+	err := b.nativeGitHandler.PushTag(
+		tag,
+		username,
+		password,
+		b.GetDirectory(),
+		b.force,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PushBranch push branch
+func (b *Bitbucket) PushBranch(branch string) error {
+	username, password := b.Spec.GitCredentials()
+
+	err := b.nativeGitHandler.PushBranch(
+		branch,
+		username,
+		password,
+		b.GetDirectory(),
+		b.force)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (b *Bitbucket) GetChangedFiles(workingDir string) ([]string, error) {
+	return b.nativeGitHandler.GetChangedFiles(workingDir)
+}
@@ -0,0 +1,18 @@
+package bitbucket
+
+import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+)
+
+func (b *Bitbucket) setDirectory() {
+
+	if _, err := os.Stat(b.Spec.Directory); os.IsNotExist(err) {
+
+		err := os.MkdirAll(b.Spec.Directory, 0755)
+		if err != nil {
+			logrus.Errorf("err - %s", err)
+		}
+	}
+}
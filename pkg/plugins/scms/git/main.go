@@ -12,6 +12,7 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/tmp"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/git/commit"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/git/sign"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/git/ssh"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
 )
 
@@ -28,7 +29,7 @@ type Spec struct {
 	//
 	//	remarks:
 	//		when using the ssh protocol, the user must have the right to clone the repository
-	//		based on its local ssh configuration
+	//		based on its local ssh configuration, or based on the "ssh" setting
 	URL string `yaml:",omitempty" jsonschema:"required"`
 	//	"username" specifies the username when using the HTTP protocol
 	//
@@ -112,6 +113,14 @@ type Spec struct {
 	//	compatible:
 	//	  * scm
 	GPG sign.GPGSpec `yaml:",omitempty"`
+	//	"ssh" specifies the authentication used when the git url uses the ssh protocol
+	//
+	//	compatible:
+	//	  * scm
+	//
+	//	default:
+	//	  the ssh-agent and the default known_hosts files are used
+	SSH ssh.Spec `yaml:",omitempty"`
 	//  "submodules" defines if Updatecli should checkout submodules.
 	//
 	//  compatible:
@@ -178,7 +187,13 @@ If you know what you are doing, please set the workingBranch option to false in
 		workingBranch = *s.WorkingBranch
 	}
 
-	nativeGitHandler := gitgeneric.GoGit{}
+	if err := s.SSH.Validate(); err != nil {
+		return nil, err
+	}
+
+	nativeGitHandler := gitgeneric.GoGit{
+		SSH: s.SSH,
+	}
 
 	return &Git{
 		spec:             s,
@@ -223,6 +238,9 @@ func (gs *Spec) Merge(child interface{}) error {
 	if childGHSpec.Username != "" {
 		gs.Username = childGHSpec.Username
 	}
+	if !childGHSpec.SSH.IsZero() {
+		gs.SSH = childGHSpec.SSH
+	}
 	if childGHSpec.Submodules != nil {
 		gs.Submodules = childGHSpec.Submodules
 	}
@@ -0,0 +1,321 @@
+package ssh
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/sirupsen/logrus"
+	cryptossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	// KnownHostsPolicyStrict only accepts hosts already listed in a known_hosts file
+	KnownHostsPolicyStrict = "strict"
+	// KnownHostsPolicyAcceptNew adds unknown hosts to the known_hosts file
+	// but still rejects hosts presenting a key different from the known one
+	KnownHostsPolicyAcceptNew = "acceptnew"
+	// KnownHostsPolicyInsecure accepts any host key
+	KnownHostsPolicyInsecure = "insecure"
+
+	// defaultUser is the ssh user used when none is specified by the git url or the configuration
+	defaultUser = "git"
+)
+
+var (
+	// ErrWrongKnownHostsPolicy is returned when the known hosts policy is not supported
+	ErrWrongKnownHostsPolicy = errors.New("wrong known hosts policy")
+	// ErrNoAuthMethod is returned when ssh settings are defined without a private key or the ssh-agent
+	ErrNoAuthMethod = errors.New("no ssh authentication method defined, either privatekey or agent must be set")
+	// ErrConflictingAuthMethods is returned when both a private key and the ssh-agent are defined
+	ErrConflictingAuthMethods = errors.New("ssh privatekey and agent are mutually exclusive")
+)
+
+// Spec defines the specification for authenticating git operations over ssh.
+type Spec struct {
+	/*
+		user defines the ssh user used to authenticate.
+
+		default:
+			the user defined in the git url, like "git" in "git@github.com:updatecli/updatecli.git",
+			otherwise "git"
+	*/
+	User string `yaml:",omitempty"`
+	/*
+		privatekey defines the ssh private key used to authenticate.
+		It accepts either a path to a private key file or the private key content.
+
+		remark:
+			privatekey and agent are mutually exclusive, one of them must be set
+
+		default:
+			none
+	*/
+	PrivateKey string `yaml:",omitempty"`
+	/*
+		passphrase defines the passphrase used to decrypt the ssh private key
+	*/
+	Passphrase string `yaml:",omitempty"`
+	/*
+		agent enables authentication using the keys provided by the running ssh-agent,
+		identified by the SSH_AUTH_SOCK environment variable.
+
+		remark:
+			privatekey and agent are mutually exclusive, one of them must be set
+
+		default:
+			false
+	*/
+	Agent bool `yaml:",omitempty"`
+	/*
+		knownhostspolicy defines how the remote host key is verified.
+
+		accepted values:
+			* strict: only accept hosts listed in a known_hosts file
+			* acceptnew: add unknown hosts to the first known_hosts file, reject hosts with a changed key
+			* insecure: accept any host key
+
+		default:
+			strict
+	*/
+	KnownHostsPolicy string `yaml:",omitempty"`
+	/*
+		knownhostsfiles defines the list of known_hosts files used to verify the remote host key.
+
+		default:
+			the files defined by the SSH_KNOWN_HOSTS environment variable,
+			otherwise ~/.ssh/known_hosts and /etc/ssh/ssh_known_hosts
+	*/
+	KnownHostsFiles []string `yaml:",omitempty"`
+}
+
+// IsZero returns true if no ssh settings are defined
+func (s Spec) IsZero() bool {
+	return s.User == "" &&
+		s.PrivateKey == "" &&
+		s.Passphrase == "" &&
+		!s.Agent &&
+		s.KnownHostsPolicy == "" &&
+		len(s.KnownHostsFiles) == 0
+}
+
+// Validate checks that the ssh specification is valid
+func (s Spec) Validate() error {
+	if !s.IsZero() {
+		switch {
+		case s.PrivateKey != "" && s.Agent:
+			return ErrConflictingAuthMethods
+		case s.PrivateKey == "" && !s.Agent:
+			return ErrNoAuthMethod
+		}
+	}
+
+	switch s.KnownHostsPolicy {
+	case "", KnownHostsPolicyStrict, KnownHostsPolicyAcceptNew, KnownHostsPolicyInsecure:
+	default:
+		return fmt.Errorf("%w: %q, accepted values are [%s]",
+			ErrWrongKnownHostsPolicy,
+			s.KnownHostsPolicy,
+			strings.Join([]string{KnownHostsPolicyStrict, KnownHostsPolicyAcceptNew, KnownHostsPolicyInsecure}, ","))
+	}
+
+	return nil
+}
+
+// GetAuthMethod returns the go-git authentication method for the ssh endpoint.
+// endpointUser is the user defined by the git url, if any.
+func (s Spec) GetAuthMethod(endpointUser string) (transport.AuthMethod, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	user := s.User
+	if user == "" {
+		user = endpointUser
+	}
+	if user == "" {
+		user = defaultUser
+	}
+
+	hostKeyCallback, err := s.hostKeyCallback()
+	if err != nil {
+		return nil, fmt.Errorf("known hosts: %w", err)
+	}
+
+	if s.Agent {
+		logrus.Debugf("using ssh-agent to authenticate as %q", user)
+
+		auth, err := gitssh.NewSSHAgentAuth(user)
+		if err != nil {
+			return nil, fmt.Errorf("ssh-agent: %w", err)
+		}
+		auth.HostKeyCallback = hostKeyCallback
+
+		return auth, nil
+	}
+
+	var auth *gitssh.PublicKeys
+	switch isInlinePrivateKey(s.PrivateKey) {
+	case true:
+		logrus.Debugf("using inline ssh private key to authenticate as %q", user)
+		auth, err = gitssh.NewPublicKeys(user, []byte(s.PrivateKey), s.Passphrase)
+	case false:
+		var privateKeyPath string
+		privateKeyPath, err = expandHome(s.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("ssh private key: %w", err)
+		}
+
+		logrus.Debugf("using ssh private key %q to authenticate as %q", privateKeyPath, user)
+		auth, err = gitssh.NewPublicKeysFromFile(user, privateKeyPath, s.Passphrase)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("ssh private key: %w", err)
+	}
+	auth.HostKeyCallback = hostKeyCallback
+
+	return auth, nil
+}
+
+// hostKeyCallback returns the host key callback matching the known hosts policy
+func (s Spec) hostKeyCallback() (cryptossh.HostKeyCallback, error) {
+	switch s.KnownHostsPolicy {
+	case KnownHostsPolicyInsecure:
+		logrus.Warningln("ssh host key verification is disabled")
+		//nolint:gosec // explicitly requested by the user
+		return cryptossh.InsecureIgnoreHostKey(), nil
+
+	case KnownHostsPolicyAcceptNew:
+		return s.acceptNewHostKeyCallback()
+	}
+
+	files, err := s.knownHostsFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	return gitssh.NewKnownHostsCallback(files...)
+}
+
+// knownHostsFiles returns the known_hosts files defined by the configuration, with their home directory expanded
+func (s Spec) knownHostsFiles() ([]string, error) {
+	var files []string
+	for _, file := range s.KnownHostsFiles {
+		expanded, err := expandHome(file)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, expanded)
+	}
+
+	return files, nil
+}
+
+// acceptNewHostKeyCallback returns a host key callback which records unknown hosts
+// in the first known_hosts file, and rejects known hosts presenting a different key.
+func (s Spec) acceptNewHostKeyCallback() (cryptossh.HostKeyCallback, error) {
+	files, err := s.knownHostsFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		files = []string{filepath.Join(home, ".ssh", "known_hosts")}
+	}
+
+	// knownhosts.New requires every file to exist
+	if err := os.MkdirAll(filepath.Dir(files[0]), 0700); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(files[0], os.O_CREATE|os.O_RDONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	callback, err := knownhosts.New(files...)
+	if err != nil {
+		return nil, err
+	}
+
+	// accepted records the hosts added during the lifetime of the callback
+	// as the known_hosts files are only parsed once.
+	accepted := make(map[string][]byte)
+	mu := sync.Mutex{}
+
+	return func(hostname string, remote net.Addr, key cryptossh.PublicKey) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		host := knownhosts.Normalize(hostname)
+		if acceptedKey, ok := accepted[host]; ok {
+			if bytes.Equal(acceptedKey, key.Marshal()) {
+				return nil
+			}
+			return fmt.Errorf("ssh host key mismatch for %q", hostname)
+		}
+
+		err := callback(hostname, remote, key)
+
+		var keyErr *knownhosts.KeyError
+		if err == nil || !errors.As(err, &keyErr) || len(keyErr.Want) > 0 {
+			return err
+		}
+
+		// go-git probes the callback with a fake key to detect the host key algorithms,
+		// we only want to record keys presented by the remote host.
+		if _, parseErr := cryptossh.ParsePublicKey(key.Marshal()); parseErr != nil {
+			return err
+		}
+
+		logrus.Infof("adding ssh host %q to known hosts file %q", hostname, files[0])
+
+		f, err := os.OpenFile(files[0], os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		if _, err = f.WriteString(knownhosts.Line([]string{host}, key) + "\n"); err != nil {
+			return err
+		}
+
+		accepted[host] = key.Marshal()
+
+		return nil
+	}, nil
+}
+
+// isInlinePrivateKey returns true if the private key is provided as a PEM content instead of a file path
+func isInlinePrivateKey(key string) bool {
+	return strings.HasPrefix(strings.TrimSpace(key), "-----BEGIN")
+}
+
+// expandHome replaces a leading "~" in path by the current user home directory,
+// as shells do, so paths like "~/.ssh/id_ed25519" can be used in the configuration
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
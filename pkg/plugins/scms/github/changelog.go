@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 
 	"github.com/shurcooL/githubv4"
@@ -116,7 +117,7 @@ func (g *Github) ChangelogV3(version string) (string, error) {
 		req.Header.Add("X-GitHub-Api-Version", "2022-11-28")
 	}
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := httpclient.NewClient().Do(req)
 	if err != nil {
 		logrus.Debugf("failed to retrieve changelog from GitHub %v\n", err)
 		return "", err
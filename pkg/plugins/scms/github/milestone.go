@@ -0,0 +1,61 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/githubv4"
+)
+
+// milestonesQuery defines a github v4 API query to retrieve the open milestones of a repository matching a title
+/*
+https://developer.github.com/v4/explorer/
+
+query getMilestones{
+	repository(owner: "updatecli", name: "updatecli"){
+		milestones(first: 100, query: "v1.0.0", states: [OPEN]) {
+			nodes {
+				id
+				title
+			}
+		}
+	}
+}
+*/
+type milestonesQuery struct {
+	Repository struct {
+		Milestones struct {
+			Nodes []milestoneNode
+		} `graphql:"milestones(first: 100, query: $title, states: [OPEN])"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+type milestoneNode struct {
+	ID    string
+	Title string
+}
+
+// getMilestone queries GitHub Api to retrieve an open milestone by its title
+func (g *Github) getMilestone(owner, name, title string) (milestoneNode, error) {
+	var query milestonesQuery
+
+	variables := map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"name":  githubv4.String(name),
+		"title": githubv4.String(title),
+	}
+
+	err := g.client.Query(context.Background(), &query, variables)
+	if err != nil {
+		return milestoneNode{}, fmt.Errorf("retrieving GitHub milestone %q: %w", title, err)
+	}
+
+	// The query argument performs a fuzzy search, so we look for an exact match
+	for _, milestone := range query.Repository.Milestones.Nodes {
+		if milestone.Title == title {
+			return milestone, nil
+		}
+	}
+
+	return milestoneNode{}, fmt.Errorf("open GitHub milestone %q not found on repository %s/%s", title, owner, name)
+}
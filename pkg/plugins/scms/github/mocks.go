@@ -10,9 +10,17 @@ import (
 type MockGitHubClient struct {
 	mockedQuery interface{}
 	mockedErr   error
+	// mockedQueryFunc, when set, answers every query
+	mockedQueryFunc func(q interface{}, variables map[string]interface{}) error
+	// mutations records every mutation input sent to the client
+	mutations []githubv4.Input
 }
 
 func (mock *MockGitHubClient) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
+	if mock.mockedQueryFunc != nil {
+		return mock.mockedQueryFunc(q, variables)
+	}
+
 	switch q.(type) {
 	case *tagsQuery:
 		qt, _ := q.(*tagsQuery)
@@ -45,5 +53,6 @@ func (mock *MockGitHubClient) Query(ctx context.Context, q interface{}, variable
 }
 
 func (mock *MockGitHubClient) Mutate(ctx context.Context, m interface{}, input githubv4.Input, variables map[string]interface{}) error {
+	mock.mutations = append(mock.mutations, input)
 	return nil
 }
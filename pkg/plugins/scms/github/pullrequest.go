@@ -86,6 +86,57 @@ type ActionSpec struct {
 	//   Labels must already exist on the repository
 	//
 	Labels []string `yaml:",omitempty"`
+	// reviewers specifies the GitHub users requested to review the Pull Request.
+	//
+	// compatible:
+	//   * action
+	//
+	// default:
+	//    empty
+	//
+	// remark:
+	//   Reviewers are only added, existing review requests are kept.
+	//   The Pull Request author can't be requested as a reviewer.
+	//
+	Reviewers []string `yaml:",omitempty"`
+	// teamreviewers specifies the GitHub teams requested to review the Pull Request.
+	//
+	// compatible:
+	//   * action
+	//
+	// default:
+	//    empty
+	//
+	// remark:
+	//   A team is identified by its slug, optionally prefixed by its organization such as "updatecli/maintainers".
+	//   Without organization, the repository owner is used.
+	//   Teams must have access to the repository.
+	//
+	TeamReviewers []string `yaml:",omitempty"`
+	// assignees specifies the GitHub users assigned to the Pull Request.
+	//
+	// compatible:
+	//   * action
+	//
+	// default:
+	//    empty
+	//
+	// remark:
+	//   Assignees are only added, existing assignees are kept.
+	//
+	Assignees []string `yaml:",omitempty"`
+	// milestone specifies the title of the milestone associated with the Pull Request.
+	//
+	// compatible:
+	//   * action
+	//
+	// default:
+	//    empty
+	//
+	// remark:
+	//   The milestone must already exist and be open on the repository
+	//
+	Milestone string `yaml:",omitempty"`
 	// draft allows to set pull request in draft
 	//
 	// compatible:
@@ -394,12 +445,43 @@ func (p *PullRequest) updatePullRequest() error {
 		input.LabelIDs = &labelsID
 	}
 
+	if p.spec.Milestone != "" {
+		owner, name := p.pullRequestRepository()
+		milestone, err := p.gh.getMilestone(owner, name, p.spec.Milestone)
+		if err != nil {
+			return err
+		}
+		input.MilestoneID = githubv4.NewID(milestone.ID)
+	}
+
+	var participants pullRequestParticipants
+	if len(p.spec.Assignees) != 0 || len(p.spec.Reviewers) != 0 || len(p.spec.TeamReviewers) != 0 {
+		participants, err = p.getPullRequestParticipants()
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(p.spec.Assignees) != 0 {
+		assigneeIDs, changed, err := p.mergeAssignees(participants)
+		if err != nil {
+			return err
+		}
+		if changed {
+			input.AssigneeIDs = &assigneeIDs
+		}
+	}
+
 	err = p.gh.client.Mutate(context.Background(), &mutation, input, nil)
 	if err != nil {
 		logrus.Debugf("Error updating pull-request: %s", err.Error())
 		return err
 	}
 
+	if err = p.requestReviews(participants); err != nil {
+		return err
+	}
+
 	logrus.Infof("\nPull Request available at:\n\n\t%s\n\n", mutation.UpdatePullRequest.PullRequest.Url)
 
 	return nil
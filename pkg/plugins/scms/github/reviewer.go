@@ -0,0 +1,344 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/sirupsen/logrus"
+)
+
+// pullRequestParticipantsQuery defines a github v4 API query to retrieve the author, assignees,
+// requested reviewers and reviewers of a pull request
+/*
+https://developer.github.com/v4/explorer/
+
+query getPullRequestParticipants{
+	rateLimit {
+		cost
+		remaining
+		resetAt
+	}
+	repository(owner: "updatecli", name: "updatecli"){
+		pullRequest(number: 1){
+			author { login }
+			assignees(first: 100) {
+				nodes { id login }
+			}
+			reviewRequests(first: 100) {
+				nodes {
+					requestedReviewer {
+						... on User { login }
+						... on Team { slug organization { login } }
+					}
+				}
+			}
+			reviews(first: 100) {
+				nodes { author { login } }
+			}
+		}
+	}
+}
+*/
+type pullRequestParticipantsQuery struct {
+	RateLimit  RateLimit
+	Repository struct {
+		PullRequest struct {
+			Author struct {
+				Login string
+			}
+			Assignees struct {
+				Nodes []userNode
+			} `graphql:"assignees(first: 100)"`
+			ReviewRequests struct {
+				Nodes []struct {
+					RequestedReviewer struct {
+						User userNode `graphql:"... on User"`
+						Team teamNode `graphql:"... on Team"`
+					}
+				}
+			} `graphql:"reviewRequests(first: 100)"`
+			Reviews struct {
+				Nodes []struct {
+					Author struct {
+						Login string
+					}
+				}
+			} `graphql:"reviews(first: 100)"`
+		} `graphql:"pullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+type userNode struct {
+	ID    string
+	Login string
+}
+
+type teamNode struct {
+	ID           string
+	Slug         string
+	Organization struct {
+		Login string
+	}
+}
+
+// userQuery defines a github v4 API query to retrieve a user ID from its login
+type userQuery struct {
+	User userNode `graphql:"user(login: $login)"`
+}
+
+// teamQuery defines a github v4 API query to retrieve a team ID from its organization and slug
+type teamQuery struct {
+	Organization struct {
+		Team teamNode `graphql:"team(slug: $slug)"`
+	} `graphql:"organization(login: $organization)"`
+}
+
+// pullRequestParticipants holds the people already involved in a pull request
+type pullRequestParticipants struct {
+	Author    string
+	Assignees []userNode
+	// Reviewers contains users who already reviewed the pull request or who are requested to
+	Reviewers []string
+	// TeamReviewers contains teams requested to review the pull request, formatted as "organization/slug"
+	TeamReviewers []string
+}
+
+// getPullRequestParticipants queries GitHub Api to retrieve the people already involved in the pull request
+func (p *PullRequest) getPullRequestParticipants() (pullRequestParticipants, error) {
+	owner, name := p.pullRequestRepository()
+
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+		"number": githubv4.Int(p.remotePullRequest.Number),
+	}
+
+	var query pullRequestParticipantsQuery
+
+	err := p.gh.client.Query(context.Background(), &query, variables)
+	if err != nil {
+		logrus.Debugf("Error fetching pull request participants: %s", err.Error())
+		return pullRequestParticipants{}, err
+	}
+
+	query.RateLimit.Show()
+
+	pr := query.Repository.PullRequest
+
+	participants := pullRequestParticipants{
+		Author:    pr.Author.Login,
+		Assignees: pr.Assignees.Nodes,
+	}
+
+	for _, node := range pr.ReviewRequests.Nodes {
+		if node.RequestedReviewer.User.Login != "" {
+			participants.Reviewers = append(participants.Reviewers, node.RequestedReviewer.User.Login)
+		}
+		if node.RequestedReviewer.Team.Slug != "" {
+			participants.TeamReviewers = append(participants.TeamReviewers,
+				node.RequestedReviewer.Team.Organization.Login+"/"+node.RequestedReviewer.Team.Slug)
+		}
+	}
+
+	for _, node := range pr.Reviews.Nodes {
+		if node.Author.Login != "" {
+			participants.Reviewers = append(participants.Reviewers, node.Author.Login)
+		}
+	}
+
+	return participants, nil
+}
+
+// requestReviews requests a review from every reviewer and team reviewer
+// not yet involved in the pull request
+func (p *PullRequest) requestReviews(participants pullRequestParticipants) error {
+
+	/*
+		  mutation($input: RequestReviewsInput!){
+			requestReviews(input:$input){
+			  pullRequest{
+				url
+			  }
+			}
+		  }
+
+		  {
+			"input": {
+			  "pullRequestId" : "yyy",
+			  "userIds": ["xxx"],
+			  "teamIds": ["zzz"],
+			  "union": true
+			}
+		  }
+	*/
+
+	if len(p.spec.Reviewers) == 0 && len(p.spec.TeamReviewers) == 0 {
+		return nil
+	}
+
+	userIDs := []githubv4.ID{}
+	for _, login := range p.spec.Reviewers {
+		if strings.EqualFold(login, participants.Author) {
+			logrus.Debugf("Skipping reviewer %q as it's the pull request author", login)
+			continue
+		}
+
+		if containsFold(participants.Reviewers, login) {
+			continue
+		}
+
+		user, err := p.gh.getUser(login)
+		if err != nil {
+			return err
+		}
+		userIDs = append(userIDs, githubv4.ID(user.ID))
+	}
+
+	owner, _ := p.pullRequestRepository()
+
+	teamIDs := []githubv4.ID{}
+	for _, team := range p.spec.TeamReviewers {
+		organization, slug := parseTeam(team, owner)
+
+		if containsFold(participants.TeamReviewers, organization+"/"+slug) {
+			continue
+		}
+
+		t, err := p.gh.getTeam(organization, slug)
+		if err != nil {
+			return err
+		}
+		teamIDs = append(teamIDs, githubv4.ID(t.ID))
+	}
+
+	if len(userIDs) == 0 && len(teamIDs) == 0 {
+		logrus.Debugf("All reviewers already requested")
+		return nil
+	}
+
+	var mutation struct {
+		RequestReviews struct {
+			PullRequest PullRequestApi
+		} `graphql:"requestReviews(input: $input)"`
+	}
+
+	input := githubv4.RequestReviewsInput{
+		PullRequestID: githubv4.ID(p.remotePullRequest.ID),
+		Union:         githubv4.NewBoolean(true),
+	}
+
+	if len(userIDs) > 0 {
+		input.UserIDs = &userIDs
+	}
+
+	if len(teamIDs) > 0 {
+		input.TeamIDs = &teamIDs
+	}
+
+	err := p.gh.client.Mutate(context.Background(), &mutation, input, nil)
+	if err != nil {
+		logrus.Debugf("Error requesting pull-request reviews: %s", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// mergeAssignees returns the IDs of the pull request assignees extended with missing assignees from the spec
+func (p *PullRequest) mergeAssignees(participants pullRequestParticipants) ([]githubv4.ID, bool, error) {
+	assigneeIDs := []githubv4.ID{}
+	logins := []string{}
+	for _, assignee := range participants.Assignees {
+		assigneeIDs = append(assigneeIDs, githubv4.ID(assignee.ID))
+		logins = append(logins, assignee.Login)
+	}
+
+	changed := false
+	for _, login := range p.spec.Assignees {
+		if containsFold(logins, login) {
+			continue
+		}
+
+		user, err := p.gh.getUser(login)
+		if err != nil {
+			return nil, false, err
+		}
+
+		assigneeIDs = append(assigneeIDs, githubv4.ID(user.ID))
+		logins = append(logins, login)
+		changed = true
+	}
+
+	return assigneeIDs, changed, nil
+}
+
+// getUser queries GitHub Api to retrieve a user from its login
+func (g *Github) getUser(login string) (userNode, error) {
+	var query userQuery
+
+	variables := map[string]interface{}{
+		"login": githubv4.String(login),
+	}
+
+	err := g.client.Query(context.Background(), &query, variables)
+	if err != nil {
+		return userNode{}, fmt.Errorf("retrieving GitHub user %q: %w", login, err)
+	}
+
+	if query.User.ID == "" {
+		return userNode{}, fmt.Errorf("GitHub user %q not found", login)
+	}
+
+	return query.User, nil
+}
+
+// getTeam queries GitHub Api to retrieve a team from its organization and slug
+func (g *Github) getTeam(organization, slug string) (teamNode, error) {
+	var query teamQuery
+
+	variables := map[string]interface{}{
+		"organization": githubv4.String(organization),
+		"slug":         githubv4.String(slug),
+	}
+
+	err := g.client.Query(context.Background(), &query, variables)
+	if err != nil {
+		return teamNode{}, fmt.Errorf("retrieving GitHub team %q: %w", organization+"/"+slug, err)
+	}
+
+	if query.Organization.Team.ID == "" {
+		return teamNode{}, fmt.Errorf("GitHub team %q not found", organization+"/"+slug)
+	}
+
+	return query.Organization.Team, nil
+}
+
+// pullRequestRepository returns the owner and the name of the repository hosting the pull request
+func (p *PullRequest) pullRequestRepository() (string, string) {
+	if p.spec.Parent {
+		return p.repository.ParentOwner, p.repository.ParentName
+	}
+	return p.repository.Owner, p.repository.Name
+}
+
+// parseTeam splits a team reference such as "organization/slug" into its organization and slug.
+// The default organization is used when the reference only contains a slug.
+func parseTeam(team, defaultOrganization string) (string, string) {
+	team = strings.TrimPrefix(team, "@")
+	if organization, slug, found := strings.Cut(team, "/"); found {
+		return organization, slug
+	}
+	return defaultOrganization, team
+}
+
+// containsFold reports whether s is in list, ignoring case
+func containsFold(list []string, s string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, s) {
+			return true
+		}
+	}
+	return false
+}
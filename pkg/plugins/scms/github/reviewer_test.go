@@ -0,0 +1,177 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdatePullRequestParticipants(t *testing.T) {
+	tests := []struct {
+		name string
+		spec ActionSpec
+		// participants is the JSON representation of the pull request participants returned by the API
+		participants  string
+		wantMutations []githubv4.Input
+		wantErr       bool
+	}{
+		{
+			name: "New pull request",
+			spec: ActionSpec{
+				Reviewers:     []string{"alice", "bob"},
+				TeamReviewers: []string{"maintainers", "olblak/security"},
+				Assignees:     []string{"carol"},
+				Milestone:     "v1.0.0",
+			},
+			participants: `{"repository": {"pullRequest": {"author": {"login": "updateclibot"}}}}`,
+			wantMutations: []githubv4.Input{
+				githubv4.UpdatePullRequestInput{
+					PullRequestID: githubv4.ID("PR_1"),
+					Title:         githubv4.NewString("Bump version"),
+					Body:          githubv4.NewString(""),
+					AssigneeIDs:   &[]githubv4.ID{"U_carol"},
+					MilestoneID:   githubv4.NewID("M_v1.0.0"),
+				},
+				githubv4.RequestReviewsInput{
+					PullRequestID: githubv4.ID("PR_1"),
+					UserIDs:       &[]githubv4.ID{"U_alice", "U_bob"},
+					TeamIDs:       &[]githubv4.ID{"T_updatecli/maintainers", "T_olblak/security"},
+					Union:         githubv4.NewBoolean(true),
+				},
+			},
+		},
+		{
+			name: "Existing pull request with partial participants",
+			spec: ActionSpec{
+				Reviewers:     []string{"alice", "Bob", "dave"},
+				TeamReviewers: []string{"updatecli/maintainers"},
+				Assignees:     []string{"carol", "erin"},
+			},
+			participants: `{"repository": {"pullRequest": {
+				"author": {"login": "alice"},
+				"assignees": {"nodes": [{"id": "U_carol", "login": "carol"}]},
+				"reviewRequests": {"nodes": [
+					{"requestedReviewer": {"user": {"login": "bob"}}},
+					{"requestedReviewer": {"team": {"slug": "maintainers", "organization": {"login": "updatecli"}}}}
+				]},
+				"reviews": {"nodes": [{"author": {"login": "frank"}}]}
+			}}}`,
+			wantMutations: []githubv4.Input{
+				githubv4.UpdatePullRequestInput{
+					PullRequestID: githubv4.ID("PR_1"),
+					Title:         githubv4.NewString("Bump version"),
+					Body:          githubv4.NewString(""),
+					AssigneeIDs:   &[]githubv4.ID{"U_carol", "U_erin"},
+				},
+				githubv4.RequestReviewsInput{
+					PullRequestID: githubv4.ID("PR_1"),
+					UserIDs:       &[]githubv4.ID{"U_dave"},
+					Union:         githubv4.NewBoolean(true),
+				},
+			},
+		},
+		{
+			name: "Existing pull request already reconciled",
+			spec: ActionSpec{
+				Reviewers: []string{"frank"},
+				Assignees: []string{"carol"},
+			},
+			participants: `{"repository": {"pullRequest": {
+				"assignees": {"nodes": [{"id": "U_carol", "login": "carol"}]},
+				"reviews": {"nodes": [{"author": {"login": "frank"}}]}
+			}}}`,
+			wantMutations: []githubv4.Input{
+				githubv4.UpdatePullRequestInput{
+					PullRequestID: githubv4.ID("PR_1"),
+					Title:         githubv4.NewString("Bump version"),
+					Body:          githubv4.NewString(""),
+				},
+			},
+		},
+		{
+			name: "Unknown reviewer",
+			spec: ActionSpec{
+				Reviewers: []string{"ghost"},
+			},
+			participants: `{}`,
+			wantErr:      true,
+		},
+		{
+			name: "Unknown milestone",
+			spec: ActionSpec{
+				Milestone: "v2.0.0",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockGitHubClient{
+				mockedQueryFunc: func(q interface{}, variables map[string]interface{}) error {
+					switch query := q.(type) {
+					case *pullRequestParticipantsQuery:
+						return json.Unmarshal([]byte(tt.participants), query)
+					case *userQuery:
+						login := string(variables["login"].(githubv4.String))
+						if login == "ghost" {
+							return fmt.Errorf("Could not resolve to a User with the login of %q", login)
+						}
+						query.User = userNode{ID: "U_" + login, Login: login}
+					case *teamQuery:
+						query.Organization.Team = teamNode{
+							ID: fmt.Sprintf("T_%s/%s", variables["organization"], variables["slug"]),
+						}
+					case *milestonesQuery:
+						query.Repository.Milestones.Nodes = []milestoneNode{
+							{ID: "M_v1.0.0", Title: "v1.0.0"},
+							{ID: "M_v1.0.0-rc", Title: "v1.0.0-rc"},
+						}
+					}
+					return nil
+				},
+			}
+
+			p := PullRequest{
+				gh: &Github{
+					Spec: Spec{
+						Owner:      "updatecli",
+						Repository: "updatecli",
+					},
+					client: client,
+				},
+				Title: "Bump version",
+				spec:  tt.spec,
+				remotePullRequest: PullRequestApi{
+					ID:     "PR_1",
+					Number: 1,
+				},
+				repository: &Repository{
+					Owner: "updatecli",
+					Name:  "updatecli",
+				},
+			}
+
+			err := p.updatePullRequest()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// The pull request body is generated, we only compare the other fields
+			for i := range client.mutations {
+				if input, ok := client.mutations[i].(githubv4.UpdatePullRequestInput); ok {
+					input.Body = githubv4.NewString("")
+					client.mutations[i] = input
+				}
+			}
+
+			assert.Equal(t, tt.wantMutations, client.mutations)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package gitgeneric
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	transportHttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+// getAuth returns the authentication method matching the protocol used by the git url.
+// It returns nil if no authentication is configured.
+func (g GoGit) getAuth(username, password, URL string) (transport.AuthMethod, error) {
+	endpoint, err := transport.NewEndpoint(URL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing git url: %w", err)
+	}
+
+	if endpoint.Protocol == "ssh" {
+		// Let go-git use its default ssh authentication
+		if g.SSH.IsZero() {
+			return nil, nil
+		}
+
+		return g.SSH.GetAuthMethod(endpoint.User)
+	}
+
+	return basicAuth(username, password), nil
+}
+
+// getRepositoryAuth returns the authentication method matching the default remote of a git repository.
+func (g GoGit) getRepositoryAuth(username, password string, repository *git.Repository) (transport.AuthMethod, error) {
+	remote, err := repository.Remote(DefaultRemoteReferenceName)
+	if errors.Is(err, git.ErrRemoteNotFound) {
+		return basicAuth(username, password), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("retrieving git remote %q: %w", DefaultRemoteReferenceName, err)
+	}
+
+	if len(remote.Config().URLs) == 0 {
+		return basicAuth(username, password), nil
+	}
+
+	return g.getAuth(username, password, remote.Config().URLs[0])
+}
+
+// basicAuth returns the http basic authentication, or nil if no credentials are defined
+func basicAuth(username, password string) transport.AuthMethod {
+	if username == "" && password == "" {
+		return nil
+	}
+
+	return &transportHttp.BasicAuth{
+		Username: username, // anything except an empty string
+		Password: password,
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
-	transportHttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/sirupsen/logrus"
 )
 
@@ -128,11 +127,6 @@ func (g GoGit) NewBranch(branch, workingDir string) (bool, error) {
 // PushBranch publish a single branch created locally
 func (g GoGit) PushBranch(branch string, username string, password string, workingDir string, force bool) error {
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything except an empty string
-		Password: password,
-	}
-
 	r, err := git.PlainOpen(workingDir)
 
 	if err != nil {
@@ -140,6 +134,11 @@ func (g GoGit) PushBranch(branch string, username string, password string, worki
 		return err
 	}
 
+	auth, err := g.getRepositoryAuth(username, password, r)
+	if err != nil {
+		return err
+	}
+
 	logrus.Debugf("Pushing git branch: %q", branch)
 
 	// By default don't force push
@@ -153,7 +152,7 @@ func (g GoGit) PushBranch(branch string, username string, password string, worki
 		RemoteName: "origin",
 		Progress:   os.Stdout,
 		RefSpecs:   []config.RefSpec{refspec},
-		Auth:       &auth,
+		Auth:       auth,
 	}
 
 	err = r.Push(po)
@@ -11,12 +11,12 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/git/sign"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/git/ssh"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
-	transportHttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 )
 
 const (
@@ -47,6 +47,10 @@ type GitHandler interface {
 }
 
 type GoGit struct {
+	// SSH defines the authentication used for git remotes using the ssh protocol.
+	// If not defined, go-git defaults are used, relying on the ssh-agent and
+	// the default known_hosts files.
+	SSH ssh.Spec
 }
 
 /*
@@ -77,11 +81,6 @@ func (g GoGit) IsLocalBranchPublished(baseBranch, workingBranch, username, passw
 
 	logrus.Debugln("Checking if local changes have been done that should be published")
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything excepted an empty string
-		Password: password,
-	}
-
 	// Check if base branch and working branch have the same reference
 	matching, err := g.IsSimilarBranch(baseBranch, workingBranch, workingDir)
 	if err != nil {
@@ -93,6 +92,11 @@ func (g GoGit) IsLocalBranchPublished(baseBranch, workingBranch, username, passw
 		return false, err
 	}
 
+	auth, err := g.getRepositoryAuth(username, password, gitRepository)
+	if err != nil {
+		return false, err
+	}
+
 	workingBranchReferenceName := workingBranch
 	//
 	rem, err := gitRepository.Remote(DefaultRemoteReferenceName)
@@ -101,9 +105,8 @@ func (g GoGit) IsLocalBranchPublished(baseBranch, workingBranch, username, passw
 		return false, err
 	}
 
-	listOptions := git.ListOptions{}
-	if !isAuthEmpty(&auth) {
-		listOptions.Auth = &auth
+	listOptions := git.ListOptions{
+		Auth: auth,
 	}
 
 	remoteRefs, err := rem.List(&listOptions)
@@ -281,9 +284,9 @@ func (g GoGit) Checkout(username, password, basedBranch, newBranch, gitRepositor
 		return err
 	}
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything except an empty string
-		Password: password,
+	auth, err := g.getRepositoryAuth(username, password, repository)
+	if err != nil {
+		return err
 	}
 
 	// Checkout source branch without creating it yet
@@ -342,14 +345,11 @@ func (g GoGit) Checkout(username, password, basedBranch, newBranch, gitRepositor
 		// For large repository, the pull can take a long time so ideally we would like to only do it once
 		// when Updatecli is started.
 		pullOptions := git.PullOptions{
+			Auth:     auth,
 			Force:    true,
 			Progress: &b,
 		}
 
-		if !isAuthEmpty(&auth) {
-			pullOptions.Auth = &auth
-		}
-
 		err = worktree.Pull(&pullOptions)
 		if b.String() != "" {
 			logrus.Debugln(b.String())
@@ -450,9 +450,9 @@ func (g GoGit) Clone(username, password, URL, workingDir string, withSubmodules
 
 	var repo *git.Repository
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything except an empty string
-		Password: password,
+	auth, err := g.getAuth(username, password, URL)
+	if err != nil {
+		return err
 	}
 
 	submodule := git.DefaultSubmoduleRecursionDepth
@@ -462,17 +462,14 @@ func (g GoGit) Clone(username, password, URL, workingDir string, withSubmodules
 
 	var b bytes.Buffer
 	cloneOptions := git.CloneOptions{
+		Auth:              auth,
 		URL:               URL,
 		Progress:          &b,
 		RecurseSubmodules: submodule,
 	}
 
-	if !isAuthEmpty(&auth) {
-		cloneOptions.Auth = &auth
-	}
-
 	b.WriteString(fmt.Sprintf("cloning git repository: %s in %s\n", URL, workingDir))
-	repo, err := git.PlainClone(workingDir, false, &cloneOptions)
+	repo, err = git.PlainClone(workingDir, false, &cloneOptions)
 
 	if b.String() != "" {
 		logrus.Debugln(b.String())
@@ -501,14 +498,11 @@ func (g GoGit) Clone(username, password, URL, workingDir string, withSubmodules
 		b.WriteString(status.String())
 
 		pullOptions := git.PullOptions{
+			Auth:     auth,
 			Force:    true,
 			Progress: &b,
 		}
 
-		if !isAuthEmpty(&auth) {
-			pullOptions.Auth = &auth
-		}
-
 		err = w.Pull(&pullOptions)
 
 		if b.String() != "" {
@@ -540,13 +534,11 @@ func (g GoGit) Clone(username, password, URL, workingDir string, withSubmodules
 	for _, r := range remotes {
 
 		fetchOptions := git.FetchOptions{
+			Auth:     auth,
 			Progress: &b,
 			RefSpecs: []config.RefSpec{"refs/*:refs/*"},
 			Force:    true,
 		}
-		if !isAuthEmpty(&auth) {
-			fetchOptions.Auth = &auth
-		}
 
 		err := r.Fetch(&fetchOptions)
 
@@ -572,12 +564,12 @@ func (g GoGit) Push(username string, password string, workingDir string, force b
 
 	logrus.Debugf("stage: git-push\n\n")
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything excepted an empty string
-		Password: password,
+	r, err := git.PlainOpen(workingDir)
+	if err != nil {
+		return false, err
 	}
 
-	r, err := git.PlainOpen(workingDir)
+	auth, err := g.getRepositoryAuth(username, password, r)
 	if err != nil {
 		return false, err
 	}
@@ -607,15 +599,11 @@ func (g GoGit) Push(username string, password string, workingDir string, force b
 	b := bytes.Buffer{}
 
 	pushOptions := git.PushOptions{
-		Auth:     &auth,
+		Auth:     auth,
 		Progress: &b,
 		RefSpecs: []config.RefSpec{refspec},
 	}
 
-	if !isAuthEmpty(&auth) {
-		pushOptions.Auth = &auth
-	}
-
 	// Only push one branch at a time
 	err = r.Push(&pushOptions)
 	if b.String() != "" {
@@ -640,7 +628,7 @@ func (g GoGit) Push(username string, password string, workingDir string, force b
 			localBranch))
 
 		pushOptions = git.PushOptions{
-			Auth:     &auth,
+			Auth:     auth,
 			Progress: &b,
 			RefSpecs: []config.RefSpec{refspec},
 		}
@@ -740,6 +728,8 @@ type DatedTag struct {
 	When time.Time
 	Name string
 	Hash string
+	// Commit holds the commit hash referenced by the tag, it differs from Hash for annotated tags
+	Commit string
 }
 
 // TagRefs returns a list of git tags ordered by creation time
@@ -773,9 +763,10 @@ func (g GoGit) TagRefs(workingDir string) (tags []DatedTag, err error) {
 		listOfDatedTags = append(
 			listOfDatedTags,
 			DatedTag{
-				Name: tagRef.Name().Short(),
-				Hash: tagRef.Hash().String(),
-				When: commit.Committer.When,
+				Name:   tagRef.Name().Short(),
+				Hash:   tagRef.Hash().String(),
+				Commit: tagCommitHash.String(),
+				When:   commit.Committer.When,
 			},
 		)
 
@@ -828,11 +819,6 @@ func (g GoGit) NewTag(tag, message, workingDir string) (bool, error) {
 // PushTag publish a single tag created locally
 func (g GoGit) PushTag(tag string, username string, password string, workingDir string, force bool) error {
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything except an empty string
-		Password: password,
-	}
-
 	r, err := git.PlainOpen(workingDir)
 
 	if err != nil {
@@ -840,6 +826,11 @@ func (g GoGit) PushTag(tag string, username string, password string, workingDir
 		return err
 	}
 
+	auth, err := g.getRepositoryAuth(username, password, r)
+	if err != nil {
+		return err
+	}
+
 	logrus.Debugf("Pushing git Tag: %q", tag)
 
 	// By default don't force push
@@ -851,15 +842,12 @@ func (g GoGit) PushTag(tag string, username string, password string, workingDir
 
 	b := bytes.Buffer{}
 	po := &git.PushOptions{
+		Auth:       auth,
 		RemoteName: DefaultRemoteReferenceName,
 		Progress:   &b,
 		RefSpecs:   []config.RefSpec{refspec},
 	}
 
-	if !isAuthEmpty(&auth) {
-		po.Auth = &auth
-	}
-
 	err = r.Push(po)
 
 	logrus.Debugln(b.String())
@@ -898,11 +886,6 @@ func (g GoGit) RemoteURLs(workingDir string) (map[string]string, error) {
 	return remoteList, nil
 }
 
-// isAuthEmpty return true if no username/password are defined
-func isAuthEmpty(auth *transportHttp.BasicAuth) bool {
-	return auth.Username == "" && auth.Password == ""
-}
-
 // Pull run `git pull` on the local HEAD.
 func (g GoGit) Pull(username, password, gitRepositoryPath, branch string, singleBranch, forceReset bool) error {
 
@@ -924,14 +907,15 @@ func (g GoGit) Pull(username, password, gitRepositoryPath, branch string, single
 		return err
 	}
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything except an empty string
-		Password: password,
+	auth, err := g.getRepositoryAuth(username, password, repository)
+	if err != nil {
+		return err
 	}
 
 	b := bytes.Buffer{}
 
 	pullOptions := git.PullOptions{
+		Auth:         auth,
 		Force:        forceReset,
 		Progress:     &b,
 		SingleBranch: singleBranch,
@@ -941,10 +925,6 @@ func (g GoGit) Pull(username, password, gitRepositoryPath, branch string, single
 		pullOptions.ReferenceName = plumbing.NewBranchReferenceName(branch)
 	}
 
-	if !isAuthEmpty(&auth) {
-		pullOptions.Auth = &auth
-	}
-
 	err = worktree.Pull(&pullOptions)
 	if b.String() != "" {
 		logrus.Debugln(b.String())
@@ -172,7 +172,7 @@ func TestTagRefsIntegration(t *testing.T) {
 	found := false
 
 	// Test that the first tag from array is also the oldest one
-	if refs[0] == expectedRef {
+	if refs[0].When.Equal(expectedRef.When) && refs[0].Name == expectedRef.Name && refs[0].Hash == expectedRef.Hash {
 		found = true
 	}
 
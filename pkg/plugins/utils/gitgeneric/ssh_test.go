@@ -0,0 +1,361 @@
+package gitgeneric
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cryptossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/updatecli/updatecli/pkg/plugins/scms/git/ssh"
+)
+
+// testSSHServer is a minimal ssh server serving git repositories
+// using the local git-upload-pack and git-receive-pack commands.
+type testSSHServer struct {
+	listener      net.Listener
+	hostPublicKey cryptossh.PublicKey
+}
+
+func newTestSSHServer(t *testing.T, authorizedKey cryptossh.PublicKey) *testSSHServer {
+	t.Helper()
+
+	_, hostPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	hostSigner, err := cryptossh.NewSignerFromKey(hostPrivateKey)
+	require.NoError(t, err)
+
+	config := &cryptossh.ServerConfig{
+		PublicKeyCallback: func(conn cryptossh.ConnMetadata, key cryptossh.PublicKey) (*cryptossh.Permissions, error) {
+			if conn.User() == "git" && bytes.Equal(key.Marshal(), authorizedKey.Marshal()) {
+				return &cryptossh.Permissions{}, nil
+			}
+			return nil, fmt.Errorf("unauthorized key for user %q", conn.User())
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSSHConn(conn, config)
+		}
+	}()
+
+	return &testSSHServer{
+		listener:      listener,
+		hostPublicKey: hostSigner.PublicKey(),
+	}
+}
+
+func (s *testSSHServer) URL(repository string) string {
+	return fmt.Sprintf("ssh://git@%s%s", s.listener.Addr().String(), filepath.ToSlash(repository))
+}
+
+func (s *testSSHServer) knownHostsLine() string {
+	return knownhosts.Line([]string{knownhosts.Normalize(s.listener.Addr().String())}, s.hostPublicKey) + "\n"
+}
+
+func serveSSHConn(conn net.Conn, config *cryptossh.ServerConfig) {
+	_, channels, requests, err := cryptossh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go cryptossh.DiscardRequests(requests)
+
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(cryptossh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+
+		channel, channelRequests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+
+		go func() {
+			defer channel.Close()
+			for req := range channelRequests {
+				if req.Type != "exec" {
+					_ = req.Reply(false, nil)
+					continue
+				}
+				_ = req.Reply(true, nil)
+
+				// The exec payload is a ssh string containing the command
+				command := string(req.Payload[4:])
+				status := runGitCommand(command, channel)
+
+				exitStatus := make([]byte, 4)
+				binary.BigEndian.PutUint32(exitStatus, status)
+				_, _ = channel.SendRequest("exit-status", false, exitStatus)
+				return
+			}
+		}()
+	}
+}
+
+// runGitCommand runs commands like "git-upload-pack '/path/to/repository'"
+func runGitCommand(command string, channel cryptossh.Channel) uint32 {
+	name, repository, found := strings.Cut(command, " ")
+	if !found || (name != "git-upload-pack" && name != "git-receive-pack") {
+		fmt.Fprintf(channel.Stderr(), "unsupported command %q\n", command)
+		return 1
+	}
+
+	//nolint:gosec // test server only runs the two commands above
+	cmd := exec.Command("git", strings.TrimPrefix(name, "git-"), strings.Trim(repository, "'"))
+	cmd.Stdin = channel
+	cmd.Stdout = channel
+	cmd.Stderr = channel.Stderr()
+
+	if err := cmd.Run(); err != nil {
+		return 1
+	}
+	return 0
+}
+
+// newTestRemoteRepository creates a bare git repository containing one commit
+func newTestRemoteRepository(t *testing.T) string {
+	t.Helper()
+
+	workDir := t.TempDir()
+	repository, err := git.PlainInit(workDir, false)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "README.md"), []byte("# Updatecli\n"), 0600))
+
+	worktree, err := repository.Worktree()
+	require.NoError(t, err)
+	_, err = worktree.Add("README.md")
+	require.NoError(t, err)
+	_, err = worktree.Commit("init", &git.CommitOptions{
+		Author: &object.Signature{Name: "updatecli", Email: "bot@updatecli.io", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	remoteDir := filepath.Join(t.TempDir(), "remote.git")
+	_, err = git.PlainClone(remoteDir, true, &git.CloneOptions{URL: workDir})
+	require.NoError(t, err)
+
+	return remoteDir
+}
+
+func TestSSHTransport(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git binary not found")
+	}
+
+	clientPublicKey, clientPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	authorizedKey, err := cryptossh.NewPublicKey(clientPublicKey)
+	require.NoError(t, err)
+
+	pemBlock, err := cryptossh.MarshalPrivateKeyWithPassphrase(clientPrivateKey, "", []byte("secret"))
+	require.NoError(t, err)
+	inlinePrivateKey := string(pem.EncodeToMemory(pemBlock))
+
+	privateKeyFile := filepath.Join(t.TempDir(), "id_ed25519")
+	require.NoError(t, os.WriteFile(privateKeyFile, []byte(inlinePrivateKey), 0600))
+
+	server := newTestSSHServer(t, authorizedKey)
+
+	knownHostsFile := filepath.Join(t.TempDir(), "known_hosts")
+	require.NoError(t, os.WriteFile(knownHostsFile, []byte(server.knownHostsLine()), 0600))
+
+	emptyKnownHostsFile := filepath.Join(t.TempDir(), "known_hosts")
+	require.NoError(t, os.WriteFile(emptyKnownHostsFile, []byte{}, 0600))
+
+	tests := []struct {
+		name          string
+		spec          ssh.Spec
+		wantErr       bool
+		wantKnownHost string
+	}{
+		{
+			name: "Private key file with known host",
+			spec: ssh.Spec{
+				PrivateKey:      privateKeyFile,
+				Passphrase:      "secret",
+				KnownHostsFiles: []string{knownHostsFile},
+			},
+		},
+		{
+			name: "Inline private key with known host",
+			spec: ssh.Spec{
+				PrivateKey:      inlinePrivateKey,
+				Passphrase:      "secret",
+				KnownHostsFiles: []string{knownHostsFile},
+			},
+		},
+		{
+			name: "Wrong passphrase",
+			spec: ssh.Spec{
+				PrivateKey:      privateKeyFile,
+				Passphrase:      "wrong",
+				KnownHostsFiles: []string{knownHostsFile},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unknown host with strict policy",
+			spec: ssh.Spec{
+				PrivateKey:      privateKeyFile,
+				Passphrase:      "secret",
+				KnownHostsFiles: []string{emptyKnownHostsFile},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unknown host with acceptnew policy",
+			spec: ssh.Spec{
+				PrivateKey:       privateKeyFile,
+				Passphrase:       "secret",
+				KnownHostsPolicy: ssh.KnownHostsPolicyAcceptNew,
+				KnownHostsFiles:  []string{filepath.Join(t.TempDir(), "known_hosts")},
+			},
+			wantKnownHost: server.knownHostsLine(),
+		},
+		{
+			name: "Unknown host with insecure policy",
+			spec: ssh.Spec{
+				PrivateKey:       privateKeyFile,
+				Passphrase:       "secret",
+				KnownHostsPolicy: ssh.KnownHostsPolicyInsecure,
+				KnownHostsFiles:  []string{emptyKnownHostsFile},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remoteDir := newTestRemoteRepository(t)
+			workingDir := filepath.Join(t.TempDir(), "clone")
+
+			g := GoGit{SSH: tt.spec}
+
+			err := g.Clone("", "", server.URL(remoteDir), workingDir, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.wantKnownHost != "" {
+				got, err := os.ReadFile(tt.spec.KnownHostsFiles[0])
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantKnownHost, string(got))
+			}
+
+			require.NoError(t, g.Pull("", "", workingDir, "master", false, false))
+
+			// Push a new commit then a tag
+			require.NoError(t, os.WriteFile(filepath.Join(workingDir, "CHANGELOG.md"), []byte("# Changelog\n"), 0600))
+			require.NoError(t, g.Add([]string{"CHANGELOG.md"}, workingDir))
+			require.NoError(t, g.Commit("updatecli", "bot@updatecli.io", "add changelog", workingDir, "", ""))
+
+			forcePush, err := g.Push("", "", workingDir, false)
+			require.NoError(t, err)
+			assert.False(t, forcePush)
+
+			local, err := git.PlainOpen(workingDir)
+			require.NoError(t, err)
+			head, err := local.Head()
+			require.NoError(t, err)
+			_, err = local.CreateTag("v0.1.0", head.Hash(), nil)
+			require.NoError(t, err)
+			require.NoError(t, g.PushTag("v0.1.0", "", "", workingDir, false))
+
+			localHash, err := g.GetLatestCommitHash(workingDir)
+			require.NoError(t, err)
+
+			remote, err := git.PlainOpen(remoteDir)
+			require.NoError(t, err)
+
+			remoteHead, err := remote.Head()
+			require.NoError(t, err)
+			assert.Equal(t, localHash, remoteHead.Hash().String())
+
+			_, err = remote.Tag("v0.1.0")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetAuth(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         ssh.Spec
+		username     string
+		password     string
+		URL          string
+		expectedName string
+		expectedNil  bool
+	}{
+		{
+			name:         "HTTP url with credentials",
+			username:     "updatecli",
+			password:     "password",
+			URL:          "https://github.com/updatecli/updatecli.git",
+			expectedName: "http-basic-auth",
+		},
+		{
+			name:        "HTTP url without credentials",
+			URL:         "https://github.com/updatecli/updatecli.git",
+			expectedNil: true,
+		},
+		{
+			name:        "SSH url without ssh settings",
+			username:    "updatecli",
+			password:    "password",
+			URL:         "git@github.com:updatecli/updatecli.git",
+			expectedNil: true,
+		},
+		{
+			name: "HTTP url ignores ssh settings",
+			spec: ssh.Spec{
+				KnownHostsPolicy: ssh.KnownHostsPolicyInsecure,
+			},
+			URL:         "https://github.com/updatecli/updatecli.git",
+			expectedNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth, err := GoGit{SSH: tt.spec}.getAuth(tt.username, tt.password, tt.URL)
+			require.NoError(t, err)
+
+			if tt.expectedNil {
+				assert.Nil(t, auth)
+				return
+			}
+			require.NotNil(t, auth)
+			assert.Equal(t, tt.expectedName, auth.Name())
+		})
+	}
+}
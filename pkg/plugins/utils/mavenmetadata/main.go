@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/text"
 	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
@@ -33,6 +34,11 @@ func New(metadataURL string, versionFilter version.Filter) *DefaultHandler {
 	}
 }
 
+// SetHttpClient defines the http client used to retrieve the metadata file
+func (d *DefaultHandler) SetHttpClient(client httpclient.HTTPClient) {
+	d.contentRetriever.SetHttpClient(client)
+}
+
 // getMetadataFile is an internal method that returns the parsed metadata object
 func (d *DefaultHandler) getMetadataFile() (metadata, error) {
 	body, err := d.contentRetriever.ReadAll(d.metadataURL)
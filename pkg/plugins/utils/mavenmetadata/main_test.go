@@ -136,6 +136,17 @@ func TestDefaultHandler_GetLatestVersion(t *testing.T) {
 			mockedHttpBody:       wikitextCoreMavenMetadata,
 			want:                 "1.6.1",
 		},
+		{
+			name: "Normal case with org.eclipse.mylyn.wikitext.wikitext.core on repo.jenkins-ci.org/releases using maven filter",
+			versionFilter: version.Filter{
+				Kind:    "maven",
+				Pattern: "[1.6,1.7.2)",
+			},
+			metadataURL:          "https://repo.jenkins-ci.org/releases/org/eclipse/mylyn/wikitext/wikitext.core/maven-metadata.xml",
+			mockedHTTPStatusCode: 200,
+			mockedHttpBody:       wikitextCoreMavenMetadata,
+			want:                 "1.7.1",
+		},
 		{
 			name:                 "Case with HTTP/500 error",
 			metadataURL:          "https://repo.jenkins-ci.org/releases/org/eclipse/mylyn/wikitext/wikitext.core/maven-metadata.xml",
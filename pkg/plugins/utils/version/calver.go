@@ -0,0 +1,504 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+/*
+Calver parses, sorts and filters calendar versions such as "2024.10.1" or "24.04".
+
+Its pattern is composed of a format, optionally followed by space separated constraints,
+like "YYYY.0M.MICRO sameyear".
+
+The format accepts the following tokens, as defined on https://calver.org:
+  - YYYY: full year, like 2006 or 2016
+  - YY: short year, like 6, 16 or 106
+  - 0Y: zero-padded year, like 06, 16 or 106
+  - MM: short month, like 1 or 11
+  - 0M: zero-padded month, like 01 or 11
+  - WW: short week of the year, like 1 or 33
+  - 0W: zero-padded week of the year, like 01 or 33
+  - DD: short day, like 1 or 31
+  - 0D: zero-padded day, like 01 or 31
+  - MAJOR, MINOR, MICRO: version numbers, like 1 or 42
+  - MODIFIER: optional tag, like "beta1"
+
+Any other character is matched literally and brackets define an optional part of the format,
+like "YYYY.MINOR[.MICRO]" which matches both "2024.2" and "2024.2.1".
+
+The accepted constraints are:
+  - sameyear: only versions released during the current year
+  - newerthan=<N><days|weeks|months|years>: only versions released during the last N days, weeks, months or years
+  - <op><version>: compare versions with one of the operators >, >=, <, <=, =.
+    The version may be truncated like ">=2024.04" for the format "YYYY.0M.MICRO"
+*/
+type Calver struct {
+	Pattern      string
+	FoundVersion Version
+	// now returns the current time, it is overridden by tests
+	now      func() time.Time
+	format   calverFormat
+	versions []calverVersion
+}
+
+type calverTokenKind int
+
+const (
+	calverLiteral calverTokenKind = iota
+	calverYear
+	calverMonth
+	calverWeek
+	calverDay
+	calverNumber
+	calverModifier
+)
+
+// calverTokens lists the supported format tokens, longest first
+var calverTokens = []struct {
+	name   string
+	kind   calverTokenKind
+	regex  string
+	offset int
+}{
+	{name: "MODIFIER", kind: calverModifier, regex: `([0-9A-Za-z][0-9A-Za-z.]*)`},
+	{name: "MAJOR", kind: calverNumber, regex: `(\d+)`},
+	{name: "MINOR", kind: calverNumber, regex: `(\d+)`},
+	{name: "MICRO", kind: calverNumber, regex: `(\d+)`},
+	{name: "YYYY", kind: calverYear, regex: `(\d{4})`},
+	{name: "YY", kind: calverYear, regex: `(\d{1,3})`, offset: 2000},
+	{name: "0Y", kind: calverYear, regex: `(\d{2,3})`, offset: 2000},
+	{name: "MM", kind: calverMonth, regex: `(\d{1,2})`},
+	{name: "0M", kind: calverMonth, regex: `(\d{2})`},
+	{name: "WW", kind: calverWeek, regex: `(\d{1,2})`},
+	{name: "0W", kind: calverWeek, regex: `(\d{2})`},
+	{name: "DD", kind: calverDay, regex: `(\d{1,2})`},
+	{name: "0D", kind: calverDay, regex: `(\d{2})`},
+}
+
+// calverToken is a parsed format token
+type calverToken struct {
+	kind calverTokenKind
+	// value holds the literal text, or the regular expression of the token
+	value  string
+	offset int
+	// group identifies the optional part of the format containing the token, zero if none
+	group int
+}
+
+// calverFormat is a parsed calendar versioning format
+type calverFormat struct {
+	raw    string
+	tokens []calverToken
+	// fields lists the indexes of the tokens capturing a value
+	fields []int
+}
+
+// calverVersion is a version parsed according to a calendar versioning format
+type calverVersion struct {
+	original string
+	// values holds the numeric value of each field, in the format order
+	values   []int
+	modifier string
+}
+
+var calverNewerThanRegex = regexp.MustCompile(`^newerthan=(\d+)(days|weeks|months|years)$`)
+
+// parseCalverFormat parses a calendar versioning format like "YYYY.0M.MICRO"
+func parseCalverFormat(format string) (calverFormat, error) {
+	f := calverFormat{raw: format}
+
+	groups, group := 0, 0
+	for i := 0; i < len(format); {
+		switch format[i] {
+		case '[':
+			if group != 0 {
+				return calverFormat{}, fmt.Errorf("calver format %q: nested optional parts are not supported", format)
+			}
+			groups++
+			group = groups
+			i++
+			continue
+		case ']':
+			if group == 0 {
+				return calverFormat{}, fmt.Errorf("calver format %q: unexpected %q", format, "]")
+			}
+			group = 0
+			i++
+			continue
+		}
+
+		matched := false
+		for _, token := range calverTokens {
+			if strings.HasPrefix(format[i:], token.name) {
+				f.fields = append(f.fields, len(f.tokens))
+				f.tokens = append(f.tokens, calverToken{
+					kind:   token.kind,
+					value:  token.regex,
+					offset: token.offset,
+					group:  group,
+				})
+				i += len(token.name)
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			f.tokens = append(f.tokens, calverToken{
+				kind:  calverLiteral,
+				value: format[i : i+1],
+				group: group,
+			})
+			i++
+		}
+	}
+
+	if group != 0 {
+		return calverFormat{}, fmt.Errorf("calver format %q: missing %q", format, "]")
+	}
+
+	if len(f.fields) == 0 {
+		return calverFormat{}, fmt.Errorf("calver format %q doesn't contain any token", format)
+	}
+
+	return f, nil
+}
+
+// regex returns the regular expression matching the first n tokens of the format
+func (f calverFormat) regex(n int) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+
+	for i := 0; i < n; i++ {
+		token := f.tokens[i]
+
+		if token.group != 0 && (i == 0 || f.tokens[i-1].group != token.group) {
+			sb.WriteString("(?:")
+		}
+
+		switch token.kind {
+		case calverLiteral:
+			sb.WriteString(regexp.QuoteMeta(token.value))
+		default:
+			sb.WriteString(token.value)
+		}
+
+		if token.group != 0 && (i == n-1 || f.tokens[i+1].group != token.group) {
+			sb.WriteString(")?")
+		}
+	}
+
+	sb.WriteString("$")
+
+	return regexp.MustCompile(sb.String())
+}
+
+// parse parses version according to the first n tokens of the format
+func (f calverFormat) parse(version string, n int) (calverVersion, error) {
+	matches := f.regex(n).FindStringSubmatch(version)
+	if matches == nil {
+		return calverVersion{}, fmt.Errorf("version %q doesn't match calver format %q", version, f.raw)
+	}
+
+	v := calverVersion{
+		original: version,
+	}
+
+	for i, tokenID := range f.fields {
+		if tokenID >= n {
+			break
+		}
+
+		token := f.tokens[tokenID]
+		match := matches[i+1]
+
+		if token.kind == calverModifier {
+			v.modifier = match
+			v.values = append(v.values, 0)
+			continue
+		}
+
+		value := 0
+		if match != "" {
+			var err error
+			value, err = strconv.Atoi(match)
+			if err != nil {
+				return calverVersion{}, fmt.Errorf("version %q: %w", version, err)
+			}
+			value += token.offset
+		}
+
+		invalid := (token.kind == calverMonth && (value < 1 || value > 12)) ||
+			(token.kind == calverWeek && (value < 1 || value > 53)) ||
+			(token.kind == calverDay && (value < 1 || value > 31))
+		if match != "" && invalid {
+			return calverVersion{}, fmt.Errorf("version %q: %q is not a valid date component", version, match)
+		}
+
+		v.values = append(v.values, value)
+	}
+
+	return v, nil
+}
+
+// parsePrefix parses a version which may only contain the beginning of the format,
+// and returns the number of fields it defines.
+func (f calverFormat) parsePrefix(version string) (calverVersion, int, error) {
+	for n := len(f.tokens); n > 0; n-- {
+		// Only try prefixes ending with a field
+		if f.tokens[n-1].kind == calverLiteral {
+			continue
+		}
+
+		v, err := f.parse(version, n)
+		if err == nil {
+			return v, len(v.values), nil
+		}
+	}
+
+	return calverVersion{}, 0, fmt.Errorf("version %q doesn't match calver format %q", version, f.raw)
+}
+
+// hasField returns true if the format contains a token of the given kind
+func (f calverFormat) hasField(kind calverTokenKind) bool {
+	for _, i := range f.fields {
+		if f.tokens[i].kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// date returns the release date described by the version
+func (f calverFormat) date(v calverVersion) time.Time {
+	year, month, week, day := 0, 1, 0, 1
+
+	for i, tokenID := range f.fields {
+		if i >= len(v.values) {
+			break
+		}
+		switch f.tokens[tokenID].kind {
+		case calverYear:
+			year = v.values[i]
+		case calverMonth:
+			month = max(v.values[i], 1)
+		case calverWeek:
+			week = v.values[i]
+		case calverDay:
+			day = max(v.values[i], 1)
+		}
+	}
+
+	if week > 0 {
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, (week-1)*7)
+	}
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// compare compares the first n fields of a and b, it returns
+// -1 if a is older than b, 1 if a is newer than b, and 0 otherwise
+func (f calverFormat) compare(a, b calverVersion, n int) int {
+	for i := 0; i < n && i < len(a.values) && i < len(b.values); i++ {
+		if f.tokens[f.fields[i]].kind == calverModifier {
+			// A version without modifier is newer than the same version with a modifier
+			switch {
+			case a.modifier == b.modifier:
+				continue
+			case a.modifier == "":
+				return 1
+			case b.modifier == "":
+				return -1
+			}
+			return strings.Compare(a.modifier, b.modifier)
+		}
+
+		switch {
+		case a.values[i] < b.values[i]:
+			return -1
+		case a.values[i] > b.values[i]:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// calverConstraint filters calendar versions
+type calverConstraint func(v calverVersion) bool
+
+// parseConstraint returns the constraint function matching s
+func (c *Calver) parseConstraint(s string) (calverConstraint, error) {
+	if s == "sameyear" {
+		if !c.format.hasField(calverYear) {
+			return nil, fmt.Errorf("calver constraint %q requires a year in the format %q", s, c.format.raw)
+		}
+		year := c.now().Year()
+		return func(v calverVersion) bool {
+			return c.format.date(v).Year() == year
+		}, nil
+	}
+
+	if matches := calverNewerThanRegex.FindStringSubmatch(s); matches != nil {
+		if !c.format.hasField(calverYear) {
+			return nil, fmt.Errorf("calver constraint %q requires a year in the format %q", s, c.format.raw)
+		}
+
+		n, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return nil, err
+		}
+
+		limit := c.now()
+		switch matches[2] {
+		case "days":
+			limit = limit.AddDate(0, 0, -n)
+		case "weeks":
+			limit = limit.AddDate(0, 0, -7*n)
+		case "months":
+			limit = limit.AddDate(0, -n, 0)
+		case "years":
+			limit = limit.AddDate(-n, 0, 0)
+		}
+
+		// Versions only describe a date, so we compare dates without time
+		limit = time.Date(limit.Year(), limit.Month(), limit.Day(), 0, 0, 0, 0, time.UTC)
+
+		return func(v calverVersion) bool {
+			return !c.format.date(v).Before(limit)
+		}, nil
+	}
+
+	for _, op := range []string{">=", "<=", ">", "<", "="} {
+		value, found := strings.CutPrefix(s, op)
+		if !found {
+			continue
+		}
+
+		ref, n, err := c.format.parsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("calver constraint %q: %w", s, err)
+		}
+
+		return func(v calverVersion) bool {
+			result := c.format.compare(v, ref, n)
+			switch op {
+			case ">=":
+				return result >= 0
+			case "<=":
+				return result <= 0
+			case ">":
+				return result > 0
+			case "<":
+				return result < 0
+			}
+			return result == 0
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported calver constraint %q", s)
+}
+
+// Init parses the pattern and the versions matching its format
+func (c *Calver) Init(versions []string) error {
+	if c.now == nil {
+		c.now = time.Now
+	}
+
+	fields := strings.Fields(c.Pattern)
+	if len(fields) == 0 {
+		return fmt.Errorf("calver pattern must start with a format like %q", "YYYY.0M.MICRO")
+	}
+
+	format, err := parseCalverFormat(fields[0])
+	if err != nil {
+		return err
+	}
+	c.format = format
+
+	c.versions = nil
+	for _, version := range versions {
+		v, err := c.format.parse(version, len(c.format.tokens))
+		if err != nil {
+			logrus.Debugf("Skipping %q because %s", version, err)
+			continue
+		}
+		c.versions = append(c.versions, v)
+	}
+
+	return nil
+}
+
+// Sort re-order a list of versions with the newest version first
+func (c *Calver) Sort() {
+	sort.SliceStable(c.versions, func(i, j int) bool {
+		return c.format.compare(c.versions[i], c.versions[j], len(c.format.fields)) > 0
+	})
+}
+
+// Search returns the newest version matching the pattern
+func (c *Calver) Search(versions []string) error {
+	// We need to be sure that at least one version exist
+	if len(versions) == 0 {
+		return ErrNoVersionsFound
+	}
+
+	if err := c.Init(versions); err != nil {
+		return err
+	}
+
+	if len(c.versions) == 0 {
+		return ErrNoValidCalverFound
+	}
+
+	constraints := []calverConstraint{}
+	for _, s := range strings.Fields(c.Pattern)[1:] {
+		constraint, err := c.parseConstraint(s)
+		if err != nil {
+			return err
+		}
+		constraints = append(constraints, constraint)
+	}
+
+	c.Sort()
+
+	for _, v := range c.versions {
+		matching := true
+		for _, constraint := range constraints {
+			if !constraint(v) {
+				matching = false
+				break
+			}
+		}
+
+		if matching {
+			c.FoundVersion.ParsedVersion = v.original
+			c.FoundVersion.OriginalVersion = v.original
+			return nil
+		}
+	}
+
+	return &ErrNoVersionFoundForPattern{Pattern: c.Pattern}
+}
+
+// Validate checks that the pattern is valid
+func (c *Calver) Validate() error {
+	if err := c.Init(nil); err != nil {
+		return err
+	}
+
+	for _, s := range strings.Fields(c.Pattern)[1:] {
+		if _, err := c.parseConstraint(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
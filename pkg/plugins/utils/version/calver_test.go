@@ -0,0 +1,149 @@
+package version
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalverSearch(t *testing.T) {
+	now := time.Date(2024, time.October, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		pattern  string
+		versions []string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "Ubuntu releases",
+			pattern:  "YY.0M",
+			versions: []string{"22.04", "24.04", "23.10", "20.04"},
+			want:     "24.04",
+		},
+		{
+			name:     "Ubuntu releases with a version constraint",
+			pattern:  "YY.0M <24",
+			versions: []string{"22.04", "24.04", "23.10", "20.04"},
+			want:     "23.10",
+		},
+		{
+			name:     "Pip releases are not sorted alphabetically",
+			pattern:  "YY.MINOR.MICRO",
+			versions: []string{"24.1.2", "24.10.0", "24.2", "24.9.1"},
+			want:     "24.10.0",
+		},
+		{
+			name:     "JetBrains releases with optional micro",
+			pattern:  "YYYY.MINOR[.MICRO]",
+			versions: []string{"2024.1.4", "2024.2", "2024.2.1", "2023.3.7"},
+			want:     "2024.2.1",
+		},
+		{
+			name:     "Date-stamped images",
+			pattern:  "YYYY0M0D",
+			versions: []string{"20241015", "20240930", "latest", "20241301"},
+			want:     "20241015",
+		},
+		{
+			name:     "Modifier is older than the release",
+			pattern:  "YYYY.0M.MICRO[-MODIFIER]",
+			versions: []string{"2024.10.0-rc1", "2024.10.0", "2024.09.2"},
+			want:     "2024.10.0",
+		},
+		{
+			name:     "Same year",
+			pattern:  "YYYY.0M.MICRO sameyear",
+			versions: []string{"2023.12.1", "2024.01.0", "2024.03.2"},
+			want:     "2024.03.2",
+		},
+		{
+			name:     "Same year without matching version",
+			pattern:  "YYYY.0M.MICRO sameyear",
+			versions: []string{"2022.12.1", "2023.12.1"},
+			wantErr:  true,
+		},
+		{
+			name:     "Newer than 6 months combined with a version constraint",
+			pattern:  "YYYY.0M.MICRO newerthan=6months <2024.10",
+			versions: []string{"2024.03.0", "2024.04.1", "2024.09.3", "2024.10.0"},
+			want:     "2024.09.3",
+		},
+		{
+			name:     "Newer than 1 months",
+			pattern:  "YYYY.0M.0D newerthan=1months",
+			versions: []string{"2024.08.01", "2024.09.14"},
+			wantErr:  true,
+		},
+		{
+			name:     "Truncated version constraint",
+			pattern:  "YYYY.0M.MICRO =2024.04",
+			versions: []string{"2024.03.0", "2024.04.1", "2024.04.2", "2024.05.0"},
+			want:     "2024.04.2",
+		},
+		{
+			name:     "No version matching the format",
+			pattern:  "YYYY.0M",
+			versions: []string{"1.0.0", "v2.0.0"},
+			wantErr:  true,
+		},
+		{
+			name:     "Unsupported constraint",
+			pattern:  "YYYY.0M nextyear",
+			versions: []string{"2024.04"},
+			wantErr:  true,
+		},
+		{
+			name:     "Year constraint without year in the format",
+			pattern:  "MAJOR.MINOR sameyear",
+			versions: []string{"1.0"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Calver{
+				Pattern: tt.pattern,
+				now:     func() time.Time { return now },
+			}
+
+			err := c.Search(tt.versions)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, c.FoundVersion.OriginalVersion)
+		})
+	}
+}
+
+func TestCalverFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		wantErr bool
+	}{
+		{format: "YYYY.0M.MICRO"},
+		{format: "vYY.MM[.MICRO][-MODIFIER]"},
+		{format: "YYYY.[MINOR", wantErr: true},
+		{format: "YYYY.MINOR]", wantErr: true},
+		{format: "[YYYY[.MINOR]]", wantErr: true},
+		{format: "latest", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			_, err := parseCalverFormat(tt.format)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -12,6 +12,10 @@ var (
 	ErrNoVersionsFound error = errors.New("versions list empty")
 	// ErrNoValidSemVerFound return a error when the versions list is empty
 	ErrNoValidSemVerFound error = errors.New("no valid semantic version found")
+	// ErrNoValidPEP440Found return a error when no version is a valid python version
+	ErrNoValidPEP440Found error = errors.New("no valid pep440 version found")
+	// ErrNoValidCalverFound return a error when no version matches the calendar versioning format
+	ErrNoValidCalverFound error = errors.New("no valid calendar version found")
 )
 
 // ErrNoVersionFoundForPattern returns when a given pattern does not find any version
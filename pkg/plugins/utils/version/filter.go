@@ -3,6 +3,7 @@ package version
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -17,6 +18,12 @@ const (
 	SEMVERVERSIONKIND string = "semver"
 	// LATESTVERSIONKIND specifies that we are looking for the latest version of an array
 	LATESTVERSIONKIND string = "latest"
+	// CALVERVERSIONKIND represents versions as a calendar versioning type
+	CALVERVERSIONKIND string = "calver"
+	// PEP440VERSIONKIND represents versions following the python versioning specification
+	PEP440VERSIONKIND string = "pep440"
+	// MAVENVERSIONKIND represents versions following the maven versioning rules
+	MAVENVERSIONKIND string = "maven"
 )
 
 var (
@@ -25,14 +32,18 @@ var (
 		REGEXVERSIONKIND,
 		SEMVERVERSIONKIND,
 		LATESTVERSIONKIND,
+		CALVERVERSIONKIND,
+		PEP440VERSIONKIND,
+		MAVENVERSIONKIND,
 	}
 )
 
 // Filter defines parameters to apply different kind of version matching based on a list of versions
 type Filter struct {
-	// specifies the version kind such as semver, regex, or latest
+	// specifies the version kind such as semver, calver, pep440, maven, regex, or latest
 	Kind string `yaml:",omitempty"`
 	// specifies the version pattern according the version kind
+	// For calver, the pattern starts with the version format followed by optional constraints like "YYYY.0M.MICRO sameyear"
 	Pattern string `yaml:",omitempty"`
 	// strict enforce strict versioning rule. Only used for semantic versioning at this time
 	Strict bool `yaml:",omitempty"`
@@ -52,6 +63,8 @@ func (f Filter) Init() (Filter, error) {
 		f.Pattern = "*"
 	} else if f.Kind == REGEXVERSIONKIND && len(f.Pattern) == 0 {
 		f.Pattern = ".*"
+	} else if (f.Kind == PEP440VERSIONKIND || f.Kind == MAVENVERSIONKIND) && len(f.Pattern) == 0 {
+		f.Pattern = "*"
 	}
 
 	return f, f.Validate()
@@ -70,6 +83,22 @@ func (f Filter) Validate() error {
 	if !ok {
 		return &ErrUnsupportedVersionKind{Kind: f.Kind}
 	}
+
+	switch f.Kind {
+	case CALVERVERSIONKIND:
+		c := Calver{Pattern: f.Pattern}
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	case PEP440VERSIONKIND:
+		if _, err := parsePEP440Specifiers(f.Pattern); err != nil {
+			return err
+		}
+	case MAVENVERSIONKIND:
+		if _, err := parseMavenConstraint(f.Pattern); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -127,6 +156,39 @@ func (f *Filter) Search(versions []string) (Version, error) {
 		}
 
 		return s.FoundVersion, nil
+	case CALVERVERSIONKIND:
+		c := Calver{
+			Pattern: f.Pattern,
+		}
+
+		err := c.Search(versions)
+		if err != nil {
+			return foundVersion, err
+		}
+
+		return c.FoundVersion, nil
+	case PEP440VERSIONKIND:
+		p := PEP440{
+			Constraint: f.Pattern,
+		}
+
+		err := p.Search(versions)
+		if err != nil {
+			return foundVersion, err
+		}
+
+		return p.FoundVersion, nil
+	case MAVENVERSIONKIND:
+		m := Maven{
+			Constraint: f.Pattern,
+		}
+
+		err := m.Search(versions)
+		if err != nil {
+			return foundVersion, err
+		}
+
+		return m.FoundVersion, nil
 	default:
 		return foundVersion, &ErrUnsupportedVersionKindPattern{Pattern: f.Pattern, Kind: f.Kind}
 	}
@@ -149,6 +211,53 @@ func (f *Filter) GreaterThanPattern(version string) (string, error) {
 	case REGEXVERSIONKIND:
 		return f.Pattern, nil
 
+	case CALVERVERSIONKIND:
+		c := Calver{Pattern: f.Pattern}
+		if err := c.Init(nil); err != nil {
+			return "", err
+		}
+
+		// Ensure the current version can be compared with the ones we are looking for
+		if _, err := c.format.parse(version, len(c.format.tokens)); err != nil {
+			return "", err
+		}
+
+		return f.Pattern + " >=" + version, nil
+
+	case PEP440VERSIONKIND:
+		v, err := parsePEP440(version)
+		if err != nil {
+			return "", err
+		}
+
+		return greaterThanReleasePattern(f.Pattern, v.String(), v.release[0], v.release[1:])
+
+	case MAVENVERSIONKIND:
+		v := parseMaven(version)
+
+		release := []int{}
+		for _, segment := range v.releaseSegments() {
+			i, err := strconv.Atoi(segment)
+			if err != nil {
+				return "", fmt.Errorf("maven version %q: %w", version, err)
+			}
+			release = append(release, i)
+		}
+		if len(release) == 0 {
+			return "", fmt.Errorf("maven version %q doesn't start with a number", version)
+		}
+
+		pattern, err := greaterThanReleasePattern(f.Pattern, version, release[0], release[1:])
+		if err != nil {
+			return "", err
+		}
+
+		// 2.0-alpha is the oldest 2.0 version, so "<2.0-alpha" excludes the 2.0 pre-releases
+		if f.Pattern == "patch" || f.Pattern == "minor" {
+			pattern += "-alpha"
+		}
+		return pattern, nil
+
 	case SEMVERVERSIONKIND:
 
 		switch f.Pattern {
@@ -279,3 +388,24 @@ func (f *Filter) GreaterThanPattern(version string) (string, error) {
 	}
 	return "", &ErrUnsupportedVersionKind{Kind: f.Kind}
 }
+
+// greaterThanReleasePattern returns a pattern of comparisons matching versions greater than or equal to version,
+// within the same major or minor release depending on pattern.
+// It is used by version kinds supporting comma separated comparisons like ">=1.2,<2"
+func greaterThanReleasePattern(pattern, version string, major int, rest []int) (string, error) {
+	minor := 0
+	if len(rest) > 0 {
+		minor = rest[0]
+	}
+
+	switch pattern {
+	case "", "*", "major":
+		return ">=" + version, nil
+	case "minor":
+		return fmt.Sprintf(">=%s,<%d", version, major+1), nil
+	case "patch":
+		return fmt.Sprintf(">=%s,<%d.%d", version, major, minor+1), nil
+	}
+
+	return pattern, nil
+}
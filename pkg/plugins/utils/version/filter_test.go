@@ -189,6 +189,30 @@ func TestValidate(t *testing.T) {
 			},
 			wantErr: &ErrUnsupportedVersionKind{Kind: "noExist"},
 		},
+		{
+			name: "Valid calver filter",
+			filter: Filter{
+				Kind:    CALVERVERSIONKIND,
+				Pattern: "YYYY.0M.MICRO sameyear",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Valid pep440 filter",
+			filter: Filter{
+				Kind:    PEP440VERSIONKIND,
+				Pattern: ">=1.2,<2",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Valid maven filter",
+			filter: Filter{
+				Kind:    MAVENVERSIONKIND,
+				Pattern: "[1.2,2.0)",
+			},
+			wantErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -396,6 +420,69 @@ func TestGreaterThanPattern(t *testing.T) {
 			want:    "",
 			wantErr: &ErrIncorrectSemVerConstraint{SemVerConstraint: "1.0 - 2.0 !!!"},
 		},
+		{
+			name: "Calver version kind",
+			filter: Filter{
+				Kind:    CALVERVERSIONKIND,
+				Pattern: "YY.0M",
+			},
+			version: "24.04",
+			want:    "YY.0M >=24.04",
+		},
+		{
+			name: "Calver version kind with constraint",
+			filter: Filter{
+				Kind:    CALVERVERSIONKIND,
+				Pattern: "YYYY.MINOR[.MICRO] <2025",
+			},
+			version: "2024.2",
+			want:    "YYYY.MINOR[.MICRO] <2025 >=2024.2",
+		},
+		{
+			name: "PEP440 version kind with minor pattern",
+			filter: Filter{
+				Kind:    PEP440VERSIONKIND,
+				Pattern: "minor",
+			},
+			version: "1.2rc1",
+			want:    ">=1.2rc1,<2",
+		},
+		{
+			name: "PEP440 version kind with patch pattern",
+			filter: Filter{
+				Kind:    PEP440VERSIONKIND,
+				Pattern: "patch",
+			},
+			version: "1.2.3",
+			want:    ">=1.2.3,<1.3",
+		},
+		{
+			name: "Maven version kind",
+			filter: Filter{
+				Kind:    MAVENVERSIONKIND,
+				Pattern: "*",
+			},
+			version: "1.2.3.Final",
+			want:    ">=1.2.3.Final",
+		},
+		{
+			name: "Maven version kind with patch pattern",
+			filter: Filter{
+				Kind:    MAVENVERSIONKIND,
+				Pattern: "patch",
+			},
+			version: "1.2-SNAPSHOT",
+			want:    ">=1.2-SNAPSHOT,<1.3-alpha",
+		},
+		{
+			name: "Maven version kind with range pattern",
+			filter: Filter{
+				Kind:    MAVENVERSIONKIND,
+				Pattern: "[1.0,2.0)",
+			},
+			version: "1.2",
+			want:    "[1.0,2.0)",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,545 @@
+package version
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// mavenQualifiers lists the well known qualifiers, ordered.
+// Unknown qualifiers sort after them, alphabetically.
+var mavenQualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+
+// mavenQualifierAliases maps qualifiers to their canonical name
+var mavenQualifierAliases = map[string]string{
+	"ga":      "",
+	"final":   "",
+	"release": "",
+	"cr":      "rc",
+}
+
+// mavenReleaseQualifier is the comparable value of a release, without qualifier
+var mavenReleaseQualifier = mavenComparableQualifier("")
+
+// Maven is an interface to compare maven versions
+// following the rules of org.apache.maven.artifact.versioning.ComparableVersion
+type Maven struct {
+	// Constraint is either a comma separated list of comparisons like ">=1.2,<2"
+	// or a maven version range like "[1.2,2.0)"
+	Constraint   string
+	versions     []mavenVersion
+	FoundVersion Version
+}
+
+// mavenItem is an element of a maven version
+type mavenItem interface {
+	// compare returns -1, 0 or 1 when the item is respectively lower, equal or greater than other.
+	// A nil other is the "null" item used to pad the shortest version
+	compare(other mavenItem) int
+	isNull() bool
+}
+
+// mavenIntItem is a numeric item, stored without leading zeros so any size can be compared
+type mavenIntItem string
+
+// mavenStringItem is a qualifier item
+type mavenStringItem string
+
+// mavenListItem is a sub list of items, started by a "-" or a transition between digits and letters
+type mavenListItem []mavenItem
+
+func newMavenIntItem(s string) mavenIntItem {
+	s = strings.TrimLeft(s, "0")
+	return mavenIntItem(s)
+}
+
+func (i mavenIntItem) isNull() bool {
+	return i == ""
+}
+
+func (i mavenIntItem) compare(other mavenItem) int {
+	switch o := other.(type) {
+	case nil:
+		if i.isNull() {
+			return 0
+		}
+		return 1
+	case mavenIntItem:
+		if c := compareInt(len(i), len(o)); c != 0 {
+			return c
+		}
+		return strings.Compare(string(i), string(o))
+	case mavenStringItem:
+		// 1.1 > 1-sp
+		return 1
+	case *mavenListItem:
+		// 1.1 > 1-1
+		return 1
+	}
+	return 0
+}
+
+func newMavenStringItem(s string, followedByDigit bool) mavenStringItem {
+	if followedByDigit && len(s) == 1 {
+		// a1 = alpha-1, b1 = beta-1, m1 = milestone-1
+		switch s {
+		case "a":
+			s = "alpha"
+		case "b":
+			s = "beta"
+		case "m":
+			s = "milestone"
+		}
+	}
+
+	if alias, ok := mavenQualifierAliases[s]; ok {
+		s = alias
+	}
+
+	return mavenStringItem(s)
+}
+
+// mavenComparableQualifier returns a value which can be compared lexically
+func mavenComparableQualifier(qualifier string) string {
+	if i := slices.Index(mavenQualifiers, qualifier); i >= 0 {
+		return fmt.Sprintf("%d", i)
+	}
+	return fmt.Sprintf("%d-%s", len(mavenQualifiers), qualifier)
+}
+
+func (s mavenStringItem) isNull() bool {
+	return mavenComparableQualifier(string(s)) == mavenReleaseQualifier
+}
+
+func (s mavenStringItem) compare(other mavenItem) int {
+	switch o := other.(type) {
+	case nil:
+		// 1-rc < 1, 1-ga == 1, 1-sp > 1
+		return strings.Compare(mavenComparableQualifier(string(s)), mavenReleaseQualifier)
+	case mavenIntItem:
+		// 1-sp < 1.1
+		return -1
+	case mavenStringItem:
+		return strings.Compare(mavenComparableQualifier(string(s)), mavenComparableQualifier(string(o)))
+	case *mavenListItem:
+		// 1-sp < 1-1
+		return -1
+	}
+	return 0
+}
+
+func (l *mavenListItem) isNull() bool {
+	return len(*l) == 0
+}
+
+func (l *mavenListItem) compare(other mavenItem) int {
+	switch o := other.(type) {
+	case nil:
+		if len(*l) == 0 {
+			return 0
+		}
+		return (*l)[0].compare(nil)
+	case mavenIntItem:
+		// 1-1 < 1.0.x
+		return -1
+	case mavenStringItem:
+		// 1-1 > 1-sp
+		return 1
+	case *mavenListItem:
+		for i := 0; i < max(len(*l), len(*o)); i++ {
+			var left, right mavenItem
+			if i < len(*l) {
+				left = (*l)[i]
+			}
+			if i < len(*o) {
+				right = (*o)[i]
+			}
+
+			var result int
+			switch {
+			case left == nil && right == nil:
+				result = 0
+			case left == nil:
+				result = -right.compare(nil)
+			default:
+				result = left.compare(right)
+			}
+
+			if result != 0 {
+				return result
+			}
+		}
+	}
+	return 0
+}
+
+// normalize removes the trailing null items, like the ".0" of "1.0"
+func (l *mavenListItem) normalize() {
+	for i := len(*l) - 1; i >= 0; i-- {
+		item := (*l)[i]
+		if item.isNull() {
+			*l = slices.Delete(*l, i, i+1)
+			continue
+		}
+		if _, ok := item.(*mavenListItem); !ok {
+			break
+		}
+	}
+}
+
+// mavenVersion is a parsed maven version
+type mavenVersion struct {
+	original string
+	items    *mavenListItem
+}
+
+// parseMaven parses a maven version, any string is a valid maven version
+func parseMaven(version string) mavenVersion {
+	root := &mavenListItem{}
+	list := root
+	stack := []*mavenListItem{root}
+
+	value := strings.ToLower(strings.TrimSpace(version))
+
+	parseItem := func(isDigit bool, s string) mavenItem {
+		if isDigit {
+			return newMavenIntItem(s)
+		}
+		return newMavenStringItem(s, false)
+	}
+
+	newList := func() {
+		l := &mavenListItem{}
+		*list = append(*list, l)
+		list = l
+		stack = append(stack, l)
+	}
+
+	isDigit := false
+	start := 0
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+
+		switch {
+		case c == '.' || c == '-':
+			if i == start {
+				*list = append(*list, mavenIntItem(""))
+			} else {
+				*list = append(*list, parseItem(isDigit, value[start:i]))
+			}
+			start = i + 1
+
+			if c == '-' {
+				newList()
+			}
+
+		case c >= '0' && c <= '9':
+			if !isDigit && i > start {
+				*list = append(*list, newMavenStringItem(value[start:i], true))
+				start = i
+				newList()
+			}
+			isDigit = true
+
+		default:
+			if isDigit && i > start {
+				*list = append(*list, parseItem(true, value[start:i]))
+				start = i
+				newList()
+			}
+			isDigit = false
+		}
+	}
+
+	if len(value) > start {
+		// Treat a trailing ".X" as "-X" for any qualifier X, so 1.0.0.X1 < 1.0.0-X2
+		if !isDigit && len(*list) > 0 {
+			newList()
+		}
+		*list = append(*list, parseItem(isDigit, value[start:]))
+	}
+
+	for i := len(stack) - 1; i >= 0; i-- {
+		stack[i].normalize()
+	}
+
+	return mavenVersion{
+		original: version,
+		items:    root,
+	}
+}
+
+// compareMaven returns -1, 0 or 1 if a is respectively older, equal or newer than b
+func compareMaven(a, b mavenVersion) int {
+	return a.items.compare(b.items)
+}
+
+// releaseSegments returns the leading numeric items of the version, like [1 2] for 1.2-SNAPSHOT
+func (v mavenVersion) releaseSegments() []string {
+	segments := []string{}
+	for _, item := range *v.items {
+		i, ok := item.(mavenIntItem)
+		if !ok {
+			break
+		}
+		if i.isNull() {
+			i = "0"
+		}
+		segments = append(segments, string(i))
+	}
+	return segments
+}
+
+// isPrerelease returns true when the version contains a qualifier older than a release,
+// like alpha, beta, milestone, rc or snapshot
+func (v mavenVersion) isPrerelease() bool {
+	var walk func(l *mavenListItem) bool
+	walk = func(l *mavenListItem) bool {
+		for _, item := range *l {
+			switch i := item.(type) {
+			case mavenStringItem:
+				if i.compare(nil) < 0 {
+					return true
+				}
+			case *mavenListItem:
+				if walk(i) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return walk(v.items)
+}
+
+// mavenRestriction is a version interval, a nil bound is unbounded
+type mavenRestriction struct {
+	lower, upper                   *mavenVersion
+	lowerInclusive, upperInclusive bool
+}
+
+func (r mavenRestriction) match(v mavenVersion) bool {
+	if r.lower != nil {
+		c := compareMaven(v, *r.lower)
+		if c < 0 || (c == 0 && !r.lowerInclusive) {
+			return false
+		}
+	}
+	if r.upper != nil {
+		c := compareMaven(v, *r.upper)
+		if c > 0 || (c == 0 && !r.upperInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+// mavenConstraint is a set of restrictions which must all match,
+// or any match when defined by a maven version range
+type mavenConstraint struct {
+	restrictions []mavenRestriction
+	exclusions   []mavenVersion
+	any          bool
+	// prerelease is true when the constraint names a pre-release,
+	// otherwise pre-releases and snapshots are skipped.
+	// An exclusive upper bound like "<2.0-alpha" doesn't count as it is used to exclude them.
+	prerelease bool
+}
+
+func (c mavenConstraint) match(v mavenVersion) bool {
+	for _, excluded := range c.exclusions {
+		if compareMaven(v, excluded) == 0 {
+			return false
+		}
+	}
+
+	if len(c.restrictions) == 0 {
+		return true
+	}
+
+	for _, r := range c.restrictions {
+		matching := r.match(v)
+		if c.any && matching {
+			return true
+		}
+		if !c.any && !matching {
+			return false
+		}
+	}
+
+	return !c.any
+}
+
+// parseMavenConstraint parses either a maven version range like "[1.0,2.0),[3.0,)"
+// or a comma separated list of comparisons like ">=1.0,<2.0,!=1.5"
+func parseMavenConstraint(constraint string) (mavenConstraint, error) {
+	constraint = strings.TrimSpace(constraint)
+
+	if strings.HasPrefix(constraint, "[") || strings.HasPrefix(constraint, "(") {
+		return parseMavenRange(constraint)
+	}
+
+	c := mavenConstraint{}
+	for _, s := range strings.Split(constraint, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" || s == "*" {
+			continue
+		}
+
+		operator := "="
+		for _, op := range []string{">=", "<=", "!=", "==", ">", "<", "="} {
+			if value, found := strings.CutPrefix(s, op); found {
+				operator = op
+				s = strings.TrimSpace(value)
+				break
+			}
+		}
+
+		if s == "" {
+			return mavenConstraint{}, fmt.Errorf("invalid maven constraint %q: missing version", constraint)
+		}
+
+		v := parseMaven(s)
+		if operator != "<" && operator != "!=" && v.isPrerelease() {
+			c.prerelease = true
+		}
+
+		switch operator {
+		case ">=":
+			c.restrictions = append(c.restrictions, mavenRestriction{lower: &v, lowerInclusive: true})
+		case ">":
+			c.restrictions = append(c.restrictions, mavenRestriction{lower: &v})
+		case "<=":
+			c.restrictions = append(c.restrictions, mavenRestriction{upper: &v, upperInclusive: true})
+		case "<":
+			c.restrictions = append(c.restrictions, mavenRestriction{upper: &v})
+		case "!=":
+			c.exclusions = append(c.exclusions, v)
+		default:
+			c.restrictions = append(c.restrictions, mavenRestriction{lower: &v, upper: &v, lowerInclusive: true, upperInclusive: true})
+		}
+	}
+
+	return c, nil
+}
+
+// parseMavenRange parses a maven version range, as documented on
+// https://maven.apache.org/enforcer/enforcer-rules/versionRanges.html
+func parseMavenRange(constraint string) (mavenConstraint, error) {
+	c := mavenConstraint{any: true}
+
+	rest := constraint
+	for rest != "" {
+		if rest[0] != '[' && rest[0] != '(' {
+			return mavenConstraint{}, fmt.Errorf("invalid maven version range %q", constraint)
+		}
+
+		end := strings.IndexAny(rest, "])")
+		if end < 0 {
+			return mavenConstraint{}, fmt.Errorf("invalid maven version range %q: missing closing bracket", constraint)
+		}
+
+		r := mavenRestriction{
+			lowerInclusive: rest[0] == '[',
+			upperInclusive: rest[end] == ']',
+		}
+
+		bounds := strings.Split(rest[1:end], ",")
+		switch len(bounds) {
+		case 1:
+			// [1.0] is an exact version
+			if !r.lowerInclusive || !r.upperInclusive || strings.TrimSpace(bounds[0]) == "" {
+				return mavenConstraint{}, fmt.Errorf("invalid maven version range %q: single version must be enclosed in []", constraint)
+			}
+			v := parseMaven(bounds[0])
+			r.lower, r.upper = &v, &v
+		case 2:
+			if lower := strings.TrimSpace(bounds[0]); lower != "" {
+				v := parseMaven(lower)
+				r.lower = &v
+			}
+			if upper := strings.TrimSpace(bounds[1]); upper != "" {
+				v := parseMaven(upper)
+				r.upper = &v
+			}
+			if r.lower != nil && r.upper != nil && compareMaven(*r.lower, *r.upper) > 0 {
+				return mavenConstraint{}, fmt.Errorf("invalid maven version range %q: lower bound is greater than upper bound", constraint)
+			}
+		default:
+			return mavenConstraint{}, fmt.Errorf("invalid maven version range %q", constraint)
+		}
+
+		if (r.lower != nil && r.lower.isPrerelease()) || (r.upper != nil && r.upperInclusive && r.upper.isPrerelease()) {
+			c.prerelease = true
+		}
+
+		c.restrictions = append(c.restrictions, r)
+
+		rest = strings.TrimSpace(rest[end+1:])
+		rest = strings.TrimSpace(strings.TrimPrefix(rest, ","))
+	}
+
+	return c, nil
+}
+
+// Init parses the list of maven versions
+func (m *Maven) Init(versions []string) error {
+	m.versions = nil
+
+	for _, version := range versions {
+		if strings.TrimSpace(version) == "" {
+			logrus.Debugf("Skipping empty version")
+			continue
+		}
+		m.versions = append(m.versions, parseMaven(version))
+	}
+
+	if len(m.versions) > 0 {
+		return nil
+	}
+
+	return ErrNoVersionsFound
+}
+
+// Sort re-order a list of versions with the newest version first
+func (m *Maven) Sort() {
+	slices.SortStableFunc(m.versions, func(a, b mavenVersion) int {
+		return compareMaven(b, a)
+	})
+}
+
+// Search returns the newest version matching the constraint.
+// Pre-releases and snapshots are ignored unless the constraint names one
+func (m *Maven) Search(versions []string) error {
+	// We need to be sure that at least one version exist
+	if len(versions) == 0 {
+		return ErrNoVersionsFound
+	}
+
+	constraint, err := parseMavenConstraint(m.Constraint)
+	if err != nil {
+		return err
+	}
+
+	if err := m.Init(versions); err != nil {
+		return err
+	}
+
+	m.Sort()
+
+	for _, v := range m.versions {
+		if !constraint.prerelease && v.isPrerelease() {
+			continue
+		}
+		if constraint.match(v) {
+			m.FoundVersion.ParsedVersion = v.original
+			m.FoundVersion.OriginalVersion = v.original
+			return nil
+		}
+	}
+
+	return &ErrNoVersionFoundForPattern{Pattern: m.Constraint}
+}
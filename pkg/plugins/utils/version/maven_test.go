@@ -0,0 +1,160 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareMaven(t *testing.T) {
+	// Ordered versions from the maven ComparableVersion test suite
+	ordered := [][]string{
+		{
+			"1-alpha2snapshot", "1-alpha2", "1-alpha-123", "1-beta-2", "1-beta123", "1-m2", "1-m11", "1-rc", "1-cr2",
+			"1-rc123", "1-SNAPSHOT", "1", "1-sp", "1-sp2", "1-sp123", "1-abc", "1-def", "1-pom-1", "1-1-snapshot",
+			"1-1", "1-2", "1-123",
+		},
+		{
+			"2.0", "2.0.a", "2-1", "2.0.2", "2.0.123", "2.1.0", "2.1-a", "2.1b", "2.1-c", "2.1-1", "2.1.0.1", "2.2",
+			"2.123", "11.a2", "11.a11", "11.b2", "11.b11", "11.m2", "11.m11", "11", "11.a", "11b", "11c", "11m",
+		},
+	}
+
+	for _, versions := range ordered {
+		for i := 0; i < len(versions)-1; i++ {
+			a := parseMaven(versions[i])
+			b := parseMaven(versions[i+1])
+
+			assert.Equal(t, -1, compareMaven(a, b), "%s < %s", versions[i], versions[i+1])
+			assert.Equal(t, 1, compareMaven(b, a), "%s > %s", versions[i+1], versions[i])
+		}
+	}
+
+	equals := [][2]string{
+		{"1", "1.0.0"},
+		{"1.0-ga", "1"},
+		{"1.0.Final", "1"},
+		{"1-release", "1"},
+		{"1a1", "1-alpha-1"},
+		{"1b2", "1-beta-2"},
+		{"1m3", "1-milestone-3"},
+		{"1cr1", "1-rc-1"},
+		{"1.0000000000000000000000001", "1.1"},
+	}
+
+	for _, versions := range equals {
+		assert.Equal(t, 0, compareMaven(parseMaven(versions[0]), parseMaven(versions[1])), "%s == %s", versions[0], versions[1])
+	}
+}
+
+func TestMavenSearch(t *testing.T) {
+	versions := []string{"1.0-alpha-1", "1.0", "1.0.Final", "1.1-SNAPSHOT", "1.2", "1.10", "2.0-beta-1", "2.0", "3.0-SNAPSHOT"}
+
+	tests := []struct {
+		name       string
+		constraint string
+		versions   []string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:     "Newest version",
+			versions: versions,
+			want:     "2.0",
+		},
+		{
+			name:       "Newest version ignoring snapshots",
+			constraint: "*",
+			versions:   []string{"1.4", "1.5-SNAPSHOT"},
+			want:       "1.4",
+		},
+		{
+			name:       "Newest version ignoring pre-releases",
+			constraint: ">=1.9.10",
+			versions:   []string{"1.9.20", "2.0.0-RC1", "2.0.0-SNAPSHOT"},
+			want:       "1.9.20",
+		},
+		{
+			name:       "Pre-release named by the constraint",
+			constraint: ">=2.0-beta-1",
+			versions:   versions,
+			want:       "3.0-SNAPSHOT",
+		},
+		{
+			name:       "Comparison range",
+			constraint: ">=1.2,<2",
+			versions:   versions,
+			want:       "1.10",
+		},
+		{
+			name:       "Comparison range excluding pre-releases",
+			constraint: ">=1.2,<2-alpha",
+			versions:   versions,
+			want:       "1.10",
+		},
+		{
+			name:       "Maven version range",
+			constraint: "[1.0,1.10)",
+			versions:   versions,
+			want:       "1.2",
+		},
+		{
+			name:       "Maven version range ignoring snapshots",
+			constraint: "[1.0,2.0)",
+			versions:   []string{"1.9", "2.0-SNAPSHOT"},
+			want:       "1.9",
+		},
+		{
+			name:       "Maven version range naming a pre-release",
+			constraint: "[2.0-alpha,2.0)",
+			versions:   versions,
+			want:       "2.0-beta-1",
+		},
+		{
+			name:       "Maven version range with several sets",
+			constraint: "(,1.0],[1.2,1.3)",
+			versions:   versions,
+			want:       "1.2",
+		},
+		{
+			name:       "Maven exact version",
+			constraint: "[2.0]",
+			versions:   versions,
+			want:       "2.0",
+		},
+		{
+			name:       "Exclusion",
+			constraint: "<3-alpha,!=2.0",
+			versions:   versions,
+			want:       "1.10",
+		},
+		{
+			name:       "Invalid range",
+			constraint: "[2.0,1.0]",
+			versions:   versions,
+			wantErr:    true,
+		},
+		{
+			name:       "No matching version",
+			constraint: ">4",
+			versions:   versions,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Maven{Constraint: tt.constraint}
+
+			err := m.Search(tt.versions)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, m.FoundVersion.OriginalVersion)
+		})
+	}
+}
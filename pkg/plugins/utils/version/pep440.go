@@ -0,0 +1,506 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// pep440Regex is the version regular expression defined by https://peps.python.org/pep-0440/#appendix-b-parsing-version-strings-with-regular-expressions
+var pep440Regex = regexp.MustCompile(`(?i)^v?` +
+	`(?:(?P<epoch>[0-9]+)!)?` +
+	`(?P<release>[0-9]+(?:\.[0-9]+)*)` +
+	`(?P<pre>[-_\.]?(?P<pre_l>alpha|beta|preview|pre|a|b|c|rc)[-_\.]?(?P<pre_n>[0-9]+)?)?` +
+	`(?P<post>(?:-(?P<post_n1>[0-9]+))|(?:[-_\.]?(?P<post_l>post|rev|r)[-_\.]?(?P<post_n2>[0-9]+)?))?` +
+	`(?P<dev>[-_\.]?(?P<dev_l>dev)[-_\.]?(?P<dev_n>[0-9]+)?)?` +
+	`(?:\+(?P<local>[a-z0-9]+(?:[-_\.][a-z0-9]+)*))?$`)
+
+// pep440PreReleases lists the normalized pre-release labels, ordered
+var pep440PreReleases = []string{"a", "b", "rc"}
+
+// PEP440 is an interface to compare python versions, as defined by https://peps.python.org/pep-0440/
+type PEP440 struct {
+	// Constraint is a comma separated list of version specifiers like ">=1.2,<2"
+	Constraint   string
+	versions     []pep440Version
+	FoundVersion Version
+}
+
+// pep440Version is a parsed python version
+type pep440Version struct {
+	original string
+	epoch    int
+	release  []int
+	// pre holds the pre-release label index in pep440PreReleases, or -1
+	pre     int
+	preN    int
+	hasPost bool
+	postN   int
+	hasDev  bool
+	devN    int
+	local   []string
+}
+
+// parsePEP440 parses a python version
+func parsePEP440(version string) (pep440Version, error) {
+	matches := pep440Regex.FindStringSubmatch(strings.TrimSpace(version))
+	if matches == nil {
+		return pep440Version{}, fmt.Errorf("invalid pep440 version %q", version)
+	}
+
+	group := func(name string) string {
+		return strings.ToLower(matches[pep440Regex.SubexpIndex(name)])
+	}
+
+	atoi := func(s string) int {
+		// The regular expression only captures digits, so errors can only be overflows
+		i, _ := strconv.Atoi(s)
+		return i
+	}
+
+	v := pep440Version{
+		original: version,
+		epoch:    atoi(group("epoch")),
+		pre:      -1,
+	}
+
+	for _, segment := range strings.Split(group("release"), ".") {
+		v.release = append(v.release, atoi(segment))
+	}
+
+	switch group("pre_l") {
+	case "":
+	case "a", "alpha":
+		v.pre = 0
+	case "b", "beta":
+		v.pre = 1
+	default:
+		// c, pre, preview and rc are release candidates
+		v.pre = 2
+	}
+	v.preN = atoi(group("pre_n"))
+
+	if group("post") != "" {
+		v.hasPost = true
+		v.postN = atoi(group("post_n1") + group("post_n2"))
+	}
+
+	if group("dev") != "" {
+		v.hasDev = true
+		v.devN = atoi(group("dev_n"))
+	}
+
+	if local := group("local"); local != "" {
+		v.local = strings.FieldsFunc(local, func(r rune) bool {
+			return r == '-' || r == '_' || r == '.'
+		})
+	}
+
+	return v, nil
+}
+
+// isPrerelease returns true for pre-release and development versions
+func (v pep440Version) isPrerelease() bool {
+	return v.pre >= 0 || v.hasDev
+}
+
+// public returns the version without its local label
+func (v pep440Version) public() pep440Version {
+	v.local = nil
+	return v
+}
+
+// String returns the normalized version
+func (v pep440Version) String() string {
+	var sb strings.Builder
+
+	if v.epoch != 0 {
+		fmt.Fprintf(&sb, "%d!", v.epoch)
+	}
+
+	release := []string{}
+	for _, segment := range v.release {
+		release = append(release, strconv.Itoa(segment))
+	}
+	sb.WriteString(strings.Join(release, "."))
+
+	if v.pre >= 0 {
+		fmt.Fprintf(&sb, "%s%d", pep440PreReleases[v.pre], v.preN)
+	}
+	if v.hasPost {
+		fmt.Fprintf(&sb, ".post%d", v.postN)
+	}
+	if v.hasDev {
+		fmt.Fprintf(&sb, ".dev%d", v.devN)
+	}
+	if len(v.local) > 0 {
+		sb.WriteString("+" + strings.Join(v.local, "."))
+	}
+
+	return sb.String()
+}
+
+// comparePEP440 returns -1, 0 or 1 if a is respectively older, equal or newer than b
+func comparePEP440(a, b pep440Version) int {
+	if c := compareInt(a.epoch, b.epoch); c != 0 {
+		return c
+	}
+
+	// Trailing zeros are not significant, 1.0 == 1.0.0
+	for i := 0; i < max(len(a.release), len(b.release)); i++ {
+		var x, y int
+		if i < len(a.release) {
+			x = a.release[i]
+		}
+		if i < len(b.release) {
+			y = b.release[i]
+		}
+		if c := compareInt(x, y); c != 0 {
+			return c
+		}
+	}
+
+	// A development release without pre or post release sorts before any pre-release: 1.0.dev0 < 1.0a0
+	preKey := func(v pep440Version) (int, int) {
+		switch {
+		case v.pre < 0 && !v.hasPost && v.hasDev:
+			return -1, 0
+		case v.pre < 0:
+			return len(pep440PreReleases), 0
+		}
+		return v.pre, v.preN
+	}
+	aPre, aPreN := preKey(a)
+	bPre, bPreN := preKey(b)
+	if c := compareInt(aPre, bPre); c != 0 {
+		return c
+	}
+	if c := compareInt(aPreN, bPreN); c != 0 {
+		return c
+	}
+
+	// A version without post release sorts before any post release
+	if c := compareBool(a.hasPost, b.hasPost); c != 0 {
+		return c
+	}
+	if c := compareInt(a.postN, b.postN); c != 0 {
+		return c
+	}
+
+	// A version without development release sorts after any development release
+	if c := compareBool(!a.hasDev, !b.hasDev); c != 0 {
+		return c
+	}
+	if c := compareInt(a.devN, b.devN); c != 0 {
+		return c
+	}
+
+	return compareLocal(a.local, b.local)
+}
+
+// compareLocal compares local version labels where numeric segments sort after alphanumeric ones
+func compareLocal(a, b []string) int {
+	for i := 0; i < min(len(a), len(b)); i++ {
+		x, xErr := strconv.Atoi(a[i])
+		y, yErr := strconv.Atoi(b[i])
+
+		switch {
+		case xErr == nil && yErr == nil:
+			if c := compareInt(x, y); c != 0 {
+				return c
+			}
+		case xErr == nil:
+			return 1
+		case yErr == nil:
+			return -1
+		default:
+			if c := strings.Compare(a[i], b[i]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	return compareInt(len(a), len(b))
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+	return -1
+}
+
+// pep440Specifier is a single version specifier like ">=1.2"
+type pep440Specifier struct {
+	operator string
+	version  pep440Version
+	raw      string
+	// wildcard is true for specifiers like "==1.2.*"
+	wildcard bool
+}
+
+var pep440Operators = []string{"===", "~=", "==", "!=", "<=", ">=", "<", ">"}
+
+// parsePEP440Specifiers parses a comma separated list of version specifiers
+func parsePEP440Specifiers(constraint string) ([]pep440Specifier, error) {
+	specifiers := []pep440Specifier{}
+
+	for _, s := range strings.Split(constraint, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" || s == "*" {
+			continue
+		}
+
+		specifier := pep440Specifier{operator: "=="}
+		for _, operator := range pep440Operators {
+			if value, found := strings.CutPrefix(s, operator); found {
+				specifier.operator = operator
+				s = strings.TrimSpace(value)
+				break
+			}
+		}
+		specifier.raw = s
+
+		if specifier.operator == "===" {
+			specifiers = append(specifiers, specifier)
+			continue
+		}
+
+		if value, found := strings.CutSuffix(s, ".*"); found {
+			if specifier.operator != "==" && specifier.operator != "!=" {
+				return nil, fmt.Errorf("invalid pep440 specifier %q: wildcards are only allowed with == and !=", specifier.operator+s)
+			}
+			specifier.wildcard = true
+			s = value
+		}
+
+		v, err := parsePEP440(s)
+		if err != nil {
+			return nil, err
+		}
+		specifier.version = v
+
+		if specifier.operator == "~=" && len(v.release) < 2 {
+			return nil, fmt.Errorf("invalid pep440 specifier %q: compatible release requires at least two release segments", "~="+s)
+		}
+
+		specifiers = append(specifiers, specifier)
+	}
+
+	return specifiers, nil
+}
+
+// match returns true if v satisfies the specifier
+func (s pep440Specifier) match(v pep440Version) bool {
+	switch s.operator {
+	case "===":
+		return strings.EqualFold(strings.TrimSpace(v.original), s.raw)
+
+	case "~=":
+		prefix := s.version
+		prefix.release = prefix.release[:len(prefix.release)-1]
+		return comparePEP440(v, s.version) >= 0 && pep440PrefixMatch(v, prefix)
+
+	case "==", "!=":
+		var equal bool
+		switch {
+		case s.wildcard:
+			equal = pep440PrefixMatch(v, s.version)
+		case len(s.version.local) == 0:
+			equal = comparePEP440(v.public(), s.version) == 0
+		default:
+			equal = comparePEP440(v, s.version) == 0
+		}
+		return equal == (s.operator == "==")
+
+	case "<=":
+		return comparePEP440(v.public(), s.version) <= 0
+
+	case ">=":
+		return comparePEP440(v.public(), s.version) >= 0
+
+	case "<":
+		if comparePEP440(v.public(), s.version) >= 0 {
+			return false
+		}
+		// <1.0 must not match 1.0rc1, unless the specifier is a pre-release
+		if !s.version.isPrerelease() && v.isPrerelease() && pep440SameRelease(v, s.version) {
+			return false
+		}
+		return true
+
+	case ">":
+		if comparePEP440(v.public(), s.version) <= 0 {
+			return false
+		}
+		// >1.0 must not match 1.0.post1, unless the specifier is a post-release
+		if !s.version.hasPost && v.hasPost && pep440SameRelease(v, s.version) {
+			return false
+		}
+		return true
+	}
+
+	return false
+}
+
+// pep440PrefixMatch returns true if the release of v starts with the release of prefix
+func pep440PrefixMatch(v, prefix pep440Version) bool {
+	if v.epoch != prefix.epoch {
+		return false
+	}
+
+	for i, segment := range prefix.release {
+		value := 0
+		if i < len(v.release) {
+			value = v.release[i]
+		}
+		if value != segment {
+			return false
+		}
+	}
+
+	// Pre, post and development parts of the prefix must match as well
+	if prefix.pre >= 0 || prefix.hasPost || prefix.hasDev {
+		v.local = nil
+		v.release = v.release[:min(len(v.release), len(prefix.release))]
+		return comparePEP440(v, prefix) == 0
+	}
+
+	return true
+}
+
+// pep440SameRelease returns true if a and b share the same epoch and release segments
+func pep440SameRelease(a, b pep440Version) bool {
+	a = pep440Version{epoch: a.epoch, release: a.release, pre: -1}
+	b = pep440Version{epoch: b.epoch, release: b.release, pre: -1}
+	return comparePEP440(a, b) == 0
+}
+
+// MatchPEP440 returns true if the python version satisfies every specifier
+// of the constraint, such as ">=3.8,!=3.9.*"
+func MatchPEP440(version, constraint string) (bool, error) {
+	v, err := parsePEP440(version)
+	if err != nil {
+		return false, err
+	}
+
+	specifiers, err := parsePEP440Specifiers(constraint)
+	if err != nil {
+		return false, err
+	}
+
+	for _, s := range specifiers {
+		if !s.match(v) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Init parses the list of python versions, invalid ones are skipped
+func (p *PEP440) Init(versions []string) error {
+	p.versions = nil
+
+	for _, version := range versions {
+		v, err := parsePEP440(version)
+		if err != nil {
+			logrus.Debugf("Skipping %q because %s", version, err)
+			continue
+		}
+		p.versions = append(p.versions, v)
+	}
+
+	if len(p.versions) > 0 {
+		return nil
+	}
+
+	return ErrNoValidPEP440Found
+}
+
+// Sort re-order a list of versions with the newest version first
+func (p *PEP440) Sort() {
+	slices.SortStableFunc(p.versions, func(a, b pep440Version) int {
+		return comparePEP440(b, a)
+	})
+}
+
+// Search returns the newest version matching the constraint.
+// Pre-releases are only returned when the constraint explicitly mentions one,
+// or when no final release matches the constraint.
+func (p *PEP440) Search(versions []string) error {
+	// We need to be sure that at least one version exist
+	if len(versions) == 0 {
+		return ErrNoVersionsFound
+	}
+
+	specifiers, err := parsePEP440Specifiers(p.Constraint)
+	if err != nil {
+		return err
+	}
+
+	if err := p.Init(versions); err != nil {
+		return err
+	}
+
+	p.Sort()
+
+	allowPrerelease := false
+	for _, s := range specifiers {
+		if s.operator != "===" && s.version.isPrerelease() {
+			allowPrerelease = true
+		}
+	}
+
+	var found *pep440Version
+	for i := range p.versions {
+		v := p.versions[i]
+
+		matching := true
+		for _, s := range specifiers {
+			if !s.match(v) {
+				matching = false
+				break
+			}
+		}
+		if !matching {
+			continue
+		}
+
+		if !v.isPrerelease() || allowPrerelease {
+			found = &v
+			break
+		}
+
+		// Keep the newest matching pre-release in case no final release matches
+		if found == nil {
+			found = &v
+		}
+	}
+
+	if found == nil {
+		return &ErrNoVersionFoundForPattern{Pattern: p.Constraint}
+	}
+
+	p.FoundVersion.ParsedVersion = found.String()
+	p.FoundVersion.OriginalVersion = found.original
+
+	return nil
+}
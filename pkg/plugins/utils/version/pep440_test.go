@@ -0,0 +1,183 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComparePEP440(t *testing.T) {
+	// Ordered list of versions from the PEP 440 specification examples
+	ordered := []string{
+		"1.0.dev456",
+		"1.0a1",
+		"1.0a2.dev456",
+		"1.0a12.dev456",
+		"1.0a12",
+		"1.0b1.dev456",
+		"1.0b2",
+		"1.0b2.post345.dev456",
+		"1.0b2.post345",
+		"1.0rc1.dev456",
+		"1.0rc1",
+		"1.0",
+		"1.0+abc.5",
+		"1.0+abc.7",
+		"1.0+5",
+		"1.0.post456.dev34",
+		"1.0.post456",
+		"1.0.15",
+		"1.1.dev1",
+		"2!0.1",
+	}
+
+	for i := 0; i < len(ordered)-1; i++ {
+		a, err := parsePEP440(ordered[i])
+		require.NoError(t, err)
+		b, err := parsePEP440(ordered[i+1])
+		require.NoError(t, err)
+
+		assert.Equal(t, -1, comparePEP440(a, b), "%s < %s", ordered[i], ordered[i+1])
+		assert.Equal(t, 1, comparePEP440(b, a), "%s > %s", ordered[i+1], ordered[i])
+	}
+
+	equals := [][2]string{
+		{"1.0", "1.0.0"},
+		{"1.0RC1", "1.0rc1"},
+		{"1.0c1", "1.0rc1"},
+		{"1.0-alpha.1", "1.0a1"},
+		{"v1.0-1", "1.0.post1"},
+		{"1.0.post", "1.0.post0"},
+	}
+
+	for _, versions := range equals {
+		a, err := parsePEP440(versions[0])
+		require.NoError(t, err)
+		b, err := parsePEP440(versions[1])
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, comparePEP440(a, b), "%s == %s", versions[0], versions[1])
+	}
+}
+
+func TestPEP440Search(t *testing.T) {
+	versions := []string{"1.0", "1.1rc1", "1.1", "1.1.post1", "1.2.0", "1.2.1", "2.0rc1", "2.0", "2!0.1", "invalid"}
+
+	tests := []struct {
+		name       string
+		constraint string
+		versions   []string
+		want       Version
+		wantErr    bool
+	}{
+		{
+			name:     "Newest version",
+			versions: versions,
+			want:     Version{ParsedVersion: "2!0.1", OriginalVersion: "2!0.1"},
+		},
+		{
+			name:       "Range",
+			constraint: ">=1.2,<2",
+			versions:   versions,
+			want:       Version{ParsedVersion: "1.2.1", OriginalVersion: "1.2.1"},
+		},
+		{
+			name:       "Lower than excludes the pre-releases of the excluded version",
+			constraint: "<2.0",
+			versions:   versions,
+			want:       Version{ParsedVersion: "1.2.1", OriginalVersion: "1.2.1"},
+		},
+		{
+			name:       "Explicit pre-release",
+			constraint: ">=2.0rc1,<2!0",
+			versions:   []string{"1.0", "2.0rc1", "2.0rc2"},
+			want:       Version{ParsedVersion: "2.0rc2", OriginalVersion: "2.0rc2"},
+		},
+		{
+			name:       "Pre-release returned when no final release matches",
+			constraint: ">=2.0rc1",
+			versions:   []string{"1.0", "2.1a1"},
+			want:       Version{ParsedVersion: "2.1a1", OriginalVersion: "2.1a1"},
+		},
+		{
+			name:       "Compatible release",
+			constraint: "~=1.1",
+			versions:   versions,
+			want:       Version{ParsedVersion: "1.2.1", OriginalVersion: "1.2.1"},
+		},
+		{
+			name:       "Prefix matching",
+			constraint: "==1.1.*",
+			versions:   versions,
+			want:       Version{ParsedVersion: "1.1.post1", OriginalVersion: "1.1.post1"},
+		},
+		{
+			name:       "Greater than excludes post-releases",
+			constraint: ">1.1,<1.2",
+			versions:   versions,
+			wantErr:    true,
+		},
+		{
+			name:       "Exclusion",
+			constraint: ">=1.2,!=1.2.1,<2",
+			versions:   versions,
+			want:       Version{ParsedVersion: "1.2.0", OriginalVersion: "1.2.0"},
+		},
+		{
+			name:       "Invalid specifier",
+			constraint: ">=1.*",
+			versions:   versions,
+			wantErr:    true,
+		},
+		{
+			name:     "No valid version",
+			versions: []string{"latest", "main"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := PEP440{Constraint: tt.constraint}
+
+			err := p.Search(tt.versions)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, p.FoundVersion)
+		})
+	}
+}
+
+func TestMatchPEP440(t *testing.T) {
+	tests := []struct {
+		version    string
+		constraint string
+		want       bool
+		wantErr    bool
+	}{
+		{version: "3.12", constraint: ">=3.8", want: true},
+		{version: "3.7.17", constraint: ">=3.8", want: false},
+		{version: "3.9.1", constraint: ">=3.8,!=3.9.*", want: false},
+		{version: "3.10", constraint: ">=3.8, !=3.9.*, <4", want: true},
+		{version: "3.12", constraint: "", want: true},
+		{version: "3.12", constraint: ">=three", wantErr: true},
+		{version: "latest", constraint: ">=3.8", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version+" "+tt.constraint, func(t *testing.T) {
+			got, err := MatchPEP440(tt.version, tt.constraint)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}